@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -641,10 +643,11 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 			)
 	}
 
-	// branch the commit-multistore for safety
+	// branch the commit-multistore for safety, the block height being the one of
+	// the queried state so that queries at a past height are computed as of it
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithBlockHeight(height)
 
 	return ctx, nil
 }
@@ -770,6 +773,18 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.appVersion),
 			}
 
+		case "status":
+			bz, err := json.Marshal(app.appStatus())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode app status"))
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -778,11 +793,32 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be either 'simulate', 'version' or 'status', none was present",
 		),
 	)
 }
 
+// appStatus returns the application level status of the node: its stored
+// heights, pruning configuration and minimum gas prices.
+func (app *BaseApp) appStatus() sdk.AppStatusResponse {
+	latest := app.LastBlockHeight()
+	earliest := latest
+	if rms, ok := app.cms.(*rootmulti.Store); ok {
+		earliest = rms.EarliestVersion()
+	}
+
+	pruning := app.cms.GetPruning()
+
+	return sdk.AppStatusResponse{
+		EarliestStoreHeight: earliest,
+		LatestStoreHeight:   latest,
+		PruningKeepRecent:   pruning.KeepRecent,
+		PruningKeepEvery:    pruning.KeepEvery,
+		PruningInterval:     pruning.Interval,
+		MinGasPrices:        app.minGasPrices,
+	}
+}
+
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(sdk.Queryable)
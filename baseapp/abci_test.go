@@ -139,3 +139,24 @@ func TestBaseAppCreateQueryContextRejectsNegativeHeights(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseAppCreateQueryContextHeight(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmprototypes.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the latest height is queried by default
+	ctx, err := app.createQueryContext(0, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), ctx.BlockHeight())
+
+	// queries at a past height are run as of that height
+	ctx, err = app.createQueryContext(2, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), ctx.BlockHeight())
+}
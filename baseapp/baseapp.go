@@ -59,6 +59,7 @@ type BaseApp struct { // nolint: maligned
 	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
 
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	postHandler    sdk.PostHandler  // post handler run after the messages of successful txs
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -502,6 +503,24 @@ func validateBasicTxMsgs(msgs []sdk.Msg) error {
 	return nil
 }
 
+// getTxSigners returns the unique signers of the messages in order of their
+// first appearance.
+func getTxSigners(msgs []sdk.Msg) []sdk.AccAddress {
+	var signers []sdk.AccAddress
+	seen := map[string]bool{}
+
+	for _, msg := range msgs {
+		for _, addr := range msg.GetSigners() {
+			if !seen[string(addr)] {
+				signers = append(signers, addr)
+				seen[string(addr)] = true
+			}
+		}
+	}
+
+	return signers
+}
+
 // Returns the applications's deliverState if app is in runTxModeDeliver,
 // otherwise it returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
@@ -516,6 +535,7 @@ func (app *BaseApp) getState(mode runTxMode) *state {
 func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context {
 	ctx := app.getState(mode).ctx.
 		WithTxBytes(txBytes).
+		WithTxHash(tmhash.Sum(txBytes)).
 		WithVoteInfos(app.voteInfos)
 
 	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
@@ -613,6 +633,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		return sdk.GasInfo{}, nil, err
 	}
 
+	ctx = ctx.WithTxSigners(getTxSigners(msgs))
+
 	var events sdk.Events
 	if app.anteHandler != nil {
 		var (
@@ -662,6 +684,15 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+	if err == nil && app.postHandler != nil && mode != runTxModeCheck && mode != runTxModeReCheck {
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		if err := app.postHandler(postCtx, tx, mode == runTxModeSimulate); err != nil {
+			return gInfo, nil, err
+		}
+
+		result.Events = append(result.Events, postCtx.EventManager().ABCIEvents()...)
+	}
+
 	if err == nil && mode == runTxModeDeliver {
 		msCache.Write()
 
@@ -700,23 +731,25 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err       error
 		)
 
+		msgCtx := ctx.WithMsgIndex(i)
+
 		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
 			msgFqName = svcMsg.MethodName
 			handler := app.msgServiceRouter.Handler(msgFqName)
 			if handler == nil {
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message service method: %s; message index: %d", msgFqName, i)
 			}
-			msgResult, err = handler(ctx, svcMsg.Request)
+			msgResult, err = handler(msgCtx, svcMsg.Request)
 		} else {
 			// legacy sdk.Msg routing
 			msgRoute := msg.Route()
 			msgFqName = msg.Type()
-			handler := app.router.Route(ctx, msgRoute)
+			handler := app.router.Route(msgCtx, msgRoute)
 			if handler == nil {
 				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
 			}
 
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 		}
 
 		if err != nil {
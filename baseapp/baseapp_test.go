@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
@@ -360,6 +361,32 @@ func TestAppVersionSetterGetter(t *testing.T) {
 	require.Equal(t, versionString, string(res.Value))
 }
 
+func TestAppStatusQuery(t *testing.T) {
+	app := setupBaseApp(t,
+		SetPruning(store.NewPruningOptions(2, 3, 1)),
+		SetMinGasPrices("0.025stake"),
+	)
+
+	for height := int64(1); height <= 10; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.Commit()
+	}
+
+	res := app.Query(abci.RequestQuery{Path: "app/status"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var status sdk.AppStatusResponse
+	require.NoError(t, json.Unmarshal(res.Value, &status))
+	require.Equal(t, sdk.AppStatusResponse{
+		EarliestStoreHeight: 8,
+		LatestStoreHeight:   10,
+		PruningKeepRecent:   2,
+		PruningKeepEvery:    3,
+		PruningInterval:     1,
+		MinGasPrices:        sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3))),
+	}, status)
+}
+
 func TestLoadVersionInvalid(t *testing.T) {
 	logger := log.NewNopLogger()
 	pruningOpt := SetPruning(store.PruneNothing)
@@ -530,6 +557,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetAnteHandler(nil)
 	})
+	require.Panics(t, func() {
+		app.SetPostHandler(nil)
+	})
 	require.Panics(t, func() {
 		app.SetAddrPeerFilter(nil)
 	})
@@ -1084,6 +1114,70 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	require.Equal(t, int64(2), msgCounter2)
 }
 
+// The context passed to the AnteHandler and message handlers carries the tx
+// hash, size and the index of the executed message.
+func TestTxContextInfo(t *testing.T) {
+	var (
+		anteCtx     sdk.Context
+		msgIndexes  []int
+		msgTxHashes [][]byte
+	)
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			anteCtx = ctx
+			return ctx, nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			msgIndexes = append(msgIndexes, ctx.MsgIndex())
+			msgTxHashes = append(msgTxHashes, ctx.TxHash())
+			return &sdk.Result{}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	tx := newTxCounter(0, 0, 1, 2)
+	txBytes, err := codec.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	txHash := tmhash.Sum(txBytes)
+	require.Equal(t, txHash, anteCtx.TxHash().Bytes())
+	require.Equal(t, len(txBytes), anteCtx.TxSize())
+	require.Equal(t, -1, anteCtx.MsgIndex())
+	// msgCounter has no signers
+	require.Empty(t, anteCtx.TxSigners())
+
+	require.Equal(t, []int{0, 1, 2}, msgIndexes)
+	for _, hash := range msgTxHashes {
+		require.Equal(t, txHash, hash)
+	}
+}
+
+func TestGetTxSigners(t *testing.T) {
+	addr1, addr2 := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)), sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	msgs := []sdk.Msg{
+		testdata.NewTestMsg(addr1, addr2),
+		testdata.NewTestMsg(addr2),
+		testdata.NewTestMsg(addr2, addr1),
+	}
+
+	require.Equal(t, []sdk.AccAddress{addr1, addr2}, getTxSigners(msgs))
+	require.Empty(t, getTxSigners(nil))
+}
+
 // Interleave calls to Check and Deliver and ensure
 // that there is no cross-talk. Check sees results of the previous Check calls
 // and Deliver sees that of the previous Deliver calls, but they don't see eachother.
@@ -1572,6 +1666,84 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	app.Commit()
 }
 
+func TestBaseAppPostHandler(t *testing.T) {
+	postKey := []byte("post-key")
+	failPost := false
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) error {
+			if failPost {
+				return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "post handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, postKey, getIntFromStore(store, postKey)+1)
+			ctx.EventManager().EmitEvents(counterEvent("post_handler", tx.(txTest).Counter))
+
+			return nil
+		})
+	}
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	cdc := codec.NewLegacyAmino()
+	app := setupBaseApp(t, postOpt, routerOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+	registerTestCodec(cdc)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the post handler is not run on CheckTx
+	tx := newTxCounter(0, 0)
+	txBytes, err := cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	checkRes := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.True(t, checkRes.IsOK(), fmt.Sprintf("%v", checkRes))
+	require.Equal(t, int64(0), getIntFromStore(app.getState(runTxModeCheck).ctx.KVStore(capKey1), postKey))
+
+	// the post handler is not run when the message handler fails
+	tx = newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store := app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(store, postKey))
+
+	// the post handler state changes and events are committed along with the
+	// ones of the messages
+	tx = newTxCounter(0, 0)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, sdk.MarkEventsToIndex(counterEvent("post_handler", 0).ToABCIEvents(), map[string]struct{}{})[0], res.Events[len(res.Events)-1])
+
+	// the transaction fails, and the state changes of its messages are discarded,
+	// when the post handler fails
+	failPost = true
+	tx = newTxCounter(1, 1)
+	txBytes, err = cdc.MarshalBinaryBare(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Empty(t, res.Events)
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
 func TestGasConsumptionBadTx(t *testing.T) {
 	gasWanted := uint64(5)
 	anteOpt := func(bapp *BaseApp) {
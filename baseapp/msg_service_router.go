@@ -3,12 +3,15 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"time"
 
+	metrics "github.com/armon/go-metrics"
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gogo/protobuf/proto"
 	"google.golang.org/grpc"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -37,6 +40,25 @@ func (msr *MsgServiceRouter) Handler(methodName string) MsgServiceHandler {
 	return msr.routes[methodName]
 }
 
+// ExecMsg executes msg outside of a transaction, routing a service message to
+// its handler in msr and any other message to its legacy handler in router,
+// e.g. for modules executing messages on behalf of an account.
+func ExecMsg(ctx sdk.Context, router sdk.Router, msr *MsgServiceRouter, msg sdk.Msg) (*sdk.Result, error) {
+	if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+		handler := msr.Handler(svcMsg.MethodName)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message service method: %s", svcMsg.MethodName)
+		}
+		return handler(ctx, svcMsg.Request)
+	}
+
+	handler := router.Route(ctx, msg.Route())
+	if handler == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+	}
+	return handler(ctx, msg)
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -83,7 +105,8 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			)
 		}
 
-		msr.routes[fqMethod] = func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+		msgTypeURL := "/" + proto.MessageName(serviceMsg)
+		msr.routes[fqMethod] = withMsgTelemetry(msgTypeURL, func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -102,7 +125,32 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 			}
 
 			return sdk.WrapServiceResult(ctx, resMsg, err)
+		})
+	}
+}
+
+// withMsgTelemetry wraps a MsgServiceHandler so that every delivered Msg
+// increments the tx_msg_count counter, failed Msgs additionally increment
+// tx_msg_failed, and the handler execution time is recorded in the
+// tx_msg_handler summary. All of them are labeled with the Msg type URL.
+// Msgs executed on the check state, i.e. in simulations, are not recorded.
+func withMsgTelemetry(msgTypeURL string, handler MsgServiceHandler) MsgServiceHandler {
+	return func(ctx sdk.Context, req sdk.MsgRequest) (*sdk.Result, error) {
+		if ctx.IsCheckTx() {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		res, err := handler(ctx, req)
+
+		labels := []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameMsgType, msgTypeURL)}
+		telemetry.MeasureSinceWithLabels([]string{"tx", "msg", "handler"}, start, labels)
+		telemetry.IncrCounterWithLabels([]string{"tx", "msg", "count"}, 1, labels)
+		if err != nil {
+			telemetry.IncrCounterWithLabels([]string{"tx", "msg", "failed"}, 1, labels)
 		}
+
+		return res, err
 	}
 }
 
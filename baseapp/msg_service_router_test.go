@@ -1,6 +1,8 @@
 package baseapp_test
 
 import (
+	"context"
+	"encoding/json"
 	"os"
 	"testing"
 
@@ -13,7 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -120,3 +125,99 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+func TestExecMsg(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	app := baseapp.NewBaseApp("test", log.NewTMLogger(log.NewSyncWriter(os.Stdout)), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	app.Router().AddRoute(sdk.NewRoute("TestMsg", func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		return &sdk.Result{Log: "legacy"}, nil
+	}))
+	_ = app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	ctx := app.NewContext(false, tmproto.Header{Height: 1})
+
+	_, err := baseapp.ExecMsg(ctx, app.Router(), app.MsgServiceRouter(), testdata.NewServiceMsgCreateDog(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+	require.NoError(t, err)
+
+	res, err := baseapp.ExecMsg(ctx, app.Router(), app.MsgServiceRouter(), testdata.NewTestMsg())
+	require.NoError(t, err)
+	require.Equal(t, "legacy", res.Log)
+
+	_, err = baseapp.ExecMsg(ctx, app.Router(), app.MsgServiceRouter(), sdk.ServiceMsg{MethodName: "/testdata.Msg/Unknown", Request: &testdata.MsgCreateDog{}})
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+
+	_, err = baseapp.ExecMsg(ctx, baseapp.NewRouter(), app.MsgServiceRouter(), testdata.NewTestMsg())
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+}
+
+// failingMsgServer is a testdata.MsgServer rejecting every Msg.
+type failingMsgServer struct{}
+
+func (failingMsgServer) CreateDog(context.Context, *testdata.MsgCreateDog) (*testdata.MsgCreateDogResponse, error) {
+	return nil, sdkerrors.ErrInvalidRequest
+}
+
+func TestMsgServiceTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+
+	newHandler := func(srv testdata.MsgServer) baseapp.MsgServiceHandler {
+		app := baseapp.NewBaseApp("test", log.NewNopLogger(), dbm.NewMemDB(), encCfg.TxConfig.TxDecoder())
+		app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+		testdata.RegisterMsgServer(app.MsgServiceRouter(), srv)
+		return app.MsgServiceRouter().Handler("/testdata.Msg/CreateDog")
+	}
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+
+	_, err = newHandler(testdata.MsgServerImpl{})(ctx, msg)
+	require.NoError(t, err)
+	_, err = newHandler(failingMsgServer{})(ctx, msg)
+	require.Error(t, err)
+
+	// msgs executed on the check state are not recorded
+	_, err = newHandler(testdata.MsgServerImpl{})(ctx.WithIsCheckTx(true), msg)
+	require.NoError(t, err)
+
+	gr, err := m.Gather(telemetry.FormatDefault)
+	require.NoError(t, err)
+
+	var res struct {
+		Counters []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+		Samples []struct {
+			Name   string
+			Count  int
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &res))
+
+	counts := map[string]int{}
+	for _, c := range res.Counters {
+		if c.Labels[telemetry.MetricLabelNameMsgType] == "/testdata.MsgCreateDog" {
+			counts[c.Name] = c.Count
+		}
+	}
+	require.Equal(t, map[string]int{"test.tx.msg.count": 2, "test.tx.msg.failed": 1}, counts)
+
+	var handlerSamples int
+	for _, s := range res.Samples {
+		if s.Name == "test.tx.msg.handler" && s.Labels[telemetry.MetricLabelNameMsgType] == "/testdata.MsgCreateDog" {
+			handlerSamples = s.Count
+		}
+	}
+	require.Equal(t, 2, handlerSamples)
+}
@@ -170,6 +170,8 @@ func readQueryCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context,
 		clientCtx = clientCtx.WithUseLedger(useLedger)
 	}
 
+	clientCtx = readBech32PrefixFlags(clientCtx, flagSet)
+
 	return ReadPersistentCommandFlags(clientCtx, flagSet)
 }
 
@@ -224,9 +226,13 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 		clientCtx = clientCtx.WithSignModeStr(signModeStr)
 	}
 
+	// The Bech32 prefixes are read first as the from address is decoded with
+	// them.
+	clientCtx = readBech32PrefixFlags(clientCtx, flagSet)
+
 	if clientCtx.From == "" || flagSet.Changed(flags.FlagFrom) {
 		from, _ := flagSet.GetString(flags.FlagFrom)
-		fromAddr, fromName, keyType, err := GetFromFields(clientCtx.Keyring, from, clientCtx.GenerateOnly)
+		fromAddr, fromName, keyType, err := getFromFields(clientCtx.Keyring, from, clientCtx.GenerateOnly, clientCtx.AccAddressFromBech32)
 		if err != nil {
 			return clientCtx, err
 		}
@@ -245,6 +251,27 @@ func readTxCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Context, err
 	return clientCtx, nil
 }
 
+// readBech32PrefixFlags returns an updated Context with the Bech32 prefixes set
+// based on the flags defined in both AddQueryFlagsToCmd and AddTxFlagsToCmd.
+func readBech32PrefixFlags(clientCtx Context, flagSet *pflag.FlagSet) Context {
+	if clientCtx.Bech32Prefix == "" || flagSet.Changed(flags.FlagBech32Prefix) {
+		prefix, _ := flagSet.GetString(flags.FlagBech32Prefix)
+		clientCtx = clientCtx.WithBech32Prefix(prefix)
+	}
+
+	if clientCtx.Bech32ValPrefix == "" || flagSet.Changed(flags.FlagBech32ValPrefix) {
+		prefix, _ := flagSet.GetString(flags.FlagBech32ValPrefix)
+		clientCtx = clientCtx.WithBech32ValPrefix(prefix)
+	}
+
+	if clientCtx.Bech32ConsPrefix == "" || flagSet.Changed(flags.FlagBech32ConsPrefix) {
+		prefix, _ := flagSet.GetString(flags.FlagBech32ConsPrefix)
+		clientCtx = clientCtx.WithBech32ConsPrefix(prefix)
+	}
+
+	return clientCtx
+}
+
 // ReadHomeFlag checks if home flag is changed.
 // If this is a case, we update HomeDir field of Client Context
 /* Discovered a bug with Cory
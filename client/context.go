@@ -46,6 +46,9 @@ type Context struct {
 	TxConfig          TxConfig
 	AccountRetriever  AccountRetriever
 	NodeURI           string
+	Bech32Prefix      string
+	Bech32ValPrefix   string
+	Bech32ConsPrefix  string
 	Viper             *viper.Viper
 
 	// TODO: Deprecated (remove).
@@ -210,6 +213,29 @@ func (ctx Context) WithInterfaceRegistry(interfaceRegistry codectypes.InterfaceR
 	return ctx
 }
 
+// WithBech32Prefix returns a copy of the context with an updated Bech32 prefix
+// of account addresses, overriding the one of the sdk Config.
+func (ctx Context) WithBech32Prefix(prefix string) Context {
+	ctx.Bech32Prefix = prefix
+	return ctx
+}
+
+// WithBech32ValPrefix returns a copy of the context with an updated Bech32
+// prefix of validator addresses, overriding the one derived from the Bech32
+// prefix of account addresses.
+func (ctx Context) WithBech32ValPrefix(prefix string) Context {
+	ctx.Bech32ValPrefix = prefix
+	return ctx
+}
+
+// WithBech32ConsPrefix returns a copy of the context with an updated Bech32
+// prefix of consensus addresses, overriding the one derived from the Bech32
+// prefix of account addresses.
+func (ctx Context) WithBech32ConsPrefix(prefix string) Context {
+	ctx.Bech32ConsPrefix = prefix
+	return ctx
+}
+
 // WithViper returns the context with Viper field. This Viper instance is used to read
 // client-side config from the config file.
 func (ctx Context) WithViper(prefix string) Context {
@@ -297,16 +323,102 @@ func (ctx Context) printOutput(out []byte) error {
 	return nil
 }
 
+// Bech32AccountAddrPrefix returns the Bech32 prefix of account addresses of the
+// context, which defaults to the one of the sdk Config.
+func (ctx Context) Bech32AccountAddrPrefix() string {
+	if ctx.Bech32Prefix == "" {
+		return sdk.GetConfig().GetBech32AccountAddrPrefix()
+	}
+
+	return ctx.Bech32Prefix
+}
+
+// Bech32ValidatorAddrPrefix returns the Bech32 prefix of validator addresses of
+// the context. Unless it is set explicitly, it is derived from an overridden
+// prefix of account addresses as in the SDK default prefixes, e.g.
+// "cosmosvaloper", and defaults to the one of the sdk Config.
+func (ctx Context) Bech32ValidatorAddrPrefix() string {
+	if ctx.Bech32ValPrefix != "" {
+		return ctx.Bech32ValPrefix
+	}
+
+	if ctx.Bech32Prefix == "" {
+		return sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	}
+
+	return ctx.Bech32Prefix + sdk.PrefixValidator + sdk.PrefixOperator
+}
+
+// Bech32ConsensusAddrPrefix returns the Bech32 prefix of consensus addresses of
+// the context. Unless it is set explicitly, it is derived from an overridden
+// prefix of account addresses as in the SDK default prefixes, e.g.
+// "cosmosvalcons", and defaults to the one of the sdk Config.
+func (ctx Context) Bech32ConsensusAddrPrefix() string {
+	if ctx.Bech32ConsPrefix != "" {
+		return ctx.Bech32ConsPrefix
+	}
+
+	if ctx.Bech32Prefix == "" {
+		return sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	}
+
+	return ctx.Bech32Prefix + sdk.PrefixValidator + sdk.PrefixConsensus
+}
+
+// AccAddressFromBech32 decodes an account address encoded with the Bech32
+// prefix of the context.
+func (ctx Context) AccAddressFromBech32(address string) (sdk.AccAddress, error) {
+	return sdk.AccAddressFromBech32WithPrefix(address, ctx.Bech32AccountAddrPrefix())
+}
+
+// ValAddressFromBech32 decodes a validator address encoded with the Bech32
+// prefix of the context.
+func (ctx Context) ValAddressFromBech32(address string) (sdk.ValAddress, error) {
+	return sdk.ValAddressFromBech32WithPrefix(address, ctx.Bech32ValidatorAddrPrefix())
+}
+
+// ConsAddressFromBech32 decodes a consensus address encoded with the Bech32
+// prefix of the context.
+func (ctx Context) ConsAddressFromBech32(address string) (sdk.ConsAddress, error) {
+	return sdk.ConsAddressFromBech32WithPrefix(address, ctx.Bech32ConsensusAddrPrefix())
+}
+
+// AccAddressString returns the Bech32 encoding of an account address with the
+// Bech32 prefix of the context.
+func (ctx Context) AccAddressString(addr sdk.AccAddress) string {
+	return sdk.MustBech32ifyAddressBytes(ctx.Bech32AccountAddrPrefix(), addr)
+}
+
+// ValAddressString returns the Bech32 encoding of a validator address with the
+// Bech32 prefix of the context.
+func (ctx Context) ValAddressString(addr sdk.ValAddress) string {
+	return sdk.MustBech32ifyAddressBytes(ctx.Bech32ValidatorAddrPrefix(), addr)
+}
+
+// ConsAddressString returns the Bech32 encoding of a consensus address with the
+// Bech32 prefix of the context.
+func (ctx Context) ConsAddressString(addr sdk.ConsAddress) string {
+	return sdk.MustBech32ifyAddressBytes(ctx.Bech32ConsensusAddrPrefix(), addr)
+}
+
 // GetFromFields returns a from account address, account name and keyring type, given either
 // an address or key name. If genOnly is true, only a valid Bech32 cosmos
 // address is returned.
 func GetFromFields(kr keyring.Keyring, from string, genOnly bool) (sdk.AccAddress, string, keyring.KeyType, error) {
+	return getFromFields(kr, from, genOnly, sdk.AccAddressFromBech32)
+}
+
+// getFromFields is GetFromFields decoding a from address with the given
+// function, e.g. with the Bech32 prefix of a context.
+func getFromFields(
+	kr keyring.Keyring, from string, genOnly bool, accAddressFromBech32 func(string) (sdk.AccAddress, error),
+) (sdk.AccAddress, string, keyring.KeyType, error) {
 	if from == "" {
 		return nil, "", 0, nil
 	}
 
 	if genOnly {
-		addr, err := sdk.AccAddressFromBech32(from)
+		addr, err := accAddressFromBech32(from)
 		if err != nil {
 			return nil, "", 0, errors.Wrap(err, "must provide a valid Bech32 address in generate-only mode")
 		}
@@ -315,7 +427,7 @@ func GetFromFields(kr keyring.Keyring, from string, genOnly bool) (sdk.AccAddres
 	}
 
 	var info keyring.Info
-	if addr, err := sdk.AccAddressFromBech32(from); err == nil {
+	if addr, err := accAddressFromBech32(from); err == nil {
 		info, err = kr.KeyByAddress(addr)
 		if err != nil {
 			return nil, "", 0, err
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -14,8 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestMain(m *testing.M) {
@@ -101,6 +104,43 @@ value:
 `, string(buf.Bytes()))
 }
 
+func TestContext_Bech32Prefix(t *testing.T) {
+	addr := secp256k1.GenPrivKey().PubKey().Address()
+
+	ctx := client.Context{}
+	require.Equal(t, sdk.AccAddress(addr).String(), ctx.AccAddressString(sdk.AccAddress(addr)))
+	require.Equal(t, sdk.ValAddress(addr).String(), ctx.ValAddressString(sdk.ValAddress(addr)))
+	require.Equal(t, sdk.ConsAddress(addr).String(), ctx.ConsAddressString(sdk.ConsAddress(addr)))
+
+	ctx = ctx.WithBech32Prefix("osmo")
+	require.Equal(t, "osmo", ctx.Bech32AccountAddrPrefix())
+	require.Equal(t, "osmovaloper", ctx.Bech32ValidatorAddrPrefix())
+	require.Equal(t, "osmovalcons", ctx.Bech32ConsensusAddrPrefix())
+
+	accStr := ctx.AccAddressString(sdk.AccAddress(addr))
+	require.True(t, strings.HasPrefix(accStr, "osmo1"))
+	acc, err := ctx.AccAddressFromBech32(accStr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(addr), acc)
+	_, err = ctx.AccAddressFromBech32(sdk.AccAddress(addr).String())
+	require.Error(t, err)
+
+	val, err := ctx.ValAddressFromBech32(ctx.ValAddressString(sdk.ValAddress(addr)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.ValAddress(addr), val)
+
+	cons, err := ctx.ConsAddressFromBech32(ctx.ConsAddressString(sdk.ConsAddress(addr)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.ConsAddress(addr), cons)
+
+	ctx = ctx.WithBech32ValPrefix("osmoval").WithBech32ConsPrefix("osmocons")
+	require.Equal(t, "osmo", ctx.Bech32AccountAddrPrefix())
+	require.Equal(t, "osmoval", ctx.Bech32ValidatorAddrPrefix())
+	require.Equal(t, "osmocons", ctx.Bech32ConsensusAddrPrefix())
+	require.True(t, strings.HasPrefix(ctx.ValAddressString(sdk.ValAddress(addr)), "osmoval1"))
+	require.True(t, strings.HasPrefix(ctx.ConsAddressString(sdk.ConsAddress(addr)), "osmocons1"))
+}
+
 func TestCLIQueryConn(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = 1
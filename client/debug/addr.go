@@ -0,0 +1,171 @@
+package debug
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// Address prefix aliases accepted by the addr convert command, resolved
+// against the bech32 prefixes of the application's sdk.Config.
+const (
+	prefixAcc  = "acc"
+	prefixVal  = "val"
+	prefixCons = "cons"
+)
+
+// AddrConvertCmd returns a command converting an address to another bech32 prefix.
+func AddrConvertCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert [address] [prefix]",
+		Short: "Convert a hex or bech32 address to the given bech32 prefix",
+		Long: fmt.Sprintf(`Convert a hex or bech32 address to the given bech32 prefix. The prefix is either
+one of the aliases %q, %q and %q, referring to this application's account, validator
+operator and validator consensus address prefixes, or any other human readable part,
+such as the account prefix of another chain.
+
+Example:
+$ %s debug addr convert cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg val
+$ %s debug addr convert cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg osmo
+`, prefixAcc, prefixVal, prefixCons, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bz, err := decodeAddress(args[0])
+			if err != nil {
+				return err
+			}
+
+			addr, err := bech32.ConvertAndEncode(resolvePrefix(args[1]), bz)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(addr)
+			return nil
+		},
+	}
+}
+
+// AddrFromPubkeyCmd returns a command deriving the addresses of a public key.
+func AddrFromPubkeyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "from-pubkey [pubkey]",
+		Short: "Derive the addresses of a public key given as JSON, hex, base64 or bech32",
+		Long: fmt.Sprintf(`Derive the account, validator operator and validator consensus addresses of a
+public key. The public key is either the JSON encoding of the key (as printed by
+'keys show --output json' or 'tendermint show-validator'), the hex encoding of a
+compressed secp256k1 or an ed25519 key, or any encoding accepted by 'debug pubkey'.
+
+Example:
+$ %s debug addr from-pubkey '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A8c0cvR6sJ4smNWjuAXEt99lRl9wwqf3ezQ0DUKhFPAk"}'
+$ %s debug addr from-pubkey 03C73472F47AB09E2C98D5A3B805C4B7DF65465F70C2A7F77B34340D42A114F024
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pk, err := getPubKeyFromJSONOrString(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			addr := pk.Address()
+
+			cmd.Println("Key type:", pk.Type())
+			cmd.Printf("Address (hex): %X\n", addr.Bytes())
+			cmd.Printf("Bech32 Acc: %s\n", sdk.AccAddress(addr))
+			cmd.Printf("Bech32 Val: %s\n", sdk.ValAddress(addr))
+			cmd.Printf("Bech32 Cons: %s\n", sdk.ConsAddress(addr))
+			return nil
+		},
+	}
+}
+
+// AddrDecodeCmd returns a command decoding a bech32 string into its raw bytes.
+func AddrDecodeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode [bech32]",
+		Short: "Decode a bech32 string into its human readable part and raw bytes",
+		Long: fmt.Sprintf(`Decode a bech32 string of any prefix into its human readable part and raw bytes.
+
+Example:
+$ %s debug addr decode cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hrp, bz, err := bech32.DecodeAndConvert(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Prefix:", hrp)
+			cmd.Println("Bytes:", bz)
+			cmd.Printf("Hex: %X\n", bz)
+			cmd.Println("Base64:", base64.StdEncoding.EncodeToString(bz))
+			return nil
+		},
+	}
+}
+
+// decodeAddress decodes an address given in bech32 of any prefix or in hex.
+func decodeAddress(addrStr string) ([]byte, error) {
+	_, bz, err := bech32.DecodeAndConvert(addrStr)
+	if err == nil {
+		return bz, nil
+	}
+
+	bz, err2 := hex.DecodeString(addrStr)
+	if err2 == nil {
+		return bz, nil
+	}
+
+	return nil, fmt.Errorf("expected hex or bech32. Got errors: bech32: %v, hex: %v", err, err2)
+}
+
+// resolvePrefix maps the acc, val and cons aliases onto the configured bech32
+// address prefixes. Any other prefix is returned unchanged.
+func resolvePrefix(prefix string) string {
+	config := sdk.GetConfig()
+
+	switch strings.ToLower(prefix) {
+	case prefixAcc:
+		return config.GetBech32AccountAddrPrefix()
+	case prefixVal:
+		return config.GetBech32ValidatorAddrPrefix()
+	case prefixCons:
+		return config.GetBech32ConsensusAddrPrefix()
+	default:
+		return prefix
+	}
+}
+
+// getPubKeyFromJSONOrString decodes a public key from its JSON encoding or
+// from hex, falling back to the encodings supported by getPubKeyFromString.
+func getPubKeyFromJSONOrString(clientCtx client.Context, pkStr string) (cryptotypes.PubKey, error) {
+	var pk cryptotypes.PubKey
+	if err := clientCtx.JSONMarshaler.UnmarshalInterfaceJSON([]byte(pkStr), &pk); err == nil {
+		return pk, nil
+	}
+
+	if bz, err := hex.DecodeString(pkStr); err == nil {
+		switch len(bz) {
+		case secp256k1.PubKeySize:
+			return &secp256k1.PubKey{Key: bz}, nil
+		case ed25519.PubKeySize:
+			return &ed25519.PubKey{Key: bz}, nil
+		}
+	}
+
+	return getPubKeyFromString(pkStr)
+}
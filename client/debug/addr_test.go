@@ -0,0 +1,68 @@
+package debug_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+func TestAddrSubcommands(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	clientCtx := client.Context{}.WithJSONMarshaler(encCfg.Marshaler)
+
+	pk := secp256k1.GenPrivKeyFromSecret([]byte("debug")).PubKey()
+	accAddr := sdk.AccAddress(pk.Address())
+	valAddr := sdk.ValAddress(pk.Address())
+	consAddr := sdk.ConsAddress(pk.Address())
+	osmoAddr, err := bech32.ConvertAndEncode("osmo", pk.Address())
+	require.NoError(t, err)
+
+	pkJSON, err := encCfg.Marshaler.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		cmd    func() *cobra.Command
+		args   []string
+		expErr bool
+		expOut []string
+	}{
+		{"addr without subcommand", debug.AddrCmd, []string{accAddr.String()}, false, []string{fmt.Sprintf("Bech32 Val: %s", valAddr)}},
+		{"convert to val alias", debug.AddrConvertCmd, []string{accAddr.String(), "val"}, false, []string{valAddr.String()}},
+		{"convert to cons alias", debug.AddrConvertCmd, []string{valAddr.String(), "cons"}, false, []string{consAddr.String()}},
+		{"convert to other chain prefix", debug.AddrConvertCmd, []string{accAddr.String(), "osmo"}, false, []string{osmoAddr}},
+		{"convert from hex", debug.AddrConvertCmd, []string{fmt.Sprintf("%X", pk.Address()), "acc"}, false, []string{accAddr.String()}},
+		{"convert invalid address", debug.AddrConvertCmd, []string{"invalid", "acc"}, true, nil},
+		{"from pubkey json", debug.AddrFromPubkeyCmd, []string{string(pkJSON)}, false, []string{accAddr.String(), valAddr.String(), consAddr.String()}},
+		{"from pubkey hex", debug.AddrFromPubkeyCmd, []string{fmt.Sprintf("%X", pk.Bytes())}, false, []string{accAddr.String()}},
+		{"from invalid pubkey", debug.AddrFromPubkeyCmd, []string{"invalid"}, true, nil},
+		{"decode", debug.AddrDecodeCmd, []string{osmoAddr}, false, []string{"Prefix: osmo", fmt.Sprintf("Hex: %X", pk.Address())}},
+		{"decode invalid bech32", debug.AddrDecodeCmd, []string{"invalid"}, true, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd(), tc.args)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, exp := range tc.expOut {
+				require.Contains(t, out.String(), exp)
+			}
+		})
+	}
+}
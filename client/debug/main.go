@@ -121,16 +121,20 @@ $ %s debug pubkey cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 }
 
 func AddrCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "addr [address]",
-		Short: "Convert an address between hex and bech32",
+		Short: "Convert an address between hex and bech32, or run an address utility subcommand",
 		Long: fmt.Sprintf(`Convert an address between hex encoding and bech32.
 			
 Example:
 $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
-			`, version.AppName),
-		Args: cobra.ExactArgs(1),
+$ %s debug addr convert cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg val
+			`, version.AppName, version.AppName),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return cmd.Help()
+			}
 
 			addrString := args[0]
 			var addr []byte
@@ -162,6 +166,14 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 			return nil
 		},
 	}
+
+	cmd.AddCommand(
+		AddrConvertCmd(),
+		AddrFromPubkeyCmd(),
+		AddrDecodeCmd(),
+	)
+
+	return cmd
 }
 
 func RawBytesCmd() *cobra.Command {
@@ -70,6 +70,9 @@ const (
 	FlagCountTotal       = "count-total"
 	FlagTimeoutHeight    = "timeout-height"
 	FlagKeyAlgorithm     = "algo"
+	FlagBech32Prefix     = "prefix"
+	FlagBech32ValPrefix  = "validator-prefix"
+	FlagBech32ConsPrefix = "consensus-prefix"
 
 	// Tendermint logging flags
 	FlagLogLevel  = "log_level"
@@ -85,6 +88,7 @@ func AddQueryFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
 	cmd.Flags().Int64(FlagHeight, 0, "Use a specific height to query state at (this can error if the node is pruning state)")
 	cmd.Flags().StringP(tmcli.OutputFlag, "o", "text", "Output format (text|json)")
+	addBech32PrefixFlags(cmd)
 
 	cmd.MarkFlagRequired(FlagChainID)
 
@@ -112,6 +116,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
 	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
+	addBech32PrefixFlags(cmd)
 
 	// --gas can accept integers and "auto"
 	cmd.Flags().String(FlagGas, "", fmt.Sprintf("gas limit to set per-transaction; set to %q to calculate sufficient gas automatically (default %d)", GasFlagAuto, DefaultGasLimit))
@@ -122,6 +127,14 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.SetOut(cmd.OutOrStdout())
 }
 
+// addBech32PrefixFlags adds the flags overriding the application's Bech32
+// prefixes of addresses to cmd.
+func addBech32PrefixFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagBech32Prefix, "", "Bech32 prefix of the account addresses of the chain (e.g. cosmos); if omitted, the application's prefixes are used")
+	cmd.Flags().String(FlagBech32ValPrefix, "", "Bech32 prefix of the validator addresses of the chain; if omitted, it is derived from --prefix (e.g. cosmosvaloper)")
+	cmd.Flags().String(FlagBech32ConsPrefix, "", "Bech32 prefix of the consensus addresses of the chain; if omitted, it is derived from --prefix (e.g. cosmosvalcons)")
+}
+
 // AddPaginationFlagsToCmd adds common pagination flags to cmd
 func AddPaginationFlagsToCmd(cmd *cobra.Command, query string) {
 	cmd.Flags().Uint64(FlagPage, 1, fmt.Sprintf("pagination page of %s to query for. This sets offset to a multiple of limit", query))
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+)
+
+const (
+	// MaxRequestBodyBytes is the maximum size of the body of a GraphQL request.
+	MaxRequestBodyBytes = 1 << 20
+	// MaxQueryDepth is the maximum nesting depth of the fields of a query, the
+	// fields of the root query type being at depth one.
+	MaxQueryDepth = 10
+	// MaxQueryFields is the maximum number of fields selected by a query. The
+	// fields of a fragment are counted every time it is spread.
+	MaxQueryFields = 200
+)
+
+// checkQueryLimits returns an error if an operation of the query nests fields
+// deeper than MaxQueryDepth, selects more than MaxQueryFields fields or spreads
+// fragments cyclically, which the query validation of graphql-go does not
+// survive. Queries which cannot be parsed are left to the execution to report.
+func checkQueryLimits(query string) error {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return nil
+	}
+
+	fragments := map[string]*ast.FragmentDefinition{}
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok && fragment.Name != nil {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	// fragments are also walked on their own as the cycles of unused fragments
+	// break the validation as well
+	for _, def := range doc.Definitions {
+		var set *ast.SelectionSet
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			set = def.SelectionSet
+		case *ast.FragmentDefinition:
+			set = def.SelectionSet
+		default:
+			continue
+		}
+
+		walker := &queryWalker{fragments: fragments, spread: map[string]bool{}}
+		if err := walker.walk(set, 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// queryWalker counts the fields selected by an operation, expanding fragments.
+type queryWalker struct {
+	fragments map[string]*ast.FragmentDefinition
+	// spread holds the fragments being expanded, unknown fragment spreads are
+	// skipped as they are rejected by the query validation.
+	spread map[string]bool
+	fields int
+}
+
+func (w *queryWalker) walk(set *ast.SelectionSet, depth int) error {
+	if set == nil {
+		return nil
+	}
+
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if depth > MaxQueryDepth {
+				return fmt.Errorf("query depth exceeds the maximum of %d", MaxQueryDepth)
+			}
+
+			w.fields++
+			if w.fields > MaxQueryFields {
+				return fmt.Errorf("query selects more than the maximum of %d fields", MaxQueryFields)
+			}
+
+			if err := w.walk(selection.SelectionSet, depth+1); err != nil {
+				return err
+			}
+
+		case *ast.InlineFragment:
+			if err := w.walk(selection.SelectionSet, depth); err != nil {
+				return err
+			}
+
+		case *ast.FragmentSpread:
+			if selection.Name == nil {
+				continue
+			}
+
+			name := selection.Name.Value
+			fragment, ok := w.fragments[name]
+			if !ok {
+				continue
+			}
+			if w.spread[name] {
+				return fmt.Errorf("fragment %s is spread within itself", name)
+			}
+
+			w.spread[name] = true
+			err := w.walk(fragment.SelectionSet, depth)
+			delete(w.spread, name)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package graphql
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	anyType      = reflect.TypeOf(codectypes.Any{})
+)
+
+// JSON is the GraphQL scalar type of the values without a GraphQL object type
+// of their own, e.g. protobuf Any messages and maps. They are represented by
+// their protobuf JSON encoding.
+var JSON = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "The JSON scalar type represents values by their protobuf JSON encoding.",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: parseJSONLiteral,
+})
+
+func parseJSONLiteral(valueAST ast.Value) interface{} {
+	switch v := valueAST.(type) {
+	case *ast.ObjectValue:
+		obj := make(map[string]interface{}, len(v.Fields))
+		for _, f := range v.Fields {
+			obj[f.Name.Value] = parseJSONLiteral(f.Value)
+		}
+		return obj
+
+	case *ast.ListValue:
+		list := make([]interface{}, len(v.Values))
+		for i, value := range v.Values {
+			list[i] = parseJSONLiteral(value)
+		}
+		return list
+
+	case *ast.IntValue:
+		return json.Number(v.Value)
+
+	case *ast.FloatValue:
+		return json.Number(v.Value)
+
+	case *ast.BooleanValue:
+		return v.Value
+
+	case *ast.StringValue:
+		return v.Value
+
+	case *ast.EnumValue:
+		return v.Value
+
+	default:
+		return nil
+	}
+}
+
+// messageField is a field of a protobuf message, named as in its JSON encoding.
+type messageField struct {
+	name string
+	typ  reflect.Type
+	tag  string
+}
+
+// schemaBuilder builds the GraphQL types of protobuf messages out of their Go
+// types. The fields of the object types are named and typed as in the protobuf
+// JSON encoding of the messages, which is what the queries resolve to.
+type schemaBuilder struct {
+	server  *Server
+	joins   map[string][]Join
+	outputs map[reflect.Type]graphql.Output
+	inputs  map[reflect.Type]graphql.Input
+}
+
+func newSchemaBuilder(server *Server) *schemaBuilder {
+	return &schemaBuilder{
+		server:  server,
+		joins:   map[string][]Join{},
+		outputs: map[reflect.Type]graphql.Output{},
+		inputs:  map[reflect.Type]graphql.Input{},
+	}
+}
+
+// outputType returns the GraphQL output type of the messages of type t.
+func (b *schemaBuilder) outputType(t reflect.Type) graphql.Output {
+	return b.fieldType(t, "", false).(graphql.Output)
+}
+
+// arguments returns the GraphQL arguments of the request messages of type t.
+func (b *schemaBuilder) arguments(t reflect.Type) graphql.FieldConfigArgument {
+	args := graphql.FieldConfigArgument{}
+	for _, f := range messageFields(t) {
+		args[f.name] = &graphql.ArgumentConfig{
+			Type: b.fieldType(f.typ, f.tag, true).(graphql.Input),
+		}
+	}
+
+	return args
+}
+
+// fieldType returns the GraphQL type of a message field of type t with the
+// given protobuf struct tag.
+func (b *schemaBuilder) fieldType(t reflect.Type, tag string, input bool) graphql.Type {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Slice {
+		if t.Elem().Kind() == reflect.Uint8 {
+			// bytes are base64 encoded
+			return graphql.String
+		}
+
+		return graphql.NewList(b.fieldType(t.Elem(), tag, input))
+	}
+
+	// custom types, e.g. sdk.Int and sdk.Dec, and enums are encoded as strings
+	if strings.Contains(tag, ",customtype=") || strings.Contains(tag, ",enum=") {
+		return graphql.String
+	}
+
+	switch t {
+	case timeType, durationType:
+		return graphql.String
+
+	case anyType:
+		return JSON
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return graphql.Boolean
+
+	case reflect.Int32, reflect.Uint32:
+		return graphql.Int
+
+	case reflect.Int64, reflect.Uint64:
+		// 64-bit integers are encoded as strings
+		return graphql.String
+
+	case reflect.Float32, reflect.Float64:
+		return graphql.Float
+
+	case reflect.String:
+		return graphql.String
+
+	case reflect.Struct:
+		if input {
+			return b.inputObject(t)
+		}
+
+		return b.object(t)
+
+	default:
+		return JSON
+	}
+}
+
+// object returns the GraphQL object type of the messages of type t, along with
+// the fields of their registered joins.
+func (b *schemaBuilder) object(t reflect.Type) graphql.Output {
+	if typ, ok := b.outputs[t]; ok {
+		return typ
+	}
+
+	name, fields := messageName(t), messageFields(t)
+	if name == "" || len(fields) == 0 {
+		b.outputs[t] = JSON
+		return JSON
+	}
+
+	// fields are resolved lazily as messages can be recursive
+	b.outputs[t] = graphql.NewObject(graphql.ObjectConfig{
+		Name: strings.ReplaceAll(name, ".", "_"),
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			objFields := graphql.Fields{}
+			for _, f := range fields {
+				objFields[f.name] = &graphql.Field{
+					Type: b.fieldType(f.typ, f.tag, false).(graphql.Output),
+				}
+			}
+
+			for _, join := range b.joins[name] {
+				objFields[join.Field] = &graphql.Field{
+					Type:    b.outputType(b.server.methods[join.Method].resType.Elem()),
+					Resolve: b.server.resolveJoinFn(join),
+				}
+			}
+
+			return objFields
+		}),
+	})
+
+	return b.outputs[t]
+}
+
+// inputObject returns the GraphQL input object type of the messages of type t.
+func (b *schemaBuilder) inputObject(t reflect.Type) graphql.Input {
+	if typ, ok := b.inputs[t]; ok {
+		return typ
+	}
+
+	name, fields := messageName(t), messageFields(t)
+	if name == "" || len(fields) == 0 {
+		b.inputs[t] = JSON
+		return JSON
+	}
+
+	b.inputs[t] = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: strings.ReplaceAll(name, ".", "_") + "Input",
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			objFields := graphql.InputObjectConfigFieldMap{}
+			for _, f := range fields {
+				objFields[f.name] = &graphql.InputObjectFieldConfig{
+					Type: b.fieldType(f.typ, f.tag, true).(graphql.Input),
+				}
+			}
+
+			return objFields
+		}),
+	})
+
+	return b.inputs[t]
+}
+
+// messageName returns the fully-qualified protobuf name of the messages of
+// type t, or an empty string if t is not a registered protobuf message type.
+func messageName(t reflect.Type) string {
+	msg, ok := reflect.New(t).Interface().(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	return proto.MessageName(msg)
+}
+
+// messageFields returns the fields of the protobuf messages of type t, including
+// the fields of their oneofs.
+func messageFields(t reflect.Type) []messageField {
+	var fields []messageField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if tag := f.Tag.Get("protobuf"); tag != "" {
+			fields = append(fields, messageField{name: protobufName(tag), typ: f.Type, tag: tag})
+		}
+	}
+
+	msg, ok := reflect.New(t).Interface().(interface{ XXX_OneofWrappers() []interface{} })
+	if !ok {
+		return fields
+	}
+
+	for _, wrapper := range msg.XXX_OneofWrappers() {
+		f := reflect.TypeOf(wrapper).Elem().Field(0)
+		if tag := f.Tag.Get("protobuf"); tag != "" {
+			fields = append(fields, messageField{name: protobufName(tag), typ: f.Type, tag: tag})
+		}
+	}
+
+	return fields
+}
+
+// protobufName returns the original protobuf field name of a protobuf struct
+// tag, which is the field name used in JSON encodings.
+func protobufName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,303 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gorilla/mux"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// Join defines a field added to the GraphQL object type of a protobuf message,
+// resolved by a gRPC query built from the object it is added to. It allows to
+// query related state of several modules in a single GraphQL query, e.g. a
+// validator along with its distribution commission.
+type Join struct {
+	// Message is the fully-qualified name of the protobuf message the field is
+	// added to, e.g. "cosmos.staking.v1beta1.Validator".
+	Message string
+	// Field is the name of the added field.
+	Field string
+	// Method is the fully-qualified name of the gRPC query method resolving the
+	// field, e.g. "/cosmos.distribution.v1beta1.Query/ValidatorCommission".
+	Method string
+	// Request returns the JSON fields of the Method request from the JSON
+	// fields of the object the field is added to.
+	Request func(parent map[string]interface{}) (map[string]interface{}, error)
+}
+
+// method is a gRPC query method exposed in the GraphQL schema.
+type method struct {
+	service  string
+	name     string
+	fullName string
+	reqType  reflect.Type
+	resType  reflect.Type
+}
+
+type clientCtxKey struct{}
+
+// Server serves a GraphQL schema built out of the gRPC query services registered
+// on it. Every query service is exposed as a field of the root query type, named
+// after the service's package, e.g. "cosmos_bank_v1beta1", with a field for each
+// of its methods taking the method's request fields as arguments. Queries are
+// run against the node of the client context.
+//
+// Server implements the gogogrpc.Server interface so that the query services of
+// an application are registered with its RegisterGRPCServer method.
+type Server struct {
+	clientCtx client.Context
+	methods   map[string]method
+	joins     []Join
+}
+
+// NewServer returns a GraphQL Server running its queries with the given client
+// context.
+func NewServer(clientCtx client.Context) *Server {
+	return &Server{
+		clientCtx: clientCtx,
+		methods:   map[string]method{},
+	}
+}
+
+// RegisterService implements the gogogrpc.Server interface. Only the methods of
+// the Query services are registered, the handler is not used as queries are
+// run against the node of the client context.
+func (s *Server) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	if !strings.HasSuffix(sd.ServiceName, ".Query") {
+		return
+	}
+
+	handlerType := reflect.TypeOf(sd.HandlerType).Elem()
+	for _, md := range sd.Methods {
+		m, ok := handlerType.MethodByName(md.MethodName)
+		if !ok || m.Type.NumIn() != 2 || m.Type.NumOut() != 2 {
+			continue
+		}
+
+		fullName := fmt.Sprintf("/%s/%s", sd.ServiceName, md.MethodName)
+		s.methods[fullName] = method{
+			service:  sd.ServiceName,
+			name:     md.MethodName,
+			fullName: fullName,
+			reqType:  m.Type.In(1),
+			resType:  m.Type.Out(0),
+		}
+	}
+}
+
+// RegisterJoin registers a Join in the GraphQL schema.
+func (s *Server) RegisterJoin(join Join) {
+	s.joins = append(s.joins, join)
+}
+
+// RegisterRoutes builds the GraphQL schema and registers its /graphql endpoint
+// on the given router. The endpoint accepts queries in the body of POST requests
+// and in the query string of GET requests, along with an optional height query
+// parameter. Request bodies are limited to MaxRequestBodyBytes and queries to
+// MaxQueryDepth and MaxQueryFields.
+func (s *Server) RegisterRoutes(rtr *mux.Router) error {
+	schema, err := s.Schema()
+	if err != nil {
+		return err
+	}
+
+	rtr.HandleFunc("/graphql", s.handlerFn(schema)).Methods("GET", "POST")
+	return nil
+}
+
+// Schema builds the GraphQL schema of the registered query services and joins.
+func (s *Server) Schema() (graphql.Schema, error) {
+	b := newSchemaBuilder(s)
+
+	for _, join := range s.joins {
+		if proto.MessageType(join.Message) == nil {
+			return graphql.Schema{}, fmt.Errorf("unknown message %s of join %s", join.Message, join.Field)
+		}
+		if _, ok := s.methods[join.Method]; !ok {
+			return graphql.Schema{}, fmt.Errorf("unknown method %s of join %s", join.Method, join.Field)
+		}
+
+		b.joins[join.Message] = append(b.joins[join.Message], join)
+	}
+
+	services := map[string]graphql.Fields{}
+	for _, m := range s.methods {
+		if services[m.service] == nil {
+			services[m.service] = graphql.Fields{}
+		}
+
+		services[m.service][m.name] = &graphql.Field{
+			Type:    b.outputType(m.resType.Elem()),
+			Args:    b.arguments(m.reqType.Elem()),
+			Resolve: s.resolveMethodFn(m),
+		}
+	}
+
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	query := graphql.Fields{}
+	for _, name := range names {
+		query[serviceFieldName(name)] = &graphql.Field{
+			Type: graphql.NewObject(graphql.ObjectConfig{
+				Name:   strings.ReplaceAll(name, ".", "_"),
+				Fields: services[name],
+			}),
+			Resolve: func(graphql.ResolveParams) (interface{}, error) {
+				return map[string]interface{}{}, nil
+			},
+		}
+	}
+
+	if len(query) == 0 {
+		return graphql.Schema{}, fmt.Errorf("no query service registered")
+	}
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: query,
+		}),
+	})
+}
+
+// serviceFieldName returns the name of the root query field of a query service,
+// i.e. its package name with dots replaced by underscores.
+func serviceFieldName(service string) string {
+	return strings.ReplaceAll(strings.TrimSuffix(service, ".Query"), ".", "_")
+}
+
+func (s *Server) resolveMethodFn(m method) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		return s.query(p.Context, m, p.Args)
+	}
+}
+
+func (s *Server) resolveJoinFn(join Join) graphql.FieldResolveFn {
+	m := s.methods[join.Method]
+
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		parent, ok := p.Source.(map[string]interface{})
+		if !ok {
+			return nil, nil
+		}
+
+		args, err := join.Request(parent)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.query(p.Context, m, args)
+	}
+}
+
+// query runs a gRPC query with a request decoded from the JSON fields args, and
+// returns its response as decoded from its JSON encoding.
+func (s *Server) query(ctx context.Context, m method, args map[string]interface{}) (interface{}, error) {
+	clientCtx, ok := ctx.Value(clientCtxKey{}).(client.Context)
+	if !ok {
+		clientCtx = s.clientCtx
+	}
+
+	bz, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := reflect.New(m.reqType.Elem()).Interface().(proto.Message)
+	if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, req); err != nil {
+		return nil, err
+	}
+
+	res := reflect.New(m.resType.Elem()).Interface().(proto.Message)
+	if err := clientCtx.Invoke(ctx, m.fullName, req, res); err != nil {
+		return nil, err
+	}
+
+	bz, err = clientCtx.JSONMarshaler.MarshalJSON(res)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(bz, &out); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// request is a GraphQL request, as sent in the body of POST requests.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+func (s *Server) handlerFn(schema graphql.Schema) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, s.clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var req request
+		if r.Method == http.MethodGet {
+			req.Query = r.URL.Query().Get("query")
+			req.OperationName = r.URL.Query().Get("operationName")
+			if variables := r.URL.Query().Get("variables"); variables != "" {
+				err := json.Unmarshal([]byte(variables), &req.Variables)
+				if rest.CheckBadRequestError(w, err) {
+					return
+				}
+			}
+		} else {
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBodyBytes))
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			err = json.Unmarshal(body, &req)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+		}
+
+		var res *graphql.Result
+		if err := checkQueryLimits(req.Query); err != nil {
+			res = &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+		} else {
+			res = graphql.Do(graphql.Params{
+				Schema:         schema,
+				RequestString:  req.Query,
+				VariableValues: req.Variables,
+				OperationName:  req.OperationName,
+				Context:        context.WithValue(r.Context(), clientCtxKey{}, clientCtx),
+			})
+		}
+
+		bz, err := json.Marshal(res)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
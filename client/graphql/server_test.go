@@ -0,0 +1,227 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/client/graphql"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	network *network.Network
+	server  *httptest.Server
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+
+	s.network = network.New(s.T(), cfg)
+	s.Require().NotNil(s.network)
+
+	_, err := s.network.WaitForHeight(2)
+	s.Require().NoError(err)
+
+	clientCtx := s.network.Validators[0].ClientCtx
+	srv := graphql.NewServer(clientCtx)
+	banktypes.RegisterQueryServer(srv, nil)
+	distrtypes.RegisterQueryServer(srv, nil)
+	slashingtypes.RegisterQueryServer(srv, nil)
+	stakingtypes.RegisterQueryServer(srv, nil)
+	for _, join := range simapp.GraphQLJoins(clientCtx.JSONMarshaler) {
+		srv.RegisterJoin(join)
+	}
+
+	rtr := mux.NewRouter()
+	s.Require().NoError(srv.RegisterRoutes(rtr))
+	s.server = httptest.NewServer(rtr)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.server.Close()
+	s.network.Cleanup()
+}
+
+// result is the response of a GraphQL request.
+type result struct {
+	Data   json.RawMessage   `json:"data"`
+	Errors []json.RawMessage `json:"errors"`
+}
+
+func (s *IntegrationTestSuite) post(query string, variables map[string]interface{}) result {
+	bz, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	s.Require().NoError(err)
+
+	resp, err := http.Post(s.server.URL+"/graphql", "application/json", strings.NewReader(string(bz)))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var res result
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&res))
+	return res
+}
+
+func (s *IntegrationTestSuite) TestQueryService() {
+	val := s.network.Validators[0]
+
+	res := s.post(`query Balances($address: String) {
+		cosmos_bank_v1beta1 {
+			AllBalances(address: $address, pagination: {limit: "1"}) {
+				balances { denom amount }
+				pagination { next_key total }
+			}
+		}
+	}`, map[string]interface{}{"address": val.Address.String()})
+	s.Require().Empty(res.Errors)
+
+	var data struct {
+		Bank struct {
+			AllBalances struct {
+				Balances   []sdk.Coin
+				Pagination struct {
+					NextKey *string `json:"next_key"`
+				}
+			}
+		} `json:"cosmos_bank_v1beta1"`
+	}
+	s.Require().NoError(json.Unmarshal(res.Data, &data))
+	s.Require().Len(data.Bank.AllBalances.Balances, 1)
+	s.Require().Equal(fmt.Sprintf("%stoken", val.Moniker), data.Bank.AllBalances.Balances[0].Denom)
+	s.Require().NotNil(data.Bank.AllBalances.Pagination.NextKey)
+}
+
+func (s *IntegrationTestSuite) TestJoins() {
+	val := s.network.Validators[0]
+
+	res := s.post(`{
+		cosmos_staking_v1beta1 {
+			Validators(status: "BOND_STATUS_BONDED") {
+				validators {
+					operator_address
+					distribution_commission { commission { commission { denom } } }
+					outstanding_rewards { rewards { rewards { denom } } }
+					signing_info { val_signing_info { address tombstoned } }
+				}
+			}
+		}
+	}`, nil)
+	s.Require().Empty(res.Errors)
+
+	var data struct {
+		Staking struct {
+			Validators struct {
+				Validators []struct {
+					OperatorAddress        string           `json:"operator_address"`
+					DistributionCommission *json.RawMessage `json:"distribution_commission"`
+					OutstandingRewards     *json.RawMessage `json:"outstanding_rewards"`
+					SigningInfo            struct {
+						ValSigningInfo struct {
+							Address    string
+							Tombstoned bool
+						} `json:"val_signing_info"`
+					} `json:"signing_info"`
+				}
+			}
+		} `json:"cosmos_staking_v1beta1"`
+	}
+	s.Require().NoError(json.Unmarshal(res.Data, &data))
+	s.Require().Len(data.Staking.Validators.Validators, 1)
+
+	validator := data.Staking.Validators.Validators[0]
+	s.Require().Equal(val.ValAddress.String(), validator.OperatorAddress)
+	s.Require().NotNil(validator.DistributionCommission)
+	s.Require().NotNil(validator.OutstandingRewards)
+	s.Require().Equal(sdk.ConsAddress(val.PubKey.Address()).String(), validator.SigningInfo.ValSigningInfo.Address)
+	s.Require().False(validator.SigningInfo.ValSigningInfo.Tombstoned)
+}
+
+func (s *IntegrationTestSuite) TestGetRequest() {
+	query := url.QueryEscape(`{ cosmos_staking_v1beta1 { Params { params { bond_denom max_validators } } } }`)
+
+	resp, err := http.Get(fmt.Sprintf("%s/graphql?query=%s&height=1", s.server.URL, query))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	var res result
+	s.Require().NoError(json.NewDecoder(resp.Body).Decode(&res))
+	s.Require().Empty(res.Errors)
+	s.Require().JSONEq(
+		fmt.Sprintf(`{"cosmos_staking_v1beta1":{"Params":{"params":{"bond_denom":"%s","max_validators":100}}}}`, sdk.DefaultBondDenom),
+		string(res.Data),
+	)
+}
+
+func (s *IntegrationTestSuite) TestInvalidQuery() {
+	res := s.post(`{ cosmos_bank_v1beta1 { Unknown { balances { denom } } } }`, nil)
+	s.Require().Len(res.Errors, 1)
+
+	res = s.post(`{ cosmos_bank_v1beta1 { Balance(address: "invalid", denom: "stake") { balance { denom } } } }`, nil)
+	s.Require().NotEmpty(res.Errors)
+}
+
+func (s *IntegrationTestSuite) TestLimits() {
+	// the fields nested deeper than the maximum depth are rejected
+	query := `{ cosmos_staking_v1beta1 { Params { params { bond_denom } } } }`
+	for i := 3; i < graphql.MaxQueryDepth; i++ {
+		query = strings.Replace(query, "params { bond_denom }", "params { params { bond_denom } }", 1)
+	}
+	res := s.post(query, nil)
+	s.Require().Len(res.Errors, 1)
+	s.Require().Contains(string(res.Errors[0]), "query depth exceeds")
+
+	// as are the queries selecting too many fields, including through fragments
+	res = s.post(fmt.Sprintf(`
+		query { cosmos_staking_v1beta1 { Params { params { ...a } } } }
+		fragment a on cosmos_staking_v1beta1_Params { %s }`,
+		strings.Repeat("bond_denom ", graphql.MaxQueryFields)), nil)
+	s.Require().Len(res.Errors, 1)
+	s.Require().Contains(string(res.Errors[0]), "fields")
+
+	// as are cyclic fragments
+	res = s.post(`
+		query { cosmos_staking_v1beta1 { Params { params { ...a } } } }
+		fragment a on cosmos_staking_v1beta1_Params { bond_denom ...b }
+		fragment b on cosmos_staking_v1beta1_Params { max_validators ...a }`, nil)
+	s.Require().Len(res.Errors, 1)
+	s.Require().Contains(string(res.Errors[0]), "spread within itself")
+
+	res = s.post(`
+		query { cosmos_staking_v1beta1 { Params { params { bond_denom } } } }
+		fragment a on cosmos_staking_v1beta1_Params { bond_denom ...a }`, nil)
+	s.Require().Len(res.Errors, 1)
+	s.Require().Contains(string(res.Errors[0]), "spread within itself")
+
+	// the request body is limited
+	body := fmt.Sprintf(`{"query": "{ cosmos_staking_v1beta1 { Params { params { bond_denom } } } }", "variables": {"padding": "%s"}}`,
+		strings.Repeat("a", graphql.MaxRequestBodyBytes))
+	resp, err := http.Post(s.server.URL+"/graphql", "application/json", strings.NewReader(body))
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
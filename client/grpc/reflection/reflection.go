@@ -2,6 +2,7 @@ package reflection
 
 import (
 	"context"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -43,3 +44,33 @@ func (r reflectionServiceServer) ListImplementations(_ context.Context, req *Lis
 
 	return &ListImplementationsResponse{ImplementationMessageNames: impls}, nil
 }
+
+// CodecRegistry implements the CodecRegistry method of the
+// ReflectionServiceServer interface.
+func (r reflectionServiceServer) CodecRegistry(_ context.Context, _ *CodecRegistryRequest) (*CodecRegistryResponse, error) {
+	ifaces := r.interfaceRegistry.ListAllInterfaces()
+	sort.Strings(ifaces)
+
+	res := &CodecRegistryResponse{
+		MsgTypeUrls:        r.implementations("cosmos.base.v1beta1.Msg"),
+		ServiceMsgTypeUrls: r.implementations("cosmos.base.v1beta1.ServiceMsg"),
+		Interfaces:         make([]*InterfaceImplementations, len(ifaces)),
+	}
+	for i, iface := range ifaces {
+		res.Interfaces[i] = &InterfaceImplementations{
+			InterfaceName:              iface,
+			ImplementationMessageNames: r.implementations(iface),
+		}
+	}
+
+	return res, nil
+}
+
+// implementations returns the sorted type URLs of the implementations of an
+// interface.
+func (r reflectionServiceServer) implementations(iface string) []string {
+	impls := r.interfaceRegistry.ListImplementations(iface)
+	sort.Strings(impls)
+
+	return impls
+}
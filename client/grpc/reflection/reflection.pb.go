@@ -204,11 +204,171 @@ func (m *ListImplementationsResponse) GetImplementationMessageNames() []string {
 	return nil
 }
 
+// CodecRegistryRequest is the request type of the CodecRegistry RPC.
+type CodecRegistryRequest struct {
+}
+
+func (m *CodecRegistryRequest) Reset()         { *m = CodecRegistryRequest{} }
+func (m *CodecRegistryRequest) String() string { return proto.CompactTextString(m) }
+func (*CodecRegistryRequest) ProtoMessage()    {}
+func (*CodecRegistryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d48c054165687f5c, []int{4}
+}
+func (m *CodecRegistryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodecRegistryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodecRegistryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodecRegistryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecRegistryRequest.Merge(m, src)
+}
+func (m *CodecRegistryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodecRegistryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecRegistryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecRegistryRequest proto.InternalMessageInfo
+
+// CodecRegistryResponse is the response type of the CodecRegistry RPC.
+type CodecRegistryResponse struct {
+	// msg_type_urls are the type URLs of the registered sdk.Msg implementations.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// service_msg_type_urls are the type URLs of the registered Msg service
+	// methods, e.g. "/cosmos.bank.v1beta1.Msg/Send".
+	ServiceMsgTypeUrls []string `protobuf:"bytes,2,rep,name=service_msg_type_urls,json=serviceMsgTypeUrls,proto3" json:"service_msg_type_urls,omitempty"`
+	// interfaces are all the registered interfaces along with their
+	// implementations.
+	Interfaces []*InterfaceImplementations `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+}
+
+func (m *CodecRegistryResponse) Reset()         { *m = CodecRegistryResponse{} }
+func (m *CodecRegistryResponse) String() string { return proto.CompactTextString(m) }
+func (*CodecRegistryResponse) ProtoMessage()    {}
+func (*CodecRegistryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d48c054165687f5c, []int{5}
+}
+func (m *CodecRegistryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodecRegistryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodecRegistryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CodecRegistryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodecRegistryResponse.Merge(m, src)
+}
+func (m *CodecRegistryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodecRegistryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodecRegistryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodecRegistryResponse proto.InternalMessageInfo
+
+func (m *CodecRegistryResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *CodecRegistryResponse) GetServiceMsgTypeUrls() []string {
+	if m != nil {
+		return m.ServiceMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *CodecRegistryResponse) GetInterfaces() []*InterfaceImplementations {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+// InterfaceImplementations defines a registered interface along with the type
+// URLs of its implementations.
+type InterfaceImplementations struct {
+	InterfaceName              string   `protobuf:"bytes,1,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	ImplementationMessageNames []string `protobuf:"bytes,2,rep,name=implementation_message_names,json=implementationMessageNames,proto3" json:"implementation_message_names,omitempty"`
+}
+
+func (m *InterfaceImplementations) Reset()         { *m = InterfaceImplementations{} }
+func (m *InterfaceImplementations) String() string { return proto.CompactTextString(m) }
+func (*InterfaceImplementations) ProtoMessage()    {}
+func (*InterfaceImplementations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d48c054165687f5c, []int{6}
+}
+func (m *InterfaceImplementations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterfaceImplementations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterfaceImplementations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterfaceImplementations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterfaceImplementations.Merge(m, src)
+}
+func (m *InterfaceImplementations) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterfaceImplementations) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterfaceImplementations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterfaceImplementations proto.InternalMessageInfo
+
+func (m *InterfaceImplementations) GetInterfaceName() string {
+	if m != nil {
+		return m.InterfaceName
+	}
+	return ""
+}
+
+func (m *InterfaceImplementations) GetImplementationMessageNames() []string {
+	if m != nil {
+		return m.ImplementationMessageNames
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListAllInterfacesRequest)(nil), "cosmos.base.reflection.v1beta1.ListAllInterfacesRequest")
 	proto.RegisterType((*ListAllInterfacesResponse)(nil), "cosmos.base.reflection.v1beta1.ListAllInterfacesResponse")
 	proto.RegisterType((*ListImplementationsRequest)(nil), "cosmos.base.reflection.v1beta1.ListImplementationsRequest")
 	proto.RegisterType((*ListImplementationsResponse)(nil), "cosmos.base.reflection.v1beta1.ListImplementationsResponse")
+	proto.RegisterType((*CodecRegistryRequest)(nil), "cosmos.base.reflection.v1beta1.CodecRegistryRequest")
+	proto.RegisterType((*CodecRegistryResponse)(nil), "cosmos.base.reflection.v1beta1.CodecRegistryResponse")
+	proto.RegisterType((*InterfaceImplementations)(nil), "cosmos.base.reflection.v1beta1.InterfaceImplementations")
 }
 
 func init() {
@@ -216,32 +376,41 @@ func init() {
 }
 
 var fileDescriptor_d48c054165687f5c = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x4a, 0x4d, 0xcb, 0x49, 0x4d, 0x2e, 0xc9,
-	0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0x44, 0x12, 0xd2, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x83, 0x68, 0xd0, 0x03, 0x69, 0xd0, 0x43, 0x92, 0x85, 0x6a, 0x90, 0x92,
-	0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f,
-	0x49, 0x04, 0x49, 0x17, 0x43, 0x74, 0x2b, 0x49, 0x71, 0x49, 0xf8, 0x64, 0x16, 0x97, 0x38, 0xe6,
-	0xe4, 0x78, 0xe6, 0x95, 0xa4, 0x16, 0xa5, 0x25, 0x26, 0xa7, 0x16, 0x07, 0xa5, 0x16, 0x96, 0xa6,
-	0x16, 0x97, 0x28, 0xb9, 0x70, 0x49, 0x62, 0x91, 0x2b, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52,
-	0xe7, 0xe2, 0xcf, 0x84, 0x89, 0xc6, 0xe7, 0x25, 0xe6, 0xa6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6b,
-	0x70, 0x06, 0xf1, 0xc1, 0x85, 0xfd, 0x40, 0xa2, 0x4a, 0xce, 0x5c, 0x52, 0x20, 0x53, 0x3c, 0x73,
-	0x0b, 0x72, 0x52, 0x73, 0x53, 0xf3, 0xa0, 0xd6, 0x43, 0xed, 0x10, 0x52, 0xe5, 0xe2, 0x43, 0x35,
-	0x46, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x17, 0xc5, 0x14, 0xa5, 0x78, 0x2e, 0x69, 0xac,
-	0x86, 0x40, 0x1d, 0xe3, 0xc0, 0x25, 0x93, 0x89, 0x22, 0x15, 0x9f, 0x9b, 0x5a, 0x5c, 0x9c, 0x98,
-	0x8e, 0xea, 0x32, 0x29, 0x54, 0x35, 0xbe, 0x10, 0x25, 0x60, 0x57, 0x1a, 0xed, 0x60, 0xe6, 0x12,
-	0x0c, 0x82, 0x07, 0x5e, 0x70, 0x6a, 0x51, 0x59, 0x66, 0x72, 0xaa, 0xd0, 0x1e, 0x46, 0x2e, 0x41,
-	0x8c, 0x20, 0x10, 0xb2, 0xd0, 0xc3, 0x1f, 0xe4, 0x7a, 0xb8, 0x42, 0x54, 0xca, 0x92, 0x0c, 0x9d,
-	0x10, 0x2f, 0x2a, 0x19, 0x35, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x47, 0x48, 0x8b, 0x50, 0x02, 0xc9,
-	0x44, 0x38, 0xf4, 0x31, 0x23, 0x97, 0x30, 0x96, 0x60, 0x13, 0xb2, 0x22, 0xc6, 0x19, 0xd8, 0x23,
-	0x4c, 0xca, 0x9a, 0x2c, 0xbd, 0x50, 0x4f, 0x04, 0x83, 0x3d, 0xe1, 0x2b, 0xe4, 0x4d, 0xbc, 0x27,
-	0xf4, 0xab, 0x51, 0xd3, 0x47, 0xad, 0x3e, 0x6a, 0x2c, 0x16, 0x3b, 0xf9, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0x2e, 0xcc, 0x42, 0x08, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x9f, 0x9c, 0x93, 0x99, 0x9a, 0x57,
-	0xa2, 0x9f, 0x5e, 0x54, 0x90, 0x8c, 0xe4, 0x84, 0x24, 0x36, 0x70, 0xc6, 0x30, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x32, 0x5b, 0x2b, 0x51, 0x89, 0x03, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x26, 0x08, 0xa9, 0x5b, 0xa5, 0xa8, 0x0b, 0x45, 0xc1, 0x54, 0x56, 0x64, 0x09, 0x11,
+	0x21, 0xf0, 0x92, 0x14, 0x10, 0x3f, 0x17, 0xa0, 0x5c, 0x2a, 0x08, 0x07, 0x17, 0x24, 0xc4, 0xc5,
+	0x72, 0xdc, 0xa9, 0x59, 0x61, 0x7b, 0x8d, 0x67, 0x53, 0x29, 0x42, 0x5c, 0xe0, 0x05, 0x90, 0x78,
+	0x15, 0x8e, 0x3c, 0x40, 0x8f, 0x95, 0xb8, 0x70, 0x44, 0x09, 0x77, 0x5e, 0x01, 0x39, 0x76, 0x82,
+	0x1d, 0x5c, 0x9c, 0xf6, 0x64, 0x69, 0x67, 0xbe, 0x6f, 0xe6, 0xfb, 0x66, 0xc6, 0x94, 0xbb, 0x12,
+	0x03, 0x89, 0x7c, 0xe0, 0x20, 0xf0, 0x18, 0xf6, 0x7d, 0x70, 0x95, 0x90, 0x21, 0x3f, 0xe8, 0x0e,
+	0x40, 0x39, 0xdd, 0xdc, 0x93, 0x19, 0xc5, 0x52, 0x49, 0xa6, 0xa7, 0x00, 0x33, 0x01, 0x98, 0xb9,
+	0x68, 0x06, 0xd0, 0x36, 0x3d, 0x29, 0x3d, 0x1f, 0xb8, 0x13, 0x09, 0xee, 0x84, 0xa1, 0x54, 0x4e,
+	0x12, 0xc6, 0x14, 0x6d, 0x68, 0xb4, 0xf5, 0x4c, 0xa0, 0x7a, 0xe4, 0xfb, 0x3b, 0xa1, 0x82, 0x78,
+	0xdf, 0x71, 0x01, 0x2d, 0x78, 0x37, 0x04, 0x54, 0xc6, 0x13, 0x7a, 0xa9, 0x24, 0x86, 0x91, 0x0c,
+	0x11, 0xd8, 0x55, 0x7a, 0x4e, 0xcc, 0x5e, 0xed, 0xd0, 0x09, 0x00, 0x5b, 0xa4, 0xdd, 0xe8, 0xac,
+	0x58, 0x6b, 0xf3, 0xe7, 0xe7, 0xc9, 0xab, 0xb1, 0x4d, 0xb5, 0x84, 0x65, 0x27, 0x88, 0x7c, 0x08,
+	0x20, 0xcc, 0xca, 0x67, 0x35, 0xd8, 0x15, 0xba, 0x56, 0xa4, 0x69, 0x91, 0x36, 0xe9, 0xac, 0x58,
+	0xcd, 0x02, 0x8b, 0x61, 0xd3, 0xcb, 0xa5, 0x24, 0x59, 0x33, 0x0f, 0xe9, 0xa6, 0x28, 0x84, 0xec,
+	0x00, 0x10, 0x1d, 0xaf, 0xd8, 0x99, 0x56, 0xcc, 0xe9, 0xa7, 0x29, 0x69, 0x97, 0x17, 0xe9, 0x85,
+	0x6d, 0xb9, 0x07, 0xae, 0x05, 0x9e, 0x40, 0x15, 0x8f, 0x66, 0x1e, 0x1c, 0x12, 0xba, 0xb1, 0x10,
+	0xc8, 0x6a, 0x1a, 0xb4, 0x19, 0xa0, 0x67, 0xab, 0x51, 0x04, 0xf6, 0x30, 0xf6, 0x67, 0x45, 0x56,
+	0x03, 0xf4, 0x5e, 0x8c, 0x22, 0x78, 0x19, 0xfb, 0xc8, 0xba, 0x74, 0x03, 0x21, 0x3e, 0x10, 0x2e,
+	0xd8, 0xc5, 0xdc, 0xfa, 0x34, 0x97, 0x65, 0xc1, 0x7e, 0x0e, 0xf2, 0x8a, 0xd2, 0xb9, 0x74, 0x6c,
+	0x35, 0xda, 0x8d, 0xce, 0x6a, 0xef, 0xae, 0xf9, 0xff, 0x19, 0x9b, 0xf3, 0xf9, 0x2c, 0x1a, 0x94,
+	0xe3, 0x32, 0x3e, 0x11, 0xda, 0x3a, 0x2e, 0x71, 0xc9, 0x39, 0x54, 0x1a, 0x5d, 0xaf, 0x32, 0xba,
+	0xf7, 0xfb, 0x0c, 0x5d, 0xb7, 0xe6, 0x0a, 0x76, 0x53, 0x03, 0xd8, 0x37, 0x42, 0xd7, 0xff, 0xd9,
+	0x35, 0x56, 0xa9, 0xfb, 0xb8, 0xd5, 0xd5, 0xee, 0x9d, 0x02, 0x99, 0xce, 0xd5, 0xe8, 0x7d, 0xfc,
+	0xfe, 0xeb, 0x4b, 0xfd, 0x3a, 0xbb, 0x56, 0x75, 0x89, 0x7f, 0xad, 0x65, 0x13, 0x42, 0xcf, 0x97,
+	0xec, 0x27, 0xbb, 0xbf, 0x4c, 0x1b, 0xe5, 0x97, 0xa1, 0x3d, 0x38, 0x15, 0x36, 0x13, 0xb1, 0x3b,
+	0x15, 0xd1, 0x67, 0x4f, 0x97, 0x17, 0xc1, 0xdf, 0x17, 0x17, 0xe0, 0x03, 0x17, 0x0b, 0x6a, 0xbe,
+	0x12, 0xda, 0x2c, 0xdc, 0x02, 0xbb, 0x55, 0xd5, 0x63, 0xd9, 0x4d, 0x69, 0xb7, 0x4f, 0x88, 0xca,
+	0x34, 0xdd, 0x99, 0x6a, 0xba, 0xc9, 0xcc, 0x2a, 0x4d, 0x6e, 0x02, 0xb7, 0xe3, 0x0c, 0xff, 0xb8,
+	0x7f, 0x38, 0xd6, 0xc9, 0xd1, 0x58, 0x27, 0x3f, 0xc7, 0x3a, 0xf9, 0x3c, 0xd1, 0x6b, 0x47, 0x13,
+	0xbd, 0xf6, 0x63, 0xa2, 0xd7, 0x5e, 0x6f, 0x79, 0x42, 0xbd, 0x19, 0x0e, 0x4c, 0x57, 0x06, 0x33,
+	0xce, 0xf4, 0x73, 0x03, 0xf7, 0xde, 0x72, 0xd7, 0x17, 0x10, 0x2a, 0xee, 0xc5, 0x91, 0x9b, 0xab,
+	0x32, 0x38, 0x3b, 0xfd, 0x71, 0x6e, 0xfd, 0x19, 0x00, 0x0d, 0xc7, 0x51, 0xb4, 0xa9, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +431,10 @@ type ReflectionServiceClient interface {
 	// ListImplementations list all the concrete types that implement a given
 	// interface.
 	ListImplementations(ctx context.Context, in *ListImplementationsRequest, opts ...grpc.CallOption) (*ListImplementationsResponse, error)
+	// CodecRegistry lists the registered Msg type URLs along with all the
+	// registered interfaces and their implementations, i.e. the transactions an
+	// application accepts and the types it is able to decode.
+	CodecRegistry(ctx context.Context, in *CodecRegistryRequest, opts ...grpc.CallOption) (*CodecRegistryResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -290,6 +463,15 @@ func (c *reflectionServiceClient) ListImplementations(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *reflectionServiceClient) CodecRegistry(ctx context.Context, in *CodecRegistryRequest, opts ...grpc.CallOption) (*CodecRegistryResponse, error) {
+	out := new(CodecRegistryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.reflection.v1beta1.ReflectionService/CodecRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 type ReflectionServiceServer interface {
 	// ListAllInterfaces lists all the interfaces registered in the interface
@@ -298,6 +480,10 @@ type ReflectionServiceServer interface {
 	// ListImplementations list all the concrete types that implement a given
 	// interface.
 	ListImplementations(context.Context, *ListImplementationsRequest) (*ListImplementationsResponse, error)
+	// CodecRegistry lists the registered Msg type URLs along with all the
+	// registered interfaces and their implementations, i.e. the transactions an
+	// application accepts and the types it is able to decode.
+	CodecRegistry(context.Context, *CodecRegistryRequest) (*CodecRegistryResponse, error)
 }
 
 // UnimplementedReflectionServiceServer can be embedded to have forward compatible implementations.
@@ -310,6 +496,9 @@ func (*UnimplementedReflectionServiceServer) ListAllInterfaces(ctx context.Conte
 func (*UnimplementedReflectionServiceServer) ListImplementations(ctx context.Context, req *ListImplementationsRequest) (*ListImplementationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListImplementations not implemented")
 }
+func (*UnimplementedReflectionServiceServer) CodecRegistry(ctx context.Context, req *CodecRegistryRequest) (*CodecRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodecRegistry not implemented")
+}
 
 func RegisterReflectionServiceServer(s grpc1.Server, srv ReflectionServiceServer) {
 	s.RegisterService(&_ReflectionService_serviceDesc, srv)
@@ -351,6 +540,24 @@ func _ReflectionService_ListImplementations_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_CodecRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CodecRegistryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).CodecRegistry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.reflection.v1beta1.ReflectionService/CodecRegistry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).CodecRegistry(ctx, req.(*CodecRegistryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.reflection.v1beta1.ReflectionService",
 	HandlerType: (*ReflectionServiceServer)(nil),
@@ -363,6 +570,10 @@ var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListImplementations",
 			Handler:    _ReflectionService_ListImplementations_Handler,
 		},
+		{
+			MethodName: "CodecRegistry",
+			Handler:    _ReflectionService_CodecRegistry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/reflection/v1beta1/reflection.proto",
@@ -485,6 +696,123 @@ func (m *ListImplementationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *CodecRegistryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodecRegistryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodecRegistryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CodecRegistryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodecRegistryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodecRegistryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Interfaces) > 0 {
+		for iNdEx := len(m.Interfaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Interfaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ServiceMsgTypeUrls) > 0 {
+		for iNdEx := len(m.ServiceMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ServiceMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.ServiceMsgTypeUrls[iNdEx])
+			i = encodeVarintReflection(dAtA, i, uint64(len(m.ServiceMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintReflection(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InterfaceImplementations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterfaceImplementations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterfaceImplementations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ImplementationMessageNames) > 0 {
+		for iNdEx := len(m.ImplementationMessageNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ImplementationMessageNames[iNdEx])
+			copy(dAtA[i:], m.ImplementationMessageNames[iNdEx])
+			i = encodeVarintReflection(dAtA, i, uint64(len(m.ImplementationMessageNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.InterfaceName) > 0 {
+		i -= len(m.InterfaceName)
+		copy(dAtA[i:], m.InterfaceName)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.InterfaceName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReflection(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReflection(v)
 	base := offset
@@ -548,6 +876,61 @@ func (m *ListImplementationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *CodecRegistryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CodecRegistryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	if len(m.ServiceMsgTypeUrls) > 0 {
+		for _, s := range m.ServiceMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	if len(m.Interfaces) > 0 {
+		for _, e := range m.Interfaces {
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InterfaceImplementations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InterfaceName)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if len(m.ImplementationMessageNames) > 0 {
+		for _, s := range m.ImplementationMessageNames {
+			l = len(s)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovReflection(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -850,6 +1233,318 @@ func (m *ListImplementationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CodecRegistryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodecRegistryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodecRegistryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodecRegistryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodecRegistryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodecRegistryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceMsgTypeUrls = append(m.ServiceMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interfaces = append(m.Interfaces, &InterfaceImplementations{})
+			if err := m.Interfaces[len(m.Interfaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InterfaceImplementations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterfaceImplementations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterfaceImplementations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterfaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplementationMessageNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImplementationMessageNames = append(m.ImplementationMessageNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReflection(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
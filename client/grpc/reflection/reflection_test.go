@@ -2,6 +2,7 @@ package reflection_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -50,6 +51,22 @@ func (s IntegrationTestSuite) TestSimulateService() {
 	s.Require().Contains(resImpl.GetImplementationMessageNames(), "/cosmos.evidence.v1beta1.Equivocation")
 }
 
+func (s IntegrationTestSuite) TestCodecRegistry() {
+	res, err := s.queryClient.CodecRegistry(context.Background(), &reflection.CodecRegistryRequest{})
+	s.Require().NoError(err)
+
+	s.Require().Contains(res.MsgTypeUrls, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().True(sort.StringsAreSorted(res.MsgTypeUrls))
+	s.Require().Contains(res.ServiceMsgTypeUrls, "/cosmos.bank.v1beta1.Msg/Send")
+
+	ifaces := make(map[string][]string, len(res.Interfaces))
+	for _, iface := range res.Interfaces {
+		ifaces[iface.InterfaceName] = iface.ImplementationMessageNames
+	}
+	s.Require().Contains(ifaces["cosmos.evidence.v1beta1.Evidence"], "/cosmos.evidence.v1beta1.Equivocation")
+	s.Require().Equal(res.MsgTypeUrls, ifaces["cosmos.base.v1beta1.Msg"])
+}
+
 func TestSimulateTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
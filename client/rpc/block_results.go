@@ -0,0 +1,220 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// decodedAttribute is an ABCI event attribute with its key and value rendered
+// as strings.
+type decodedAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// decodedEvent is an ABCI event with readable attributes. Typed events (see
+// sdk.EventManager.EmitTypedEvent) are additionally decoded into their proto
+// message and rendered as proto JSON, which resolves any Any fields they hold.
+type decodedEvent struct {
+	Type       string             `json:"type"`
+	Attributes []decodedAttribute `json:"attributes"`
+	Typed      json.RawMessage    `json:"typed,omitempty"`
+}
+
+// decodedTxResult is the result of a single transaction in a block.
+type decodedTxResult struct {
+	Hash      tmbytes.HexBytes `json:"hash,omitempty"`
+	Code      uint32           `json:"code"`
+	Codespace string           `json:"codespace,omitempty"`
+	Log       string           `json:"log,omitempty"`
+	GasWanted int64            `json:"gas_wanted"`
+	GasUsed   int64            `json:"gas_used"`
+	Data      json.RawMessage  `json:"data,omitempty"`
+	Events    []decodedEvent   `json:"events"`
+}
+
+// decodedBlockResults is the ABCI results of a block with all events decoded.
+type decodedBlockResults struct {
+	Height           int64             `json:"height"`
+	BeginBlockEvents []decodedEvent    `json:"begin_block_events"`
+	TxResults        []decodedTxResult `json:"tx_results"`
+	EndBlockEvents   []decodedEvent    `json:"end_block_events"`
+}
+
+// BlockResultsCommand returns the decoded ABCI results of the block at a given height
+func BlockResultsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-results [height]",
+		Short: "Get the decoded begin block, transaction and end block events for the block at given height",
+		Long: `Get the ABCI results of the block at the given height (or the latest block when omitted).
+Event attributes are printed as strings, typed events and transaction result data are
+decoded using the application codec and printed as JSON.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			var height *int64
+
+			// optional height
+			if len(args) > 0 {
+				h, err := strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				if h > 0 {
+					height = &h
+				}
+			}
+
+			output, err := getBlockResults(clientCtx, height)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(output))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+
+	return cmd
+}
+
+func getBlockResults(clientCtx client.Context, height *int64) ([]byte, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := node.BlockResults(context.Background(), height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the block is needed to compute the transaction hashes
+	block, err := node.Block(context.Background(), &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(block.Block.Txs) != len(res.TxsResults) {
+		return nil, fmt.Errorf("block %d has %d txs but %d tx results", res.Height, len(block.Block.Txs), len(res.TxsResults))
+	}
+
+	results := decodedBlockResults{
+		Height:           res.Height,
+		BeginBlockEvents: decodeEvents(clientCtx.JSONMarshaler, res.BeginBlockEvents),
+		TxResults:        make([]decodedTxResult, len(res.TxsResults)),
+		EndBlockEvents:   decodeEvents(clientCtx.JSONMarshaler, res.EndBlockEvents),
+	}
+
+	for i, txRes := range res.TxsResults {
+		results.TxResults[i] = decodedTxResult{
+			Hash:      block.Block.Txs[i].Hash(),
+			Code:      txRes.Code,
+			Codespace: txRes.Codespace,
+			Log:       txRes.Log,
+			GasWanted: txRes.GasWanted,
+			GasUsed:   txRes.GasUsed,
+			Data:      decodeTxData(clientCtx.JSONMarshaler, txRes.Data),
+			Events:    decodeEvents(clientCtx.JSONMarshaler, txRes.Events),
+		}
+	}
+
+	return json.Marshal(results)
+}
+
+// decodeTxData decodes the result data of a successful transaction as
+// sdk.TxMsgData. It returns nil when the data cannot be decoded.
+func decodeTxData(cdc codec.JSONMarshaler, data []byte) json.RawMessage {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(data, &txMsgData); err != nil {
+		return nil
+	}
+
+	bz, err := cdc.MarshalJSON(&txMsgData)
+	if err != nil {
+		return nil
+	}
+
+	return bz
+}
+
+func decodeEvents(cdc codec.JSONMarshaler, events []abci.Event) []decodedEvent {
+	decoded := make([]decodedEvent, len(events))
+	for i, event := range events {
+		decoded[i] = decodeEvent(cdc, event)
+	}
+
+	return decoded
+}
+
+func decodeEvent(cdc codec.JSONMarshaler, event abci.Event) decodedEvent {
+	decoded := decodedEvent{
+		Type:       event.Type,
+		Attributes: make([]decodedAttribute, len(event.Attributes)),
+	}
+
+	for i, attr := range event.Attributes {
+		decoded.Attributes[i] = decodedAttribute{Key: string(attr.Key), Value: string(attr.Value)}
+	}
+
+	if msg, err := parseTypedEvent(cdc, event); err == nil {
+		if bz, err := cdc.MarshalJSON(msg); err == nil {
+			decoded.Typed = bz
+		}
+	}
+
+	return decoded
+}
+
+// parseTypedEvent is a variant of sdk.ParseTypedEvent that unmarshals the
+// event with the application codec so that Any fields are resolved against
+// its interface registry.
+func parseTypedEvent(cdc codec.JSONMarshaler, event abci.Event) (proto.Message, error) {
+	concreteGoType := proto.MessageType(event.Type)
+	if concreteGoType == nil || concreteGoType.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("failed to retrieve the message of type %q", event.Type)
+	}
+
+	protoMsg, ok := reflect.New(concreteGoType.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%q does not implement proto.Message", event.Type)
+	}
+
+	attrMap := make(map[string]json.RawMessage)
+	for _, attr := range event.Attributes {
+		attrMap[string(attr.Key)] = attr.Value
+	}
+
+	attrBytes, err := json.Marshal(attrMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cdc.UnmarshalJSON(attrBytes, protoMsg); err != nil {
+		return nil, err
+	}
+
+	return protoMsg, nil
+}
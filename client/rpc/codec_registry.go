@@ -0,0 +1,40 @@
+package rpc
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
+)
+
+// CodecRegistryCommand returns the Msg type URLs, interfaces and implementations
+// registered in the interface registry of the node's application.
+func CodecRegistryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "codec-registry",
+		Short: "Query the Msg types, interfaces and implementations registered in the node's application",
+		Long: `Query the type URLs of the Msgs and Msg service methods registered in the node's
+application, i.e. the transactions it accepts, along with all its registered interfaces
+and their implementations.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := reflection.NewReflectionServiceClient(clientCtx)
+			res, err := queryClient.CodecRegistry(cmd.Context(), &reflection.CodecRegistryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
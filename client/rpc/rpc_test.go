@@ -1,16 +1,20 @@
 package rpc_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
+	"github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
@@ -43,6 +47,52 @@ func (s *IntegrationTestSuite) TestStatusCommand() {
 
 	// Make sure the output has the validator moniker.
 	s.Require().Contains(out.String(), fmt.Sprintf("\"moniker\":\"%s\"", val0.Moniker))
+
+	var res struct {
+		ValidatorInfo struct {
+			SignedLatestBlock bool
+		}
+		AppInfo sdk.AppStatusResponse
+	}
+	s.Require().NoError(legacy.Cdc.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().True(res.ValidatorInfo.SignedLatestBlock)
+	s.Require().Equal(int64(1), res.AppInfo.EarliestStoreHeight)
+	s.Require().GreaterOrEqual(res.AppInfo.LatestStoreHeight, int64(1))
+	minGasPrices, err := sdk.ParseDecCoins(s.network.Config.MinGasPrices)
+	s.Require().NoError(err)
+	s.Require().Equal(minGasPrices, res.AppInfo.MinGasPrices)
+}
+
+func (s *IntegrationTestSuite) TestBlockResultsCommand() {
+	val0 := s.network.Validators[0]
+	cmd := rpc.BlockResultsCommand()
+
+	out, err := clitestutil.ExecTestCLICmd(val0.ClientCtx, cmd, []string{"1"})
+	s.Require().NoError(err)
+
+	var res map[string]json.RawMessage
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &res))
+	s.Require().Equal("1", string(res["height"]))
+	s.Require().Contains(res, "begin_block_events")
+	s.Require().Contains(res, "tx_results")
+	s.Require().Contains(res, "end_block_events")
+
+	// begin block events of the first block include the minted coins
+	s.Require().Contains(string(res["begin_block_events"]), "\"type\":\"mint\"")
+}
+
+func (s *IntegrationTestSuite) TestCodecRegistryCommand() {
+	val0 := s.network.Validators[0]
+	cmd := rpc.CodecRegistryCommand()
+
+	out, err := clitestutil.ExecTestCLICmd(val0.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res reflection.CodecRegistryResponse
+	s.Require().NoError(val0.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Contains(res.MsgTypeUrls, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().Contains(res.ServiceMsgTypeUrls, "/cosmos.staking.v1beta1.Msg/Delegate")
+	s.Require().NotEmpty(res.Interfaces)
 }
 
 func (s *IntegrationTestSuite) TestLatestBlocks() {
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/spf13/cobra"
@@ -14,24 +15,28 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // ValidatorInfo is info about the node's validator, same as Tendermint,
-// except that we use our own PubKey.
+// except that we use our own PubKey. SignedLatestBlock reports whether the
+// validator signature is part of the commit of the latest block.
 type validatorInfo struct {
-	Address     bytes.HexBytes
-	PubKey      cryptotypes.PubKey
-	VotingPower int64
+	Address           bytes.HexBytes
+	PubKey            cryptotypes.PubKey
+	VotingPower       int64
+	SignedLatestBlock bool
 }
 
 // ResultStatus is node's info, same as Tendermint, except that we use our own
-// PubKey.
+// PubKey, along with the application level status of the node.
 type resultStatus struct {
 	NodeInfo      p2p.DefaultNodeInfo
 	SyncInfo      ctypes.SyncInfo
 	ValidatorInfo validatorInfo
+	AppInfo       sdk.AppStatusResponse
 }
 
 // StatusCommand returns the command to return the status of the network.
@@ -39,6 +44,11 @@ func StatusCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Query remote node for status",
+		Long: `Query remote node for status. Along with the Tendermint status (node info,
+sync info including the catching up flag, and validator info), the output includes
+whether the node's validator signed the latest block, and the application status:
+the earliest and latest stored application heights, the state pruning configuration
+and the minimum gas prices of the node.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -55,14 +65,27 @@ func StatusCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
+
+			signed, err := signedLatestBlock(clientCtx, status)
+			if err != nil {
+				return err
+			}
+
+			appInfo, err := getAppStatus(clientCtx)
+			if err != nil {
+				return err
+			}
+
 			statusWithPk := resultStatus{
 				NodeInfo: status.NodeInfo,
 				SyncInfo: status.SyncInfo,
 				ValidatorInfo: validatorInfo{
-					Address:     status.ValidatorInfo.Address,
-					PubKey:      pk,
-					VotingPower: status.ValidatorInfo.VotingPower,
+					Address:           status.ValidatorInfo.Address,
+					PubKey:            pk,
+					VotingPower:       status.ValidatorInfo.VotingPower,
+					SignedLatestBlock: signed,
 				},
+				AppInfo: appInfo,
 			}
 
 			output, err := clientCtx.LegacyAmino.MarshalJSON(statusWithPk)
@@ -89,6 +112,50 @@ func getNodeStatus(clientCtx client.Context) (*ctypes.ResultStatus, error) {
 	return node.Status(context.Background())
 }
 
+// signedLatestBlock returns whether the node's validator signature is part of
+// the commit of the latest block. It is always false for a node which is not
+// in the active validator set.
+func signedLatestBlock(clientCtx client.Context, status *ctypes.ResultStatus) (bool, error) {
+	if status.ValidatorInfo.VotingPower == 0 || status.SyncInfo.LatestBlockHeight == 0 {
+		return false, nil
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return false, err
+	}
+
+	height := status.SyncInfo.LatestBlockHeight
+	commit, err := node.Commit(context.Background(), &height)
+	if err != nil {
+		return false, err
+	}
+
+	for _, sig := range commit.Commit.Signatures {
+		if sig.ForBlock() && sig.ValidatorAddress.String() == status.ValidatorInfo.Address.String() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// getAppStatus queries the application level status of the node.
+func getAppStatus(clientCtx client.Context) (sdk.AppStatusResponse, error) {
+	var appStatus sdk.AppStatusResponse
+
+	bz, _, err := clientCtx.Query("/app/status")
+	if err != nil {
+		return appStatus, err
+	}
+
+	if err := json.Unmarshal(bz, &appStatus); err != nil {
+		return appStatus, err
+	}
+
+	return appStatus, nil
+}
+
 // NodeInfoResponse defines a response type that contains node status and version
 // information.
 type NodeInfoResponse struct {
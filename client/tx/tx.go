@@ -2,19 +2,25 @@ package tx
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/types/tx"
@@ -33,6 +39,11 @@ func GenerateOrBroadcastTxCLI(clientCtx client.Context, flagSet *pflag.FlagSet,
 // GenerateOrBroadcastTxWithFactory will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
+	msgs, err := convertMsgAddresses(clientCtx, msgs)
+	if err != nil {
+		return err
+	}
+
 	if clientCtx.GenerateOnly {
 		return GenerateTx(clientCtx, txf, msgs...)
 	}
@@ -40,6 +51,110 @@ func GenerateOrBroadcastTxWithFactory(clientCtx client.Context, txf Factory, msg
 	return BroadcastTx(clientCtx, txf, msgs...)
 }
 
+// convertMsgAddresses re-encodes the Bech32 addresses of msgs, which are encoded
+// with the prefixes of the sdk Config, with the prefixes of the client context,
+// e.g. the ones set by the --prefix flag.
+func convertMsgAddresses(clientCtx client.Context, msgs []sdk.Msg) ([]sdk.Msg, error) {
+	config := sdk.GetConfig()
+	prefixes := make(map[string]string)
+
+	for from, to := range map[string]string{
+		config.GetBech32AccountAddrPrefix():   clientCtx.Bech32AccountAddrPrefix(),
+		config.GetBech32ValidatorAddrPrefix(): clientCtx.Bech32ValidatorAddrPrefix(),
+		config.GetBech32ConsensusAddrPrefix(): clientCtx.Bech32ConsensusAddrPrefix(),
+	} {
+		if from != to {
+			prefixes[from] = to
+		}
+	}
+
+	if len(prefixes) == 0 {
+		return msgs, nil
+	}
+
+	converted := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		if svcMsg, ok := msg.(sdk.ServiceMsg); ok {
+			req, err := convertAddresses(clientCtx.JSONMarshaler, svcMsg.Request, prefixes)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[i] = sdk.ServiceMsg{MethodName: svcMsg.MethodName, Request: req.(sdk.MsgRequest)}
+			continue
+		}
+
+		m, err := convertAddresses(clientCtx.JSONMarshaler, msg, prefixes)
+		if err != nil {
+			return nil, err
+		}
+
+		converted[i] = m.(sdk.Msg)
+	}
+
+	return converted, nil
+}
+
+// convertAddresses returns a copy of msg in which every Bech32 string encoded
+// with a prefix of prefixes is re-encoded with the prefix it maps to.
+func convertAddresses(cdc codec.JSONMarshaler, msg proto.Message, prefixes map[string]string) (proto.Message, error) {
+	bz, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	bz, err = json.Marshal(convertBech32Strings(v, prefixes))
+	if err != nil {
+		return nil, err
+	}
+
+	converted := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(proto.Message)
+	if err := cdc.UnmarshalJSON(bz, converted); err != nil {
+		return nil, err
+	}
+
+	return converted, nil
+}
+
+// convertBech32Strings re-encodes the Bech32 strings of a decoded JSON value
+// whose prefix is in prefixes.
+func convertBech32Strings(v interface{}, prefixes map[string]string) interface{} {
+	switch v := v.(type) {
+	case string:
+		hrp, bz, err := bech32.DecodeAndConvert(v)
+		if err != nil {
+			return v
+		}
+
+		prefix, ok := prefixes[hrp]
+		if !ok {
+			return v
+		}
+
+		return sdk.MustBech32ifyAddressBytes(prefix, bz)
+
+	case []interface{}:
+		for i := range v {
+			v[i] = convertBech32Strings(v[i], prefixes)
+		}
+
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = convertBech32Strings(v[k], prefixes)
+		}
+	}
+
+	return v
+}
+
 // GenerateTx will generate an unsigned transaction and print it to the writer
 // specified by ctx.Output. If simulation was requested, the gas will be
 // simulated and also printed to the same writer before the transaction is
@@ -75,6 +75,14 @@ type APIConfig struct {
 	// Swagger defines if swagger documentation should automatically be registered.
 	Swagger bool `mapstructure:"swagger"`
 
+	// LegacyRESTgRPC defines if the legacy REST query endpoints should be served
+	// from the gRPC query services instead of the legacy queriers.
+	LegacyRESTgRPC bool `mapstructure:"legacy-rest-grpc"`
+
+	// GraphQL defines if the GraphQL endpoint over the gRPC query services
+	// should be registered.
+	GraphQL bool `mapstructure:"graphql"`
+
 	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
 	EnableUnsafeCORS bool `mapstructure:"enabled-unsafe-cors"`
 
@@ -176,6 +184,8 @@ func DefaultConfig() *Config {
 		API: APIConfig{
 			Enable:             false,
 			Swagger:            false,
+			LegacyRESTgRPC:     false,
+			GraphQL:            false,
 			Address:            "tcp://0.0.0.0:1317",
 			MaxOpenConnections: 1000,
 			RPCReadTimeout:     10,
@@ -228,6 +238,8 @@ func GetConfig(v *viper.Viper) Config {
 		API: APIConfig{
 			Enable:             v.GetBool("api.enable"),
 			Swagger:            v.GetBool("api.swagger"),
+			LegacyRESTgRPC:     v.GetBool("api.legacy-rest-grpc"),
+			GraphQL:            v.GetBool("api.graphql"),
 			Address:            v.GetString("api.address"),
 			MaxOpenConnections: v.GetUint("api.max-open-connections"),
 			RPCReadTimeout:     v.GetUint("api.rpc-read-timeout"),
@@ -117,6 +117,15 @@ enable = {{ .API.Enable }}
 # Swagger defines if swagger documentation should automatically be registered.
 swagger = {{ .API.Swagger }}
 
+# LegacyRESTgRPC defines if the legacy REST query endpoints (e.g. /bank, /staking
+# and /distribution) should be served from the gRPC query services instead of
+# the legacy queriers.
+legacy-rest-grpc = {{ .API.LegacyRESTgRPC }}
+
+# GraphQL defines if the GraphQL endpoint (/graphql) over the gRPC query
+# services should be registered.
+graphql = {{ .API.GraphQL }}
+
 # Address defines the API server to listen on.
 address = "{{ .API.Address }}"
 
@@ -0,0 +1,52 @@
+package simapp
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client/graphql"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GraphQLJoins returns the joins of the SimApp GraphQL schema. They add the
+// distribution commission, the outstanding rewards and the signing info of a
+// validator to the staking Validator type.
+func GraphQLJoins(cdc codec.JSONMarshaler) []graphql.Join {
+	validatorAddress := func(validator map[string]interface{}) (map[string]interface{}, error) {
+		return map[string]interface{}{"validator_address": validator["operator_address"]}, nil
+	}
+
+	return []graphql.Join{
+		{
+			Message: "cosmos.staking.v1beta1.Validator",
+			Field:   "distribution_commission",
+			Method:  "/cosmos.distribution.v1beta1.Query/ValidatorCommission",
+			Request: validatorAddress,
+		},
+		{
+			Message: "cosmos.staking.v1beta1.Validator",
+			Field:   "outstanding_rewards",
+			Method:  "/cosmos.distribution.v1beta1.Query/ValidatorOutstandingRewards",
+			Request: validatorAddress,
+		},
+		{
+			Message: "cosmos.staking.v1beta1.Validator",
+			Field:   "signing_info",
+			Method:  "/cosmos.slashing.v1beta1.Query/SigningInfo",
+			Request: func(validator map[string]interface{}) (map[string]interface{}, error) {
+				bz, err := json.Marshal(validator["consensus_pubkey"])
+				if err != nil {
+					return nil, err
+				}
+
+				var pk cryptotypes.PubKey
+				if err := cdc.UnmarshalInterfaceJSON(bz, &pk); err != nil {
+					return nil, err
+				}
+
+				return map[string]interface{}{"cons_address": sdk.ConsAddress(pk.Address()).String()}, nil
+			},
+		},
+	}
+}
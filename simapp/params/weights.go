@@ -17,7 +17,9 @@ const (
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
 
-	DefaultWeightCommunitySpendProposal int = 5
-	DefaultWeightTextProposal           int = 5
-	DefaultWeightParamChangeProposal    int = 5
+	DefaultWeightCommunitySpendProposal        int = 5
+	DefaultWeightCommunityStreamProposal       int = 5
+	DefaultWeightCancelCommunityStreamProposal int = 5
+	DefaultWeightTextProposal                  int = 5
+	DefaultWeightParamChangeProposal           int = 5
 )
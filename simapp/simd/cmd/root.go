@@ -119,8 +119,10 @@ func queryCommand() *cobra.Command {
 		authcmd.GetAccountCmd(),
 		rpc.ValidatorCommand(),
 		rpc.BlockCommand(),
+		rpc.BlockResultsCommand(),
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
+		rpc.CodecRegistryCommand(),
 	)
 
 	simapp.ModuleBasics.AddQueryCommands(cmd)
@@ -0,0 +1,28 @@
+package oracle
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker aggregates the price votes at the end of every vote period and
+// slashes the validators that missed too many votes at the end of every slash
+// window.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	params := k.GetParams(ctx)
+	height := uint64(ctx.BlockHeight())
+
+	if height%params.VotePeriod == 0 {
+		k.TallyVotes(ctx, params)
+	}
+
+	if height%params.SlashWindow == 0 {
+		k.SlashAndResetMissCounters(ctx, params)
+	}
+}
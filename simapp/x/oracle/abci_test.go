@@ -0,0 +1,141 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	pks := simapp.CreateTestPubKeys(2)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(100))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	voter, absent := sdk.ValAddress(pks[0].Address()), sdk.ValAddress(pks[1].Address())
+	tstaking.CreateValidatorWithValPower(voter, pks[0], 50, true)
+	tstaking.CreateValidatorWithValPower(absent, pks[1], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 2
+	params.SlashWindow = 4
+	params.Whitelist = []string{"uatom"}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	handler := oracle.NewHandler(app.OracleKeeper)
+	for height := int64(1); height <= 4; height++ {
+		ctx = ctx.WithBlockHeight(height)
+
+		_, err := handler(ctx, types.NewMsgPriceVote(
+			sdk.AccAddress(voter), voter, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDec(height))),
+		))
+		require.NoError(t, err)
+
+		oracle.EndBlocker(ctx, app.OracleKeeper)
+
+		price, found := app.OracleKeeper.GetPrice(ctx, "uatom")
+		if height%2 == 1 {
+			// the vote period is still running
+			_, voted := app.OracleKeeper.GetPriceVote(ctx, voter)
+			require.True(t, voted)
+			if height == 1 {
+				require.False(t, found)
+			}
+			continue
+		}
+
+		// the last vote of the period is tallied
+		require.True(t, found)
+		require.Equal(t, sdk.NewDec(height), price)
+		_, voted := app.OracleKeeper.GetPriceVote(ctx, voter)
+		require.False(t, voted)
+
+		if height == 2 {
+			require.Equal(t, uint64(1), app.OracleKeeper.GetMissCounter(ctx, absent))
+		}
+	}
+
+	// the absent validator missed every vote period of the slash window
+	require.True(t, app.StakingKeeper.Validator(ctx, absent).IsJailed())
+	require.False(t, app.StakingKeeper.Validator(ctx, voter).IsJailed())
+	require.Zero(t, app.OracleKeeper.GetMissCounter(ctx, absent))
+}
+
+func TestEndBlockerEmptyWhitelist(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(100))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	valAddr := sdk.ValAddress(pks[0].Address())
+	tstaking.CreateValidatorWithValPower(valAddr, pks[0], 50, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the default params do not whitelist any denom so no vote can be submitted
+	params := types.DefaultParams()
+	require.Empty(t, params.Whitelist)
+	app.OracleKeeper.SetParams(ctx, params)
+
+	for height := params.VotePeriod; height <= params.SlashWindow; height += params.VotePeriod {
+		ctx = ctx.WithBlockHeight(int64(height))
+		oracle.EndBlocker(ctx, app.OracleKeeper)
+
+		if height == params.VotePeriod {
+			require.Zero(t, app.OracleKeeper.GetMissCounter(ctx, valAddr))
+		}
+	}
+
+	// no validator is jailed at the end of the slash window
+	app.StakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		require.False(t, validator.IsJailed(), validator.GetOperator().String())
+		return false
+	})
+}
+
+func TestEndBlockerVotePeriodExceedsSlashWindow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(100))
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	absent := sdk.ValAddress(pks[0].Address())
+	tstaking.CreateValidatorWithValPower(absent, pks[0], 50, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 2
+	params.SlashWindow = 4
+	params.Whitelist = []string{"uatom"}
+	app.OracleKeeper.SetParams(ctx, params)
+
+	// a parameter change proposal only runs the validation of the changed param
+	subspace, found := app.ParamsKeeper.GetSubspace(types.ModuleName)
+	require.True(t, found)
+	require.NoError(t, subspace.Update(ctx, types.KeyVotePeriod, []byte(`"8"`)))
+	require.Equal(t, uint64(8), app.OracleKeeper.GetParams(ctx).VotePeriod)
+
+	for height := int64(1); height <= 8; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NotPanics(t, func() { oracle.EndBlocker(ctx, app.OracleKeeper) })
+	}
+
+	// the absent validator missed the single vote period of the slash window
+	require.True(t, app.StakingKeeper.Validator(ctx, absent).IsJailed())
+	require.Zero(t, app.OracleKeeper.GetMissCounter(ctx, absent))
+}
@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GetQueryCmd returns the cli query commands for the oracle module.
+func GetQueryCmd() *cobra.Command {
+	oracleQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the oracle module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	oracleQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryPrice(),
+		GetCmdQueryPrices(),
+		GetCmdQueryPriceVote(),
+		GetCmdQueryFeederDelegation(),
+		GetCmdQueryMissCounter(),
+	)
+
+	return oracleQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the oracle parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current oracle parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrice implements a command to return the last aggregated price of a denom.
+func GetCmdQueryPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price [denom]",
+		Short: "Query the last aggregated price of a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last aggregated price of a denom:
+
+$ %s query oracle price uatom
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Price(context.Background(), &types.QueryPriceRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPrices implements a command to return the last aggregated prices of all the denoms.
+func GetCmdQueryPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prices",
+		Short: "Query the last aggregated prices of all the denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Prices(context.Background(), &types.QueryPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPriceVote implements a command to return the price vote of a validator for the current vote period.
+func GetCmdQueryPriceVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [validator]",
+		Short: "Query the price vote of a validator for the current vote period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the price vote of a validator for the current vote period:
+
+$ %s query oracle vote cosmosvaloper1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PriceVote(context.Background(), &types.QueryPriceVoteRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryFeederDelegation implements a command to return the feeder account of a validator.
+func GetCmdQueryFeederDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder [validator]",
+		Short: "Query the account submitting price votes for a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the account submitting price votes for a validator:
+
+$ %s query oracle feeder cosmosvaloper1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeederDelegation(context.Background(), &types.QueryFeederDelegationRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMissCounter implements a command to return the miss counter of a validator.
+func GetCmdQueryMissCounter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "miss [validator]",
+		Short: "Query the number of vote periods a validator missed in the current slash window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of vote periods a validator missed in the current slash window:
+
+$ %s query oracle miss cosmosvaloper1...
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MissCounter(context.Background(), &types.QueryMissCounterRequest{Validator: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// NewTxCmd returns the transaction commands for the oracle module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Oracle transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdPriceVote(),
+		NewCmdDelegateFeedConsent(),
+	)
+
+	return txCmd
+}
+
+// NewCmdPriceVote implements submitting the prices observed by a validator.
+func NewCmdPriceVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [validator] [prices]",
+		Short: "Submit the prices observed by a validator for the current vote period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit the prices observed by a validator for the current vote period. The
+transaction must be signed by the validator operator or by the feeder account
+the validator delegated its votes to. A new vote overwrites the previous vote
+of the same vote period.
+
+Example:
+$ %s tx oracle vote cosmosvaloper1... 12.5uatom,0.3uosmo --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := clientCtx.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			prices, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPriceVote(clientCtx.GetFromAddress(), valAddr, prices)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateFeedConsent implements delegating the price votes of a
+// validator to a feeder account.
+func NewCmdDelegateFeedConsent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-feeder [feeder]",
+		Short: "Delegate the price votes of a validator to a feeder account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate the price votes of the validator operated by the '--from' account to
+a feeder account.
+
+Example:
+$ %s tx oracle delegate-feeder cosmos1... --from myvalidatorkey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			feeder, err := clientCtx.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegateFeedConsent(sdk.ValAddress(clientCtx.GetFromAddress()), feeder)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package oracle
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	for _, price := range data.Prices {
+		k.SetPrice(ctx, price.Denom, price.Amount)
+	}
+
+	for _, fd := range data.FeederDelegations {
+		valAddr, err := sdk.ValAddressFromBech32(fd.Validator)
+		if err != nil {
+			panic(err)
+		}
+		feeder, err := sdk.AccAddressFromBech32(fd.Feeder)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetFeederDelegation(ctx, valAddr, feeder)
+	}
+
+	for _, mc := range data.MissCounters {
+		valAddr, err := sdk.ValAddressFromBech32(mc.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetMissCounter(ctx, valAddr, mc.MissCount)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper. The
+// price votes of the running vote period are not exported.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	feederDelegations := []types.FeederDelegation{}
+	k.IterateFeederDelegations(ctx, func(valAddr sdk.ValAddress, feeder sdk.AccAddress) bool {
+		feederDelegations = append(feederDelegations, types.FeederDelegation{
+			Validator: valAddr.String(),
+			Feeder:    feeder.String(),
+		})
+		return false
+	})
+
+	missCounters := []types.MissCounter{}
+	k.IterateMissCounters(ctx, func(valAddr sdk.ValAddress, missCount uint64) bool {
+		missCounters = append(missCounters, types.MissCounter{
+			Validator: valAddr.String(),
+			MissCount: missCount,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), k.GetPrices(ctx), feederDelegations, missCounters)
+}
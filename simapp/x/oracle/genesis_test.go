@@ -0,0 +1,44 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	params := types.DefaultParams()
+	params.Whitelist = []string{"uatom", "uosmo"}
+	genesisState := types.NewGenesisState(
+		params,
+		sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(125, 1)),
+			sdk.NewDecCoinFromDec("uosmo", sdk.NewDecWithPrec(3, 1)),
+		),
+		[]types.FeederDelegation{{Validator: valAddr.String(), Feeder: addrs[1].String()}},
+		[]types.MissCounter{{Validator: valAddr.String(), MissCount: 2}},
+	)
+	require.NoError(t, genesisState.Validate())
+
+	oracle.InitGenesis(ctx, app.OracleKeeper, *genesisState)
+
+	price, found := app.OracleKeeper.GetPrice(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, sdk.NewDecWithPrec(125, 1), price)
+	require.Equal(t, addrs[1], app.OracleKeeper.GetFeederDelegation(ctx, valAddr))
+	require.Equal(t, uint64(2), app.OracleKeeper.GetMissCounter(ctx, valAddr))
+
+	exported := oracle.ExportGenesis(ctx, app.OracleKeeper)
+	require.Equal(t, genesisState, exported)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params of the oracle module
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Price returns the last aggregated price of a denom
+func (k Keeper) Price(c context.Context, req *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	price, found := k.GetPrice(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no price for denom %s", req.Denom)
+	}
+
+	return &types.QueryPriceResponse{Price: price}, nil
+}
+
+// Prices returns the last aggregated prices of all the denoms
+func (k Keeper) Prices(c context.Context, _ *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPricesResponse{Prices: k.GetPrices(ctx)}, nil
+}
+
+// PriceVote returns the price vote of a validator for the current vote period
+func (k Keeper) PriceVote(c context.Context, req *types.QueryPriceVoteRequest) (*types.QueryPriceVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, found := k.GetPriceVote(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no price vote for validator %s", req.Validator)
+	}
+
+	return &types.QueryPriceVoteResponse{Vote: vote}, nil
+}
+
+// FeederDelegation returns the feeder account of a validator
+func (k Keeper) FeederDelegation(c context.Context, req *types.QueryFeederDelegationRequest) (*types.QueryFeederDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeederDelegationResponse{Feeder: k.GetFeederDelegation(ctx, valAddr).String()}, nil
+}
+
+// MissCounter returns the miss counter of a validator
+func (k Keeper) MissCounter(c context.Context, req *types.QueryMissCounterRequest) (*types.QueryMissCounterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryMissCounterResponse{MissCount: k.GetMissCounter(ctx, valAddr)}, nil
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the oracle store
+type Keeper struct {
+	cdc           codec.BinaryMarshaler
+	storeKey      sdk.StoreKey
+	paramSpace    paramtypes.Subspace
+	stakingKeeper types.StakingKeeper
+}
+
+// NewKeeper creates a new oracle Keeper instance
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace, sk types.StakingKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      key,
+		paramSpace:    paramSpace,
+		stakingKeeper: sk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of oracle parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of oracle parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetFeederDelegation returns the account allowed to submit price votes on
+// behalf of a validator. It defaults to the validator operator account.
+func (k Keeper) GetFeederDelegation(ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeederDelegationKey(valAddr))
+	if bz == nil {
+		return sdk.AccAddress(valAddr)
+	}
+
+	return sdk.AccAddress(bz)
+}
+
+// SetFeederDelegation sets the account allowed to submit price votes on behalf
+// of a validator.
+func (k Keeper) SetFeederDelegation(ctx sdk.Context, valAddr sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeederDelegationKey(valAddr), feeder.Bytes())
+}
+
+// IterateFeederDelegations iterates over the feeder delegations and performs a
+// callback function.
+func (k Keeper) IterateFeederDelegations(ctx sdk.Context, cb func(valAddr sdk.ValAddress, feeder sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeederDelegationKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.ValAddress(iterator.Key()), sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// ValidateFeeder returns an error if feeder is not allowed to submit price
+// votes on behalf of the validator.
+func (k Keeper) ValidateFeeder(ctx sdk.Context, feeder sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return sdkerrors.Wrap(types.ErrNoValidator, valAddr.String())
+	}
+
+	if delegate := k.GetFeederDelegation(ctx, valAddr); !delegate.Equals(feeder) {
+		return sdkerrors.Wrapf(types.ErrNoVotingPermission, "%s is not the feeder of %s", feeder, valAddr)
+	}
+
+	return nil
+}
+
+// GetPriceVote returns the price vote of a validator for the current vote
+// period.
+func (k Keeper) GetPriceVote(ctx sdk.Context, valAddr sdk.ValAddress) (vote types.PriceVote, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PriceVoteKey(valAddr))
+	if bz == nil {
+		return vote, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// SetPriceVote sets the price vote of a validator for the current vote period,
+// overwriting any previous vote of the period.
+func (k Keeper) SetPriceVote(ctx sdk.Context, valAddr sdk.ValAddress, vote types.PriceVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PriceVoteKey(valAddr), k.cdc.MustMarshalBinaryBare(&vote))
+}
+
+// DeletePriceVote deletes the price vote of a validator.
+func (k Keeper) DeletePriceVote(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PriceVoteKey(valAddr))
+}
+
+// IteratePriceVotes iterates over the price votes of the current vote period
+// and performs a callback function.
+func (k Keeper) IteratePriceVotes(ctx sdk.Context, cb func(valAddr sdk.ValAddress, vote types.PriceVote) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceVoteKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.PriceVote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		if cb(sdk.ValAddress(iterator.Key()), vote) {
+			break
+		}
+	}
+}
+
+// GetPrice returns the last aggregated price of a denom.
+func (k Keeper) GetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PriceKey(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+
+	var price sdk.DecProto
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price.Dec, true
+}
+
+// SetPrice sets the aggregated price of a denom.
+func (k Keeper) SetPrice(ctx sdk.Context, denom string, price sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PriceKey(denom), k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: price}))
+}
+
+// DeletePrice deletes the aggregated price of a denom.
+func (k Keeper) DeletePrice(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PriceKey(denom))
+}
+
+// IteratePrices iterates over the aggregated prices and performs a callback
+// function.
+func (k Keeper) IteratePrices(ctx sdk.Context, cb func(denom string, price sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var price sdk.DecProto
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &price)
+		if cb(string(iterator.Key()), price.Dec) {
+			break
+		}
+	}
+}
+
+// GetPrices returns all the aggregated prices.
+func (k Keeper) GetPrices(ctx sdk.Context) sdk.DecCoins {
+	prices := sdk.DecCoins{}
+	k.IteratePrices(ctx, func(denom string, price sdk.Dec) bool {
+		prices = append(prices, sdk.NewDecCoinFromDec(denom, price))
+		return false
+	})
+
+	return prices
+}
+
+// GetMissCounter returns the number of vote periods a validator missed during
+// the current slash window.
+func (k Keeper) GetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MissCounterKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetMissCounter sets the miss counter of a validator.
+func (k Keeper) SetMissCounter(ctx sdk.Context, valAddr sdk.ValAddress, missCount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MissCounterKey(valAddr), sdk.Uint64ToBigEndian(missCount))
+}
+
+// DeleteMissCounter deletes the miss counter of a validator.
+func (k Keeper) DeleteMissCounter(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MissCounterKey(valAddr))
+}
+
+// IterateMissCounters iterates over the miss counters and performs a callback
+// function.
+func (k Keeper) IterateMissCounters(ctx sdk.Context, cb func(valAddr sdk.ValAddress, missCount uint64) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MissCounterKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.ValAddress(iterator.Key()), sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
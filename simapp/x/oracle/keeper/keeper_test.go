@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/keeper"
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	valAddrs    []sdk.ValAddress
+}
+
+// SetupTest bonds three validators with a voting power of 10, 20 and 30 and
+// whitelists the uatom and uosmo denoms.
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	pks := simapp.CreateTestPubKeys(3)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(100))
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+
+	suite.valAddrs = make([]sdk.ValAddress, len(pks))
+	for i, pk := range pks {
+		suite.valAddrs[i] = sdk.ValAddress(pk.Address())
+		tstaking.CreateValidatorWithValPower(suite.valAddrs[i], pk, int64(10*(i+1)), true)
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := types.DefaultParams()
+	params.Whitelist = []string{"uatom", "uosmo"}
+	params.SlashWindow = 10
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.SlashFraction = sdk.NewDecWithPrec(1, 1)
+	app.OracleKeeper.SetParams(ctx, params)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.OracleKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.msgServer = keeper.NewMsgServerImpl(app.OracleKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) vote(valAddr sdk.ValAddress, prices string) {
+	coins, err := sdk.ParseDecCoins(prices)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.PriceVote(
+		sdk.WrapSDKContext(suite.ctx), types.NewMsgPriceVote(sdk.AccAddress(valAddr), valAddr, coins),
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPriceVote() {
+	valAddr := suite.valAddrs[0]
+	feeder := sdk.AccAddress(suite.valAddrs[1])
+	prices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(125, 1)))
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// the validator operator votes by default
+	_, err := suite.msgServer.PriceVote(ctx, types.NewMsgPriceVote(feeder, valAddr, prices))
+	suite.Require().ErrorIs(err, types.ErrNoVotingPermission)
+	_, err = suite.msgServer.PriceVote(ctx, types.NewMsgPriceVote(sdk.AccAddress(valAddr), valAddr, prices))
+	suite.Require().NoError(err)
+
+	// only whitelisted denoms can be voted on
+	_, err = suite.msgServer.PriceVote(ctx, types.NewMsgPriceVote(
+		sdk.AccAddress(valAddr), valAddr, sdk.NewDecCoins(sdk.NewDecCoinFromDec("ufoo", sdk.OneDec())),
+	))
+	suite.Require().ErrorIs(err, types.ErrUnknownDenom)
+
+	// unknown validators cannot vote
+	unknown := sdk.ValAddress([]byte("unknown_validator___"))
+	_, err = suite.msgServer.PriceVote(ctx, types.NewMsgPriceVote(sdk.AccAddress(unknown), unknown, prices))
+	suite.Require().ErrorIs(err, types.ErrNoValidator)
+
+	// the feeder votes once delegated to and the operator cannot vote anymore
+	_, err = suite.msgServer.DelegateFeedConsent(ctx, types.NewMsgDelegateFeedConsent(valAddr, feeder))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.PriceVote(ctx, types.NewMsgPriceVote(sdk.AccAddress(valAddr), valAddr, prices))
+	suite.Require().ErrorIs(err, types.ErrNoVotingPermission)
+
+	newPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDec(13)))
+	_, err = suite.msgServer.PriceVote(ctx, types.NewMsgPriceVote(feeder, valAddr, newPrices))
+	suite.Require().NoError(err)
+
+	// a new vote overwrites the previous vote of the vote period
+	res, err := suite.queryClient.PriceVote(ctx, &types.QueryPriceVoteRequest{Validator: valAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(newPrices, res.Vote.Prices)
+
+	feederRes, err := suite.queryClient.FeederDelegation(ctx, &types.QueryFeederDelegationRequest{Validator: valAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(feeder.String(), feederRes.Feeder)
+}
+
+func (suite *KeeperTestSuite) TestTallyVotes() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	params := suite.app.OracleKeeper.GetParams(suite.ctx)
+	suite.app.OracleKeeper.SetPrice(suite.ctx, "uosmo", sdk.OneDec())
+
+	// uatom gets votes from 50 out of 61 voting power, uosmo from 10 only
+	suite.vote(suite.valAddrs[0], "10uatom,2uosmo")
+	suite.vote(suite.valAddrs[1], "12uatom")
+	suite.vote(suite.valAddrs[2], "11uatom")
+	suite.app.OracleKeeper.TallyVotes(suite.ctx, params)
+
+	price, err := suite.queryClient.Price(ctx, &types.QueryPriceRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(11), price.Price)
+
+	// uosmo did not reach the vote threshold and its price is deleted
+	_, err = suite.queryClient.Price(ctx, &types.QueryPriceRequest{Denom: "uosmo"})
+	suite.Require().Error(err)
+
+	prices, err := suite.queryClient.Prices(ctx, &types.QueryPricesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDec(11))), prices.Prices)
+
+	// validators without a price for every whitelisted denom missed the vote
+	for i, expected := range []uint64{0, 1, 1} {
+		res, err := suite.queryClient.MissCounter(ctx, &types.QueryMissCounterRequest{Validator: suite.valAddrs[i].String()})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, res.MissCount)
+	}
+
+	// votes are cleared
+	_, found := suite.app.OracleKeeper.GetPriceVote(suite.ctx, suite.valAddrs[0])
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSlashAndResetMissCounters() {
+	params := suite.app.OracleKeeper.GetParams(suite.ctx)
+
+	// two vote periods per slash window, a validator must vote in one of them
+	suite.app.OracleKeeper.SetMissCounter(suite.ctx, suite.valAddrs[0], 1)
+	suite.app.OracleKeeper.SetMissCounter(suite.ctx, suite.valAddrs[1], 2)
+	tokens := suite.app.StakingKeeper.Validator(suite.ctx, suite.valAddrs[1]).GetTokens()
+
+	suite.app.OracleKeeper.SlashAndResetMissCounters(suite.ctx, params)
+
+	validator := suite.app.StakingKeeper.Validator(suite.ctx, suite.valAddrs[0])
+	suite.Require().False(validator.IsJailed())
+
+	validator = suite.app.StakingKeeper.Validator(suite.ctx, suite.valAddrs[1])
+	suite.Require().True(validator.IsJailed())
+	suite.Require().Equal(tokens.Sub(tokens.QuoRaw(10)), validator.GetTokens())
+
+	for _, valAddr := range suite.valAddrs {
+		suite.Require().Zero(suite.app.OracleKeeper.GetMissCounter(suite.ctx, valAddr))
+	}
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the oracle MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) PriceVote(goCtx context.Context, msg *types.MsgPriceVote) (*types.MsgPriceVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	feeder, err := sdk.AccAddressFromBech32(msg.Feeder)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.ValidateFeeder(ctx, feeder, valAddr); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	for _, price := range msg.Prices {
+		if !params.IsWhitelisted(price.Denom) {
+			return nil, sdkerrors.Wrap(types.ErrUnknownDenom, price.Denom)
+		}
+	}
+
+	k.SetPriceVote(ctx, valAddr, types.PriceVote{Validator: msg.Validator, Prices: msg.Prices})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePriceVote,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Feeder),
+			sdk.NewAttribute(types.AttributeKeyPrices, msg.Prices.String()),
+		),
+		newMessageEvent(msg.Feeder),
+	})
+
+	return &types.MsgPriceVoteResponse{}, nil
+}
+
+func (k msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDelegateFeedConsent) (*types.MsgDelegateFeedConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.stakingKeeper.Validator(ctx, operator) == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidator, msg.Operator)
+	}
+
+	k.SetFeederDelegation(ctx, operator, delegate)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeedDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Delegate),
+		),
+		newMessageEvent(sdk.AccAddress(operator).String()),
+	})
+
+	return &types.MsgDelegateFeedConsentResponse{}, nil
+}
+
+func newMessageEvent(sender string) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+	)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TallyVotes aggregates the price votes of the bonded validators submitted
+// during the vote period. The price of a whitelisted denom is set to the
+// weighted median of its votes when the voting power behind them reaches the
+// vote threshold, and deleted otherwise. Bonded validators that did not submit
+// a price for every whitelisted denom get their miss counter incremented, no
+// vote being missed while the whitelist is empty. All the votes are cleared
+// afterwards.
+func (k Keeper) TallyVotes(ctx sdk.Context, params types.Params) {
+	ballots := make(map[string]types.PriceBallot, len(params.Whitelist))
+	totalPower := int64(0)
+
+	k.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		valAddr := validator.GetOperator()
+		power := validator.GetConsensusPower()
+		totalPower += power
+
+		vote, found := k.GetPriceVote(ctx, valAddr)
+		// there is nothing to vote on without whitelisted denoms
+		valid := found || len(params.Whitelist) == 0
+		for _, denom := range params.Whitelist {
+			price := vote.Prices.AmountOf(denom)
+			if !price.IsPositive() {
+				valid = false
+				continue
+			}
+			ballots[denom] = append(ballots[denom], types.NewVoteForTally(valAddr, price, power))
+		}
+
+		if !valid {
+			k.SetMissCounter(ctx, valAddr, k.GetMissCounter(ctx, valAddr)+1)
+		}
+
+		return false
+	})
+
+	threshold := params.VoteThreshold.MulInt64(totalPower)
+	for _, denom := range params.Whitelist {
+		ballot := ballots[denom]
+		power := ballot.Power()
+		if power > 0 && sdk.NewDec(power).GTE(threshold) {
+			price := ballot.WeightedMedian()
+			k.SetPrice(ctx, denom, price)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePriceUpdate,
+					sdk.NewAttribute(types.AttributeKeyDenom, denom),
+					sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+				),
+			)
+			continue
+		}
+
+		if _, found := k.GetPrice(ctx, denom); found {
+			k.DeletePrice(ctx, denom)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePriceDelete,
+					sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				),
+			)
+		}
+	}
+
+	var voters []sdk.ValAddress
+	k.IteratePriceVotes(ctx, func(valAddr sdk.ValAddress, _ types.PriceVote) bool {
+		voters = append(voters, valAddr)
+		return false
+	})
+	for _, valAddr := range voters {
+		k.DeletePriceVote(ctx, valAddr)
+	}
+}
+
+// SlashAndResetMissCounters slashes and jails the bonded validators that voted
+// in less than the minimum fraction of the vote periods of the slash window,
+// then resets the miss counters of all the validators.
+func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context, params types.Params) {
+	votePeriods := params.SlashWindow / params.VotePeriod
+	// Parameter change proposals validate VotePeriod and SlashWindow
+	// independently, so the vote period may exceed the slash window. At most one
+	// vote period then ends in the slash window.
+	if votePeriods == 0 {
+		votePeriods = 1
+	}
+	// Note that this *can* result in a negative "distributionHeight" up to
+	// -ValidatorUpdateDelay-1, i.e. at the end of the pre-genesis block (none)
+	// = at the beginning of the genesis block.
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	var validators []sdk.ValAddress
+	k.IterateMissCounters(ctx, func(valAddr sdk.ValAddress, missCount uint64) bool {
+		validators = append(validators, valAddr)
+
+		if missCount > votePeriods {
+			missCount = votePeriods
+		}
+		validRatio := sdk.NewDec(int64(votePeriods - missCount)).QuoInt64(int64(votePeriods))
+		if validRatio.GTE(params.MinValidPerWindow) {
+			return false
+		}
+
+		validator := k.stakingKeeper.Validator(ctx, valAddr)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			return false
+		}
+
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+
+		power := validator.GetConsensusPower()
+		k.stakingKeeper.Slash(ctx, consAddr, distributionHeight, power, params.SlashFraction)
+		k.stakingKeeper.Jail(ctx, consAddr)
+
+		k.Logger(ctx).Info(
+			"slashing and jailing validator due to missed price votes",
+			"validator", valAddr.String(),
+			"miss_count", missCount,
+			"slash_window", params.SlashWindow,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOracleSlash,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyMissCount, fmt.Sprintf("%d", missCount)),
+				sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			),
+		)
+
+		return false
+	})
+
+	for _, valAddr := range validators {
+		k.DeleteMissCounter(ctx, valAddr)
+	}
+}
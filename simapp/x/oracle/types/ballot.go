@@ -0,0 +1,66 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteForTally is the price submitted by a validator for a denom, weighted by
+// the voting power of the validator.
+type VoteForTally struct {
+	Validator sdk.ValAddress
+	Price     sdk.Dec
+	Power     int64
+}
+
+// NewVoteForTally creates a new VoteForTally instance
+func NewVoteForTally(validator sdk.ValAddress, price sdk.Dec, power int64) VoteForTally {
+	return VoteForTally{
+		Validator: validator,
+		Price:     price,
+		Power:     power,
+	}
+}
+
+// PriceBallot is the set of votes submitted for a denom during a vote period.
+type PriceBallot []VoteForTally
+
+// Power returns the total voting power of the ballot.
+func (pb PriceBallot) Power() int64 {
+	total := int64(0)
+	for _, v := range pb {
+		total += v.Power
+	}
+
+	return total
+}
+
+// WeightedMedian returns the price at which half of the voting power of the
+// ballot voted for a lower or equal price. It returns a zero price for an
+// empty ballot.
+func (pb PriceBallot) WeightedMedian() sdk.Dec {
+	if len(pb) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	sorted := make(PriceBallot, len(pb))
+	copy(sorted, pb)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Price.Equal(sorted[j].Price) {
+			return sorted[i].Validator.String() < sorted[j].Validator.String()
+		}
+		return sorted[i].Price.LT(sorted[j].Price)
+	})
+
+	total := sorted.Power()
+	cumulative := int64(0)
+	for _, v := range sorted {
+		cumulative += v.Power
+		if cumulative*2 >= total {
+			return v.Price
+		}
+	}
+
+	return sorted[len(sorted)-1].Price
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestPriceBallotWeightedMedian(t *testing.T) {
+	valAddrs := make([]sdk.ValAddress, 4)
+	for i := range valAddrs {
+		valAddrs[i] = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	}
+
+	testCases := []struct {
+		name   string
+		ballot types.PriceBallot
+		median sdk.Dec
+	}{
+		{
+			"empty ballot",
+			types.PriceBallot{},
+			sdk.ZeroDec(),
+		},
+		{
+			"single vote",
+			types.PriceBallot{types.NewVoteForTally(valAddrs[0], sdk.NewDec(7), 10)},
+			sdk.NewDec(7),
+		},
+		{
+			"equal powers",
+			types.PriceBallot{
+				types.NewVoteForTally(valAddrs[0], sdk.NewDec(3), 10),
+				types.NewVoteForTally(valAddrs[1], sdk.NewDec(1), 10),
+				types.NewVoteForTally(valAddrs[2], sdk.NewDec(2), 10),
+			},
+			sdk.NewDec(2),
+		},
+		{
+			"heavy voter",
+			types.PriceBallot{
+				types.NewVoteForTally(valAddrs[0], sdk.NewDec(1), 10),
+				types.NewVoteForTally(valAddrs[1], sdk.NewDec(2), 10),
+				types.NewVoteForTally(valAddrs[2], sdk.NewDec(3), 10),
+				types.NewVoteForTally(valAddrs[3], sdk.NewDec(4), 100),
+			},
+			sdk.NewDec(4),
+		},
+		{
+			"even split picks lower price",
+			types.PriceBallot{
+				types.NewVoteForTally(valAddrs[0], sdk.NewDec(5), 10),
+				types.NewVoteForTally(valAddrs[1], sdk.NewDec(1), 10),
+			},
+			sdk.NewDec(1),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.median.Equal(tc.ballot.WeightedMedian()), tc.ballot.WeightedMedian().String())
+		})
+	}
+}
+
+func TestPriceBallotPower(t *testing.T) {
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	ballot := types.PriceBallot{
+		types.NewVoteForTally(valAddr, sdk.OneDec(), 3),
+		types.NewVoteForTally(valAddr, sdk.OneDec(), 4),
+	}
+	require.Equal(t, int64(7), ballot.Power())
+}
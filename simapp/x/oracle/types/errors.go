@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/oracle module sentinel errors
+var (
+	ErrNoValidator        = sdkerrors.Register(ModuleName, 2, "validator does not exist")
+	ErrNoVotingPermission = sdkerrors.Register(ModuleName, 3, "unauthorized voter")
+	ErrUnknownDenom       = sdkerrors.Register(ModuleName, 4, "denom is not whitelisted")
+	ErrInvalidPrice       = sdkerrors.Register(ModuleName, 5, "invalid price")
+	ErrNoPrice            = sdkerrors.Register(ModuleName, 6, "no price")
+	ErrNoPriceVote        = sdkerrors.Register(ModuleName, 7, "no price vote")
+)
@@ -0,0 +1,20 @@
+package types
+
+// oracle module event types
+const (
+	EventTypePriceVote    = "price_vote"
+	EventTypeFeedDelegate = "feed_delegate"
+	EventTypePriceUpdate  = "price_update"
+	EventTypePriceDelete  = "price_delete"
+	EventTypeOracleSlash  = "oracle_slash"
+
+	AttributeKeyValidator = "validator"
+	AttributeKeyFeeder    = "feeder"
+	AttributeKeyPrices    = "prices"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyPrice     = "price"
+	AttributeKeyMissCount = "miss_count"
+	AttributeKeyPower     = "power"
+
+	AttributeValueCategory = ModuleName
+)
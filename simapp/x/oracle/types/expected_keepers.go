@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to weight the price
+// votes and to slash the validators missing too many votes (noalias)
+type StakingKeeper interface {
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI
+	IterateBondedValidatorsByPower(sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+
+	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
+	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
+	Jail(sdk.Context, sdk.ConsAddress) // jail a validator
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(
+	params Params, prices sdk.DecCoins, feederDelegations []FeederDelegation, missCounters []MissCounter,
+) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		Prices:            prices,
+		FeederDelegations: feederDelegations,
+		MissCounters:      missCounters,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the oracle module
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), sdk.DecCoins{}, []FeederDelegation{}, []MissCounter{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Prices.Validate(); err != nil {
+		return fmt.Errorf("invalid prices: %w", err)
+	}
+
+	seenDelegations := make(map[string]bool, len(gs.FeederDelegations))
+	for _, fd := range gs.FeederDelegations {
+		if _, err := sdk.ValAddressFromBech32(fd.Validator); err != nil {
+			return fmt.Errorf("invalid feeder delegation validator %s: %w", fd.Validator, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(fd.Feeder); err != nil {
+			return fmt.Errorf("invalid feeder delegation feeder %s: %w", fd.Feeder, err)
+		}
+		if seenDelegations[fd.Validator] {
+			return fmt.Errorf("duplicate feeder delegation for validator %s", fd.Validator)
+		}
+		seenDelegations[fd.Validator] = true
+	}
+
+	seenCounters := make(map[string]bool, len(gs.MissCounters))
+	for _, mc := range gs.MissCounters {
+		if _, err := sdk.ValAddressFromBech32(mc.Validator); err != nil {
+			return fmt.Errorf("invalid miss counter validator %s: %w", mc.Validator, err)
+		}
+		if seenCounters[mc.Validator] {
+			return fmt.Errorf("duplicate miss counter for validator %s", mc.Validator)
+		}
+		seenCounters[mc.Validator] = true
+	}
+
+	return nil
+}
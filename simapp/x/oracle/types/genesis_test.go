@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	feeder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	validGenesis := func() types.GenesisState {
+		params := types.DefaultParams()
+		params.Whitelist = []string{"uatom"}
+		return *types.NewGenesisState(
+			params,
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(125, 1))),
+			[]types.FeederDelegation{{Validator: valAddr.String(), Feeder: feeder.String()}},
+			[]types.MissCounter{{Validator: valAddr.String(), MissCount: 3}},
+		)
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func(gs *types.GenesisState)
+		expectPass bool
+	}{
+		{"default", func(gs *types.GenesisState) { *gs = *types.DefaultGenesisState() }, true},
+		{"valid", func(gs *types.GenesisState) {}, true},
+		{"zero vote period", func(gs *types.GenesisState) { gs.Params.VotePeriod = 0 }, false},
+		{"zero vote threshold", func(gs *types.GenesisState) { gs.Params.VoteThreshold = sdk.ZeroDec() }, false},
+		{"vote threshold above one", func(gs *types.GenesisState) { gs.Params.VoteThreshold = sdk.NewDec(2) }, false},
+		{"invalid whitelisted denom", func(gs *types.GenesisState) { gs.Params.Whitelist = []string{"1"} }, false},
+		{"duplicate whitelisted denom", func(gs *types.GenesisState) { gs.Params.Whitelist = []string{"uatom", "uatom"} }, false},
+		{"slash window not a multiple of vote period", func(gs *types.GenesisState) { gs.Params.SlashWindow = 7 }, false},
+		{"negative slash fraction", func(gs *types.GenesisState) { gs.Params.SlashFraction = sdk.NewDec(-1) }, false},
+		{"min valid per window above one", func(gs *types.GenesisState) { gs.Params.MinValidPerWindow = sdk.NewDec(2) }, false},
+		{"unsorted prices", func(gs *types.GenesisState) {
+			gs.Prices = sdk.DecCoins{sdk.NewDecCoin("uosmo", sdk.OneInt()), sdk.NewDecCoin("uatom", sdk.OneInt())}
+		}, false},
+		{"invalid feeder", func(gs *types.GenesisState) { gs.FeederDelegations[0].Feeder = "" }, false},
+		{"duplicate feeder delegation", func(gs *types.GenesisState) {
+			gs.FeederDelegations = append(gs.FeederDelegations, gs.FeederDelegations[0])
+		}, false},
+		{"invalid miss counter validator", func(gs *types.GenesisState) { gs.MissCounters[0].Validator = feeder.String() }, false},
+		{"duplicate miss counter", func(gs *types.GenesisState) {
+			gs.MissCounters = append(gs.MissCounters, gs.MissCounters[0])
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := validGenesis()
+			tc.malleate(&gs)
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
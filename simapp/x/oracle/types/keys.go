@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "oracle"
+
+	// StoreKey is the store key string for oracle
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for oracle
+	RouterKey = ModuleName
+)
+
+// Keys for oracle store
+// Items are stored with the following key: values
+//
+// - 0x01<valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x02<valAddr_Bytes>: PriceVote
+//
+// - 0x03<denom_Bytes>: sdk.Dec
+//
+// - 0x04<valAddr_Bytes>: uint64
+var (
+	FeederDelegationKeyPrefix = []byte{0x01}
+	PriceVoteKeyPrefix        = []byte{0x02}
+	PriceKeyPrefix            = []byte{0x03}
+	MissCounterKeyPrefix      = []byte{0x04}
+)
+
+// FeederDelegationKey returns the key of the feeder account of a validator
+func FeederDelegationKey(valAddr sdk.ValAddress) []byte {
+	return append(FeederDelegationKeyPrefix, valAddr.Bytes()...)
+}
+
+// PriceVoteKey returns the key of the price vote of a validator
+func PriceVoteKey(valAddr sdk.ValAddress) []byte {
+	return append(PriceVoteKeyPrefix, valAddr.Bytes()...)
+}
+
+// PriceKey returns the key of the aggregated price of a denom
+func PriceKey(denom string) []byte {
+	return append(PriceKeyPrefix, []byte(denom)...)
+}
+
+// MissCounterKey returns the key of the miss counter of a validator
+func MissCounterKey(valAddr sdk.ValAddress) []byte {
+	return append(MissCounterKeyPrefix, valAddr.Bytes()...)
+}
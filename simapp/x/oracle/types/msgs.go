@@ -0,0 +1,102 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// oracle message types
+const (
+	TypeMsgPriceVote           = "price_vote"
+	TypeMsgDelegateFeedConsent = "delegate_feed_consent"
+)
+
+var (
+	_ sdk.Msg = &MsgPriceVote{}
+	_ sdk.Msg = &MsgDelegateFeedConsent{}
+)
+
+// NewMsgPriceVote creates a new MsgPriceVote instance
+//nolint:interfacer
+func NewMsgPriceVote(feeder sdk.AccAddress, validator sdk.ValAddress, prices sdk.DecCoins) *MsgPriceVote {
+	return &MsgPriceVote{
+		Feeder:    feeder.String(),
+		Validator: validator.String(),
+		Prices:    prices,
+	}
+}
+
+// Route implements Msg
+func (msg MsgPriceVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgPriceVote) Type() string { return TypeMsgPriceVote }
+
+// ValidateBasic implements Msg
+func (msg MsgPriceVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Feeder); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "feeder")
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.Validator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "validator")
+	}
+	if msg.Prices.Empty() {
+		return sdkerrors.Wrap(ErrInvalidPrice, "prices cannot be empty")
+	}
+	if err := msg.Prices.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidPrice, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgPriceVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgPriceVote) GetSigners() []sdk.AccAddress {
+	feeder, _ := sdk.AccAddressFromBech32(msg.Feeder)
+	return []sdk.AccAddress{feeder}
+}
+
+// NewMsgDelegateFeedConsent creates a new MsgDelegateFeedConsent instance
+//nolint:interfacer
+func NewMsgDelegateFeedConsent(operator sdk.ValAddress, delegate sdk.AccAddress) *MsgDelegateFeedConsent {
+	return &MsgDelegateFeedConsent{
+		Operator: operator.String(),
+		Delegate: delegate.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgDelegateFeedConsent) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgDelegateFeedConsent) Type() string { return TypeMsgDelegateFeedConsent }
+
+// ValidateBasic implements Msg
+func (msg MsgDelegateFeedConsent) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.Operator); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "operator")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "delegate")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgDelegateFeedConsent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgDelegateFeedConsent) GetSigners() []sdk.AccAddress {
+	operator, _ := sdk.ValAddressFromBech32(msg.Operator)
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
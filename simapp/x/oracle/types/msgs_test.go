@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgPriceVoteValidateBasic(t *testing.T) {
+	feeder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	prices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(125, 1)))
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgPriceVote
+		expectPass bool
+	}{
+		{"valid", types.NewMsgPriceVote(feeder, valAddr, prices), true},
+		{"empty feeder", types.NewMsgPriceVote(sdk.AccAddress{}, valAddr, prices), false},
+		{"empty validator", types.NewMsgPriceVote(feeder, sdk.ValAddress{}, prices), false},
+		{"no prices", types.NewMsgPriceVote(feeder, valAddr, sdk.DecCoins{}), false},
+		{"zero price", types.NewMsgPriceVote(feeder, valAddr, sdk.DecCoins{{Denom: "uatom", Amount: sdk.ZeroDec()}}), false},
+		{"duplicate denom", types.NewMsgPriceVote(feeder, valAddr, sdk.DecCoins{prices[0], prices[0]}), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{feeder}, tc.msg.GetSigners())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDelegateFeedConsentValidateBasic(t *testing.T) {
+	delegate := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	msg := types.NewMsgDelegateFeedConsent(valAddr, delegate)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr)}, msg.GetSigners())
+
+	require.Error(t, types.NewMsgDelegateFeedConsent(sdk.ValAddress{}, delegate).ValidateBasic())
+	require.Error(t, types.NewMsgDelegateFeedConsent(valAddr, sdk.AccAddress{}).ValidateBasic())
+}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyVotePeriod        = []byte("VotePeriod")
+	KeyVoteThreshold     = []byte("VoteThreshold")
+	KeyWhitelist         = []byte("Whitelist")
+	KeySlashWindow       = []byte("SlashWindow")
+	KeyMinValidPerWindow = []byte("MinValidPerWindow")
+	KeySlashFraction     = []byte("SlashFraction")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the oracle module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	votePeriod uint64, voteThreshold sdk.Dec, whitelist []string,
+	slashWindow uint64, minValidPerWindow, slashFraction sdk.Dec,
+) Params {
+	return Params{
+		VotePeriod:        votePeriod,
+		VoteThreshold:     voteThreshold,
+		Whitelist:         whitelist,
+		SlashWindow:       slashWindow,
+		MinValidPerWindow: minValidPerWindow,
+		SlashFraction:     slashFraction,
+	}
+}
+
+// DefaultParams returns the default oracle module parameters
+func DefaultParams() Params {
+	return Params{
+		VotePeriod:        5,
+		VoteThreshold:     sdk.NewDecWithPrec(50, 2),
+		Whitelist:         []string{},
+		SlashWindow:       100800, // one week assuming 6 second block times
+		MinValidPerWindow: sdk.NewDecWithPrec(5, 2),
+		SlashFraction:     sdk.NewDecWithPrec(1, 4),
+	}
+}
+
+// Validate performs basic validation of the oracle parameters.
+func (p Params) Validate() error {
+	if err := validateVotePeriod(p.VotePeriod); err != nil {
+		return err
+	}
+	if err := validateVoteThreshold(p.VoteThreshold); err != nil {
+		return err
+	}
+	if err := validateWhitelist(p.Whitelist); err != nil {
+		return err
+	}
+	if err := validateSlashWindow(p.SlashWindow); err != nil {
+		return err
+	}
+	if err := validateMinValidPerWindow(p.MinValidPerWindow); err != nil {
+		return err
+	}
+	if err := validateSlashFraction(p.SlashFraction); err != nil {
+		return err
+	}
+	if p.SlashWindow%p.VotePeriod != 0 {
+		return fmt.Errorf(
+			"slash window (%d) must be a multiple of the vote period (%d)", p.SlashWindow, p.VotePeriod,
+		)
+	}
+
+	return nil
+}
+
+// IsWhitelisted returns true if prices can be submitted for denom.
+func (p Params) IsWhitelisted(denom string) bool {
+	for _, d := range p.Whitelist {
+		if d == denom {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyVotePeriod, &p.VotePeriod, validateVotePeriod),
+		paramtypes.NewParamSetPair(KeyVoteThreshold, &p.VoteThreshold, validateVoteThreshold),
+		paramtypes.NewParamSetPair(KeyWhitelist, &p.Whitelist, validateWhitelist),
+		paramtypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
+		paramtypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramtypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
+	}
+}
+
+func validateVotePeriod(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("vote period must be positive")
+	}
+
+	return nil
+}
+
+func validateVoteThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateWhitelist(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate whitelisted denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateSlashWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("slash window must be positive")
+	}
+
+	return nil
+}
+
+func validateMinValidPerWindow(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min valid per window cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min valid per window too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("slash fraction cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction too large: %s", v)
+	}
+
+	return nil
+}
@@ -144,6 +144,11 @@ func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
 }
 
+// AvailableVersions returns all the stored versions in ascending order.
+func (st *Store) AvailableVersions() []int {
+	return st.tree.AvailableVersions()
+}
+
 // Implements Store.
 func (st *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeIAVL
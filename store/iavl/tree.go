@@ -27,6 +27,7 @@ type (
 		Version() int64
 		Hash() []byte
 		VersionExists(version int64) bool
+		AvailableVersions() []int
 		GetVersioned(key []byte, version int64) (int64, []byte)
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
 		GetImmutable(version int64) (*iavl.ImmutableTree, error)
@@ -69,6 +70,10 @@ func (it *immutableTree) VersionExists(version int64) bool {
 	return it.Version() == version
 }
 
+func (it *immutableTree) AvailableVersions() []int {
+	return []int{int(it.Version())}
+}
+
 func (it *immutableTree) GetVersioned(key []byte, version int64) (int64, []byte) {
 	if it.Version() != version {
 		return -1, nil
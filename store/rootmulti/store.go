@@ -323,6 +323,44 @@ func (rs *Store) LastCommitID() types.CommitID {
 	return rs.lastCommitInfo.CommitID()
 }
 
+// EarliestVersion returns the earliest version from which every version up to
+// the latest one can be queried on all the mounted IAVL stores. The versions
+// kept every KeepEvery heights past the KeepRecent window are not taken into
+// account, as the heights in between them have been pruned. It returns the
+// latest version if no IAVL store is mounted.
+func (rs *Store) EarliestVersion() int64 {
+	var earliest int64
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		versions := rs.GetCommitKVStore(key).(*iavl.Store).AvailableVersions()
+		if len(versions) == 0 {
+			continue
+		}
+
+		// find the first version of the contiguous versions ending at the
+		// latest one
+		i := len(versions) - 1
+		for i > 0 && versions[i-1] == versions[i]-1 {
+			i--
+		}
+
+		if int64(versions[i]) > earliest {
+			earliest = int64(versions[i])
+		}
+	}
+
+	if earliest == 0 {
+		return rs.LastCommitID().Version
+	}
+
+	return earliest
+}
+
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
 	var previousHeight, version int64
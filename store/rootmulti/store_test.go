@@ -539,6 +539,37 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_EarliestVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		po       types.PruningOptions
+		commits  int64
+		earliest int64
+	}{
+		{"prune nothing", types.PruneNothing, 10, 1},
+		{"prune everything", types.PruneEverything, 10, 10},
+		// heights 3 and 6 are kept every 3 heights, but 4, 5 and 7 are pruned
+		{"prune some; no batch", types.NewPruningOptions(2, 3, 1), 10, 8},
+		// heights 4 to 7 are only pruned at the next interval
+		{"prune some; batch", types.NewPruningOptions(2, 3, 5), 9, 3},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			ms := newMultiStoreWithMounts(dbm.NewMemDB(), tc.po)
+			require.NoError(t, ms.LoadLatestVersion())
+
+			for i := int64(0); i < tc.commits; i++ {
+				ms.Commit()
+			}
+
+			require.Equal(t, tc.earliest, ms.EarliestVersion())
+		})
+	}
+}
+
 func TestMultistoreSnapshot_Checksum(t *testing.T) {
 	// Chunks from different nodes must fit together, so all nodes must produce identical chunks.
 	// This checksum test makes sure that the byte stream remains identical. If the test fails
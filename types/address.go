@@ -118,13 +118,17 @@ func VerifyAddressFormat(bz []byte) error {
 
 // AccAddressFromBech32 creates an AccAddress from a Bech32 string.
 func AccAddressFromBech32(address string) (addr AccAddress, err error) {
+	return AccAddressFromBech32WithPrefix(address, GetConfig().GetBech32AccountAddrPrefix())
+}
+
+// AccAddressFromBech32WithPrefix creates an AccAddress from a Bech32 string encoded
+// with the given prefix rather than the one of the sdk Config.
+func AccAddressFromBech32WithPrefix(address, prefix string) (addr AccAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
 		return AccAddress{}, errors.New("empty address string is not allowed")
 	}
 
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixAccAddr)
+	bz, err := GetFromBech32(address, prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -272,13 +276,17 @@ func ValAddressFromHex(address string) (addr ValAddress, err error) {
 
 // ValAddressFromBech32 creates a ValAddress from a Bech32 string.
 func ValAddressFromBech32(address string) (addr ValAddress, err error) {
+	return ValAddressFromBech32WithPrefix(address, GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+// ValAddressFromBech32WithPrefix creates a ValAddress from a Bech32 string encoded
+// with the given prefix rather than the one of the sdk Config.
+func ValAddressFromBech32WithPrefix(address, prefix string) (addr ValAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
 		return ValAddress{}, errors.New("empty address string is not allowed")
 	}
 
-	bech32PrefixValAddr := GetConfig().GetBech32ValidatorAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixValAddr)
+	bz, err := GetFromBech32(address, prefix)
 	if err != nil {
 		return nil, err
 	}
@@ -427,13 +435,17 @@ func ConsAddressFromHex(address string) (addr ConsAddress, err error) {
 
 // ConsAddressFromBech32 creates a ConsAddress from a Bech32 string.
 func ConsAddressFromBech32(address string) (addr ConsAddress, err error) {
+	return ConsAddressFromBech32WithPrefix(address, GetConfig().GetBech32ConsensusAddrPrefix())
+}
+
+// ConsAddressFromBech32WithPrefix creates a ConsAddress from a Bech32 string encoded
+// with the given prefix rather than the one of the sdk Config.
+func ConsAddressFromBech32WithPrefix(address, prefix string) (addr ConsAddress, err error) {
 	if len(strings.TrimSpace(address)) == 0 {
 		return ConsAddress{}, errors.New("empty address string is not allowed")
 	}
 
-	bech32PrefixConsAddr := GetConfig().GetBech32ConsensusAddrPrefix()
-
-	bz, err := GetFromBech32(address, bech32PrefixConsAddr)
+	bz, err := GetFromBech32(address, prefix)
 	if err != nil {
 		return nil, err
 	}
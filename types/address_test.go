@@ -520,3 +520,33 @@ func (s *addressTestSuite) TestGetFromBech32() {
 	s.Require().Error(err)
 	s.Require().Equal("invalid Bech32 prefix; expected x, got cosmos", err.Error())
 }
+
+func (s *addressTestSuite) TestAddressFromBech32WithPrefix() {
+	addr := secp256k1.GenPrivKey().PubKey().Address()
+
+	accStr := types.MustBech32ifyAddressBytes("osmo", addr)
+	acc, err := types.AccAddressFromBech32WithPrefix(accStr, "osmo")
+	s.Require().NoError(err)
+	s.Require().Equal(types.AccAddress(addr), acc)
+	_, err = types.AccAddressFromBech32(accStr)
+	s.Require().Error(err)
+	_, err = types.AccAddressFromBech32WithPrefix(accStr, "cosmos")
+	s.Require().Error(err)
+
+	valStr := types.MustBech32ifyAddressBytes("osmovaloper", addr)
+	val, err := types.ValAddressFromBech32WithPrefix(valStr, "osmovaloper")
+	s.Require().NoError(err)
+	s.Require().Equal(types.ValAddress(addr), val)
+	_, err = types.ValAddressFromBech32(valStr)
+	s.Require().Error(err)
+
+	consStr := types.MustBech32ifyAddressBytes("osmovalcons", addr)
+	cons, err := types.ConsAddressFromBech32WithPrefix(consStr, "osmovalcons")
+	s.Require().NoError(err)
+	s.Require().Equal(types.ConsAddress(addr), cons)
+	_, err = types.ConsAddressFromBech32(consStr)
+	s.Require().Error(err)
+
+	_, err = types.AccAddressFromBech32WithPrefix(" ", "osmo")
+	s.Require().Error(err)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -11,6 +12,10 @@ import (
 //-----------------------------------------------------------------------------
 // Coin
 
+// ErrNegativeCoinAmount is returned by the Safe arithmetic methods of Coin and
+// Coins when the result would hold a negative amount.
+var ErrNegativeCoinAmount = errors.New("negative coin amount")
+
 // NewCoin returns a new coin with a denomination and amount. It will panic if
 // the amount is negative or if the denomination is invalid.
 func NewCoin(denom string, amount Int) Coin {
@@ -91,7 +96,7 @@ func (coin Coin) IsEqual(other Coin) bool {
 }
 
 // Add adds amounts of two coins with same denom. If the coins differ in denom then
-// it panics.
+// it panics. Use SafeAdd to handle these cases as errors.
 func (coin Coin) Add(coinB Coin) Coin {
 	if coin.Denom != coinB.Denom {
 		panic(fmt.Sprintf("invalid coin denominations; %s, %s", coin.Denom, coinB.Denom))
@@ -101,7 +106,8 @@ func (coin Coin) Add(coinB Coin) Coin {
 }
 
 // Sub subtracts amounts of two coins with same denom. If the coins differ in denom
-// then it panics.
+// or the result is negative then it panics. Use SafeSub to handle these cases as
+// errors.
 func (coin Coin) Sub(coinB Coin) Coin {
 	if coin.Denom != coinB.Denom {
 		panic(fmt.Sprintf("invalid coin denominations; %s, %s", coin.Denom, coinB.Denom))
@@ -115,6 +121,41 @@ func (coin Coin) Sub(coinB Coin) Coin {
 	return res
 }
 
+// SafeAdd adds amounts of two coins with same denom. Unlike Add it returns an
+// error if the coins differ in denom or the amount overflows.
+func (coin Coin) SafeAdd(coinB Coin) (Coin, error) {
+	if coin.Denom != coinB.Denom {
+		return Coin{}, fmt.Errorf("invalid coin denominations; %s, %s", coin.Denom, coinB.Denom)
+	}
+
+	amount, err := coin.Amount.SafeAdd(coinB.Amount)
+	if err != nil {
+		return Coin{}, err
+	}
+
+	return Coin{coin.Denom, amount}, nil
+}
+
+// SafeSub subtracts amounts of two coins with same denom. Unlike Sub it returns
+// an error if the coins differ in denom, the amount overflows or the result is
+// negative.
+func (coin Coin) SafeSub(coinB Coin) (Coin, error) {
+	if coin.Denom != coinB.Denom {
+		return Coin{}, fmt.Errorf("invalid coin denominations; %s, %s", coin.Denom, coinB.Denom)
+	}
+
+	amount, err := coin.Amount.SafeSub(coinB.Amount)
+	if err != nil {
+		return Coin{}, err
+	}
+
+	if amount.IsNegative() {
+		return Coin{}, ErrNegativeCoinAmount
+	}
+
+	return Coin{coin.Denom, amount}, nil
+}
+
 // IsPositive returns true if coin amount is positive.
 //
 // TODO: Remove once unsigned integers are used.
@@ -245,10 +286,47 @@ func (coins Coins) IsValid() bool {
 //
 // CONTRACT: Add will never return Coins where one Coin has a non-positive
 // amount. In otherwords, IsValid will always return true.
+//
+// Add panics if an amount overflows, use SafeAdd to handle overflows as errors.
 func (coins Coins) Add(coinsB ...Coin) Coins {
 	return coins.safeAdd(coinsB)
 }
 
+// SafeAdd performs the same arithmetic as Add but returns ErrIntOverflow
+// instead of panicking if the amount of any denomination overflows.
+func (coins Coins) SafeAdd(coinsB ...Coin) (Coins, error) {
+	for _, coin := range coinsB {
+		if _, err := coins.AmountOf(coin.Denom).SafeAdd(coin.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	return coins.safeAdd(coinsB), nil
+}
+
+// SafeMulInt multiplies the amount of every coin by x. It returns
+// ErrNegativeCoinAmount if x is negative and ErrIntOverflow if any amount
+// overflows. Multiplying by zero returns an empty set.
+func (coins Coins) SafeMulInt(x Int) (Coins, error) {
+	if x.IsNegative() {
+		return nil, ErrNegativeCoinAmount
+	}
+
+	res := make(Coins, 0, len(coins))
+	for _, coin := range coins {
+		amount, err := coin.Amount.SafeMul(x)
+		if err != nil {
+			return nil, err
+		}
+
+		if !amount.IsZero() {
+			res = append(res, Coin{coin.Denom, amount})
+		}
+	}
+
+	return res, nil
+}
+
 // safeAdd will perform addition of two coins sets. If both coin sets are
 // empty, then an empty set is returned. If only a single set is empty, the
 // other set is returned. Otherwise, the coins are compared in order of their
@@ -328,6 +406,8 @@ func (coins Coins) DenomsSubsetOf(coinsB Coins) bool {
 //
 // CONTRACT: Sub will never return Coins where one Coin has a non-positive
 // amount. In otherwords, IsValid will always return true.
+//
+// Sub panics if an amount is negative, use SafeSub to detect negative amounts.
 func (coins Coins) Sub(coinsB Coins) Coins {
 	diff, hasNeg := coins.SafeSub(coinsB)
 	if hasNeg {
@@ -338,7 +418,8 @@ func (coins Coins) Sub(coinsB Coins) Coins {
 }
 
 // SafeSub performs the same arithmetic as Sub but returns a boolean if any
-// negative coin amount was returned.
+// negative coin amount was returned. It does not panic for valid coin sets as
+// the difference of two non-negative amounts can not overflow.
 func (coins Coins) SafeSub(coinsB Coins) (Coins, bool) {
 	diff := coins.safeAdd(coinsB.negative())
 	return diff, diff.IsAnyNegative()
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -178,6 +179,27 @@ func (s *coinTestSuite) TestSubCoin() {
 	s.Require().Equal(tc.expected, res.Amount.Int64())
 }
 
+func (s *coinTestSuite) TestSafeArithCoin() {
+	maxCoin := sdk.NewCoin(testDenom1, sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1))))
+
+	res, err := sdk.NewInt64Coin(testDenom1, 3).SafeAdd(sdk.NewInt64Coin(testDenom1, 2))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(testDenom1, 5), res)
+
+	res, err = sdk.NewInt64Coin(testDenom1, 3).SafeSub(sdk.NewInt64Coin(testDenom1, 3))
+	s.Require().NoError(err)
+	s.Require().True(res.IsZero())
+
+	_, err = sdk.NewInt64Coin(testDenom1, 3).SafeAdd(sdk.NewInt64Coin(testDenom2, 2))
+	s.Require().Error(err)
+	_, err = sdk.NewInt64Coin(testDenom1, 3).SafeSub(sdk.NewInt64Coin(testDenom2, 2))
+	s.Require().Error(err)
+	_, err = maxCoin.SafeAdd(sdk.NewInt64Coin(testDenom1, 1))
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+	_, err = sdk.NewInt64Coin(testDenom1, 2).SafeSub(sdk.NewInt64Coin(testDenom1, 3))
+	s.Require().ErrorIs(err, sdk.ErrNegativeCoinAmount)
+}
+
 func (s *coinTestSuite) TestIsGTECoin() {
 	cases := []struct {
 		inputOne sdk.Coin
@@ -423,6 +445,32 @@ func (s *coinTestSuite) TestSubCoins() {
 	}
 }
 
+func (s *coinTestSuite) TestSafeArithCoins() {
+	maxInt := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1)))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(testDenom1, 2), sdk.NewInt64Coin(testDenom2, 3))
+
+	res, err := coins.SafeAdd(sdk.NewInt64Coin(testDenom1, 1))
+	s.Require().NoError(err)
+	s.Require().Equal(coins.Add(sdk.NewInt64Coin(testDenom1, 1)), res)
+
+	_, err = coins.SafeAdd(sdk.NewCoin(testDenom2, maxInt))
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+
+	res, err = coins.SafeMulInt(sdk.NewInt(3))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(testDenom1, 6), sdk.NewInt64Coin(testDenom2, 9)), res)
+
+	res, err = coins.SafeMulInt(sdk.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().True(res.Empty())
+
+	_, err = coins.SafeMulInt(sdk.NewInt(-1))
+	s.Require().ErrorIs(err, sdk.ErrNegativeCoinAmount)
+
+	_, err = coins.SafeMulInt(maxInt)
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+}
+
 func (s *coinTestSuite) TestCoins_Validate() {
 	testCases := []struct {
 		name    string
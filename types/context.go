@@ -29,6 +29,9 @@ type Context struct {
 	headerHash    tmbytes.HexBytes
 	chainID       string
 	txBytes       []byte
+	txHash        tmbytes.HexBytes
+	txSigners     []AccAddress
+	msgIndex      int
 	logger        log.Logger
 	voteInfo      []abci.VoteInfo
 	gasMeter      GasMeter
@@ -50,6 +53,9 @@ func (c Context) BlockHeight() int64          { return c.header.Height }
 func (c Context) BlockTime() time.Time        { return c.header.Time }
 func (c Context) ChainID() string             { return c.chainID }
 func (c Context) TxBytes() []byte             { return c.txBytes }
+func (c Context) TxSize() int                 { return len(c.txBytes) }
+func (c Context) TxSigners() []AccAddress     { return c.txSigners }
+func (c Context) MsgIndex() int               { return c.msgIndex }
 func (c Context) Logger() log.Logger          { return c.logger }
 func (c Context) VoteInfos() []abci.VoteInfo  { return c.voteInfo }
 func (c Context) GasMeter() GasMeter          { return c.gasMeter }
@@ -72,6 +78,14 @@ func (c Context) HeaderHash() tmbytes.HexBytes {
 	return hash
 }
 
+// TxHash returns a copy of the hash of the transaction being processed, it is
+// empty outside of transaction processing.
+func (c Context) TxHash() tmbytes.HexBytes {
+	hash := make([]byte, len(c.txHash))
+	copy(hash, c.txHash)
+	return hash
+}
+
 func (c Context) ConsensusParams() *abci.ConsensusParams {
 	return proto.Clone(c.consParams).(*abci.ConsensusParams)
 }
@@ -90,6 +104,7 @@ func NewContext(ms MultiStore, header tmproto.Header, isCheckTx bool, logger log
 		gasMeter:     stypes.NewInfiniteGasMeter(),
 		minGasPrice:  DecCoins{},
 		eventManager: NewEventManager(),
+		msgIndex:     -1,
 	}
 }
 
@@ -156,6 +171,30 @@ func (c Context) WithTxBytes(txBytes []byte) Context {
 	return c
 }
 
+// WithTxHash returns a Context with an updated transaction hash.
+func (c Context) WithTxHash(hash []byte) Context {
+	temp := make([]byte, len(hash))
+	copy(temp, hash)
+
+	c.txHash = temp
+	return c
+}
+
+// WithTxSigners returns a Context with the updated signers of the transaction
+// being processed.
+func (c Context) WithTxSigners(signers []AccAddress) Context {
+	c.txSigners = signers
+	return c
+}
+
+// WithMsgIndex returns a Context with an updated index of the message being
+// executed within its transaction. The index is -1 outside of message
+// execution, including the AnteHandler.
+func (c Context) WithMsgIndex(index int) Context {
+	c.msgIndex = index
+	return c
+}
+
 // WithLogger returns a Context with an updated logger.
 func (c Context) WithLogger(logger log.Logger) Context {
 	c.logger = logger
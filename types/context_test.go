@@ -104,9 +104,13 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	blockGasMeter := types.NewGasMeter(20000)
 	minGasPrices := types.DecCoins{types.NewInt64DecCoin("feetoken", 1)}
 	headerHash := []byte("headerHash")
+	txHash := []byte("txHash")
+	txSigners := []types.AccAddress{types.AccAddress("signer")}
+	msgIndex := 2
 
 	ctx = types.NewContext(nil, header, ischeck, logger)
 	s.Require().Equal(header, ctx.BlockHeader())
+	s.Require().Equal(-1, ctx.MsgIndex())
 
 	ctx = ctx.
 		WithBlockHeight(height).
@@ -116,7 +120,10 @@ func (s *contextTestSuite) TestContextWithCustom() {
 		WithGasMeter(meter).
 		WithMinGasPrices(minGasPrices).
 		WithBlockGasMeter(blockGasMeter).
-		WithHeaderHash(headerHash)
+		WithHeaderHash(headerHash).
+		WithTxHash(txHash).
+		WithTxSigners(txSigners).
+		WithMsgIndex(msgIndex)
 	s.Require().Equal(height, ctx.BlockHeight())
 	s.Require().Equal(chainid, ctx.ChainID())
 	s.Require().Equal(ischeck, ctx.IsCheckTx())
@@ -127,6 +134,10 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().Equal(minGasPrices, ctx.MinGasPrices())
 	s.Require().Equal(blockGasMeter, ctx.BlockGasMeter())
 	s.Require().Equal(headerHash, ctx.HeaderHash().Bytes())
+	s.Require().Equal(txHash, ctx.TxHash().Bytes())
+	s.Require().Equal(len(txbytes), ctx.TxSize())
+	s.Require().Equal(txSigners, ctx.TxSigners())
+	s.Require().Equal(msgIndex, ctx.MsgIndex())
 	s.Require().False(ctx.WithIsCheckTx(false).IsCheckTx())
 
 	// test IsReCheckTx
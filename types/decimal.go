@@ -28,6 +28,12 @@ const (
 
 	// max number of iterations in ApproxRoot function
 	maxApproxRootIterations = 100
+
+	// number of decimal places used internally by ApproxLn, ApproxExp and
+	// ApproxPower, chosen so that the accumulated error stays below half of
+	// SmallestDec over the whole range of Dec (up to 2^315 / 10^18 ~ 6.7e76,
+	// with fractional powers amplifying the logarithm error by up to ~1e20)
+	approxPrecision = 128
 )
 
 var (
@@ -37,6 +43,10 @@ var (
 	zeroInt              = big.NewInt(0)
 	oneInt               = big.NewInt(1)
 	tenInt               = big.NewInt(10)
+
+	approxPrecisionReuse = new(big.Int).Exp(big.NewInt(10), big.NewInt(approxPrecision), nil)
+	approxPrecisionShift = new(big.Int).Exp(big.NewInt(10), big.NewInt(approxPrecision-Precision), nil)
+	approxLn2            = approxLnReduced(new(big.Int).Lsh(approxPrecisionReuse, 1))
 )
 
 // Decimal errors
@@ -412,6 +422,181 @@ func (d Dec) ApproxSqrt() (Dec, error) {
 	return d.ApproxRoot(2)
 }
 
+// ApproxLn returns the natural logarithm of a positive decimal. The result
+// differs from the exact value by at most SmallestDec. An error is returned if
+// the decimal is not positive.
+func (d Dec) ApproxLn() (Dec, error) {
+	if !d.IsPositive() {
+		return Dec{}, fmt.Errorf("natural logarithm of non-positive decimal %s", d)
+	}
+
+	return approxToDec(approxLn(approxFromDec(d)))
+}
+
+// ApproxExp returns e raised to the power of the decimal. The result differs
+// from the exact value by at most SmallestDec, results smaller than half of
+// SmallestDec are returned as zero. An error is returned if the result is out
+// of the range of Dec.
+func (d Dec) ApproxExp() (Dec, error) {
+	res, err := approxExp(approxFromDec(d))
+	if err != nil {
+		return Dec{}, err
+	}
+
+	return approxToDec(res)
+}
+
+// ApproxPower returns the decimal raised to a decimal power. Integer powers
+// are computed through Power and so also accept negative bases, with negative
+// integer powers returning the reciprocal. Fractional powers are computed as
+// exp(power * ln(d)) and differ from the exact value by at most SmallestDec;
+// they require a non-negative base. An error is returned for undefined results
+// and results out of the range of Dec.
+func (d Dec) ApproxPower(power Dec) (res Dec, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var ok bool
+			err, ok = r.(error)
+			if !ok {
+				err = errors.New("out of bounds")
+			}
+		}
+	}()
+
+	if power.IsInteger() {
+		exp := chopPrecisionAndTruncateNonMutative(power.i)
+		if !exp.IsUint64() && !new(big.Int).Neg(exp).IsUint64() {
+			return Dec{}, fmt.Errorf("power %s out of bounds", power)
+		}
+
+		if !power.IsNegative() {
+			return d.Power(exp.Uint64()), nil
+		}
+
+		if d.IsZero() {
+			return Dec{}, errors.New("zero raised to a negative power")
+		}
+
+		return OneDec().Quo(d.Power(exp.Neg(exp).Uint64())), nil
+	}
+
+	switch {
+	case d.IsNegative():
+		return Dec{}, fmt.Errorf("negative decimal %s raised to the fractional power %s", d, power)
+	case d.IsZero() && power.IsNegative():
+		return Dec{}, errors.New("zero raised to a negative power")
+	case d.IsZero():
+		return ZeroDec(), nil
+	}
+
+	ln := approxLn(approxFromDec(d))
+	exp, err := approxExp(ln.Quo(ln.Mul(ln, approxFromDec(power)), approxPrecisionReuse))
+	if err != nil {
+		return Dec{}, err
+	}
+
+	return approxToDec(exp)
+}
+
+// approxFromDec converts a decimal to a fixed point number with
+// approxPrecision decimal places.
+func approxFromDec(d Dec) *big.Int {
+	return new(big.Int).Mul(d.i, approxPrecisionShift)
+}
+
+// approxToDec rounds a fixed point number with approxPrecision decimal places
+// to the nearest decimal, returning an error if it is out of range.
+func approxToDec(x *big.Int) (Dec, error) {
+	res := quoRoundWithMode(x, approxPrecisionShift, RoundHalfEven)
+	if res.BitLen() > 255+DecimalPrecisionBits {
+		return Dec{}, errors.New("out of bounds")
+	}
+
+	return Dec{res}, nil
+}
+
+// approxLn returns the natural logarithm of a positive fixed point number. The
+// argument is reduced to y * 2^k with y in [1, 2) so that ln(x) = ln(y) + k * ln(2).
+func approxLn(x *big.Int) *big.Int {
+	k := x.BitLen() - approxPrecisionReuse.BitLen()
+
+	y := new(big.Int)
+	if k >= 0 {
+		y.Rsh(x, uint(k))
+	} else {
+		y.Lsh(x, uint(-k))
+	}
+
+	two := new(big.Int).Lsh(approxPrecisionReuse, 1)
+	for y.Cmp(two) >= 0 {
+		y.Rsh(y, 1)
+		k++
+	}
+	for y.Cmp(approxPrecisionReuse) < 0 {
+		y.Lsh(y, 1)
+		k--
+	}
+
+	res := new(big.Int).Mul(approxLn2, big.NewInt(int64(k)))
+	return res.Add(res, approxLnReduced(y))
+}
+
+// approxLnReduced returns the natural logarithm of a fixed point number y in
+// [1, 2] using the series ln(y) = 2 * (z + z^3/3 + z^5/5 + ...) where
+// z = (y - 1) / (y + 1). As z < 1/3 every term is at least nine times smaller
+// than the previous one.
+func approxLnReduced(y *big.Int) *big.Int {
+	z := new(big.Int).Sub(y, approxPrecisionReuse)
+	z.Mul(z, approxPrecisionReuse)
+	z.Quo(z, new(big.Int).Add(y, approxPrecisionReuse))
+
+	z2 := new(big.Int).Mul(z, z)
+	z2.Quo(z2, approxPrecisionReuse)
+
+	sum, term := new(big.Int), new(big.Int).Set(z)
+	for n := int64(1); term.Sign() != 0; n += 2 {
+		sum.Add(sum, new(big.Int).Quo(term, big.NewInt(n)))
+		term.Mul(term, z2)
+		term.Quo(term, approxPrecisionReuse)
+	}
+
+	return sum.Lsh(sum, 1)
+}
+
+// approxExp returns e raised to the power of a fixed point number. The
+// argument is reduced to r + k * ln(2) with |r| <= ln(2)/2 so that
+// exp(x) = exp(r) * 2^k, where exp(r) is computed with its Taylor series.
+func approxExp(x *big.Int) (*big.Int, error) {
+	k := new(big.Int).Add(x, new(big.Int).Rsh(approxLn2, 1))
+	k.Div(k, approxLn2)
+
+	// 2^k overflows Dec beyond 2^(255+DecimalPrecisionBits) and rounds to zero
+	// below 2^-(Precision*log2(10)) which is larger than 2^-64
+	switch {
+	case k.Cmp(big.NewInt(255+DecimalPrecisionBits)) > 0:
+		return nil, errors.New("out of bounds")
+	case k.Cmp(big.NewInt(-64)) < 0:
+		return new(big.Int), nil
+	}
+
+	r := new(big.Int).Mul(k, approxLn2)
+	r.Sub(x, r)
+
+	sum, term := new(big.Int).Set(approxPrecisionReuse), new(big.Int).Set(approxPrecisionReuse)
+	for n := int64(1); term.Sign() != 0; n++ {
+		term.Mul(term, r)
+		term.Quo(term, approxPrecisionReuse)
+		term.Quo(term, big.NewInt(n))
+		sum.Add(sum, term)
+	}
+
+	if k.Sign() >= 0 {
+		return sum.Lsh(sum, uint(k.Uint64())), nil
+	}
+
+	return sum.Rsh(sum, uint(new(big.Int).Neg(k).Uint64())), nil
+}
+
 // is integer, e.g. decimals are zero
 func (d Dec) IsInteger() bool {
 	return new(big.Int).Rem(d.i, precisionReuse).Sign() == 0
@@ -563,6 +748,84 @@ func (d Dec) RoundInt() Int {
 	return NewIntFromBigInt(chopPrecisionAndRoundNonMutative(d.i))
 }
 
+// RoundingMode selects how a decimal is rounded by RoundIntWithMode and
+// RoundToPrec.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, ties to the even neighbour
+	// (bankers rounding). This is the rounding used by RoundInt and Mul.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, ties away from zero.
+	RoundHalfUp
+	// RoundDown rounds towards zero (truncation).
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+)
+
+// RoundIntWithMode rounds the decimal to an integer using the given rounding mode
+func (d Dec) RoundIntWithMode(mode RoundingMode) Int {
+	return NewIntFromBigInt(quoRoundWithMode(d.i, precisionReuse, mode))
+}
+
+// RoundToPrec rounds the decimal to prec decimal places using the given
+// rounding mode. It panics if prec is not within [0, Precision].
+func (d Dec) RoundToPrec(prec int64, mode RoundingMode) Dec {
+	if prec < 0 || prec > Precision {
+		panic(fmt.Sprintf("invalid precision; max: %d, min: 0, got: %d", Precision, prec))
+	}
+
+	multiplier := precisionMultiplier(prec)
+	res := quoRoundWithMode(d.i, multiplier, mode)
+	return Dec{res.Mul(res, multiplier)}
+}
+
+// quoRoundWithMode returns x / y rounded with the given rounding mode, where y
+// is positive. The inputs are not mutated.
+func quoRoundWithMode(x, y *big.Int, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(x, y, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// quo is truncated towards zero, away is the neighbour away from zero
+	away := new(big.Int).Add(quo, big.NewInt(int64(x.Sign())))
+
+	var roundAway bool
+	switch mode {
+	case RoundHalfEven, RoundHalfUp:
+		switch new(big.Int).Lsh(rem.Abs(rem), 1).Cmp(y) {
+		case -1:
+			roundAway = false
+		case 1:
+			roundAway = true
+		default:
+			roundAway = mode == RoundHalfUp || quo.Bit(0) == 1
+		}
+	case RoundDown:
+		roundAway = false
+	case RoundUp:
+		roundAway = true
+	case RoundFloor:
+		roundAway = x.Sign() < 0
+	case RoundCeiling:
+		roundAway = x.Sign() > 0
+	default:
+		panic(fmt.Sprintf("invalid rounding mode %d", mode))
+	}
+
+	if roundAway {
+		return away
+	}
+
+	return quo
+}
+
 // ___________________________________________________________________________________
 
 // similar to chopPrecisionAndRound, but always rounds down
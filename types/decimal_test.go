@@ -395,6 +395,144 @@ func (s *decimalTestSuite) TestApproxSqrt() {
 	}
 }
 
+func (s *decimalTestSuite) TestApproxLn() {
+	testCases := []struct {
+		input    sdk.Dec
+		expected sdk.Dec
+		expErr   bool
+	}{
+		{sdk.OneDec(), sdk.ZeroDec(), false},
+		{sdk.NewDec(2), s.mustNewDecFromStr("0.693147180559945309"), false},
+		{sdk.NewDecWithPrec(5, 1), s.mustNewDecFromStr("-0.693147180559945309"), false},
+		{sdk.NewDec(10), s.mustNewDecFromStr("2.302585092994045684"), false},
+		{sdk.SmallestDec(), s.mustNewDecFromStr("-41.446531673892822312"), false},
+		{s.mustNewDecFromStr("123456789.123456789"), s.mustNewDecFromStr("18.631401767168018033"), false},
+		{s.mustNewDecFromStr("50000000000000000000000000000000000000000000000000000000000"), s.mustNewDecFromStr("135.159373306088750048"), false},
+		{sdk.ZeroDec(), sdk.Dec{}, true},
+		{sdk.NewDec(-1), sdk.Dec{}, true},
+	}
+
+	for i, tc := range testCases {
+		res, err := tc.input.ApproxLn()
+		if tc.expErr {
+			s.Require().Error(err, "expected error for test case %d, input: %v", i, tc.input)
+			continue
+		}
+
+		s.Require().NoError(err)
+		s.Require().True(tc.expected.Sub(res).Abs().LTE(sdk.SmallestDec()), "unexpected result for test case %d, input: %v, got: %v", i, tc.input, res)
+	}
+}
+
+func (s *decimalTestSuite) TestApproxExp() {
+	testCases := []struct {
+		input    sdk.Dec
+		expected sdk.Dec
+		expErr   bool
+	}{
+		{sdk.ZeroDec(), sdk.OneDec(), false},
+		{sdk.OneDec(), s.mustNewDecFromStr("2.718281828459045235"), false},
+		{sdk.NewDec(-1), s.mustNewDecFromStr("0.367879441171442322"), false},
+		{sdk.NewDecWithPrec(25, 1), s.mustNewDecFromStr("12.182493960703473438"), false},
+		{sdk.SmallestDec(), s.mustNewDecFromStr("1.000000000000000001"), false},
+		{sdk.NewDec(-20), s.mustNewDecFromStr("0.000000002061153622"), false},
+		{sdk.NewDec(-42), sdk.SmallestDec(), false},
+		{sdk.NewDec(-1000), sdk.ZeroDec(), false},
+		{sdk.NewDec(100), s.mustNewDecFromStr("26881171418161354484126255515800135873611118.773741922415191609"), false},
+		{sdk.NewDec(135), s.mustNewDecFromStr("42633899483147210448936866880765989356468745853255281087440.011736227864297277"), false},
+		{sdk.NewDec(177), sdk.Dec{}, true},
+		{sdk.NewDec(1000), sdk.Dec{}, true},
+	}
+
+	for i, tc := range testCases {
+		res, err := tc.input.ApproxExp()
+		if tc.expErr {
+			s.Require().Error(err, "expected error for test case %d, input: %v", i, tc.input)
+			continue
+		}
+
+		s.Require().NoError(err)
+		s.Require().True(tc.expected.Sub(res).Abs().LTE(sdk.SmallestDec()), "unexpected result for test case %d, input: %v, got: %v", i, tc.input, res)
+	}
+}
+
+func (s *decimalTestSuite) TestApproxPower() {
+	testCases := []struct {
+		base     sdk.Dec
+		power    sdk.Dec
+		expected sdk.Dec
+		expErr   bool
+	}{
+		{sdk.NewDec(2), sdk.NewDec(10), sdk.NewDec(1024), false},
+		{sdk.NewDec(-2), sdk.NewDec(3), sdk.NewDec(-8), false},
+		{sdk.NewDec(-2), sdk.NewDec(-2), sdk.NewDecWithPrec(25, 2), false},
+		{sdk.NewDec(5), sdk.ZeroDec(), sdk.OneDec(), false},
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.ZeroDec(), false},
+		{sdk.NewDec(2), sdk.NewDecWithPrec(5, 1), s.mustNewDecFromStr("1.414213562373095049"), false},
+		{sdk.NewDecWithPrec(105, 2), sdk.NewDecWithPrec(25, 1), s.mustNewDecFromStr("1.129726321947045722"), false},
+		{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), false},
+		{sdk.NewDec(10), sdk.NewDecWithPrec(-15, 1), s.mustNewDecFromStr("0.031622776601683793"), false},
+		{sdk.NewDec(27), s.mustNewDecFromStr("0.333333333333333333"), s.mustNewDecFromStr("2.999999999999999997"), false},
+		{s.mustNewDecFromStr("1.000000000000000001"), s.mustNewDecFromStr("100000000000000000000.5"), s.mustNewDecFromStr("26881171418161353153508270316813122731475358.834068831806516499"), false},
+		{sdk.NewDec(-2), sdk.NewDecWithPrec(5, 1), sdk.Dec{}, true},
+		{sdk.ZeroDec(), sdk.NewDec(-1), sdk.Dec{}, true},
+		{sdk.ZeroDec(), sdk.NewDecWithPrec(-5, 1), sdk.Dec{}, true},
+		{sdk.NewDec(10), sdk.NewDecWithPrec(1005, 1), sdk.Dec{}, true},
+		{sdk.NewDec(10), sdk.NewDec(100), sdk.Dec{}, true},
+	}
+
+	for i, tc := range testCases {
+		res, err := tc.base.ApproxPower(tc.power)
+		if tc.expErr {
+			s.Require().Error(err, "expected error for test case %d, base: %v, power: %v", i, tc.base, tc.power)
+			continue
+		}
+
+		s.Require().NoError(err)
+		s.Require().True(tc.expected.Sub(res).Abs().LTE(sdk.SmallestDec()), "unexpected result for test case %d, base: %v, power: %v, got: %v", i, tc.base, tc.power, res)
+	}
+}
+
+func (s *decimalTestSuite) TestRoundWithMode() {
+	testCases := []struct {
+		input    sdk.Dec
+		mode     sdk.RoundingMode
+		expected int64
+	}{
+		{s.mustNewDecFromStr("2.5"), sdk.RoundHalfEven, 2},
+		{s.mustNewDecFromStr("3.5"), sdk.RoundHalfEven, 4},
+		{s.mustNewDecFromStr("-2.5"), sdk.RoundHalfEven, -2},
+		{s.mustNewDecFromStr("2.51"), sdk.RoundHalfEven, 3},
+		{s.mustNewDecFromStr("2.5"), sdk.RoundHalfUp, 3},
+		{s.mustNewDecFromStr("-2.5"), sdk.RoundHalfUp, -3},
+		{s.mustNewDecFromStr("2.49"), sdk.RoundHalfUp, 2},
+		{s.mustNewDecFromStr("2.9"), sdk.RoundDown, 2},
+		{s.mustNewDecFromStr("-2.9"), sdk.RoundDown, -2},
+		{s.mustNewDecFromStr("2.1"), sdk.RoundUp, 3},
+		{s.mustNewDecFromStr("-2.1"), sdk.RoundUp, -3},
+		{s.mustNewDecFromStr("2.9"), sdk.RoundFloor, 2},
+		{s.mustNewDecFromStr("-2.1"), sdk.RoundFloor, -3},
+		{s.mustNewDecFromStr("2.1"), sdk.RoundCeiling, 3},
+		{s.mustNewDecFromStr("-2.9"), sdk.RoundCeiling, -2},
+		{sdk.NewDec(-7), sdk.RoundUp, -7},
+	}
+
+	for i, tc := range testCases {
+		res := tc.input.RoundIntWithMode(tc.mode)
+		s.Require().Equal(sdk.NewInt(tc.expected), res, "unexpected result for test case %d, input: %v", i, tc.input)
+
+		// rounding to zero decimal places is equivalent
+		s.Require().Equal(sdk.NewDec(tc.expected), tc.input.RoundToPrec(0, tc.mode), "unexpected result for test case %d, input: %v", i, tc.input)
+	}
+
+	s.Require().Equal(s.mustNewDecFromStr("1.24"), s.mustNewDecFromStr("1.245").RoundToPrec(2, sdk.RoundHalfEven))
+	s.Require().Equal(s.mustNewDecFromStr("1.25"), s.mustNewDecFromStr("1.245").RoundToPrec(2, sdk.RoundHalfUp))
+	s.Require().Equal(s.mustNewDecFromStr("-1.25"), s.mustNewDecFromStr("-1.241").RoundToPrec(2, sdk.RoundFloor))
+	s.Require().Equal(sdk.SmallestDec(), sdk.SmallestDec().RoundToPrec(sdk.Precision, sdk.RoundDown))
+	s.Require().Panics(func() { sdk.OneDec().RoundToPrec(sdk.Precision+1, sdk.RoundDown) })
+	s.Require().Panics(func() { sdk.OneDec().RoundToPrec(-1, sdk.RoundDown) })
+}
+
 func (s *decimalTestSuite) TestDecSortableBytes() {
 	tests := []struct {
 		d    sdk.Dec
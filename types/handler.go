@@ -7,6 +7,11 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// PostHandler processes transactions after all their messages have been
+// successfully handled, e.g. to refund fees. Its state changes are committed along
+// with the ones of the messages, and the transaction fails if it returns an error.
+type PostHandler func(ctx Context, tx Tx, simulate bool) error
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
@@ -3,6 +3,7 @@ package types
 import (
 	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -11,6 +12,10 @@ import (
 
 const maxBitLen = 255
 
+// ErrIntOverflow is returned by the Safe arithmetic methods of Int when the
+// result does not fit into maxBitLen bits.
+var ErrIntOverflow = errors.New("integer overflow")
+
 func newIntegerFromString(s string) (*big.Int, bool) {
 	return new(big.Int).SetString(s, 0)
 }
@@ -223,7 +228,8 @@ func (i Int) LTE(i2 Int) bool {
 	return lte(i.i, i2.i)
 }
 
-// Add adds Int from another
+// Add adds Int from another. It panics on overflow, use SafeAdd to handle
+// overflows as errors.
 func (i Int) Add(i2 Int) (res Int) {
 	res = Int{add(i.i, i2.i)}
 	// Check overflow
@@ -238,7 +244,8 @@ func (i Int) AddRaw(i2 int64) Int {
 	return i.Add(NewInt(i2))
 }
 
-// Sub subtracts Int from another
+// Sub subtracts Int from another. It panics on overflow, use SafeSub to handle
+// overflows as errors.
 func (i Int) Sub(i2 Int) (res Int) {
 	res = Int{sub(i.i, i2.i)}
 	// Check overflow
@@ -253,7 +260,8 @@ func (i Int) SubRaw(i2 int64) Int {
 	return i.Sub(NewInt(i2))
 }
 
-// Mul multiples two Ints
+// Mul multiples two Ints. It panics on overflow, use SafeMul to handle
+// overflows as errors.
 func (i Int) Mul(i2 Int) (res Int) {
 	// Check overflow
 	if i.i.BitLen()+i2.i.BitLen()-1 > maxBitLen {
@@ -267,6 +275,43 @@ func (i Int) Mul(i2 Int) (res Int) {
 	return
 }
 
+// SafeAdd adds Int from another and returns ErrIntOverflow instead of
+// panicking if the result overflows.
+func (i Int) SafeAdd(i2 Int) (Int, error) {
+	res := add(i.i, i2.i)
+	if res.BitLen() > maxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+
+	return Int{res}, nil
+}
+
+// SafeSub subtracts Int from another and returns ErrIntOverflow instead of
+// panicking if the result overflows.
+func (i Int) SafeSub(i2 Int) (Int, error) {
+	res := sub(i.i, i2.i)
+	if res.BitLen() > maxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+
+	return Int{res}, nil
+}
+
+// SafeMul multiplies two Ints and returns ErrIntOverflow instead of panicking
+// if the result overflows.
+func (i Int) SafeMul(i2 Int) (Int, error) {
+	if i.i.BitLen()+i2.i.BitLen()-1 > maxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+
+	res := mul(i.i, i2.i)
+	if res.BitLen() > maxBitLen {
+		return Int{}, ErrIntOverflow
+	}
+
+	return Int{res}, nil
+}
+
 // MulRaw multipies Int and int64
 func (i Int) MulRaw(i2 int64) Int {
 	return i.Mul(NewInt(i2))
@@ -96,6 +96,50 @@ func (s *intTestSuite) TestIntPanic() {
 	s.Require().NotPanics(func() { sdk.Int{}.BigInt() })
 }
 
+func (s *intTestSuite) TestSafeArithInt() {
+	intmax := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1)))
+	intmin := intmax.Neg()
+	i3 := sdk.NewIntWithDecimal(3, 76)
+
+	res, err := sdk.NewInt(3).SafeAdd(sdk.NewInt(4))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(7), res)
+
+	res, err = sdk.NewInt(3).SafeSub(sdk.NewInt(4))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(-1), res)
+
+	res, err = sdk.NewInt(3).SafeMul(sdk.NewInt(-4))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(-12), res)
+
+	res, err = intmax.SafeAdd(sdk.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().Equal(intmax, res)
+
+	res, err = intmin.SafeSub(sdk.ZeroInt())
+	s.Require().NoError(err)
+	s.Require().Equal(intmin, res)
+
+	res, err = intmax.SafeMul(sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().Equal(intmax, res)
+
+	// overflows return errors instead of panicking
+	_, err = intmax.SafeAdd(sdk.OneInt())
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+	_, err = i3.Neg().SafeAdd(i3.Neg())
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+	_, err = intmin.SafeSub(sdk.OneInt())
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+	_, err = i3.SafeSub(i3.Neg())
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+	_, err = i3.SafeMul(i3)
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+	_, err = intmax.SafeMul(sdk.NewInt(-2))
+	s.Require().ErrorIs(err, sdk.ErrIntOverflow)
+}
+
 // Tests below uses randomness
 // Since we are using *big.Int as underlying value
 // and (U/)Int is immutable value(see TestImmutability(U/)Int)
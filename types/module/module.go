@@ -30,6 +30,8 @@ package module
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -39,6 +41,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -174,11 +177,38 @@ type AppModule interface {
 	// RegisterServices allows a module to register services
 	RegisterServices(Configurator)
 
+	// ConsensusVersion is a sequence number for state-breaking change of the
+	// module. It should be incremented on each consensus-breaking change
+	// introduced by the module. To avoid wrong/empty versions, the initial version
+	// should be set to 1.
+	ConsensusVersion() uint64
+
 	// ABCI
 	BeginBlock(sdk.Context, abci.RequestBeginBlock)
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// BeginBlockDependent is implemented by modules whose BeginBlock must run after
+// the BeginBlock of other modules. Manager.ValidateOrdering checks
+// OrderBeginBlockers against the returned module names.
+type BeginBlockDependent interface {
+	BeginBlockDependencies() []string
+}
+
+// EndBlockDependent is implemented by modules whose EndBlock must run after the
+// EndBlock of other modules. Manager.ValidateOrdering checks OrderEndBlockers
+// against the returned module names.
+type EndBlockDependent interface {
+	EndBlockDependencies() []string
+}
+
+// InitGenesisDependent is implemented by modules whose InitGenesis must run
+// after the InitGenesis of other modules. Manager.ValidateOrdering checks
+// OrderInitGenesis against the returned module names.
+type InitGenesisDependent interface {
+	InitGenesisDependencies() []string
+}
+
 // ___________________________
 
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
@@ -208,6 +238,9 @@ func (gam GenesisOnlyAppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Que
 // RegisterServices registers all services.
 func (gam GenesisOnlyAppModule) RegisterServices(Configurator) {}
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (gam GenesisOnlyAppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock returns an empty module begin-block
 func (gam GenesisOnlyAppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {}
 
@@ -267,6 +300,83 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// ValidateOrdering checks that the init genesis, export genesis, begin-blocker
+// and end-blocker orders only reference registered modules, each at most once,
+// and that every module runs after the modules it declares as dependencies
+// through BeginBlockDependent, EndBlockDependent and InitGenesisDependent.
+// Dependencies on modules which are not registered with the manager are
+// ignored, registered dependencies must be part of the order. Applications
+// should call it once the orders are set so that wiring mistakes fail at
+// startup.
+func (m *Manager) ValidateOrdering() error {
+	if err := m.validateOrder("InitGenesis", m.OrderInitGenesis, func(module AppModule) []string {
+		if dependent, ok := module.(InitGenesisDependent); ok {
+			return dependent.InitGenesisDependencies()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := m.validateOrder("ExportGenesis", m.OrderExportGenesis, func(AppModule) []string {
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := m.validateOrder("BeginBlock", m.OrderBeginBlockers, func(module AppModule) []string {
+		if dependent, ok := module.(BeginBlockDependent); ok {
+			return dependent.BeginBlockDependencies()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return m.validateOrder("EndBlock", m.OrderEndBlockers, func(module AppModule) []string {
+		if dependent, ok := module.(EndBlockDependent); ok {
+			return dependent.EndBlockDependencies()
+		}
+		return nil
+	})
+}
+
+// validateOrder validates a single module order of the given phase, see
+// ValidateOrdering.
+func (m *Manager) validateOrder(phase string, order []string, dependencies func(AppModule) []string) error {
+	positions := make(map[string]int, len(order))
+	for i, moduleName := range order {
+		if _, ok := m.Modules[moduleName]; !ok {
+			return fmt.Errorf("%s order references unregistered module %s", phase, moduleName)
+		}
+
+		if _, ok := positions[moduleName]; ok {
+			return fmt.Errorf("%s order contains module %s more than once", phase, moduleName)
+		}
+
+		positions[moduleName] = i
+	}
+
+	for i, moduleName := range order {
+		for _, dependency := range dependencies(m.Modules[moduleName]) {
+			if _, ok := m.Modules[dependency]; !ok {
+				continue
+			}
+
+			pos, ok := positions[dependency]
+			if !ok {
+				return fmt.Errorf("%s of module %s depends on module %s which is not in the %s order", phase, moduleName, dependency, phase)
+			}
+
+			if pos > i {
+				return fmt.Errorf("%s of module %s must run after module %s", phase, moduleName, dependency)
+			}
+		}
+	}
+
+	return nil
+}
+
 // RegisterInvariants registers all module routes and module querier routes
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -369,3 +479,122 @@ func (m *Manager) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 		Events:           ctx.EventManager().ABCIEvents(),
 	}
 }
+
+// MigrationHandler is the migration function that each module registers.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of moduleName -> version, where version denotes the
+// version from which we should perform the migration for each module.
+type VersionMap map[string]uint64
+
+// RunMigrations performs in-place store migrations for all modules. This
+// function MUST be called inside an x/upgrade UpgradeHandler.
+//
+// Recall that in an upgrade handler, the `fromVM` VersionMap is retrieved from
+// x/upgrade's store, and the function needs to return the target VersionMap
+// that will in turn be persisted to the x/upgrade's store. In general,
+// returning RunMigrations should be enough:
+//
+// Example:
+//   cfg := module.NewConfigurator(...)
+//   app.UpgradeKeeper.SetUpgradeHandler("my-plan", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//       return app.mm.RunMigrations(ctx, cfg, fromVM)
+//   })
+//
+// Internally, RunMigrations will perform the following steps:
+// - create an `updatedVM` VersionMap of module with their latest ConsensusVersion
+// - make a diff of `fromVM` and `updatedVM`, and for each module:
+//    - if the module's `fromVM` version is less than its `updatedVM` version,
+//      then run in-place store migrations for that module between those versions.
+//    - if the module does not exist in the `fromVM` (which means that it's a new module,
+//      because it was not in the previous x/upgrade's store), then run
+//      `InitGenesis` on that module with its default genesis state.
+// - return the `updatedVM` to be persisted in the x/upgrade's store.
+//
+// Modules are migrated following OrderInitGenesis, modules missing from that
+// order are migrated afterwards in alphabetical order.
+//
+// As an app developer, if you wish to skip running InitGenesis for your new
+// module "foo", you need to manually pass a `fromVM` argument to this function
+// foo's module version set to its latest ConsensusVersion. That way, the diff
+// between the function's `fromVM` and `updatedVM` will be empty, hence not
+// running anything for foo.
+//
+// Chains which did not record a VersionMap before (e.g. chains upgrading from
+// a binary without module versions) MUST pass a `fromVM` listing all their
+// existing modules, otherwise their InitGenesis would be run again.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
+	}
+
+	updatedVM := make(VersionMap)
+	for _, moduleName := range m.migrationOrder() {
+		module := m.Modules[moduleName]
+		fromVersion, exists := fromVM[moduleName]
+		toVersion := module.ConsensusVersion()
+
+		// Only run migrations when the module exists in the fromVM.
+		// Run InitGenesis otherwise.
+		//
+		// the module won't exist in the fromVM in two cases:
+		// 1. A new module is added. In this case we run InitGenesis with its
+		// default genesis state.
+		// 2. An existing chain is upgrading for the first time since module
+		// versions were introduced. In this case, all modules have yet to be
+		// added to x/upgrade's VersionMap store.
+		if exists {
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+		} else {
+			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+			moduleValUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc))
+			// The module manager assumes only one module will update the
+			// validator set, and that it will not be by a new module.
+			if len(moduleValUpdates) > 0 {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "new module %s cannot update the validator set in InitGenesis", moduleName)
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// GetVersionMap gets consensus version from all modules
+func (m *Manager) GetVersionMap() VersionMap {
+	vermap := make(VersionMap)
+	for _, v := range m.Modules {
+		version := v.ConsensusVersion()
+		name := v.Name()
+		vermap[name] = version
+	}
+
+	return vermap
+}
+
+// migrationOrder returns the order in which RunMigrations processes the
+// modules: OrderInitGenesis first, then the remaining modules sorted by name.
+func (m *Manager) migrationOrder() []string {
+	order := make([]string, 0, len(m.Modules))
+	seen := make(map[string]bool, len(m.Modules))
+	for _, moduleName := range m.OrderInitGenesis {
+		if _, ok := m.Modules[moduleName]; ok && !seen[moduleName] {
+			order = append(order, moduleName)
+			seen[moduleName] = true
+		}
+	}
+
+	remaining := make([]string, 0, len(m.Modules)-len(order))
+	for moduleName := range m.Modules {
+		if !seen[moduleName] {
+			remaining = append(remaining, moduleName)
+		}
+	}
+	sort.Strings(remaining)
+
+	return append(order, remaining...)
+}
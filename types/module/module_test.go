@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -179,7 +180,8 @@ func TestManager_RegisterQueryServices(t *testing.T) {
 
 	msgRouter := mocks.NewMockServer(mockCtrl)
 	queryRouter := mocks.NewMockServer(mockCtrl)
-	cfg := module.NewConfigurator(msgRouter, queryRouter)
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, msgRouter, queryRouter)
 	mockAppModule1.EXPECT().RegisterServices(cfg).Times(1)
 	mockAppModule2.EXPECT().RegisterServices(cfg).Times(1)
 
@@ -282,3 +284,145 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+// dependentAppModule is a mock app module declaring its ordering dependencies.
+type dependentAppModule struct {
+	*mocks.MockAppModule
+
+	beginBlockDeps, endBlockDeps, initGenesisDeps []string
+}
+
+func (m dependentAppModule) BeginBlockDependencies() []string  { return m.beginBlockDeps }
+func (m dependentAppModule) EndBlockDependencies() []string    { return m.endBlockDeps }
+func (m dependentAppModule) InitGenesisDependencies() []string { return m.initGenesisDeps }
+
+func TestManager_ValidateOrdering(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	module2 := dependentAppModule{
+		MockAppModule:   mockAppModule2,
+		beginBlockDeps:  []string{"module1", "unregistered"},
+		endBlockDeps:    []string{"module1"},
+		initGenesisDeps: []string{"module1"},
+	}
+	mm := module.NewManager(mockAppModule1, module2)
+	require.NotNil(t, mm)
+	require.NoError(t, mm.ValidateOrdering())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   string
+	}{
+		{
+			"valid orders",
+			func() {},
+			"",
+		},
+		{
+			"unregistered module",
+			func() { mm.SetOrderInitGenesis("module1", "module3") },
+			"InitGenesis order references unregistered module module3",
+		},
+		{
+			"duplicate module",
+			func() { mm.SetOrderExportGenesis("module1", "module2", "module1") },
+			"ExportGenesis order contains module module1 more than once",
+		},
+		{
+			"dependency after dependent",
+			func() { mm.SetOrderBeginBlockers("module2", "module1") },
+			"BeginBlock of module module2 must run after module module1",
+		},
+		{
+			"missing registered dependency",
+			func() { mm.SetOrderEndBlockers("module2") },
+			"EndBlock of module module2 depends on module module1 which is not in the EndBlock order",
+		},
+		{
+			"dependent not in order",
+			func() { mm.SetOrderEndBlockers("module1") },
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			mm.SetOrderInitGenesis("module1", "module2")
+			mm.SetOrderExportGenesis("module1", "module2")
+			mm.SetOrderBeginBlockers("module1", "module2")
+			mm.SetOrderEndBlockers("module1", "module2")
+			tc.malleate()
+
+			err := mm.ValidateOrdering()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule3.EXPECT().Name().Times(2).Return("module3")
+	mm := module.NewManager(mockAppModule1, mockAppModule2, mockAppModule3)
+	mm.SetOrderInitGenesis("module2", "module1")
+
+	mockAppModule1.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+	mockAppModule2.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(3))
+	mockAppModule3.EXPECT().ConsensusVersion().AnyTimes().Return(uint64(1))
+
+	cdc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	cfg := module.NewConfigurator(cdc, mocks.NewMockServer(mockCtrl), mocks.NewMockServer(mockCtrl))
+
+	var migrated []uint64
+	migration := func(version uint64) module.MigrationHandler {
+		return func(sdk.Context) error {
+			migrated = append(migrated, version)
+			return nil
+		}
+	}
+	require.Error(t, cfg.RegisterMigration("module2", 0, migration(0)))
+	require.NoError(t, cfg.RegisterMigration("module2", 1, migration(1)))
+	require.Error(t, cfg.RegisterMigration("module2", 1, migration(1)))
+
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+
+	// module2 misses the migration from version 2 to 3
+	_, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1, "module3": 1})
+	require.Error(t, err)
+	require.Equal(t, []uint64{1}, migrated)
+
+	migrated = nil
+	require.NoError(t, cfg.RegisterMigration("module2", 2, migration(2)))
+
+	// module3 is a new module, its InitGenesis is run with the default genesis
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule3.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule3.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, cfg, module.VersionMap{"module1": 1, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, migrated)
+	require.Equal(t, module.VersionMap{"module1": 1, "module2": 3, "module3": 1}, vm)
+
+	mockAppModule1.EXPECT().Name().Times(1).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(1).Return("module2")
+	mockAppModule3.EXPECT().Name().Times(1).Return("module3")
+	require.Equal(t, vm, mm.GetVersionMap())
+}
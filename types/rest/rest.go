@@ -14,11 +14,13 @@ import (
 	"strings"
 
 	"github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 )
 
 const (
@@ -328,6 +330,23 @@ func PostProcessResponse(w http.ResponseWriter, ctx client.Context, resp interfa
 	_, _ = w.Write(output)
 }
 
+// PostProcessGRPCResponse performs post processing for a REST response built
+// from the result of a gRPC query made through the client.Context. It behaves
+// as PostProcessResponse, the height being read from the block height header
+// of the gRPC response.
+func PostProcessGRPCResponse(w http.ResponseWriter, ctx client.Context, header metadata.MD, resp interface{}) {
+	if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		height, err := strconv.ParseInt(heights[0], 10, 64)
+		if CheckInternalServerError(w, err) {
+			return
+		}
+
+		ctx = ctx.WithHeight(height)
+	}
+
+	PostProcessResponse(w, ctx, resp)
+}
+
 // ParseHTTPArgsWithLimit parses the request's URL and returns a slice containing
 // all arguments pairs. It separates page and limit used for pagination where a
 // default limit can be provided.
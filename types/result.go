@@ -293,3 +293,14 @@ func WrapServiceResult(ctx Context, res proto.Message, err error) (*Result, erro
 		Events: events,
 	}, nil
 }
+
+// AppStatusResponse defines the application level status of a node, as returned
+// by the "app/status" ABCI query.
+type AppStatusResponse struct {
+	EarliestStoreHeight int64    `json:"earliest_store_height"`
+	LatestStoreHeight   int64    `json:"latest_store_height"`
+	PruningKeepRecent   uint64   `json:"pruning_keep_recent"`
+	PruningKeepEvery    uint64   `json:"pruning_keep_every"`
+	PruningInterval     uint64   `json:"pruning_interval"`
+	MinGasPrices        DecCoins `json:"minimum_gas_prices"`
+}
@@ -2,6 +2,9 @@ package ante_test
 
 import (
 	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
@@ -267,6 +271,50 @@ func (suite *AnteTestSuite) TestSigVerification_ExplicitAmino() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSigVerification_RejectsHighS() {
+	suite.SetupTest(true) // setup
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	newTxBuilder := func() {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	}
+
+	newTxBuilder()
+	validTx, err := suite.CreateTestTx([]cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// replace the signature by its high-S counterpart, which anyone can derive
+	// from the signature to change the hash of the transaction
+	sigs, err := validTx.GetSignaturesV2()
+	suite.Require().NoError(err)
+	sigData := sigs[0].Data.(*signing.SingleSignatureData)
+	n := btcec.S256().N
+	s := new(big.Int).SetBytes(sigData.Signature[32:])
+	highS := make([]byte, 64)
+	copy(highS, sigData.Signature[:32])
+	new(big.Int).Sub(n, s).FillBytes(highS[32:])
+	sigs[0].Data = &signing.SingleSignatureData{SignMode: sigData.SignMode, Signature: highS}
+	newTxBuilder()
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sigs...))
+	malleatedTx := suite.txBuilder.GetTx()
+
+	spkd := ante.NewSetPubKeyDecorator(suite.app.AccountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	_, err = antehandler(suite.ctx, validTx, false)
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, malleatedTx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
 func (suite *AnteTestSuite) TestSigIntegration() {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
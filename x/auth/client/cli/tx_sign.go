@@ -72,7 +72,7 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 
 		// validate multisig address if there's any
 		if ms, _ := cmd.Flags().GetString(flagMultisig); ms != "" {
-			multisigAddr, err = sdk.AccAddressFromBech32(ms)
+			multisigAddr, err = clientCtx.AccAddressFromBech32(ms)
 			if err != nil {
 				return err
 			}
@@ -230,7 +230,7 @@ func makeSignCmd() func(cmd *cobra.Command, args []string) error {
 		overwrite, _ := f.GetBool(flagOverwrite)
 		if multisigAddrStr != "" {
 			var multisigAddr sdk.AccAddress
-			multisigAddr, err = sdk.AccAddressFromBech32(multisigAddrStr)
+			multisigAddr, err = clientCtx.AccAddressFromBech32(multisigAddrStr)
 			if err != nil {
 				return err
 			}
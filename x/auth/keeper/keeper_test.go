@@ -118,6 +118,27 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, params, actualParams)
 }
 
+func TestGetParamsGas(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.AccountKeeper.SetParams(ctx, types.DefaultParams())
+
+	// reading the params consumes the gas of reading all of them but the fee
+	// refund ratio
+	var expected types.Params
+	subspace := app.GetSubspace(types.ModuleName)
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, pair := range expected.ParamSetPairs() {
+		if string(pair.Key) != string(types.KeyFeeRefundRatio) {
+			subspace.Get(ctx, pair.Key, pair.Value)
+		}
+	}
+	expectedGas := ctx.GasMeter().GasConsumed()
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.Equal(t, types.DefaultParams(), app.AccountKeeper.GetParams(ctx))
+	require.Equal(t, expectedGas, ctx.GasMeter().GasConsumed())
+}
+
 func TestSupply_ValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(true)
 
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper AccountKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the FeeRefundRatio param,
+// missing from the param store of version 1, to its default value.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.KeyFeeRefundRatio, types.DefaultFeeRefundRatio)
+	return nil
+}
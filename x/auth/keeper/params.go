@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -10,8 +12,19 @@ func (ak AccountKeeper) SetParams(ctx sdk.Context, params types.Params) {
 	ak.paramSubspace.SetParamSet(ctx, &params)
 }
 
-// GetParams gets the auth module's parameters.
+// GetParams gets the auth module's parameters. The fee refund ratio, which is
+// only used after the messages of a transaction, is read without consuming gas
+// so that the ante handlers reading the params consume the same gas as before
+// it was added.
 func (ak AccountKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	ak.paramSubspace.GetParamSet(ctx, &params)
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyFeeRefundRatio) {
+			ak.paramSubspace.Get(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), pair.Key, pair.Value)
+			continue
+		}
+
+		ak.paramSubspace.Get(ctx, pair.Key, pair.Value)
+	}
+
 	return
 }
@@ -120,6 +120,7 @@ func Migrate(authGenState v039auth.GenesisState) *v040auth.GenesisState {
 			TxSizeCostPerByte:      authGenState.Params.TxSizeCostPerByte,
 			SigVerifyCostED25519:   authGenState.Params.SigVerifyCostED25519,
 			SigVerifyCostSecp256k1: authGenState.Params.SigVerifyCostSecp256k1,
+			FeeRefundRatio:         v040auth.DefaultFeeRefundRatio,
 		},
 		Accounts: anys,
 	}
@@ -233,6 +233,7 @@ func TestMigrate(t *testing.T) {
     }
   ],
   "params": {
+    "fee_refund_ratio": "0.000000000000000000",
     "max_memo_characters": "10",
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
@@ -0,0 +1,72 @@
+package posthandler
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewPostHandler returns a PostHandler refunding to the fee payer of successful
+// transactions the share, set by the FeeRefundRatio param, of the fees paid for
+// the gas they did not use.
+func NewPostHandler(ak AccountKeeper, bankKeeper BankKeeper) sdk.PostHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) error {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		gasWanted, gasUsed := feeTx.GetGas(), ctx.GasMeter().GasConsumed()
+
+		// the refund is not metered, so that it does not change the gas used by
+		// the transaction, nor its gas estimates
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+		refund := RefundedFees(feeTx.GetFee(), gasWanted, gasUsed, ak.GetParams(ctx).FeeRefundRatio)
+		if refund.IsZero() {
+			return nil
+		}
+
+		if addr := ak.GetModuleAddress(types.FeeCollectorName); addr == nil {
+			panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
+		}
+
+		feePayer := feeTx.FeePayer()
+		if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, feePayer, refund); err != nil {
+			return sdkerrors.Wrapf(err, "failed to refund fees to %s", feePayer)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFeeRefund,
+				sdk.NewAttribute(types.AttributeKeyFeePayer, feePayer.String()),
+				sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+				sdk.NewAttribute(types.AttributeKeyGasWanted, fmt.Sprintf("%d", gasWanted)),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			),
+		)
+
+		return nil
+	}
+}
+
+// RefundedFees returns the fees refunded to a transaction which paid fees for
+// gasWanted gas and used gasUsed gas, i.e. fees * (gasWanted - gasUsed) / gasWanted * ratio,
+// truncated.
+func RefundedFees(fees sdk.Coins, gasWanted, gasUsed uint64, ratio sdk.Dec) sdk.Coins {
+	if gasUsed >= gasWanted || ratio.IsNil() || !ratio.IsPositive() {
+		return sdk.NewCoins()
+	}
+
+	unused, wanted := sdk.NewIntFromUint64(gasWanted-gasUsed), sdk.NewIntFromUint64(gasWanted)
+
+	refund := sdk.NewCoins()
+	for _, fee := range fees {
+		amount := ratio.MulInt(fee.Amount.Mul(unused)).QuoInt(wanted).TruncateInt()
+		refund = refund.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return refund
+}
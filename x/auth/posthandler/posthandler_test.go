@@ -0,0 +1,91 @@
+package posthandler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestRefundedFees(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000), sdk.NewInt64Coin("stake", 3))
+
+	testCases := []struct {
+		name      string
+		gasWanted uint64
+		gasUsed   uint64
+		ratio     sdk.Dec
+		expRefund sdk.Coins
+	}{
+		{"disabled", 100000, 40000, sdk.ZeroDec(), sdk.NewCoins()},
+		{"all gas used", 100000, 100000, sdk.OneDec(), sdk.NewCoins()},
+		{"out of gas", 100000, 100001, sdk.OneDec(), sdk.NewCoins()},
+		{"full refund", 100000, 40000, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 600), sdk.NewInt64Coin("stake", 1))},
+		{"partial refund", 100000, 40000, sdk.NewDecWithPrec(5, 1), sdk.NewCoins(sdk.NewInt64Coin("atom", 300))},
+		{"truncated refund", 3, 1, sdk.OneDec(), sdk.NewCoins(sdk.NewInt64Coin("atom", 666), sdk.NewInt64Coin("stake", 2))},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expRefund, posthandler.RefundedFees(fees, tc.gasWanted, tc.gasUsed, tc.ratio))
+		})
+	}
+}
+
+func TestPostHandlerRefund(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+	params := types.DefaultParams()
+	params.FeeRefundRatio = sdk.NewDecWithPrec(5, 1)
+	app.AccountKeeper.SetParams(ctx, params)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	feeCollector := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, feeCollector.GetAddress(), fees))
+
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(fees)
+	txBuilder.SetGasLimit(100000)
+
+	gasMeter := sdk.NewGasMeter(100000)
+	gasMeter.ConsumeGas(40000, "test")
+	ctx = ctx.WithGasMeter(gasMeter).WithEventManager(sdk.NewEventManager())
+
+	postHandler := posthandler.NewPostHandler(app.AccountKeeper, app.BankKeeper)
+	require.NoError(t, postHandler(ctx, txBuilder.GetTx(), false))
+
+	// the refund does not consume gas
+	require.Equal(t, uint64(40000), gasMeter.GasConsumed())
+
+	refund := sdk.NewCoins(sdk.NewInt64Coin("atom", 300))
+	require.Equal(t, refund, app.BankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, fees.Sub(refund), app.BankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()))
+
+	events := ctx.EventManager().Events()
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeFeeRefund,
+		sdk.NewAttribute(types.AttributeKeyFeePayer, addr.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(types.AttributeKeyGasWanted, "100000"),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, "40000"),
+	), events[len(events)-1])
+
+	// nothing is refunded when the refunds are disabled
+	app.AccountKeeper.SetParams(ctx, types.DefaultParams())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, postHandler(ctx, txBuilder.GetTx(), false))
+	require.Equal(t, refund, app.BankKeeper.GetAllBalances(ctx, addr))
+	require.Empty(t, ctx.EventManager().ABCIEvents())
+}
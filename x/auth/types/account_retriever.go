@@ -36,7 +36,7 @@ func (ar AccountRetriever) GetAccountWithHeight(clientCtx client.Context, addr s
 	var header metadata.MD
 
 	queryClient := NewQueryClient(clientCtx)
-	res, err := queryClient.Account(context.Background(), &QueryAccountRequest{Address: addr.String()}, grpc.Header(&header))
+	res, err := queryClient.Account(context.Background(), &QueryAccountRequest{Address: clientCtx.AccAddressString(addr)}, grpc.Header(&header))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -113,6 +114,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// fee_refund_ratio is the ratio of the fees paid for the unused gas of a
+	// successful transaction refunded to its fee payer, 0 disables the refunds.
+	FeeRefundRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=fee_refund_ratio,json=feeRefundRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_refund_ratio" yaml:"fee_refund_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -191,50 +195,53 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x5f, 0xf2, 0xf8, 0x98, 0x00, 0x12, 0x26, 0x80, 0x93, 0xf7, 0x64, 0x5b, 0x5e, 0xe5,
-	0x49, 0x2f, 0x8e, 0x92, 0x8a, 0x4a, 0x64, 0x51, 0x15, 0xd3, 0x2e, 0x50, 0x0b, 0x42, 0x46, 0xea,
-	0xa2, 0xaa, 0xe4, 0x8e, 0x9d, 0xc1, 0x58, 0x64, 0x32, 0xc6, 0x33, 0x46, 0x31, 0xbf, 0xa0, 0xcb,
-	0x2e, 0xbb, 0xe4, 0x47, 0xf0, 0x0f, 0xba, 0xe9, 0x12, 0xb1, 0xea, 0xca, 0xad, 0xc2, 0xa6, 0xea,
-	0x32, 0xfb, 0x4a, 0x95, 0x67, 0x9c, 0x90, 0xa0, 0x74, 0x95, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x9d,
-	0x7b, 0xe3, 0x01, 0xaa, 0x47, 0x28, 0x26, 0xb4, 0x09, 0x63, 0x76, 0xd6, 0xbc, 0x6c, 0xb9, 0x88,
-	0xc1, 0x16, 0x0f, 0xcc, 0x30, 0x22, 0x8c, 0xc8, 0x1b, 0x82, 0x37, 0x39, 0x94, 0xf3, 0xb5, 0xaa,
-	0x00, 0x1d, 0x2e, 0x69, 0xe6, 0x0a, 0x1e, 0xd4, 0x2a, 0x3e, 0xf1, 0x89, 0xc0, 0xb3, 0x53, 0x8e,
-	0x56, 0x7d, 0x42, 0xfc, 0x1e, 0x6a, 0xf2, 0xc8, 0x8d, 0x4f, 0x9b, 0xb0, 0x9f, 0x08, 0xca, 0xf8,
-	0x25, 0x81, 0xb2, 0x05, 0x29, 0xda, 0xf3, 0x3c, 0x12, 0xf7, 0x99, 0xac, 0x80, 0x45, 0xd8, 0xed,
-	0x46, 0x88, 0x52, 0x45, 0xd2, 0xa5, 0xfa, 0xb2, 0x3d, 0x0e, 0xe5, 0x77, 0x60, 0x31, 0x8c, 0x5d,
-	0xe7, 0x1c, 0x25, 0xca, 0x5f, 0xba, 0x54, 0x2f, 0xb7, 0x2b, 0xa6, 0xb0, 0x35, 0xc7, 0xb6, 0xe6,
-	0x5e, 0x3f, 0xb1, 0x1a, 0x3f, 0x53, 0xad, 0x12, 0xc6, 0x6e, 0x2f, 0xf0, 0x32, 0xed, 0xff, 0x04,
-	0x07, 0x0c, 0xe1, 0x90, 0x25, 0xa3, 0x54, 0x5b, 0x4f, 0x20, 0xee, 0x75, 0x8c, 0x07, 0xd6, 0xb0,
-	0x17, 0xc2, 0xd8, 0x7d, 0x85, 0x12, 0xf9, 0x39, 0x58, 0x83, 0xa2, 0x05, 0xa7, 0x1f, 0x63, 0x17,
-	0x45, 0x4a, 0x51, 0x97, 0xea, 0x25, 0xab, 0x3a, 0x4a, 0xb5, 0x4d, 0x91, 0x36, 0xcb, 0x1b, 0xf6,
-	0x6a, 0x0e, 0x1c, 0xf1, 0x58, 0xae, 0x81, 0x25, 0x8a, 0x2e, 0x62, 0xd4, 0xf7, 0x90, 0x52, 0xca,
-	0x72, 0xed, 0x49, 0xdc, 0x51, 0x3e, 0x5c, 0x6b, 0x85, 0x4f, 0xd7, 0x5a, 0xe1, 0xc7, 0xb5, 0x56,
-	0xb8, 0xbb, 0x69, 0x2c, 0xe5, 0xd7, 0x3d, 0x30, 0x3e, 0x4b, 0x60, 0xf5, 0x90, 0x74, 0xe3, 0xde,
-	0x64, 0x02, 0xef, 0xc1, 0x8a, 0x0b, 0x29, 0x72, 0x72, 0x77, 0x3e, 0x86, 0x72, 0x5b, 0x37, 0xe7,
-	0x6c, 0xc2, 0x9c, 0x9a, 0x9c, 0xf5, 0xcf, 0x6d, 0xaa, 0x49, 0xa3, 0x54, 0xdb, 0x10, 0xdd, 0x4e,
-	0x7b, 0x18, 0x76, 0xd9, 0x9d, 0x9a, 0xb1, 0x0c, 0x4a, 0x7d, 0x88, 0x11, 0x1f, 0xe3, 0xb2, 0xcd,
-	0xcf, 0xb2, 0x0e, 0xca, 0x21, 0x8a, 0x70, 0x40, 0x69, 0x40, 0xfa, 0x54, 0x29, 0xea, 0xc5, 0xfa,
-	0xb2, 0x3d, 0x0d, 0x75, 0x6a, 0xe3, 0x3b, 0xdc, 0xdd, 0x34, 0xd6, 0x66, 0x5a, 0x3e, 0x30, 0xbe,
-	0x15, 0xc1, 0xc2, 0x31, 0x8c, 0x20, 0xa6, 0xf2, 0x11, 0xd8, 0xc0, 0x70, 0xe0, 0x60, 0x84, 0x89,
-	0xe3, 0x9d, 0xc1, 0x08, 0x7a, 0x0c, 0x45, 0x62, 0x99, 0x25, 0x4b, 0x1d, 0xa5, 0x5a, 0x4d, 0xf4,
-	0x37, 0x47, 0x64, 0xd8, 0xeb, 0x18, 0x0e, 0x0e, 0x11, 0x26, 0xfb, 0x13, 0x4c, 0xde, 0x05, 0x2b,
-	0x6c, 0xe0, 0xd0, 0xc0, 0x77, 0x7a, 0x01, 0x0e, 0x18, 0x6f, 0xba, 0x64, 0x6d, 0x3f, 0x5c, 0x74,
-	0x9a, 0x35, 0x6c, 0xc0, 0x06, 0x27, 0x81, 0xff, 0x3a, 0x0b, 0x64, 0x1b, 0x6c, 0x72, 0xf2, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xf9, 0x6a, 0xf5, 0x51, 0xaa, 0xfd,
-	0x3b, 0xe5, 0xf1, 0x58, 0x66, 0xd8, 0xeb, 0x99, 0xd9, 0x15, 0xda, 0x27, 0x94, 0x1d, 0xa3, 0xc8,
-	0x4a, 0x18, 0x92, 0x2f, 0xc0, 0x76, 0x56, 0xed, 0x12, 0x45, 0xc1, 0x69, 0x22, 0xf4, 0xa8, 0xdb,
-	0xde, 0xd9, 0x69, 0xed, 0x8a, 0xa5, 0x5b, 0x9d, 0x61, 0xaa, 0x55, 0x4e, 0x02, 0xff, 0x0d, 0x57,
-	0x64, 0xa9, 0x2f, 0x5f, 0x70, 0x7e, 0x94, 0x6a, 0xaa, 0xa8, 0xf6, 0x07, 0x03, 0xc3, 0xae, 0xd0,
-	0x99, 0x3c, 0x01, 0xcb, 0x09, 0xa8, 0x3e, 0xce, 0xa0, 0xc8, 0x0b, 0xdb, 0x3b, 0x4f, 0xcf, 0x5b,
-	0xca, 0xdf, 0xbc, 0xe8, 0xb3, 0x61, 0xaa, 0x6d, 0xcd, 0x14, 0x3d, 0x19, 0x2b, 0x46, 0xa9, 0xa6,
-	0xcf, 0x2f, 0x3b, 0x31, 0x31, 0xec, 0x2d, 0x3a, 0x37, 0xb7, 0xb3, 0x94, 0xff, 0x67, 0x25, 0x6b,
-	0xff, 0xcb, 0x50, 0x95, 0x6e, 0x87, 0xaa, 0xf4, 0x7d, 0xa8, 0x4a, 0x1f, 0xef, 0xd5, 0xc2, 0xed,
-	0xbd, 0x5a, 0xf8, 0x7a, 0xaf, 0x16, 0xde, 0xfe, 0xe7, 0x07, 0xec, 0x2c, 0x76, 0x4d, 0x8f, 0xe0,
-	0xfc, 0x2d, 0xc8, 0x7f, 0x1a, 0xb4, 0x7b, 0xde, 0x1c, 0x88, 0xa7, 0x85, 0x25, 0x21, 0xa2, 0xee,
-	0x02, 0xff, 0x52, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x90, 0x16, 0xd9, 0x76, 0x04,
-	0x00, 0x00,
+	// 728 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x6b, 0xd5, 0xb1, 0x4f, 0x89, 0x51, 0xd3, 0x4a, 0x4c, 0xab, 0x05, 0x8f, 0xe0, 0x50,
+	0xb8, 0x40, 0x4d, 0xc1, 0x2a, 0x5c, 0x20, 0x1a, 0x8a, 0x86, 0x4e, 0x07, 0xa3, 0x4d, 0x10, 0x9c,
+	0x81, 0x0e, 0x45, 0x01, 0xf6, 0x48, 0x3d, 0xd1, 0x84, 0x45, 0x1e, 0xc3, 0x3b, 0x06, 0x62, 0x7e,
+	0x41, 0xc7, 0x8e, 0x1d, 0xfd, 0x23, 0xf2, 0x0f, 0xba, 0x64, 0x34, 0x82, 0x0e, 0x45, 0x07, 0xa2,
+	0x90, 0x97, 0xa2, 0x23, 0xf7, 0x02, 0x05, 0xef, 0x28, 0x59, 0x32, 0xd4, 0x4c, 0xba, 0xf7, 0x7d,
+	0xdf, 0xfb, 0xde, 0xbb, 0xf7, 0xc4, 0x43, 0x66, 0xc0, 0x78, 0xcc, 0x78, 0x9f, 0xe6, 0xe2, 0xa2,
+	0xff, 0xea, 0xd8, 0x07, 0x41, 0x8f, 0x65, 0xe0, 0xa4, 0x19, 0x13, 0x4c, 0xdf, 0x53, 0xbc, 0x23,
+	0xa1, 0x86, 0xef, 0x1d, 0x28, 0xd0, 0x93, 0x92, 0x7e, 0xa3, 0x90, 0x41, 0xaf, 0x1b, 0xb2, 0x90,
+	0x29, 0xbc, 0x3e, 0x35, 0xe8, 0x41, 0xc8, 0x58, 0x38, 0x81, 0xbe, 0x8c, 0xfc, 0x7c, 0xdc, 0xa7,
+	0x49, 0xa1, 0x28, 0xfb, 0x5f, 0x0d, 0x75, 0x5c, 0xca, 0xe1, 0x49, 0x10, 0xb0, 0x3c, 0x11, 0xba,
+	0x81, 0xee, 0xd1, 0xd1, 0x28, 0x03, 0xce, 0x0d, 0xcd, 0xd2, 0x0e, 0xb7, 0xc9, 0x3c, 0xd4, 0x7f,
+	0x44, 0xf7, 0xd2, 0xdc, 0xf7, 0x2e, 0xa1, 0x30, 0x3e, 0xb0, 0xb4, 0xc3, 0xce, 0xa0, 0xeb, 0x28,
+	0x5b, 0x67, 0x6e, 0xeb, 0x3c, 0x49, 0x0a, 0xf7, 0xe8, 0x9f, 0x12, 0x77, 0xd3, 0xdc, 0x9f, 0x44,
+	0x41, 0xad, 0xfd, 0x9c, 0xc5, 0x91, 0x80, 0x38, 0x15, 0x45, 0x55, 0xe2, 0xdd, 0x82, 0xc6, 0x93,
+	0xa1, 0x7d, 0xcb, 0xda, 0x64, 0x33, 0xcd, 0xfd, 0x6f, 0xa1, 0xd0, 0xbf, 0x46, 0x3b, 0x54, 0xb5,
+	0xe0, 0x25, 0x79, 0xec, 0x43, 0x66, 0x6c, 0x58, 0xda, 0x61, 0xdb, 0x3d, 0xa8, 0x4a, 0xfc, 0x50,
+	0xa5, 0xad, 0xf2, 0x36, 0x79, 0xd0, 0x00, 0xcf, 0x65, 0xac, 0xf7, 0xd0, 0x16, 0x87, 0x97, 0x39,
+	0x24, 0x01, 0x18, 0xed, 0x3a, 0x97, 0x2c, 0xe2, 0xa1, 0xf1, 0xf3, 0x15, 0x6e, 0xfd, 0x7a, 0x85,
+	0x5b, 0x7f, 0x5f, 0xe1, 0xd6, 0xbb, 0x37, 0x47, 0x5b, 0xcd, 0x75, 0xcf, 0xec, 0xdf, 0x34, 0xf4,
+	0xe0, 0x19, 0x1b, 0xe5, 0x93, 0xc5, 0x04, 0x7e, 0x42, 0xf7, 0x7d, 0xca, 0xc1, 0x6b, 0xdc, 0xe5,
+	0x18, 0x3a, 0x03, 0xcb, 0x59, 0xb3, 0x09, 0x67, 0x69, 0x72, 0xee, 0xc7, 0xd7, 0x25, 0xd6, 0xaa,
+	0x12, 0xef, 0xa9, 0x6e, 0x97, 0x3d, 0x6c, 0xd2, 0xf1, 0x97, 0x66, 0xac, 0xa3, 0x76, 0x42, 0x63,
+	0x90, 0x63, 0xdc, 0x26, 0xf2, 0xac, 0x5b, 0xa8, 0x93, 0x42, 0x16, 0x47, 0x9c, 0x47, 0x2c, 0xe1,
+	0xc6, 0x86, 0xb5, 0x71, 0xb8, 0x4d, 0x96, 0xa1, 0x61, 0x6f, 0x7e, 0x87, 0x77, 0x6f, 0x8e, 0x76,
+	0x56, 0x5a, 0x3e, 0xb3, 0x7f, 0x6f, 0xa3, 0xcd, 0x17, 0x34, 0xa3, 0x31, 0xd7, 0x9f, 0xa3, 0xbd,
+	0x98, 0x4e, 0xbd, 0x18, 0x62, 0xe6, 0x05, 0x17, 0x34, 0xa3, 0x81, 0x80, 0x4c, 0x2d, 0xb3, 0xed,
+	0x9a, 0x55, 0x89, 0x7b, 0xaa, 0xbf, 0x35, 0x22, 0x9b, 0xec, 0xc6, 0x74, 0xfa, 0x0c, 0x62, 0x76,
+	0xba, 0xc0, 0xf4, 0xc7, 0xe8, 0xbe, 0x98, 0x7a, 0x3c, 0x0a, 0xbd, 0x49, 0x14, 0x47, 0x42, 0x36,
+	0xdd, 0x76, 0xf7, 0x6f, 0x2f, 0xba, 0xcc, 0xda, 0x04, 0x89, 0xe9, 0x79, 0x14, 0x7e, 0x57, 0x07,
+	0x3a, 0x41, 0x0f, 0x25, 0xf9, 0x1a, 0xbc, 0x80, 0x71, 0xe1, 0xa5, 0x90, 0x79, 0x7e, 0x21, 0xa0,
+	0x59, 0xad, 0x55, 0x95, 0xf8, 0x93, 0x25, 0x8f, 0xbb, 0x32, 0x9b, 0xec, 0xd6, 0x66, 0xaf, 0xe1,
+	0x94, 0x71, 0xf1, 0x02, 0x32, 0xb7, 0x10, 0xa0, 0xbf, 0x44, 0xfb, 0x75, 0xb5, 0x57, 0x90, 0x45,
+	0xe3, 0x42, 0xe9, 0x61, 0x34, 0x38, 0x39, 0x39, 0x7e, 0xac, 0x96, 0xee, 0x0e, 0x67, 0x25, 0xee,
+	0x9e, 0x47, 0xe1, 0xf7, 0x52, 0x51, 0xa7, 0x7e, 0xf3, 0x54, 0xf2, 0x55, 0x89, 0x4d, 0x55, 0xed,
+	0x7f, 0x0c, 0x6c, 0xd2, 0xe5, 0x2b, 0x79, 0x0a, 0xd6, 0x0b, 0x74, 0x70, 0x37, 0x83, 0x43, 0x90,
+	0x0e, 0x4e, 0xbe, 0xbc, 0x3c, 0x36, 0x3e, 0x94, 0x45, 0xbf, 0x9a, 0x95, 0xf8, 0xd1, 0x4a, 0xd1,
+	0xf3, 0xb9, 0xa2, 0x2a, 0xb1, 0xb5, 0xbe, 0xec, 0xc2, 0xc4, 0x26, 0x8f, 0xf8, 0xda, 0x5c, 0x9d,
+	0xa3, 0x8f, 0xc6, 0x00, 0x5e, 0x06, 0xe3, 0x3c, 0x19, 0x79, 0x19, 0x15, 0x11, 0x33, 0x36, 0xeb,
+	0x7f, 0x8d, 0x7b, 0xf6, 0xb6, 0xc4, 0xad, 0x3f, 0x4b, 0xfc, 0x69, 0x18, 0x89, 0x8b, 0xdc, 0x77,
+	0x02, 0x16, 0x37, 0x2f, 0x41, 0xf3, 0x73, 0xc4, 0x47, 0x97, 0x7d, 0x51, 0xa4, 0xc0, 0x9d, 0xa7,
+	0x10, 0x54, 0x25, 0xde, 0x57, 0x5d, 0xdc, 0xf5, 0xb3, 0xc9, 0xce, 0x18, 0x80, 0x48, 0x84, 0xd4,
+	0xc0, 0x70, 0xab, 0xf9, 0x50, 0x34, 0xf7, 0xf4, 0xed, 0xcc, 0xd4, 0xae, 0x67, 0xa6, 0xf6, 0xd7,
+	0xcc, 0xd4, 0x7e, 0xb9, 0x31, 0x5b, 0xd7, 0x37, 0x66, 0xeb, 0x8f, 0x1b, 0xb3, 0xf5, 0xc3, 0x67,
+	0xef, 0x2d, 0x3b, 0x55, 0xef, 0x99, 0xac, 0xee, 0x6f, 0xca, 0xe7, 0xe1, 0x8b, 0xff, 0x06, 0x00,
+	0x46, 0x3e, 0x6f, 0x04, 0xeb, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +278,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if !this.FeeRefundRatio.Equal(that1.FeeRefundRatio) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -396,6 +406,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeRefundRatio.Size()
+		i -= size
+		if _, err := m.FeeRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -502,6 +522,8 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = m.FeeRefundRatio.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -941,6 +963,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -0,0 +1,11 @@
+package types
+
+// auth module event types
+const (
+	EventTypeFeeRefund = "fee_refund"
+
+	AttributeKeyFeePayer  = "fee_payer"
+	AttributeKeyRefund    = "refund"
+	AttributeKeyGasWanted = "gas_wanted"
+	AttributeKeyGasUsed   = "gas_used"
+)
@@ -5,6 +5,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -17,6 +18,9 @@ const (
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
 )
 
+// DefaultFeeRefundRatio disables the refund of the fees paid for unused gas.
+var DefaultFeeRefundRatio = sdk.ZeroDec()
+
 // Parameter keys
 var (
 	KeyMaxMemoCharacters      = []byte("MaxMemoCharacters")
@@ -24,6 +28,7 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyFeeRefundRatio         = []byte("FeeRefundRatio")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -38,6 +43,7 @@ func NewParams(
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		FeeRefundRatio:         DefaultFeeRefundRatio,
 	}
 }
 
@@ -55,6 +61,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyFeeRefundRatio, &p.FeeRefundRatio, validateFeeRefundRatio),
 	}
 }
 
@@ -66,6 +73,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		FeeRefundRatio:         DefaultFeeRefundRatio,
 	}
 }
 
@@ -114,6 +122,25 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 	return nil
 }
 
+func validateFeeRefundRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("fee refund ratio must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fee refund ratio must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee refund ratio too large: %s", v)
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -157,6 +184,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validateFeeRefundRatio(p.FeeRefundRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
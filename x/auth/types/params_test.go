@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -35,6 +36,14 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"negative fee refund ratio", types.Params{MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit,
+			TxSizeCostPerByte: types.DefaultTxSizeCostPerByte, SigVerifyCostED25519: types.DefaultSigVerifyCostED25519,
+			SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1, FeeRefundRatio: sdk.NewDec(-1)},
+			fmt.Errorf("fee refund ratio must be positive: -1.000000000000000000")},
+		{"too large fee refund ratio", types.Params{MaxMemoCharacters: types.DefaultMaxMemoCharacters, TxSigLimit: types.DefaultTxSigLimit,
+			TxSizeCostPerByte: types.DefaultTxSizeCostPerByte, SigVerifyCostED25519: types.DefaultSigVerifyCostED25519,
+			SigVerifyCostSecp256k1: types.DefaultSigVerifyCostSecp256k1, FeeRefundRatio: sdk.NewDec(2)},
+			fmt.Errorf("fee refund ratio too large: 2.000000000000000000")},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -8,12 +8,15 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 type IntegrationTestSuite struct {
@@ -134,6 +137,60 @@ func (s *IntegrationTestSuite) TestNewMsgCreateVestingAccountCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestClawbackVestingAccountCmds() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	addr := sdk.AccAddress("addr5_______________")
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// the schedule starts far in the future, so nothing vests during the test
+	periodsFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 4070908800,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 100},
+    {"coins": "20%[1]s", "length_seconds": 100}
+  ]
+}`, s.cfg.BondDenom))
+
+	bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(),
+		append([]string{addr.String(), periodsFile.Name()}, txFlags...))
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	queryArgs := []string{addr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+	bw, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetBalancesCmd(), queryArgs)
+	s.Require().NoError(err)
+	var balances types.QueryBalancesResponse
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), &balances), bw.String())
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(30))), balances.Unvested)
+	s.Require().True(balances.ClawedBack.IsZero())
+
+	// a clawback to the vesting account itself is invalid
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgClawbackCmd(),
+		append([]string{addr.String(), fmt.Sprintf("--%s=%s", cli.FlagDest, addr)}, txFlags...))
+	s.Require().Error(err)
+
+	bw, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgClawbackCmd(), append([]string{addr.String()}, txFlags...))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), &txResp), bw.String())
+	s.Require().Equal(uint32(0), txResp.Code, txResp.RawLog)
+
+	bw, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetBalancesCmd(), queryArgs)
+	s.Require().NoError(err)
+	balances = types.QueryBalancesResponse{}
+	s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bw.Bytes(), &balances), bw.String())
+	s.Require().True(balances.Unvested.IsZero())
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(30))), balances.ClawedBack)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -2,14 +2,17 @@ package vesting_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type HandlerTestSuite struct {
@@ -23,7 +26,7 @@ func (suite *HandlerTestSuite) SetupTest() {
 	checkTx := false
 	app := simapp.Setup(checkTx)
 
-	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper)
+	suite.handler = vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
 	suite.app = app
 }
 
@@ -91,6 +94,163 @@ func (suite *HandlerTestSuite) TestMsgCreateVestingAccount() {
 	}
 }
 
+func (suite *HandlerTestSuite) TestMsgCreateClawbackVestingAccount() {
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1})
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(ctx, acc1)
+	suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 50))},
+	}
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateClawbackVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create clawback vesting account",
+			msg:       types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+10000, periods),
+			expectErr: false,
+		},
+		{
+			name:      "clawback vesting account already exists",
+			msg:       types.NewMsgCreateClawbackVestingAccount(addr1, addr2, ctx.BlockTime().Unix()+10000, periods),
+			expectErr: true,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgCreateClawbackVestingAccount(addr1, addr3, ctx.BlockTime().Unix()+10000, types.Periods{
+				{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1000))},
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			res, err := suite.handler(ctx, tc.msg)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				toAddr, err := sdk.AccAddressFromBech32(tc.msg.ToAddress)
+				suite.Require().NoError(err)
+				accI := suite.app.AccountKeeper.GetAccount(ctx, toAddr)
+				suite.Require().NotNil(accI)
+
+				acc, ok := accI.(*types.ClawbackVestingAccount)
+				suite.Require().True(ok)
+				suite.Require().Equal(addr1, acc.GetFunder())
+				suite.Require().Equal(tc.msg.GetTotalAmount(), acc.GetVestingCoins(ctx.BlockTime()))
+				suite.Require().Equal(tc.msg.StartTime+200, acc.GetEndTime())
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgClawback() {
+	startTime := time.Unix(1000000, 0)
+	ctx := suite.app.BaseApp.NewContext(false, tmproto.Header{Height: suite.app.LastBlockHeight() + 1, Time: startTime})
+	bondDenom := suite.app.StakingKeeper.BondDenom(ctx)
+
+	funder := sdk.AccAddress([]byte("funder______________"))
+	addr := sdk.AccAddress([]byte("vesting_____________"))
+	dest := sdk.AccAddress([]byte("dest________________"))
+	other := sdk.AccAddress([]byte("other_______________"))
+	valAddr := sdk.ValAddress([]byte("validator___________"))
+
+	for _, a := range []sdk.AccAddress{funder, sdk.AccAddress(valAddr)} {
+		suite.app.AccountKeeper.SetAccount(ctx, suite.app.AccountKeeper.NewAccountWithAddress(ctx, a))
+		suite.Require().NoError(suite.app.BankKeeper.SetBalances(ctx, a, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 2000))))
+	}
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+	tstaking.Denom = bondDenom
+	tstaking.CreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(1000), true)
+
+	periods := types.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 400))},
+	}
+	_, err := suite.handler(ctx, types.NewMsgCreateClawbackVestingAccount(funder, addr, startTime.Unix(), periods))
+	suite.Require().NoError(err)
+
+	// stake part of the vesting coins: 400 stay liquid, 500 are delegated and
+	// 100 are unbonding
+	tstaking.Delegate(addr, valAddr, sdk.NewInt(600))
+	tstaking.Undelegate(addr, valAddr, sdk.NewInt(100), true)
+
+	// only the funder may claw back
+	_, err = suite.handler(ctx, types.NewMsgClawback(other, addr, nil))
+	suite.Require().Error(err)
+
+	// only clawback vesting accounts can be clawed back
+	_, err = suite.handler(ctx, types.NewMsgClawback(funder, other, nil))
+	suite.Require().Error(err)
+
+	// 700 coins are unvested during the second period
+	ctx = ctx.WithBlockTime(startTime.Add(150 * time.Second))
+	res, err := suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// the liquid coins are clawed back first
+	suite.Require().Equal(sdk.NewInt64Coin(bondDenom, 400), suite.app.BankKeeper.GetBalance(ctx, dest, bondDenom))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, addr, bondDenom).IsZero())
+
+	// then the unbonding coins
+	ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(100), ubd.Entries[0].Balance)
+	_, found = suite.app.StakingKeeper.GetUnbondingDelegation(ctx, addr, valAddr)
+	suite.Require().False(found)
+
+	// and finally the delegated coins
+	delegation, found := suite.app.StakingKeeper.GetDelegation(ctx, dest, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(200), delegation.Shares)
+	delegation, found = suite.app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(300), delegation.Shares)
+
+	acc, ok := suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().True(ok)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700)), acc.GetClawedBack())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300)), acc.GetOriginalVesting())
+	suite.Require().True(acc.GetDelegatedVesting().IsZero())
+	suite.Require().True(acc.LockedCoins(ctx.BlockTime()).IsZero())
+
+	queryRes, err := vesting.NewQueryServerImpl(suite.app.AccountKeeper).Balances(
+		sdk.WrapSDKContext(ctx), &types.QueryBalancesRequest{Address: addr.String()},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 300)), queryRes.Vested)
+	suite.Require().True(queryRes.Unvested.IsZero())
+	suite.Require().True(queryRes.Locked.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700)), queryRes.ClawedBack)
+
+	// nothing is left to claw back
+	res, err = suite.handler(ctx, types.NewMsgClawback(funder, addr, dest))
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+	acc = suite.app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 700)), acc.GetClawedBack())
+}
+
 func TestHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(HandlerTestSuite))
 }
@@ -0,0 +1,10 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeClawback = "clawback"
+
+	AttributeKeyFunder      = "funder"
+	AttributeKeyAccount     = "account"
+	AttributeKeyDestination = "destination"
+)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -17,6 +18,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 //-----------------------------------------------------------------------------
@@ -172,8 +174,10 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64     `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods   `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  string    `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+	ClawedBack     sdk.Coins `json:"clawed_back,omitempty" yaml:"clawed_back,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -570,3 +574,211 @@ func (dva DelayedVestingAccount) String() string {
 	out, _ := dva.MarshalYAML()
 	return out.(string)
 }
+
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount funded by
+// funder, whose original vesting coins are the sum of all vesting periods.
+//nolint:interfacer
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, startTime int64, periods Periods) *ClawbackVestingAccount {
+	endTime := startTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range periods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+
+	if blockTime.Unix() <= cva.StartTime {
+		return vestedCoins
+	} else if blockTime.Unix() >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	// track the start time of the next period
+	currentPeriodStartTime := cva.StartTime
+
+	// for each period, if the period is over, add those coins as vested and check the next period.
+	for _, period := range cva.VestingPeriods {
+		x := blockTime.Unix() - currentPeriodStartTime
+		if x < period.Length {
+			break
+		}
+
+		vestedCoins = vestedCoins.Add(period.Amount...)
+
+		// update the start time of the next period
+		currentPeriodStartTime += period.Length
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (cva ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return cva.VestingPeriods
+}
+
+// GetFunder returns the address of the account allowed to claw back the
+// unvested coins.
+func (cva ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(cva.FunderAddress)
+	return funder
+}
+
+// GetClawedBack returns the total amount of coins clawed back from the account.
+func (cva ClawbackVestingAccount) GetClawedBack() sdk.Coins {
+	return cva.ClawedBack
+}
+
+// ComputeClawback ends the vesting schedule of the account at blockTime and
+// returns the coins that had not vested yet. Only the periods that have fully
+// vested are kept, the original vesting amount is reduced to the vested coins
+// and, as no coins are vesting anymore, all delegated vesting coins become
+// delegated free coins. It is the caller's responsibility to move the returned
+// coins out of the account and record them with AddClawedBack.
+func (cva *ClawbackVestingAccount) ComputeClawback(blockTime int64) sdk.Coins {
+	unvested := cva.GetVestingCoins(time.Unix(blockTime, 0))
+
+	endTime := cva.StartTime
+	vestedPeriods := Periods{}
+	if blockTime > cva.StartTime {
+		for _, p := range cva.VestingPeriods {
+			if endTime+p.Length > blockTime {
+				break
+			}
+			endTime += p.Length
+			vestedPeriods = append(vestedPeriods, p)
+		}
+	}
+
+	cva.VestingPeriods = vestedPeriods
+	cva.EndTime = endTime
+	cva.OriginalVesting = cva.OriginalVesting.Sub(unvested)
+	cva.DelegatedFree = cva.DelegatedFree.Add(cva.DelegatedVesting...)
+	cva.DelegatedVesting = sdk.NewCoins()
+
+	return unvested
+}
+
+// AddClawedBack records amount as clawed back from the account.
+func (cva *ClawbackVestingAccount) AddClawedBack(amount sdk.Coins) {
+	cva.ClawedBack = cva.ClawedBack.Add(amount...)
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	// the schedule of a fully clawed back account may be empty
+	if cva.GetStartTime() > cva.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	endTime := cva.StartTime
+	originalVesting := sdk.NewCoins()
+	for _, p := range cva.VestingPeriods {
+		endTime += p.Length
+		originalVesting = originalVesting.Add(p.Amount...)
+	}
+	if endTime != cva.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !originalVesting.IsEqual(cva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+	if !cva.ClawedBack.IsValid() {
+		return fmt.Errorf("invalid clawed back coins: %s", cva.ClawedBack)
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	alias := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+		ClawedBack:       cva.ClawedBack,
+	}
+
+	pk := cva.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
@@ -578,6 +578,74 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestComputeClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	cva := types.NewClawbackVestingAccount(bacc, funder, now.Unix(), periods)
+	require.Equal(t, origCoins, cva.GetOriginalVesting())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.GetEndTime())
+	require.Equal(t, funder, cva.GetFunder())
+	require.NoError(t, cva.Validate())
+
+	// delegate vesting coins
+	cva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+
+	// require the unvested coins to be returned during the second period
+	unvested := cva.ComputeClawback(now.Add(15 * time.Hour).Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+
+	// require the schedule to end after the first period
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetOriginalVesting())
+	require.Equal(t, periods[:1], cva.GetVestingPeriods())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), cva.GetEndTime())
+	require.NoError(t, cva.Validate())
+
+	// require no coins to be vesting or locked anymore
+	require.True(t, cva.GetVestingCoins(now.Add(15*time.Hour)).IsZero())
+	require.True(t, cva.LockedCoins(now.Add(15*time.Hour)).IsZero())
+
+	// require delegated vesting coins to have become free
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedFree)
+	require.True(t, cva.DelegatedVesting.IsZero())
+
+	// require nothing to be returned by a second clawback
+	require.True(t, cva.ComputeClawback(now.Add(16*time.Hour).Unix()).IsZero())
+
+	cva.AddClawedBack(unvested)
+	require.Equal(t, unvested, cva.GetClawedBack())
+	require.NoError(t, cva.Validate())
+}
+
+func TestComputeClawbackBeforeStartClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	_, _, funder := testdata.KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	cva := types.NewClawbackVestingAccount(bacc, funder, now.Add(time.Hour).Unix(), periods)
+
+	// require all coins to be returned before the schedule starts
+	unvested := cva.ComputeClawback(now.Unix())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+	require.True(t, cva.GetOriginalVesting().IsZero())
+	require.Empty(t, cva.GetVestingPeriods())
+	require.Equal(t, cva.GetStartTime(), cva.GetEndTime())
+	require.NoError(t, cva.Validate())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -633,6 +701,30 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, 0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: types.NewBaseVestingAccount(baseAcc, initialVesting, 100),
+				StartTime:          0,
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}}},
+			},
+			true,
+		},
+		{
+			"invalid clawback vesting period amounts",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				StartTime:          0,
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -707,3 +799,26 @@ func TestDelayedVestingAccountMarshal(t *testing.T) {
 	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
 	require.NotNil(t, err)
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, time.Now().Unix(), types.Periods{types.Period{Length: 3600, Amount: coins}})
+	acc.AddClawedBack(coins)
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
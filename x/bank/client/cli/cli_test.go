@@ -3,6 +3,7 @@ package cli_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -74,6 +76,8 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.Params.BurnEnabledDenoms = []string{fmt.Sprintf("%stoken", "node0")}
+
 	bankGenesisBz, err := cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -142,6 +146,17 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 			&sdk.Coin{},
 			NewCoin("foobar", sdk.ZeroInt()),
 		},
+		{
+			"address not encoded with the bech32 prefix",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=osmo", flags.FlagBech32Prefix),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			nil,
+			nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -162,6 +177,75 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetSpendableBalancesCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+		expected  proto.Message
+	}{
+		{"no address provided", []string{}, true, nil, nil},
+		{
+			"spendable account balance",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QuerySpendableBalancesResponse{},
+			&types.QuerySpendableBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"spendable account balance of a specific denom",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=%s", cli.FlagDenom, s.cfg.BondDenom),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&sdk.Coin{},
+			NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+		},
+		{
+			"invalid denom",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=1foobar", cli.FlagDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true, nil, nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetSpendableBalancesCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType))
+				s.Require().Equal(tc.expected.String(), tc.respType.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	val := s.network.Validators[0]
 
@@ -183,7 +267,28 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 				Supply: sdk.NewCoins(
 					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
 					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Add(sdk.NewInt(10))),
-				)},
+				),
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name: "total supply with pagination",
+			args: []string{
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s=true", flags.FlagCountTotal),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			respType: &types.QueryTotalSupplyResponse{},
+			expected: &types.QueryTotalSupplyResponse{
+				Supply: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+				),
+				Pagination: &query.PageResponse{
+					NextKey: []byte(s.cfg.BondDenom),
+					Total:   2,
+				},
+			},
 		},
 		{
 			name: "total supply of a specific denomination",
@@ -375,6 +480,34 @@ func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	s.Require().Equal([]sdk.Msg{types.NewMsgSend(from, to, amount)}, tx.GetMsgs())
 }
 
+func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnlyBech32Prefix() {
+	val := s.network.Validators[0]
+
+	clientCtx := val.ClientCtx
+
+	osmoCtx := clientCtx.WithBech32Prefix("osmo")
+	from := osmoCtx.AccAddressString(val.Address)
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+	args := []string{
+		from,
+		from,
+		amount.String(),
+		fmt.Sprintf("--%s=osmo", flags.FlagBech32Prefix),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	}
+
+	bz, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewSendTxCmd(), args)
+	s.Require().NoError(err)
+	tx, err := s.cfg.TxConfig.TxJSONDecoder()(bz.Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Msg{&types.MsgSend{FromAddress: from, ToAddress: from, Amount: amount}}, tx.GetMsgs())
+
+	// addresses encoded with the application's prefix are rejected
+	args[1] = val.Address.String()
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewSendTxCmd(), args)
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	val := s.network.Validators[0]
 
@@ -461,6 +594,100 @@ func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewMultiSendTxCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	recipients := []sdk.AccAddress{
+		sdk.AccAddress([]byte("multisend1__________")),
+		sdk.AccAddress([]byte("multisend2__________")),
+		sdk.AccAddress([]byte("multisend3__________")),
+	}
+	var rows []string
+	for _, addr := range recipients {
+		rows = append(rows, addr.String())
+	}
+	csvFile := testutil.WriteToNewTempFile(s.T(), strings.Join(rows, "\n"))
+
+	args := []string{
+		val.Address.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagCSV, csvFile.Name()),
+		fmt.Sprintf("--%s=%s", cli.FlagSplit, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(31))),
+		fmt.Sprintf("--%s=2", cli.FlagMaxOutputs),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMultiSendTxCmd(), args)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	for _, addr := range recipients {
+		out, err := banktestutil.QueryBalancesExec(clientCtx, addr)
+		s.Require().NoError(err)
+
+		var balances types.QueryAllBalancesResponse
+		s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &balances))
+		s.Require().Equal(sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))), balances.Balances)
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewBurnTxCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+
+	testCases := []struct {
+		name         string
+		amount       sdk.Coins
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction",
+			sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10))),
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"burn disabled denom",
+			sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))),
+			false, &sdk.TxResponse{}, types.ErrBurnDisabled.ABCICode(),
+		},
+		{
+			"zero amount",
+			sdk.NewCoins(sdk.NewCoin(denom, sdk.ZeroInt())),
+			true, nil, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			args := []string{
+				val.Address.String(),
+				tc.amount.String(),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			}
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewBurnTxCmd(), args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
 // TestBankMsgService does a basic test of whether or not service Msg's as defined
 // in ADR 031 work in the most basic end-to-end case.
 func (s *IntegrationTestSuite) TestBankMsgService() {
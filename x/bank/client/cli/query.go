@@ -8,7 +8,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -31,8 +30,12 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetSpendableBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
+		GetCmdQueryBlockedAddresses(),
+		GetCmdQueryBlockedAddress(),
 	)
 
 	return cmd
@@ -65,7 +68,7 @@ Example:
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			addr, err := sdk.AccAddressFromBech32(args[0])
+			addr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -76,7 +79,7 @@ Example:
 			}
 
 			if denom == "" {
-				params := types.NewQueryAllBalancesRequest(addr, pageReq)
+				params := &types.QueryAllBalancesRequest{Address: clientCtx.AccAddressString(addr), Pagination: pageReq}
 
 				res, err := queryClient.AllBalances(cmd.Context(), params)
 				if err != nil {
@@ -85,7 +88,7 @@ Example:
 				return clientCtx.PrintProto(res)
 			}
 
-			params := types.NewQueryBalanceRequest(addr, denom)
+			params := &types.QueryBalanceRequest{Address: clientCtx.AccAddressString(addr), Denom: denom}
 			res, err := queryClient.Balance(cmd.Context(), params)
 			if err != nil {
 				return err
@@ -102,6 +105,74 @@ Example:
 	return cmd
 }
 
+// GetSpendableBalancesCmd returns a CLI command handler to query the spendable
+// balances of an account.
+func GetSpendableBalancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Short: "Query for the spendable balances of an account by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the spendable balance of an account or of a specific denomination.
+The spendable balance excludes the coins still locked by vesting, it is the amount
+which can be sent or delegated from the account.
+
+Example:
+  $ %s query %s spendable-balances [address]
+  $ %s query %s spendable-balances [address] --denom=[denom]
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := clientCtx.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			if denom == "" {
+				params := &types.QuerySpendableBalancesRequest{Address: clientCtx.AccAddressString(addr), Pagination: pageReq}
+
+				res, err := queryClient.SpendableBalances(cmd.Context(), params)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			params := &types.QuerySpendableBalanceByDenomRequest{Address: clientCtx.AccAddressString(addr), Denom: denom}
+			res, err := queryClient.SpendableBalanceByDenom(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Balance)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "spendable balances")
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
@@ -185,7 +256,12 @@ To query for the total supply of a specific coin denomination use:
 			queryClient := types.NewQueryClient(clientCtx)
 
 			if denom == "" {
-				res, err := queryClient.TotalSupply(cmd.Context(), &types.QueryTotalSupplyRequest{})
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.TotalSupply(cmd.Context(), &types.QueryTotalSupplyRequest{Pagination: pageReq})
 				if err != nil {
 					return err
 				}
@@ -203,6 +279,124 @@ To query for the total supply of a specific coin denomination use:
 	}
 
 	cmd.Flags().String(FlagDenom, "", "The specific balance denomination to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all supply totals")
+
+	return cmd
+}
+
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query the send enabled status of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the effective send enabled status of the given coin denominations. If no
+denomination is given, the denominations with an explicit entry are returned.
+
+Example:
+  $ %s query %s send-enabled
+  $ %s query %s send-enabled stake photon
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{Denoms: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdQueryBlockedAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-addresses",
+		Short: "Query the addresses blocked from receiving funds by governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses blocked from receiving funds by governance. The addresses
+blocked at application construction, such as module accounts, are not listed.
+
+Example:
+  $ %s query %s blocked-addresses
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAddresses(cmd.Context(), &types.QueryBlockedAddressesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked addresses")
+
+	return cmd
+}
+
+func GetCmdQueryBlockedAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-address [address]",
+		Short: "Query whether an address is blocked from receiving funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether an address is blocked from receiving funds, either by governance
+or at application construction.
+
+Example:
+  $ %s query %s blocked-address [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAddress(cmd.Context(), &types.QueryBlockedAddressRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
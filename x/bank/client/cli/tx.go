@@ -1,13 +1,28 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	FlagCSV        = "csv"
+	FlagSplit      = "split"
+	FlagMaxOutputs = "max-outputs"
+
+	// DefaultMaxOutputs is the default maximum number of outputs of a single
+	// MsgMultiSend built by the multi-send command.
+	DefaultMaxOutputs = 100
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -20,7 +35,11 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewSendTxCmd())
+	txCmd.AddCommand(
+		NewSendTxCmd(),
+		NewMultiSendTxCmd(),
+		NewBurnTxCmd(),
+	)
 
 	return txCmd
 }
@@ -38,7 +57,7 @@ ignored as it is implied from [from_key_or_address].`,
 			if err != nil {
 				return err
 			}
-			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			toAddr, err := clientCtx.AccAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
@@ -61,3 +80,352 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewBurnTxCmd returns a CLI command handler for creating a MsgBurn transaction.
+func NewBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "burn [from_key_or_address] [amount]",
+		Short: `Permanently burn funds of an account, reducing the total supply. Note, the'--from'
+flag is ignored as it is implied from [from_key_or_address].`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Permanently burn funds of an account, reducing the total supply.
+Only the denominations listed in the burn_enabled_denoms parameter of the bank module can be burned.
+
+Example:
+$ %s tx %s burn mykey 100stake
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(clientCtx.GetFromAddress(), coins)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMultiSendTxCmd returns a CLI command handler for creating MsgMultiSend
+// transactions from a CSV file of recipients.
+func NewMultiSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-send [from_key_or_address]",
+		Short: "Send funds from one account to the recipients listed in a CSV file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send funds from one account to many recipients with MsgMultiSend. The
+recipients are read from the CSV file given with --%[2]s, one per row, in the
+form "address,amount", amounts of several denominations must be quoted. Blank
+rows and rows starting with # are ignored.
+
+If --%[3]s is provided, the rows must only contain the recipient address and
+the given amount is split evenly among all recipients, any remainder stays in
+the sending account. Recipients are batched in several transactions of at most
+--%[4]s outputs each, the transactions being signed with consecutive sequences.
+
+Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+
+Example:
+  $ %[1]s tx bank multi-send mykey --%[2]s=recipients.csv
+  $ %[1]s tx bank multi-send mykey --%[2]s=recipients.csv --%[3]s=1000000stake
+`,
+				version.AppName, FlagCSV, FlagSplit, FlagMaxOutputs,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			csvFile, err := cmd.Flags().GetString(FlagCSV)
+			if err != nil {
+				return err
+			}
+			if csvFile == "" {
+				return fmt.Errorf("the --%s flag is required", FlagCSV)
+			}
+
+			var split sdk.Coins
+			splitStr, err := cmd.Flags().GetString(FlagSplit)
+			if err != nil {
+				return err
+			}
+			if splitStr != "" {
+				split, err = sdk.ParseCoinsNormalized(splitStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			maxOutputs, err := cmd.Flags().GetInt(FlagMaxOutputs)
+			if err != nil {
+				return err
+			}
+
+			outputs, err := ParseMultiSendCSV(csvFile, split)
+			if err != nil {
+				return err
+			}
+
+			msgs, err := NewMultiSendMsgs(clientCtx.GetFromAddress(), outputs, maxOutputs)
+			if err != nil {
+				return err
+			}
+
+			var total sdk.Coins
+			for _, out := range outputs {
+				total = total.Add(out.Coins...)
+			}
+			cmd.PrintErrf("sending %s to %d recipients in %d transaction(s)\n", total, len(outputs), len(msgs))
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if len(msgs) > 1 && !clientCtx.GenerateOnly && !clientCtx.Simulate {
+				// every batch is signed with the next sequence as the previous
+				// transactions are not committed yet
+				txf, err = tx.PrepareFactory(clientCtx, txf)
+				if err != nil {
+					return err
+				}
+			}
+
+			for i, msg := range msgs {
+				batchTxf := txf.WithSequence(txf.Sequence() + uint64(i))
+				if err := tx.GenerateOrBroadcastTxWithFactory(clientCtx, batchTxf, msg); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagCSV, "", "CSV file listing the recipients and their amounts")
+	cmd.Flags().String(FlagSplit, "", "Total amount to split evenly among the recipients")
+	cmd.Flags().Int(FlagMaxOutputs, DefaultMaxOutputs, "Limit the number of recipients per tx (0 for unlimited)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitSetDenomMetadataProposal implements the command to submit a
+// set-denom-metadata proposal.
+func GetCmdSubmitSetDenomMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to register or update denominations metadata",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to register or update denominations metadata along with
+an initial deposit. Metadata are identified by their base denom, existing metadata
+for the same base denom are overwritten. The proposal details must be supplied via
+a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-denom-metadata <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Register Atom Metadata",
+  "description": "Register the display units of the atom",
+  "metadata": [
+    {
+      "description": "The native staking token of the Cosmos Hub.",
+      "denom_units": [
+        {"denom": "uatom", "exponent": 0, "aliases": ["microatom"]},
+        {"denom": "atom", "exponent": 6}
+      ],
+      "base": "uatom",
+      "display": "atom"
+    }
+  ],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseSetDenomMetadataProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetDenomMetadataProposal(proposal.Title, proposal.Description, proposal.Metadata)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitUpdateSendEnabledProposal implements the command to submit an
+// update-send-enabled proposal.
+func GetCmdSubmitUpdateSendEnabledProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-send-enabled [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the send enabled status of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the per-denom send enabled entries of the bank
+module along with an initial deposit. The send_enabled entries are added or
+overwritten while the entries of the use_default_for denoms are removed so that
+they fall back to the default send enabled status. The proposal details must be
+supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-send-enabled <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Disable Photon Transfers",
+  "description": "Disable photon transfers and restore the default for atom",
+  "send_enabled": [
+    {"denom": "photon", "enabled": false}
+  ],
+  "use_default_for": ["atom"],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseUpdateSendEnabledProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateSendEnabledProposal(proposal.Title, proposal.Description, proposal.SendEnabled, proposal.UseDefaultFor)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitUpdateBlockedAddressesProposal implements the command to submit an
+// update-blocked-addresses proposal.
+func GetCmdSubmitUpdateBlockedAddressesProposal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "update-blocked-addresses [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the addresses blocked from receiving funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to block addresses from receiving funds, or to unblock
+addresses previously blocked by governance, along with an initial deposit. The
+proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-blocked-addresses <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Block Compromised Account",
+  "description": "Prevent the compromised account from receiving funds",
+  "block": ["%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"],
+  "unblock": [],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseUpdateBlockedAddressesProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateBlockedAddressesProposal(proposal.Title, proposal.Description, proposal.Block, proposal.Unblock)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// ParseSetDenomMetadataProposalWithDeposit reads and parses a SetDenomMetadataProposalWithDeposit from a file.
+func ParseSetDenomMetadataProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.SetDenomMetadataProposalWithDeposit, error) {
+	proposal := types.SetDenomMetadataProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdateSendEnabledProposalWithDeposit reads and parses an UpdateSendEnabledProposalWithDeposit from a file.
+func ParseUpdateSendEnabledProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.UpdateSendEnabledProposalWithDeposit, error) {
+	proposal := types.UpdateSendEnabledProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdateBlockedAddressesProposalWithDeposit reads and parses an UpdateBlockedAddressesProposalWithDeposit from a file.
+func ParseUpdateBlockedAddressesProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.UpdateBlockedAddressesProposalWithDeposit, error) {
+	proposal := types.UpdateBlockedAddressesProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseMultiSendCSV reads the multi-send recipients from a CSV file. See
+// ReadMultiSendCSV for the expected format.
+func ParseMultiSendCSV(csvFile string, split sdk.Coins) ([]types.Output, error) {
+	f, err := os.Open(csvFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadMultiSendCSV(f, split)
+}
+
+// ReadMultiSendCSV reads the multi-send recipients from CSV rows of the form
+// "address,amount". If split is not empty, the rows must only contain the
+// recipient address and split is divided evenly among the recipients. Blank
+// rows and rows starting with # are ignored.
+func ReadMultiSendCSV(r io.Reader, split sdk.Coins) ([]types.Output, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []types.Output
+	for _, record := range records {
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipient %q: %w", record[0], err)
+		}
+
+		switch {
+		case split.Empty() && len(record) != 2:
+			return nil, fmt.Errorf("expected address and amount for recipient %s", addr)
+
+		case !split.Empty() && len(record) != 1:
+			return nil, fmt.Errorf("unexpected amount for recipient %s when splitting %s", addr, split)
+		}
+
+		var coins sdk.Coins
+		if split.Empty() {
+			coins, err = sdk.ParseCoinsNormalized(strings.TrimSpace(record[1]))
+			if err != nil {
+				return nil, fmt.Errorf("invalid amount for recipient %s: %w", addr, err)
+			}
+		}
+
+		outputs = append(outputs, types.NewOutput(addr, coins))
+	}
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("no recipients found")
+	}
+
+	if !split.Empty() {
+		share := splitCoins(split, int64(len(outputs)))
+		if share.Empty() {
+			return nil, fmt.Errorf("%s is too small to be split among %d recipients", split, len(outputs))
+		}
+
+		for i := range outputs {
+			outputs[i].Coins = share
+		}
+	}
+
+	for _, out := range outputs {
+		if !out.Coins.IsAllPositive() {
+			return nil, fmt.Errorf("amount for recipient %s must be positive", out.Address)
+		}
+	}
+
+	return outputs, nil
+}
+
+// NewMultiSendMsgs builds the MsgMultiSend messages sending the given outputs
+// from the given address, each message holding at most maxOutputs outputs (0 for
+// unlimited).
+func NewMultiSendMsgs(from sdk.AccAddress, outputs []types.Output, maxOutputs int) ([]sdk.Msg, error) {
+	if maxOutputs <= 0 {
+		maxOutputs = len(outputs)
+	}
+
+	var msgs []sdk.Msg
+	for start := 0; start < len(outputs); start += maxOutputs {
+		end := start + maxOutputs
+		if end > len(outputs) {
+			end = len(outputs)
+		}
+
+		var total sdk.Coins
+		for _, out := range outputs[start:end] {
+			total = total.Add(out.Coins...)
+		}
+
+		msg := types.NewMsgMultiSend(
+			[]types.Input{types.NewInput(from, total)},
+			outputs[start:end],
+		)
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// splitCoins divides every coin of the given amount by n, dropping the coins
+// that become zero.
+func splitCoins(amount sdk.Coins, n int64) sdk.Coins {
+	var share sdk.Coins
+	for _, coin := range amount {
+		share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(n)))
+	}
+
+	return share
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	addr3 = sdk.AccAddress([]byte("addr3_______________"))
+)
+
+func TestReadMultiSendCSV(t *testing.T) {
+	csv := strings.Join([]string{
+		"# recipients",
+		addr1.String() + ",10stake",
+		"",
+		addr2.String() + `, "5stake,3atom"`,
+	}, "\n")
+
+	outputs, err := ReadMultiSendCSV(strings.NewReader(csv), nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.Output{
+		types.NewOutput(addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("atom", 3))),
+	}, outputs)
+
+	_, err = ReadMultiSendCSV(strings.NewReader(addr1.String()), nil)
+	require.Error(t, err, "missing amount")
+
+	_, err = ReadMultiSendCSV(strings.NewReader(addr1.String()+",0stake"), nil)
+	require.Error(t, err, "zero amount")
+
+	_, err = ReadMultiSendCSV(strings.NewReader("invalid,10stake"), nil)
+	require.Error(t, err, "invalid address")
+
+	_, err = ReadMultiSendCSV(strings.NewReader("# no recipients"), nil)
+	require.Error(t, err, "no recipients")
+}
+
+func TestReadMultiSendCSVSplit(t *testing.T) {
+	csv := strings.Join([]string{addr1.String(), addr2.String(), addr3.String()}, "\n")
+
+	outputs, err := ReadMultiSendCSV(strings.NewReader(csv), sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 2)))
+	require.NoError(t, err)
+	require.Len(t, outputs, 3)
+	for _, out := range outputs {
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 3)), out.Coins)
+	}
+
+	_, err = ReadMultiSendCSV(strings.NewReader(csv), sdk.NewCoins(sdk.NewInt64Coin("stake", 2)))
+	require.Error(t, err, "amount too small")
+
+	_, err = ReadMultiSendCSV(strings.NewReader(addr1.String()+",10stake"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	require.Error(t, err, "amount given while splitting")
+}
+
+func TestNewMultiSendMsgs(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	outputs := []types.Output{
+		types.NewOutput(addr1, coins),
+		types.NewOutput(addr2, coins),
+		types.NewOutput(addr3, coins),
+	}
+
+	msgs, err := NewMultiSendMsgs(addr1, outputs, 2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))},
+		outputs[:2],
+	), msgs[0])
+	require.Equal(t, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, coins)},
+		outputs[2:],
+	), msgs[1])
+
+	msgs, err = NewMultiSendMsgs(addr1, outputs, 0)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+}
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+var (
+	// SetDenomMetadataProposalHandler is the set denom metadata proposal handler.
+	SetDenomMetadataProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetDenomMetadataProposal, rest.ProposalRESTHandler)
+	// UpdateSendEnabledProposalHandler is the update send enabled proposal handler.
+	UpdateSendEnabledProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateSendEnabledProposal, rest.UpdateSendEnabledProposalRESTHandler)
+	// UpdateBlockedAddressesProposalHandler is the update blocked addresses proposal handler.
+	UpdateBlockedAddressesProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateBlockedAddressesProposal, rest.UpdateBlockedAddressesProposalRESTHandler)
+)
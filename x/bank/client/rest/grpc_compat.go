@@ -0,0 +1,152 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// RegisterGRPCCompatHandlers registers the x/bank legacy REST query handlers
+// served from the gRPC query service instead of the legacy querier. Their
+// responses have the same shape as the ones of the handlers registered by
+// RegisterHandlers, which they take precedence over when registered first.
+func RegisterGRPCCompatHandlers(clientCtx client.Context, rtr *mux.Router) {
+	r := clientrest.WithHTTPDeprecationHeaders(rtr)
+	r.HandleFunc("/bank/balances/{address}", grpcBalancesHandlerFn(clientCtx)).Methods("GET")
+	r.HandleFunc("/bank/total", grpcTotalSupplyHandlerFn(clientCtx)).Methods("GET")
+	r.HandleFunc("/bank/total/{denom}", grpcSupplyOfHandlerFn(clientCtx)).Methods("GET")
+}
+
+// HTTP request handler to query the balances of an account, or its balance of a
+// single denomination, from the gRPC query service
+func grpcBalancesHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var header metadata.MD
+		if denom := r.FormValue("denom"); denom != "" {
+			res, err := queryClient.Balance(context.Background(), types.NewQueryBalanceRequest(addr, denom), grpc.Header(&header))
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			rest.PostProcessGRPCResponse(w, clientCtx, header, res.Balance)
+			return
+		}
+
+		var (
+			balances sdk.Coins
+			nextKey  []byte
+		)
+		for {
+			res, err := queryClient.AllBalances(
+				context.Background(),
+				types.NewQueryAllBalancesRequest(addr, &query.PageRequest{Key: nextKey}),
+				grpc.Header(&header),
+			)
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			balances = append(balances, res.Balances...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, balances)
+	}
+}
+
+// HTTP request handler to query the total supply of coins from the gRPC query
+// service
+func grpcTotalSupplyHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			header  metadata.MD
+			supply  sdk.Coins
+			nextKey []byte
+		)
+		for {
+			res, err := queryClient.TotalSupply(
+				context.Background(),
+				&types.QueryTotalSupplyRequest{Pagination: &query.PageRequest{Key: nextKey}},
+				grpc.Header(&header),
+			)
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			supply = append(supply, res.Supply...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+
+		// paginate as the legacy querier does
+		start, end := client.Paginate(len(supply), page, limit, 100)
+		if start < 0 || end < 0 {
+			supply = sdk.Coins{}
+		} else {
+			supply = supply[start:end]
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, supply)
+	}
+}
+
+// HTTP request handler to query the supply of a single denom from the gRPC
+// query service
+func grpcSupplyOfHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		denom := mux.Vars(r)["denom"]
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).SupplyOf(
+			context.Background(), &types.QuerySupplyOfRequest{Denom: denom}, grpc.Header(&header),
+		)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Amount)
+	}
+}
@@ -0,0 +1,54 @@
+// +build norace
+
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	bankrest "github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+)
+
+func (s *IntegrationTestSuite) TestGRPCCompatHandlers() {
+	val := s.network.Validators[0]
+
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	bankrest.RegisterGRPCCompatHandlers(val.ClientCtx, router)
+
+	paths := []string{
+		fmt.Sprintf("/bank/balances/%s", val.Address),
+		fmt.Sprintf("/bank/balances/%s?denom=%s", val.Address, s.cfg.BondDenom),
+		"/bank/total",
+		"/bank/total?page=2&limit=1",
+		fmt.Sprintf("/bank/total/%s", s.cfg.BondDenom),
+	}
+
+	for _, path := range paths {
+		path := path
+		s.Run(path, func() {
+			// query both handlers at the same height, as rewards change every block
+			sep := "?"
+			if strings.Contains(path, "?") {
+				sep = "&"
+			}
+			path = fmt.Sprintf("%s%sheight=%d", path, sep, height)
+
+			legacy, err := rest.GetRequest(val.APIAddress + path)
+			s.Require().NoError(err)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+			s.Require().Equal("true", rec.Header().Get("Deprecation"))
+			s.Require().JSONEq(string(legacy), rec.Body.String())
+		})
+	}
+}
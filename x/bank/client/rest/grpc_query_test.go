@@ -18,6 +18,7 @@ import (
 func (s *IntegrationTestSuite) TestTotalSupplyGRPCHandler() {
 	val := s.network.Validators[0]
 	baseURL := val.APIAddress
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
 
 	testCases := []struct {
 		name     string
@@ -38,6 +39,25 @@ func (s *IntegrationTestSuite) TestTotalSupplyGRPCHandler() {
 					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
 					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Add(sdk.NewInt(10))),
 				),
+				Pagination: &query.PageResponse{
+					Total: 2,
+				},
+			},
+		},
+		{
+			"GRPC total supply with pagination",
+			fmt.Sprintf("%s/cosmos/bank/v1beta1/supply?pagination.limit=1", baseURL),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			&types.QueryTotalSupplyResponse{},
+			&types.QueryTotalSupplyResponse{
+				Supply: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+				),
+				Pagination: &query.PageResponse{
+					NextKey: []byte(s.cfg.BondDenom),
+				},
 			},
 		},
 		{
@@ -51,6 +71,17 @@ func (s *IntegrationTestSuite) TestTotalSupplyGRPCHandler() {
 				Amount: sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Add(sdk.NewInt(10))),
 			},
 		},
+		{
+			"GRPC total supply of an IBC denom",
+			fmt.Sprintf("%s/cosmos/bank/v1beta1/supply/%s", baseURL, ibcDenom),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			&types.QuerySupplyOfResponse{},
+			&types.QuerySupplyOfResponse{
+				Amount: sdk.NewCoin(ibcDenom, sdk.ZeroInt()),
+			},
+		},
 		{
 			"Query for `height` > 1",
 			fmt.Sprintf("%s/cosmos/bank/v1beta1/supply/%s", baseURL, s.cfg.BondDenom),
@@ -247,6 +278,31 @@ func (s *IntegrationTestSuite) TestBalancesGRPCHandler() {
 				},
 			},
 		},
+		{
+			"gRPC spendable account balance",
+			fmt.Sprintf("%s/cosmos/bank/v1beta1/spendable_balances/%s", baseURL, val.Address.String()),
+			&types.QuerySpendableBalancesResponse{},
+			&types.QuerySpendableBalancesResponse{
+				Balances: sdk.NewCoins(
+					sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+					sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens)),
+				),
+				Pagination: &query.PageResponse{
+					Total: 2,
+				},
+			},
+		},
+		{
+			"gRPC spendable account balance of a denom",
+			fmt.Sprintf("%s/cosmos/bank/v1beta1/spendable_balances/%s/by_denom?denom=%s", baseURL, val.Address.String(), s.cfg.BondDenom),
+			&types.QuerySpendableBalanceByDenomResponse{},
+			&types.QuerySpendableBalanceByDenomResponse{
+				Balance: &sdk.Coin{
+					Denom:  s.cfg.BondDenom,
+					Amount: s.cfg.StakingTokens.Sub(s.cfg.BondedTokens),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns a handler for "bank" type messages.
@@ -23,8 +24,91 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.MultiSend(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgBurn:
+			res, err := msgServer.Burn(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank message type: %T", msg)
 		}
 	}
 }
+
+// NewProposalHandler creates a governance handler to manage the
+// bank proposal types: registering denominations metadata, updating the
+// per-denom send enabled entries and updating the addresses blocked from
+// receiving funds.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetDenomMetadataProposal:
+			return handleSetDenomMetadataProposal(ctx, k, c)
+
+		case *types.UpdateSendEnabledProposal:
+			return handleUpdateSendEnabledProposal(ctx, k, c)
+
+		case *types.UpdateBlockedAddressesProposal:
+			return handleUpdateBlockedAddressesProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank proposal content type: %T", c)
+		}
+	}
+}
+
+func handleSetDenomMetadataProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetDenomMetadataProposal) error {
+	for _, metadata := range p.Metadata {
+		if err := metadata.Validate(); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidDenomMetadata, err.Error())
+		}
+	}
+
+	for _, metadata := range p.Metadata {
+		k.SetDenomMetaData(ctx, metadata)
+	}
+
+	return nil
+}
+
+func handleUpdateSendEnabledProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateSendEnabledProposal) error {
+	params := k.GetParams(ctx)
+
+	for _, denom := range p.UseDefaultFor {
+		params = params.DeleteSendEnabledParam(denom)
+	}
+
+	for _, se := range p.SendEnabled {
+		params = params.SetSendEnabledParam(se.Denom, se.Enabled)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+func handleUpdateBlockedAddressesProposal(ctx sdk.Context, k keeper.Keeper, p *types.UpdateBlockedAddressesProposal) error {
+	for _, bech32Addr := range p.Unblock {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.DeleteBlockedAddr(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, bech32Addr := range p.Block {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			return err
+		}
+
+		k.SetBlockedAddr(ctx, addr)
+	}
+
+	return nil
+}
@@ -36,14 +36,30 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, bech32Addr := range genState.BlockedAddresses {
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetBlockedAddr(ctx, addr)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		k.GetSupply(ctx).GetTotal(),
 		k.GetAllDenomMetaData(ctx),
 	)
+
+	k.IterateBlockedAddrs(ctx, func(addr sdk.AccAddress) bool {
+		genState.BlockedAddresses = append(genState.BlockedAddresses, addr.String())
+		return false
+	})
+
+	return genState
 }
@@ -24,6 +24,9 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	app.BankKeeper.SetSupply(ctx, totalSupply)
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
+	blockedAddr := sdk.AccAddress([]byte("blocked_____________"))
+	app.BankKeeper.SetBlockedAddr(ctx, blockedAddr)
+
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
 	suite.Require().Len(exportGenesis.Params.SendEnabled, 0)
@@ -31,6 +34,7 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(totalSupply.GetTotal(), exportGenesis.Supply)
 	suite.Require().Equal(expectedBalances, exportGenesis.Balances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
+	suite.Require().Equal([]string{blockedAddr.String()}, exportGenesis.BlockedAddresses)
 }
 
 func (suite *IntegrationTestSuite) getTestBalances() []types.Balance {
@@ -53,3 +57,14 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	m2 := bk.GetDenomMetaData(suite.ctx, m.Base)
 	suite.Require().Equal(m, m2)
 }
+
+func (suite *IntegrationTestSuite) TestInitGenesisBlockedAddresses() {
+	blockedAddr := sdk.AccAddress([]byte("blocked_____________"))
+	g := types.DefaultGenesisState()
+	g.BlockedAddresses = []string{blockedAddr.String()}
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().True(bk.BlockedAddr(suite.ctx, blockedAddr))
+	suite.Require().Equal([]sdk.AccAddress{blockedAddr}, bk.GetBlockedAddrs(suite.ctx))
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -78,12 +80,138 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// SpendableBalances implements the Query/SpendableBalances gRPC method
+func (k BaseKeeper) SpendableBalances(ctx context.Context, req *types.QuerySpendableBalancesRequest) (*types.QuerySpendableBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	locked := k.LockedCoins(sdkCtx, addr)
+
+	balances := sdk.NewCoins()
+	store := sdkCtx.KVStore(k.storeKey)
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(_, value []byte) error {
+		var result sdk.Coin
+		err := k.cdc.UnmarshalBinaryBare(value, &result)
+		if err != nil {
+			return err
+		}
+		balances = append(balances, spendableCoin(result, locked))
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QuerySpendableBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// SpendableBalanceByDenom implements the Query/SpendableBalanceByDenom gRPC method
+func (k BaseKeeper) SpendableBalanceByDenom(ctx context.Context, req *types.QuerySpendableBalanceByDenomRequest) (*types.QuerySpendableBalanceByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	balance := k.SpendableCoin(sdk.UnwrapSDKContext(ctx), addr, req.Denom)
+
+	return &types.QuerySpendableBalanceByDenomResponse{Balance: &balance}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
-func (k BaseKeeper) TotalSupply(ctx context.Context, _ *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	totalSupply := k.GetSupply(sdkCtx).GetTotal()
 
-	return &types.QueryTotalSupplyResponse{Supply: totalSupply}, nil
+	supply, pageRes, err := paginateCoins(totalSupply, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTotalSupplyResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
+// paginateCoins returns a page of the coins, sorted by denom, keyed by their
+// denom and following the semantics of query.Paginate.
+func paginateCoins(coins sdk.Coins, pageReq *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	offset := pageReq.Offset
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+
+	if offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	start := uint64(len(coins))
+	if offset < start {
+		start = offset
+	}
+	if len(pageReq.Key) != 0 {
+		key := string(pageReq.Key)
+		start = uint64(sort.Search(len(coins), func(i int) bool { return coins[i].Denom >= key }))
+		countTotal = false
+	}
+
+	end := uint64(len(coins))
+	if limit < end-start {
+		end = start + limit
+	}
+
+	var page sdk.Coins
+	if start < end {
+		page = coins[start:end]
+	}
+
+	res := &query.PageResponse{}
+	if end < uint64(len(coins)) {
+		res.NextKey = []byte(coins[end].Denom)
+	}
+	if countTotal {
+		res.Total = uint64(len(coins))
+	}
+
+	return page, res, nil
 }
 
 // SupplyOf implements the Query/SupplyOf gRPC method
@@ -92,8 +220,8 @@ func (k BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if req.Denom == "" {
-		return nil, status.Error(codes.InvalidArgument, "invalid denom")
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
@@ -163,3 +291,73 @@ func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetada
 		Metadata: metadata,
 	}, nil
 }
+
+// SendEnabled implements Query/SendEnabled gRPC method.
+func (k BaseKeeper) SendEnabled(c context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	var sendEnabled []types.SendEnabled
+	if len(req.Denoms) == 0 {
+		for _, se := range params.SendEnabled {
+			sendEnabled = append(sendEnabled, *se)
+		}
+	}
+
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		sendEnabled = append(sendEnabled, *types.NewSendEnabled(denom, params.SendEnabledDenom(denom)))
+	}
+
+	return &types.QuerySendEnabledResponse{
+		SendEnabled:        sendEnabled,
+		DefaultSendEnabled: params.DefaultSendEnabled,
+	}, nil
+}
+
+// BlockedAddresses implements Query/BlockedAddresses gRPC method.
+func (k BaseKeeper) BlockedAddresses(c context.Context, req *types.QueryBlockedAddressesRequest) (*types.QueryBlockedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedAddrPrefix)
+
+	var addresses []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBlockedAddressesResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BlockedAddress implements Query/BlockedAddress gRPC method.
+func (k BaseKeeper) BlockedAddress(c context.Context, req *types.QueryBlockedAddressRequest) (*types.QueryBlockedAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBlockedAddressResponse{Blocked: k.BlockedAddr(ctx, addr)}, nil
+}
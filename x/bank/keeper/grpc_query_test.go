@@ -5,10 +5,16 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -84,6 +90,44 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestQuerySpendableBalances() {
+	app := suite.app
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	now := tmtime.Now()
+	ctx := suite.ctx.WithBlockTime(now)
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.SpendableBalances(gocontext.Background(), &types.QuerySpendableBalancesRequest{})
+	suite.Require().Error(err)
+
+	// half of the foo coins vest over a day, the bar coins are not vesting
+	vestingCoins := sdk.NewCoins(newFooCoin(50))
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	vacc := vesting.NewContinuousVestingAccount(bacc, vestingCoins, now.Unix(), now.Add(24*time.Hour).Unix())
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(newFooCoin(100), newBarCoin(30))))
+
+	res, err := queryClient.SpendableBalances(gocontext.Background(), types.NewQuerySpendableBalancesRequest(addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(30)), res.Balances)
+
+	byDenom, err := queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(addr, fooDenom))
+	suite.Require().NoError(err)
+	suite.Require().Equal(newFooCoin(50), *byDenom.Balance)
+
+	// fully locked denoms are returned with a zero spendable balance
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(newFooCoin(40), newBarCoin(30))))
+	res, err = queryClient.SpendableBalances(gocontext.Background(), types.NewQuerySpendableBalancesRequest(addr, nil))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{newBarCoin(30), newFooCoin(0)}, res.Balances)
+
+	_, err = queryClient.SpendableBalanceByDenom(gocontext.Background(), types.NewQuerySpendableBalanceByDenomRequest(addr, "1foo"))
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	expectedTotalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
@@ -94,6 +138,40 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	suite.Require().NotNil(res)
 
 	suite.Require().Equal(expectedTotalSupply.Total, res.Supply)
+
+	expectedTotalSupply = types.NewSupply(sdk.NewCoins(
+		sdk.NewInt64Coin("test", 400000000),
+		sdk.NewInt64Coin(fooDenom, 100),
+		sdk.NewInt64Coin(barDenom, 200),
+	))
+	app.BankKeeper.SetSupply(ctx, expectedTotalSupply)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply.Total[:2], res.Supply)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply.Total[2:], res.Supply)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTotalSupply.Total[1:2], res.Supply)
+	suite.Require().Equal([]byte(expectedTotalSupply.Total[2].Denom), res.Pagination.NextKey)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	_, err = queryClient.TotalSupply(gocontext.Background(), &types.QueryTotalSupplyRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Offset: 1},
+	})
+	suite.Require().Error(err)
 }
 
 func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
@@ -121,6 +199,28 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().Equal(suite.app.BankKeeper.GetParams(suite.ctx), res.GetParams())
 }
 
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := types.NewParams(true, nil).SetSendEnabledParam(fooDenom, false)
+	app.BankKeeper.SetParams(ctx, params)
+
+	res, err := queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{{Denom: fooDenom, Enabled: false}}, res.SendEnabled)
+	suite.Require().True(res.DefaultSendEnabled)
+
+	res, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Denoms: []string{fooDenom, barDenom}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: fooDenom, Enabled: false},
+		{Denom: barDenom, Enabled: true},
+	}, res.SendEnabled)
+
+	_, err = queryClient.SendEnabled(gocontext.Background(), &types.QuerySendEnabledRequest{Denoms: []string{"1invalid"}})
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) QueryDenomsMetadataRequest() {
 	var (
 		req         *types.QueryDenomsMetadataRequest
@@ -299,3 +399,30 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestQueryBlockedAddresses() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	app.BankKeeper.SetBlockedAddr(ctx, addr1)
+	app.BankKeeper.SetBlockedAddr(ctx, addr2)
+
+	res, err := queryClient.BlockedAddresses(gocontext.Background(), &types.QueryBlockedAddressesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Addresses, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	blocked, err := queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().True(blocked.Blocked)
+
+	blocked, err = queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: sdk.AccAddress([]byte("addr3_______________")).String()})
+	suite.Require().NoError(err)
+	suite.Require().False(blocked.Blocked)
+
+	_, err = queryClient.BlockedAddress(gocontext.Background(), &types.QueryBlockedAddressRequest{Address: "invalid"})
+	suite.Require().Error(err)
+}
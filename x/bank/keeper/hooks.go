@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankHooks holds the hooks registered on the keeper. It is shared by pointer
+// between the copies of the keeper, so that hooks registered once the keeper has
+// been handed over to the other modules are invoked by all of them.
+type bankHooks struct {
+	hooks types.BankHooks
+}
+
+// SetHooks registers the hooks called on every transfer of coins between
+// accounts. The hooks apply to every copy of the keeper, use NewMultiBankHooks
+// to register the hooks of several modules. It panics if hooks have already
+// been set.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks.hooks = bh
+}
+
+// beforeSend calls the before send hooks, returning the error of the blocking hooks.
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	hooks := k.hooks.hooks
+	if hooks == nil {
+		return nil
+	}
+
+	k.trackSend(ctx, func(ctx sdk.Context) { hooks.TrackBeforeSend(ctx, from, to, amt) })
+
+	return hooks.BeforeSend(ctx, from, to, amt)
+}
+
+// afterSend calls the after send hooks, returning the error of the blocking hooks.
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	hooks := k.hooks.hooks
+	if hooks == nil {
+		return nil
+	}
+
+	k.trackSend(ctx, func(ctx sdk.Context) { hooks.TrackAfterSend(ctx, from, to, amt) })
+
+	return hooks.AfterSend(ctx, from, to, amt)
+}
+
+// trackSend runs a non-blocking hook on a cached context whose state changes and
+// events are only kept if the hook does not panic, in which case the panic is
+// logged and ignored. Running out of gas is not recovered from so that the gas
+// limit still applies.
+func (k BaseSendKeeper) trackSend(ctx sdk.Context, hook func(ctx sdk.Context)) {
+	cacheCtx, write := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			k.Logger(ctx).Error("bank tracking hook panicked", "error", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	hook(cacheCtx)
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
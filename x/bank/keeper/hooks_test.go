@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ types.BankHooks = &mockBankHooks{}
+
+// mockTransfer is a transfer recorded by mockBankHooks.
+type mockTransfer struct {
+	from, to sdk.AccAddress
+	amt      sdk.Coins
+}
+
+// mockBankHooks records the transfers it is called for and rejects the
+// transfers of the blocked denom.
+type mockBankHooks struct {
+	blockedDenom string
+	panicOnTrack bool
+
+	before, after, tracked []sdk.Coins
+	transfers              []mockTransfer
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if amt.AmountOf(h.blockedDenom).IsPositive() {
+		return errors.New("denom is not transferable")
+	}
+
+	h.before = append(h.before, amt)
+	h.transfers = append(h.transfers, mockTransfer{from: from, to: to, amt: amt})
+	return nil
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) error {
+	h.after = append(h.after, amt)
+	return nil
+}
+
+func (h *mockBankHooks) TrackBeforeSend(_ sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) {
+	h.tracked = append(h.tracked, amt)
+	if h.panicOnTrack {
+		panic("tracking hook failure")
+	}
+}
+
+func (h *mockBankHooks) TrackAfterSend(ctx sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) {
+	h.tracked = append(h.tracked, amt)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("track_send", sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String())))
+}
+
+func (suite *IntegrationTestSuite) TestBankHooks() {
+	app, ctx := suite.app, suite.ctx
+
+	hooks := &mockBankHooks{blockedDenom: barDenom}
+	app.BankKeeper.SetHooks(types.NewMultiBankHooks(hooks))
+	suite.Require().Panics(func() { app.BankKeeper.SetHooks(hooks) })
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	fooCoins := sdk.NewCoins(newFooCoin(10))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, fooCoins))
+	suite.Require().Equal([]sdk.Coins{fooCoins}, hooks.before)
+	suite.Require().Equal([]sdk.Coins{fooCoins}, hooks.after)
+	suite.Require().Equal([]sdk.Coins{fooCoins, fooCoins}, hooks.tracked)
+
+	// the events of the tracking hooks are emitted
+	suite.Require().Contains(ctx.EventManager().Events(), sdk.NewEvent("track_send", sdk.NewAttribute(sdk.AttributeKeyAmount, fooCoins.String())))
+
+	// the blocking hooks abort the transfer
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(newBarCoin(100), app.BankKeeper.GetBalance(ctx, addr1, barDenom))
+
+	inputs := []types.Input{types.NewInput(addr1, sdk.NewCoins(newBarCoin(10)))}
+	outputs := []types.Output{types.NewOutput(addr2, sdk.NewCoins(newBarCoin(10)))}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(newBarCoin(100), app.BankKeeper.GetBalance(ctx, addr1, barDenom))
+
+	// the tracking hooks cannot abort the transfer
+	hooks.panicOnTrack = true
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, fooCoins))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr2))
+}
+
+func (suite *IntegrationTestSuite) TestBankHooksMultiSend() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	hooks := &mockBankHooks{blockedDenom: barDenom}
+	app.BankKeeper.SetHooks(hooks)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(100))))
+
+	coins10 := sdk.NewCoins(newFooCoin(10))
+	coins20 := sdk.NewCoins(newFooCoin(20))
+	coins30 := sdk.NewCoins(newFooCoin(30))
+
+	testCases := []struct {
+		msg       string
+		inputs    []types.Input
+		outputs   []types.Output
+		transfers []mockTransfer
+		expErr    bool
+	}{
+		{
+			"single input",
+			[]types.Input{types.NewInput(addr1, coins30)},
+			[]types.Output{types.NewOutput(addr3, coins10), types.NewOutput(addr4, coins20)},
+			[]mockTransfer{{addr1, addr3, coins10}, {addr1, addr4, coins20}},
+			false,
+		},
+		{
+			"single output",
+			[]types.Input{types.NewInput(addr1, coins10), types.NewInput(addr2, coins20)},
+			[]types.Output{types.NewOutput(addr3, coins30)},
+			[]mockTransfer{{addr1, addr3, coins10}, {addr2, addr3, coins20}},
+			false,
+		},
+		{
+			"several inputs and outputs",
+			[]types.Input{types.NewInput(addr1, coins10), types.NewInput(addr2, coins20)},
+			[]types.Output{types.NewOutput(addr3, coins20), types.NewOutput(addr4, coins10)},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		hooks.transfers = nil
+
+		_, err := msgServer.MultiSend(sdk.WrapSDKContext(ctx), types.NewMsgMultiSend(tc.inputs, tc.outputs))
+		if tc.expErr {
+			suite.Require().True(types.ErrManyToManyMultiSend.Is(err), tc.msg)
+		} else {
+			suite.Require().NoError(err, tc.msg)
+		}
+		suite.Require().Equal(tc.transfers, hooks.transfers, tc.msg)
+	}
+}
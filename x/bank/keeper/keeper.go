@@ -38,6 +38,8 @@ type Keeper interface {
 	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
+	BurnEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
@@ -106,6 +108,8 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		if err != nil {
 			return err
 		}
+
+		ctx.EventManager().EmitEvent(types.NewCoinSpentEvent(delegatorAddr, coin))
 	}
 
 	if err := k.trackDelegation(ctx, delegatorAddr, ctx.BlockHeader().Time, balances, amt); err != nil {
@@ -247,11 +251,11 @@ func (k BaseKeeper) SendCoinsFromModuleToAccount(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", senderModule))
 	}
 
-	if k.BlockedAddr(recipientAddr) {
+	if k.BlockedAddr(ctx, recipientAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAddr, amt)
+	return k.TransferCoins(ctx, senderAddr, recipientAddr, amt)
 }
 
 // SendCoinsFromModuleToModule transfers coins from a ModuleAccount to another.
@@ -270,7 +274,7 @@ func (k BaseKeeper) SendCoinsFromModuleToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.TransferCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // SendCoinsFromAccountToModule transfers coins from an AccAddress to a ModuleAccount.
@@ -284,7 +288,7 @@ func (k BaseKeeper) SendCoinsFromAccountToModule(
 		panic(sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipientModule))
 	}
 
-	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	return k.TransferCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
 // DelegateCoinsFromAccountToModule delegates coins and transfers them from a
@@ -348,6 +352,10 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 
 	k.SetSupply(ctx, supply)
 
+	for _, coin := range amt {
+		ctx.EventManager().EmitEvent(types.NewCoinMintEvent(acc.GetAddress(), coin))
+	}
+
 	logger := k.Logger(ctx)
 	logger.Info("minted coins from module account", "amount", amt.String(), "from", moduleName)
 
@@ -376,12 +384,54 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 	supply.Deflate(amt)
 	k.SetSupply(ctx, supply)
 
+	for _, coin := range amt {
+		ctx.EventManager().EmitEvent(types.NewCoinBurnEvent(acc.GetAddress(), coin))
+	}
+
 	logger := k.Logger(ctx)
 	logger.Info("burned tokens from module account", "amount", amt.String(), "from", moduleName)
 
 	return nil
 }
 
+// BurnAccountCoins burns coins from the spendable balance of an account and
+// removes them from the total supply. The burn enabled status of the coins is
+// not checked here, callers handling user burns must call BurnEnabledCoins
+// beforehand.
+func (k BaseKeeper) BurnAccountCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	err := k.SubtractCoins(ctx, addr, amt)
+	if err != nil {
+		return err
+	}
+
+	// update total supply
+	supply := k.GetSupply(ctx)
+	supply.Deflate(amt)
+	k.SetSupply(ctx, supply)
+
+	for _, coin := range amt {
+		ctx.EventManager().EmitEvent(types.NewCoinBurnEvent(addr, coin))
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("burned tokens from account", "amount", amt.String(), "from", addr.String())
+
+	return nil
+}
+
+// BurnEnabledCoins checks the coins provided and returns an ErrBurnDisabled if
+// any of the coins cannot be burned by accounts. Returns nil if burning is
+// enabled for all provided coins.
+func (k BaseKeeper) BurnEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.BurnEnabledDenom(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrBurnDisabled, "%s cannot be burned", coin.Denom)
+		}
+	}
+	return nil
+}
+
 func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, blockTime time.Time, balance, amt sdk.Coins) error {
 	acc := k.ak.GetAccount(ctx, addr)
 	if acc == nil {
@@ -17,6 +17,8 @@ import (
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 const (
@@ -129,6 +131,32 @@ func (suite *IntegrationTestSuite) TestSendCoinsFromModuleToAccount_Blacklist()
 	suite.Require().Error(keeper.SendCoinsFromModuleToAccount(ctx, holderAcc.GetName(), addr1, initCoins))
 }
 
+func (suite *IntegrationTestSuite) TestBlockedAddrs() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr1))
+	suite.Require().Error(app.BankKeeper.DeleteBlockedAddr(ctx, addr1))
+
+	app.BankKeeper.SetBlockedAddr(ctx, addr1)
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, addr1))
+	suite.Require().Equal([]sdk.AccAddress{addr1}, app.BankKeeper.GetBlockedAddrs(ctx))
+
+	// module accounts provided at construction cannot be unblocked
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(ctx, feeCollector))
+	suite.Require().Error(app.BankKeeper.DeleteBlockedAddr(ctx, feeCollector))
+
+	// funds cannot be sent from a module account to a blocked address
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, initCoins))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, initCoins))
+
+	suite.Require().NoError(app.BankKeeper.DeleteBlockedAddr(ctx, addr1))
+	suite.Require().False(app.BankKeeper.BlockedAddr(ctx, addr1))
+	suite.Require().Empty(app.BankKeeper.GetBlockedAddrs(ctx))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, initCoins))
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
@@ -409,6 +437,13 @@ func (suite *IntegrationTestSuite) TestInputOutputCoins() {
 
 	acc3Balances := app.BankKeeper.GetAllBalances(ctx, addr3)
 	suite.Require().Equal(expected, acc3Balances)
+
+	// inputs of a denom that is not send enabled are rejected
+	params := app.BankKeeper.GetParams(ctx)
+	app.BankKeeper.SetParams(ctx, params.SetSendEnabledParam(barDenom, false))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+	suite.Require().ErrorIs(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs), types.ErrSendDisabled)
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
 }
 
 func (suite *IntegrationTestSuite) TestSendCoins() {
@@ -437,6 +472,12 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	acc2Balances := app.BankKeeper.GetAllBalances(ctx, addr2)
 	expected = sdk.NewCoins(newFooCoin(150), newBarCoin(75))
 	suite.Require().Equal(expected, acc2Balances)
+
+	// coins of a denom that is not send enabled are rejected
+	params := app.BankKeeper.GetParams(ctx)
+	app.BankKeeper.SetParams(ctx, params.SetSendEnabledParam(barDenom, false))
+	suite.Require().ErrorIs(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt), types.ErrSendDisabled)
+	suite.Require().Equal(expected, app.BankKeeper.GetAllBalances(ctx, addr2))
 }
 
 func (suite *IntegrationTestSuite) TestValidateBalance() {
@@ -600,9 +641,11 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr, addr2, newCoins))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(4, len(events))
+	suite.Require().Equal(6, len(events))
 	suite.Require().Equal(abci.Event(event1), events[2])
 	suite.Require().Equal(abci.Event(event2), events[3])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr, newCoins[0])), events[4])
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(addr2, newCoins[0])), events[5])
 }
 
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
@@ -642,7 +685,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(1, len(events))
+	suite.Require().Equal(2, len(events))
 
 	event1 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -652,7 +695,8 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		event1.Attributes,
 		abci.EventAttribute{Key: []byte(types.AttributeKeySender), Value: []byte(addr.String())},
 	)
-	suite.Require().Equal(abci.Event(event1), events[0])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr, newCoins[0])), events[0])
+	suite.Require().Equal(abci.Event(event1), events[1])
 
 	// Set addr's coins and addr2's coins
 	app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 50)))
@@ -664,7 +708,7 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
 
 	events = ctx.EventManager().ABCIEvents()
-	suite.Require().Equal(5, len(events))
+	suite.Require().Equal(10, len(events))
 
 	event2 := sdk.Event{
 		Type:       sdk.EventTypeMessage,
@@ -698,10 +742,86 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 		abci.EventAttribute{Key: []byte(sdk.AttributeKeyAmount), Value: []byte(newCoins2.String())},
 	)
 
-	suite.Require().Equal(abci.Event(event1), events[1])
-	suite.Require().Equal(abci.Event(event2), events[2])
-	suite.Require().Equal(abci.Event(event3), events[3])
-	suite.Require().Equal(abci.Event(event4), events[4])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr, newCoins[0])), events[2])
+	suite.Require().Equal(abci.Event(event1), events[3])
+	suite.Require().Equal(abci.Event(types.NewCoinSpentEvent(addr2, newCoins2[0])), events[4])
+	suite.Require().Equal(abci.Event(event2), events[5])
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(addr3, newCoins[0])), events[6])
+	suite.Require().Equal(abci.Event(event3), events[7])
+	suite.Require().Equal(abci.Event(types.NewCoinReceivedEvent(addr4, newCoins2[0])), events[8])
+	suite.Require().Equal(abci.Event(event4), events[9])
+}
+
+func (suite *IntegrationTestSuite) TestMintBurnCoinsEvents() {
+	app, ctx := suite.app, suite.ctx
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	moduleAddr := app.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	coins := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, govtypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.BurnCoins(ctx, govtypes.ModuleName, coins))
+
+	var spent, received, minted, burned []abci.Event
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		switch event.Type {
+		case types.EventTypeCoinSpent:
+			spent = append(spent, event)
+		case types.EventTypeCoinReceived:
+			received = append(received, event)
+		case types.EventTypeCoinMint:
+			minted = append(minted, event)
+		case types.EventTypeCoinBurn:
+			burned = append(burned, event)
+		}
+	}
+
+	// minting credits the minter, the transfer moves the coins to the burner which
+	// then burns them, every step emitting one event per denom
+	suite.Require().Len(minted, 2)
+	suite.Require().Len(received, 4)
+	suite.Require().Len(spent, 4)
+	suite.Require().Len(burned, 2)
+
+	suite.Require().Equal(abci.Event(types.NewCoinBurnEvent(moduleAddr, newBarCoin(50))), burned[0])
+	suite.Require().Equal(abci.Event(types.NewCoinBurnEvent(moduleAddr, newFooCoin(100))), burned[1])
+}
+
+func (suite *IntegrationTestSuite) TestMsgBurn() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+
+	coins := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins))
+	initialSupply := app.BankKeeper.GetSupply(ctx).GetTotal()
+
+	params := types.DefaultParams()
+	params.BurnEnabledDenoms = []string{fooDenom}
+	app.BankKeeper.SetParams(ctx, params)
+
+	// only the burn enabled denoms can be burned
+	_, err := msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().True(types.ErrBurnDisabled.Is(err))
+
+	// the balance must cover the burned coins
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(101))))
+	suite.Require().Error(err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurn(addr, sdk.NewCoins(newFooCoin(40))))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60), newBarCoin(50)), app.BankKeeper.GetAllBalances(ctx, addr))
+	suite.Require().Equal(initialSupply.Sub(sdk.NewCoins(newFooCoin(40))), app.BankKeeper.GetSupply(ctx).GetTotal())
+
+	events := ctx.EventManager().ABCIEvents()
+	suite.Require().Contains(events, abci.Event(types.NewCoinSpentEvent(addr, newFooCoin(40))))
+	suite.Require().Contains(events, abci.Event(types.NewCoinBurnEvent(addr, newFooCoin(40))))
 }
 
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
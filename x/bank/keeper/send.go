@@ -17,6 +17,7 @@ type SendKeeper interface {
 
 	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	TransferCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error
@@ -30,7 +31,13 @@ type SendKeeper interface {
 	SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	SendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
-	BlockedAddr(addr sdk.AccAddress) bool
+	BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool
+	SetBlockedAddr(ctx sdk.Context, addr sdk.AccAddress)
+	DeleteBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) error
+	IterateBlockedAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool))
+	GetBlockedAddrs(ctx sdk.Context) []sdk.AccAddress
+
+	SetHooks(bh types.BankHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -47,6 +54,8 @@ type BaseSendKeeper struct {
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	hooks *bankHooks
 }
 
 func NewBaseSendKeeper(
@@ -60,6 +69,7 @@ func NewBaseSendKeeper(
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
+		hooks:          &bankHooks{},
 	}
 }
 
@@ -76,7 +86,12 @@ func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
-// inputs and outputs don't lineup or if any single transfer of tokens fails.
+// inputs and outputs don't lineup, if any of the input coins is not enabled for
+// sending or if any single transfer of tokens fails. The bank hooks are called
+// for the transfers returned by multiSendTransfers, the before send hooks
+// before any coins are moved and the after send hooks once all of them have
+// been moved. Multi-sends with several inputs and outputs are rejected when
+// hooks are set.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -84,6 +99,27 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	for _, in := range inputs {
+		if err := k.SendEnabledCoins(ctx, in.Coins...); err != nil {
+			return err
+		}
+	}
+
+	var transfers []transfer
+	if k.hooks.hooks != nil {
+		var err error
+		transfers, err = multiSendTransfers(inputs, outputs)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, t := range transfers {
+		if err := k.beforeSend(ctx, t.from, t.to, t.amt); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
@@ -108,6 +144,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+
 		err = k.AddCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
@@ -132,12 +169,82 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		}
 	}
 
+	for _, t := range transfers {
+		if err := k.afterSend(ctx, t.from, t.to, t.amt); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// transfer is a transfer of coins between two accounts the bank hooks are
+// called for.
+type transfer struct {
+	from, to sdk.AccAddress
+	amt      sdk.Coins
+}
+
+// multiSendTransfers splits a multi-send into the transfers the bank hooks are
+// called for. A multi-send from a single input is a transfer from the input to
+// every output, and a multi-send to a single output is a transfer from every
+// input to the output. With several inputs and outputs, the coins of an input
+// cannot be attributed to an output and an error is returned.
+func multiSendTransfers(inputs []types.Input, outputs []types.Output) ([]transfer, error) {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return nil, err
+		}
+		inAddresses[i] = addr
+	}
+
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return nil, err
+		}
+		outAddresses[i] = addr
+	}
+
+	var transfers []transfer
+	switch {
+	case len(inputs) == 1:
+		for i, out := range outputs {
+			transfers = append(transfers, transfer{from: inAddresses[0], to: outAddresses[i], amt: out.Coins})
+		}
+
+	case len(outputs) == 1:
+		for i, in := range inputs {
+			transfers = append(transfers, transfer{from: inAddresses[i], to: outAddresses[0], amt: in.Coins})
+		}
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrManyToManyMultiSend, "%d inputs, %d outputs", len(inputs), len(outputs))
+	}
+
+	return transfers, nil
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// An error is returned upon failure or if any of the coins is not enabled for
+// sending. The bank hooks are called before and after the coins are moved.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.SendEnabledCoins(ctx, amt...); err != nil {
+		return err
+	}
+
+	return k.TransferCoins(ctx, fromAddr, toAddr, amt)
+}
+
+// TransferCoins transfers amt coins from a sending account to a receiving
+// account like SendCoins but without checking the send enabled status of the
+// coins. It is meant for the transfers initiated by modules which must not be
+// blocked once a denomination is disabled, e.g. IBC escrows and refunds,
+// vesting clawbacks or module account transfers.
+func (k BaseSendKeeper) TransferCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -151,6 +258,10 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	err := k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -171,11 +282,12 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
 	}
 
-	return nil
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // SubtractCoins removes amt coins the account by the given address. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
+// A coin_spent event is emitted for every subtracted denom.
 func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
@@ -199,6 +311,8 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 		if err != nil {
 			return err
 		}
+
+		ctx.EventManager().EmitEvent(types.NewCoinSpentEvent(addr, coin))
 	}
 
 	return nil
@@ -206,7 +320,7 @@ func (k BaseSendKeeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 
 // AddCoins adds amt to the account balance given by the provided address. An
 // error is returned if the initial amount is invalid or if any resulting new
-// balance is negative.
+// balance is negative. A coin_received event is emitted for every added denom.
 func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) error {
 	if !amt.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
@@ -220,6 +334,8 @@ func (k BaseSendKeeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.C
 		if err != nil {
 			return err
 		}
+
+		ctx.EventManager().EmitEvent(types.NewCoinReceivedEvent(addr, coin))
 	}
 
 	return nil
@@ -313,8 +429,57 @@ func (k BaseSendKeeper) SendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
 }
 
-// BlockedAddr checks if a given address is restricted from
-// receiving funds.
-func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
-	return k.blockedAddrs[addr.String()]
+// BlockedAddr checks if a given address is restricted from receiving funds,
+// either because it was provided at construction or blocked by governance.
+func (k BaseSendKeeper) BlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.blockedAddrs[addr.String()] {
+		return true
+	}
+
+	return ctx.KVStore(k.storeKey).Has(types.BlockedAddrKey(addr))
+}
+
+// SetBlockedAddr restricts the given address from receiving funds.
+func (k BaseSendKeeper) SetBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.BlockedAddrKey(addr), []byte{})
+}
+
+// DeleteBlockedAddr lifts the restriction set by SetBlockedAddr on the given
+// address. It returns an error if the address was not blocked by SetBlockedAddr.
+func (k BaseSendKeeper) DeleteBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.BlockedAddrKey(addr)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not blocked by governance", addr)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IterateBlockedAddrs iterates over the addresses blocked with SetBlockedAddr and
+// performs a callback function. Iteration stops when the callback returns true.
+func (k BaseSendKeeper) IterateBlockedAddrs(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedAddrPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetBlockedAddrs returns all the addresses blocked with SetBlockedAddr.
+func (k BaseSendKeeper) GetBlockedAddrs(ctx sdk.Context) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	k.IterateBlockedAddrs(ctx, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+
+	return addrs
 }
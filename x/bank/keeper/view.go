@@ -26,6 +26,7 @@ type ViewKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
@@ -186,6 +187,23 @@ func (k BaseViewKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk
 	return spendable
 }
 
+// SpendableCoin returns the spendable balance of a single denom for an account
+// by address, that is its balance minus the coins locked by vesting. A zero
+// coin is returned if the whole balance is locked.
+func (k BaseViewKeeper) SpendableCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return spendableCoin(k.GetBalance(ctx, addr, denom), k.LockedCoins(ctx, addr))
+}
+
+// spendableCoin returns the part of balance which is not locked.
+func spendableCoin(balance sdk.Coin, locked sdk.Coins) sdk.Coin {
+	spendable := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+	if spendable.IsNegative() {
+		return sdk.NewCoin(balance.Denom, sdk.ZeroInt())
+	}
+
+	return sdk.NewCoin(balance.Denom, spendable)
+}
+
 // ValidateBalance validates all balances for a given account address returning
 // an error if any balance is invalid. It will check for vesting account types
 // and validate the balances against the original vesting balances.
@@ -0,0 +1,90 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestSetDenomMetadataProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := bank.NewProposalHandler(app.BankKeeper)
+
+	metadata := types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: uint32(0), Aliases: []string{"microatom"}},
+			{Denom: "atom", Exponent: uint32(6)},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+
+	require.NoError(t, hdlr(ctx, types.NewSetDenomMetadataProposal("title", "description", []types.Metadata{metadata})))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// update the existing metadata
+	metadata.Description = "Updated description"
+	metadata.DenomUnits = append(metadata.DenomUnits, &types.DenomUnit{Denom: "katom", Exponent: uint32(9)})
+	require.NoError(t, hdlr(ctx, types.NewSetDenomMetadataProposal("title", "description", []types.Metadata{metadata})))
+	require.Equal(t, metadata, app.BankKeeper.GetDenomMetaData(ctx, "uatom"))
+
+	// invalid metadata are rejected without being stored
+	invalid := types.Metadata{Base: "ufoo", Display: "foo"}
+	require.Error(t, hdlr(ctx, types.NewSetDenomMetadataProposal("title", "description", []types.Metadata{invalid})))
+	require.Equal(t, types.Metadata{}, app.BankKeeper.GetDenomMetaData(ctx, "ufoo"))
+
+	// other proposal types are rejected
+	require.Error(t, hdlr(ctx, distrtypes.NewCommunityPoolSpendProposal("title", "description", nil, nil)))
+}
+
+func TestUpdateSendEnabledProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := bank.NewProposalHandler(app.BankKeeper)
+
+	app.BankKeeper.SetParams(ctx, types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled("bar", false)}))
+
+	proposal := types.NewUpdateSendEnabledProposal(
+		"title", "description",
+		[]types.SendEnabled{{Denom: "foo", Enabled: false}}, []string{"bar"},
+	)
+	require.NoError(t, hdlr(ctx, proposal))
+
+	params := app.BankKeeper.GetParams(ctx)
+	require.Equal(t, []*types.SendEnabled{types.NewSendEnabled("foo", false)}, params.SendEnabled)
+	require.True(t, params.DefaultSendEnabled)
+	require.False(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewInt64Coin("foo", 1)))
+	require.True(t, app.BankKeeper.SendEnabledCoin(ctx, sdk.NewInt64Coin("bar", 1)))
+}
+
+func TestUpdateBlockedAddressesProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := bank.NewProposalHandler(app.BankKeeper)
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	proposal := types.NewUpdateBlockedAddressesProposal("title", "description", []string{addr1.String(), addr2.String()}, nil)
+	require.NoError(t, hdlr(ctx, proposal))
+	require.True(t, app.BankKeeper.BlockedAddr(ctx, addr1))
+	require.True(t, app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	proposal = types.NewUpdateBlockedAddressesProposal("title", "description", nil, []string{addr1.String()})
+	require.NoError(t, hdlr(ctx, proposal))
+	require.False(t, app.BankKeeper.BlockedAddr(ctx, addr1))
+	require.True(t, app.BankKeeper.BlockedAddr(ctx, addr2))
+
+	// addresses which are not blocked through governance cannot be unblocked
+	proposal = types.NewUpdateBlockedAddressesProposal("title", "description", nil, []string{addr1.String()})
+	require.Error(t, hdlr(ctx, proposal))
+}
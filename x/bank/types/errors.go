@@ -11,4 +11,7 @@ var (
 	ErrInputOutputMismatch   = sdkerrors.Register(ModuleName, 4, "sum inputs != sum outputs")
 	ErrSendDisabled          = sdkerrors.Register(ModuleName, 5, "send transactions are disabled")
 	ErrDenomMetadataNotFound = sdkerrors.Register(ModuleName, 6, "client denom metadata not found")
+	ErrInvalidDenomMetadata  = sdkerrors.Register(ModuleName, 7, "invalid denom metadata")
+	ErrBurnDisabled          = sdkerrors.Register(ModuleName, 8, "burning is disabled for the denom")
+	ErrManyToManyMultiSend   = sdkerrors.Register(ModuleName, 9, "multi-send with several inputs and outputs is not supported with bank hooks")
 )
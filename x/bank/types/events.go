@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // bank module event types
 const (
 	EventTypeTransfer = "transfer"
@@ -8,4 +12,44 @@ const (
 	AttributeKeySender    = "sender"
 
 	AttributeValueCategory = ModuleName
+
+	// balance tracking event types, emitted once per denom every time the
+	// balance of an account is updated so that indexers can follow balances
+	// without replaying the state machine logic.
+	EventTypeCoinSpent    = "coin_spent"
+	EventTypeCoinReceived = "coin_received"
+	EventTypeCoinMint     = "coinbase" // "mint" is already used by the x/mint module
+	EventTypeCoinBurn     = "burn"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyDenom   = "denom"
 )
+
+// NewCoinSpentEvent constructs a new coin spent sdk.Event
+func NewCoinSpentEvent(spender sdk.AccAddress, coin sdk.Coin) sdk.Event {
+	return newBalanceEvent(EventTypeCoinSpent, spender, coin)
+}
+
+// NewCoinReceivedEvent constructs a new coin received sdk.Event
+func NewCoinReceivedEvent(receiver sdk.AccAddress, coin sdk.Coin) sdk.Event {
+	return newBalanceEvent(EventTypeCoinReceived, receiver, coin)
+}
+
+// NewCoinMintEvent constructs a new coin minted sdk.Event
+func NewCoinMintEvent(minter sdk.AccAddress, coin sdk.Coin) sdk.Event {
+	return newBalanceEvent(EventTypeCoinMint, minter, coin)
+}
+
+// NewCoinBurnEvent constructs a new coin burned sdk.Event
+func NewCoinBurnEvent(burner sdk.AccAddress, coin sdk.Coin) sdk.Event {
+	return newBalanceEvent(EventTypeCoinBurn, burner, coin)
+}
+
+func newBalanceEvent(eventType string, addr sdk.AccAddress, coin sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(AttributeKeyAddress, addr.String()),
+		sdk.NewAttribute(AttributeKeyDenom, coin.Denom),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coin.Amount.String()),
+	)
+}
@@ -25,3 +25,23 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 }
+
+// BankHooks event hooks for coin transfers, allowing other modules to restrict,
+// tax or track the transfers performed by the bank keeper.
+//
+// The blocking hooks are able to abort a transfer by returning an error, while
+// the tracking hooks cannot: their state changes are discarded if they panic and
+// the transfer goes through regardless. The from and to addresses are always
+// set, as multi-sends which cannot be split into transfers between two accounts
+// are rejected.
+type BankHooks interface {
+	// BeforeSend is called before the coins are moved, an error aborts the transfer.
+	BeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+	// AfterSend is called once the coins have been moved, an error aborts the transfer.
+	AfterSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
+
+	// TrackBeforeSend is called before the coins are moved and cannot abort the transfer.
+	TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins)
+	// TrackAfterSend is called once the coins have been moved and cannot abort the transfer.
+	TrackAfterSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins)
+}
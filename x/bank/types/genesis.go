@@ -42,6 +42,19 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenBlockedAddrs := make(map[string]bool)
+	for _, addr := range gs.BlockedAddresses {
+		if seenBlockedAddrs[addr] {
+			return fmt.Errorf("duplicate blocked address %s", addr)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", addr, err)
+		}
+
+		seenBlockedAddrs[addr] = true
+	}
+
 	// NOTE: this errors if supply for any given coin is zero
 	return NewSupply(gs.Supply).ValidateBasic()
 }
@@ -35,6 +35,9 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata" yaml:"denom_metadata"`
+	// blocked_addresses defines the addresses blocked from receiving funds in
+	// addition to the ones provided at application construction.
+	BlockedAddresses []string `protobuf:"bytes,5,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty" yaml:"blocked_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -98,6 +101,13 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -148,31 +158,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0x87, 0x5b, 0x40, 0xc0, 0x43, 0x1d, 0xaa, 0x26, 0x15, 0xa5, 0xc5, 0x4e, 0x38, 0xd8, 0x0a,
-	0x4e, 0x32, 0x38, 0x94, 0xc1, 0xc9, 0xc4, 0xd4, 0xcd, 0xc5, 0x5c, 0xdb, 0x4b, 0x6d, 0xa0, 0xbd,
-	0x86, 0x3b, 0x8c, 0x7c, 0x03, 0x47, 0x3e, 0x02, 0xb3, 0x9f, 0x84, 0x91, 0xc4, 0xc5, 0x09, 0x0d,
-	0x2c, 0xce, 0x7e, 0x02, 0xd3, 0xbb, 0xa3, 0x6a, 0x24, 0x4e, 0x4e, 0xfd, 0xf3, 0xfe, 0x9e, 0xe7,
-	0xbd, 0xf7, 0xee, 0xc0, 0xa1, 0x87, 0x49, 0x84, 0x89, 0xe5, 0xc2, 0xb8, 0x6b, 0xdd, 0x37, 0x5d,
-	0x44, 0x61, 0xd3, 0x0a, 0x50, 0x8c, 0x48, 0x48, 0xcc, 0xa4, 0x8f, 0x29, 0x56, 0xb6, 0x79, 0xc4,
-	0x4c, 0x23, 0xa6, 0x88, 0x54, 0x77, 0x02, 0x1c, 0x60, 0x56, 0xb7, 0xd2, 0x37, 0x1e, 0xad, 0x6a,
-	0x99, 0x8d, 0xa0, 0xcc, 0xe6, 0xe1, 0x30, 0xfe, 0x55, 0xff, 0xd6, 0x8d, 0x79, 0x59, 0xdd, 0x78,
-	0xce, 0x81, 0x8d, 0x0b, 0xde, 0xfc, 0x9a, 0x42, 0x8a, 0x94, 0x33, 0x50, 0x4c, 0x60, 0x1f, 0x46,
-	0x44, 0x95, 0xeb, 0x72, 0xa3, 0xd2, 0xda, 0x37, 0x57, 0x2c, 0xc6, 0xbc, 0x62, 0x11, 0xbb, 0x30,
-	0x99, 0xe9, 0x92, 0x23, 0x00, 0xe5, 0x1c, 0x94, 0x5d, 0xd8, 0x83, 0xb1, 0x87, 0x88, 0x9a, 0xab,
-	0xe7, 0x1b, 0x95, 0xd6, 0xc1, 0x4a, 0xd8, 0xe6, 0x21, 0x41, 0x67, 0x8c, 0xe2, 0x81, 0x22, 0x19,
-	0x24, 0x49, 0x6f, 0xa8, 0xe6, 0x19, 0xbd, 0xf7, 0x45, 0x13, 0x94, 0xd1, 0x1d, 0x1c, 0xc6, 0xf6,
-	0x49, 0x8a, 0x3e, 0xbd, 0xea, 0x8d, 0x20, 0xa4, 0x77, 0x03, 0xd7, 0xf4, 0x70, 0x64, 0x89, 0x49,
-	0xf9, 0xe3, 0x98, 0xf8, 0x5d, 0x8b, 0x0e, 0x13, 0x44, 0x18, 0x40, 0x1c, 0xa1, 0x56, 0x3c, 0xb0,
-	0xe5, 0xa3, 0x18, 0x47, 0xb7, 0x11, 0xa2, 0xd0, 0x87, 0x14, 0xaa, 0x05, 0xd6, 0xac, 0xb6, 0x72,
-	0xa9, 0x97, 0x22, 0x64, 0xd7, 0xd2, 0x86, 0x1f, 0x33, 0x7d, 0x77, 0x08, 0xa3, 0x5e, 0xdb, 0xf8,
-	0xa9, 0x30, 0x9c, 0x4d, 0xf6, 0x63, 0x99, 0x36, 0x46, 0x32, 0x28, 0x89, 0x29, 0x15, 0x15, 0x94,
-	0xa0, 0xef, 0xf7, 0x11, 0xe1, 0x3b, 0xba, 0xee, 0x2c, 0x3f, 0x15, 0x08, 0xd6, 0xd2, 0x93, 0x5a,
-	0x6e, 0xd6, 0xbf, 0x8e, 0xcb, 0xcd, 0xed, 0xf2, 0xe3, 0x58, 0x97, 0xde, 0xc7, 0xba, 0x64, 0x77,
-	0x26, 0x73, 0x4d, 0x9e, 0xce, 0x35, 0xf9, 0x6d, 0xae, 0xc9, 0xa3, 0x85, 0x26, 0x4d, 0x17, 0x9a,
-	0xf4, 0xb2, 0xd0, 0xa4, 0x9b, 0xa3, 0x3f, 0xa5, 0x0f, 0xfc, 0xea, 0x30, 0xb7, 0x5b, 0x64, 0x97,
-	0xe6, 0xf4, 0x33, 0x00, 0x00, 0xff, 0xff, 0x15, 0x28, 0xf0, 0x44, 0xc4, 0x02, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0x87, 0x13, 0xd2, 0xbf, 0x2e, 0x20, 0x30, 0x20, 0x85, 0xd2, 0x26, 0x25, 0x53, 0x18, 0x48,
+	0x68, 0x99, 0xe8, 0x80, 0x44, 0x3a, 0x20, 0x06, 0x24, 0x14, 0x36, 0x96, 0xca, 0x49, 0xac, 0x10,
+	0x35, 0x89, 0xa3, 0xda, 0x45, 0xf4, 0x0d, 0x18, 0xfb, 0x04, 0xa8, 0x33, 0x4f, 0xd2, 0xb1, 0x23,
+	0x53, 0x41, 0xed, 0xc2, 0xdc, 0x27, 0x40, 0xb1, 0xdd, 0xc0, 0x55, 0xab, 0x3b, 0xdd, 0x29, 0x89,
+	0xcf, 0xf7, 0xfb, 0x8e, 0x73, 0x6c, 0xf0, 0x34, 0x24, 0x34, 0x23, 0xd4, 0x0d, 0x50, 0x3e, 0x73,
+	0xbf, 0x0c, 0x03, 0xcc, 0xd0, 0xd0, 0x8d, 0x71, 0x8e, 0x69, 0x42, 0x9d, 0x62, 0x4e, 0x18, 0x81,
+	0x0f, 0x04, 0xe2, 0x94, 0x88, 0x23, 0x91, 0xee, 0xc3, 0x98, 0xc4, 0x84, 0xd7, 0xdd, 0xf2, 0x4d,
+	0xa0, 0x5d, 0xa3, 0xb2, 0x51, 0x5c, 0xd9, 0x42, 0x92, 0xe4, 0x67, 0xf5, 0xff, 0xba, 0x71, 0x2f,
+	0xaf, 0x5b, 0xdf, 0x35, 0x70, 0xfb, 0xad, 0x68, 0xfe, 0x91, 0x21, 0x86, 0xe1, 0x2b, 0xd0, 0x28,
+	0xd0, 0x1c, 0x65, 0x54, 0x57, 0x07, 0xaa, 0xdd, 0x19, 0x3d, 0x71, 0x2e, 0x6c, 0xc6, 0xf9, 0xc0,
+	0x11, 0xaf, 0xb6, 0xd9, 0x99, 0x8a, 0x2f, 0x03, 0xf0, 0x35, 0x68, 0x05, 0x28, 0x45, 0x79, 0x88,
+	0xa9, 0x7e, 0x6b, 0xa0, 0xd9, 0x9d, 0x51, 0xef, 0x62, 0xd8, 0x13, 0x90, 0x4c, 0x57, 0x19, 0x18,
+	0x82, 0x06, 0x5d, 0x14, 0x45, 0xba, 0xd4, 0x35, 0x9e, 0x7e, 0xfc, 0x2f, 0x4d, 0x71, 0x95, 0x9e,
+	0x90, 0x24, 0xf7, 0x5e, 0x94, 0xd1, 0x1f, 0xbf, 0x4c, 0x3b, 0x4e, 0xd8, 0xe7, 0x45, 0xe0, 0x84,
+	0x24, 0x73, 0xe5, 0x9f, 0x8a, 0xc7, 0x73, 0x1a, 0xcd, 0x5c, 0xb6, 0x2c, 0x30, 0xe5, 0x01, 0xea,
+	0x4b, 0x35, 0x0c, 0xc1, 0xdd, 0x08, 0xe7, 0x24, 0x9b, 0x66, 0x98, 0xa1, 0x08, 0x31, 0xa4, 0xd7,
+	0x78, 0xb3, 0xfe, 0xc5, 0xad, 0xbe, 0x97, 0x90, 0xd7, 0x2f, 0x1b, 0x1e, 0x77, 0xe6, 0xa3, 0x25,
+	0xca, 0xd2, 0xb1, 0x75, 0x55, 0x61, 0xf9, 0x77, 0xf8, 0xc2, 0x89, 0x86, 0xef, 0xc0, 0xfd, 0x20,
+	0x25, 0xe1, 0x0c, 0x47, 0x53, 0x14, 0x45, 0x73, 0x4c, 0x29, 0xa6, 0x7a, 0x7d, 0xa0, 0xd9, 0x6d,
+	0xaf, 0x77, 0xdc, 0x99, 0xba, 0x90, 0x9c, 0x21, 0x96, 0x7f, 0x4f, 0xae, 0xbd, 0xa9, 0x96, 0x56,
+	0x2a, 0x68, 0xca, 0x81, 0x41, 0x1d, 0x34, 0x25, 0xcb, 0x0f, 0xa7, 0xed, 0x9f, 0x3e, 0x21, 0x02,
+	0xf5, 0xf2, 0xd0, 0x4f, 0x73, 0xbf, 0xd1, 0xc9, 0x09, 0xf3, 0xb8, 0xf5, 0x6d, 0x6d, 0x2a, 0x7f,
+	0xd6, 0xa6, 0xe2, 0x4d, 0x36, 0x7b, 0x43, 0xdd, 0xee, 0x0d, 0xf5, 0xf7, 0xde, 0x50, 0x57, 0x07,
+	0x43, 0xd9, 0x1e, 0x0c, 0xe5, 0xe7, 0xc1, 0x50, 0x3e, 0x3d, 0xbb, 0x56, 0xfa, 0x55, 0xdc, 0x42,
+	0xee, 0x0e, 0x1a, 0xfc, 0xfe, 0xbd, 0xfc, 0x3b, 0x00, 0x9f, 0x35, 0x69, 0xaa, 0x0f, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +208,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -331,6 +353,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -523,6 +551,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,45 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ BankHooks = MultiBankHooks{}
+
+// MultiBankHooks combines multiple bank hooks, all hook functions are run in
+// array sequence. The blocking hooks stop at the first error.
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, from, to, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterSend(ctx, from, to, amt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiBankHooks) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) {
+	for i := range h {
+		h[i].TrackBeforeSend(ctx, from, to, amt)
+	}
+}
+
+func (h MultiBankHooks) TrackAfterSend(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) {
+	for i := range h {
+		h[i].TrackAfterSend(ctx, from, to, amt)
+	}
+}
@@ -25,6 +25,7 @@ var (
 	BalancesPrefix      = []byte("balances")
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
+	BlockedAddrPrefix   = []byte{0x2}
 )
 
 // DenomMetadataKey returns the denomination metadata key.
@@ -33,6 +34,11 @@ func DenomMetadataKey(denom string) []byte {
 	return append(DenomMetadataPrefix, d...)
 }
 
+// BlockedAddrKey returns the key of an address blocked from receiving funds.
+func BlockedAddrKey(addr sdk.AccAddress) []byte {
+	return append(BlockedAddrPrefix, addr.Bytes()...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -72,6 +72,38 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%v", res), "[696E707574313131313131313131313131313131]")
 }
 
+func TestMsgBurnRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	var msg = NewMsgBurn(addr1, coins)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "burn")
+}
+
+func TestMsgBurnValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from________________"))
+	addrEmpty := sdk.AccAddress([]byte(""))
+
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	require.NoError(t, NewMsgBurn(addr1, atom123).ValidateBasic())
+	require.Error(t, NewMsgBurn(addr1, atom0).ValidateBasic())
+	require.Error(t, NewMsgBurn(addr1, sdk.NewCoins()).ValidateBasic())
+	require.Error(t, NewMsgBurn(addrEmpty, atom123).ValidateBasic())
+}
+
+func TestMsgBurnGetSignBytes(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	var msg = NewMsgBurn(addr1, coins)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgBurn","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"cosmos1d9h8qat57ljhcm"}}`
+	require.Equal(t, expected, string(res))
+}
+
 func TestMsgMultiSendRoute(t *testing.T) {
 	// Construct a MsgSend
 	addr1 := sdk.AccAddress([]byte("input"))
@@ -19,6 +19,8 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyDefaultSendEnabled is store's key for the DefaultSendEnabled option
 	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+	// KeyBurnEnabledDenoms is store's key for the BurnEnabledDenoms Params
+	KeyBurnEnabledDenoms = []byte("BurnEnabledDenoms")
 )
 
 // ParamKeyTable for bank module.
@@ -40,6 +42,8 @@ func DefaultParams() Params {
 		SendEnabled: SendEnabledParams{},
 		// The default send enabled value allows send transfers for all coin denoms
 		DefaultSendEnabled: true,
+		// No coin denom can be burned by accounts by default
+		BurnEnabledDenoms: []string{},
 	}
 }
 
@@ -48,7 +52,10 @@ func (p Params) Validate() error {
 	if err := validateSendEnabledParams(p.SendEnabled); err != nil {
 		return err
 	}
-	return validateIsBool(p.DefaultSendEnabled)
+	if err := validateIsBool(p.DefaultSendEnabled); err != nil {
+		return err
+	}
+	return validateBurnEnabledDenoms(p.BurnEnabledDenoms)
 }
 
 // String implements the Stringer interface.
@@ -67,6 +74,16 @@ func (p Params) SendEnabledDenom(denom string) bool {
 	return p.DefaultSendEnabled
 }
 
+// BurnEnabledDenom returns true if the given denom can be burned by accounts
+func (p Params) BurnEnabledDenom(denom string) bool {
+	for _, d := range p.BurnEnabledDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
 // SetSendEnabledParam returns an updated set of Parameters with the given denom
 // send enabled flag set.
 func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
@@ -77,7 +94,21 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 		}
 	}
 	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
-	return NewParams(p.DefaultSendEnabled, sendParams)
+	p.SendEnabled = sendParams
+	return p
+}
+
+// DeleteSendEnabledParam returns an updated set of Parameters without the send
+// enabled flag of the given denom, which then falls back to the default.
+func (p Params) DeleteSendEnabledParam(denom string) Params {
+	var sendParams SendEnabledParams
+	for _, p := range p.SendEnabled {
+		if p.Denom != denom {
+			sendParams = append(sendParams, NewSendEnabled(p.Denom, p.Enabled))
+		}
+	}
+	p.SendEnabled = sendParams
+	return p
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -85,6 +116,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+		paramtypes.NewParamSetPair(KeyBurnEnabledDenoms, &p.BurnEnabledDenoms, validateBurnEnabledDenoms),
 	}
 }
 
@@ -133,6 +165,25 @@ func validateSendEnabled(i interface{}) error {
 	return sdk.ValidateDenom(param.Denom)
 }
 
+func validateBurnEnabledDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	registered := make(map[string]bool)
+	for _, denom := range denoms {
+		if registered[denom] {
+			return fmt.Errorf("duplicate burn enabled denom found: '%s'", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		registered[denom] = true
+	}
+	return nil
+}
+
 func validateIsBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -48,6 +48,34 @@ func Test_sendParamEqual(t *testing.T) {
 	require.False(t, ok)
 }
 
+func Test_deleteSendEnabledParam(t *testing.T) {
+	params := DefaultParams().SetSendEnabledParam("foo", false).SetSendEnabledParam("bar", true)
+	require.False(t, params.SendEnabledDenom("foo"))
+
+	params = params.DeleteSendEnabledParam("foo")
+	require.Equal(t, []*SendEnabled{NewSendEnabled("bar", true)}, params.SendEnabled)
+	require.True(t, params.SendEnabledDenom("foo"))
+}
+
+func Test_validateBurnEnabledDenoms(t *testing.T) {
+	require.NoError(t, validateBurnEnabledDenoms([]string{}))
+	require.NoError(t, validateBurnEnabledDenoms([]string{"foo", "bar"}))
+	require.Error(t, validateBurnEnabledDenoms([]string{"foo", "foo"}))
+	require.Error(t, validateBurnEnabledDenoms([]string{"0FOO"}))
+	require.Error(t, validateBurnEnabledDenoms("foo"))
+
+	params := DefaultParams()
+	require.False(t, params.BurnEnabledDenom("foo"))
+
+	params.BurnEnabledDenoms = []string{"foo"}
+	require.True(t, params.BurnEnabledDenom("foo"))
+	require.False(t, params.BurnEnabledDenom("bar"))
+
+	// updating the send enabled entries keeps the burn enabled denoms
+	params = params.SetSendEnabledParam("foo", false).DeleteSendEnabledParam("foo")
+	require.Equal(t, []string{"foo"}, params.BurnEnabledDenoms)
+}
+
 func Test_sendParamString(t *testing.T) {
 	paramString := "denom: foo\nenabled: false\n"
 	param := NewSendEnabled("foo", false)
@@ -0,0 +1,226 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetDenomMetadata defines the type for a SetDenomMetadataProposal
+	ProposalTypeSetDenomMetadata = "SetDenomMetadata"
+	// ProposalTypeUpdateSendEnabled defines the type for an UpdateSendEnabledProposal
+	ProposalTypeUpdateSendEnabled = "UpdateSendEnabled"
+	// ProposalTypeUpdateBlockedAddresses defines the type for an UpdateBlockedAddressesProposal
+	ProposalTypeUpdateBlockedAddresses = "UpdateBlockedAddresses"
+)
+
+// Assert the bank proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &SetDenomMetadataProposal{}
+	_ govtypes.Content = &UpdateSendEnabledProposal{}
+	_ govtypes.Content = &UpdateBlockedAddressesProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
+	govtypes.RegisterProposalTypeCodec(&SetDenomMetadataProposal{}, "cosmos-sdk/SetDenomMetadataProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateSendEnabled)
+	govtypes.RegisterProposalTypeCodec(&UpdateSendEnabledProposal{}, "cosmos-sdk/UpdateSendEnabledProposal")
+	govtypes.RegisterProposalType(ProposalTypeUpdateBlockedAddresses)
+	govtypes.RegisterProposalTypeCodec(&UpdateBlockedAddressesProposal{}, "cosmos-sdk/UpdateBlockedAddressesProposal")
+}
+
+// NewSetDenomMetadataProposal creates a new proposal registering or updating the
+// given denominations metadata.
+func NewSetDenomMetadataProposal(title, description string, metadata []Metadata) *SetDenomMetadataProposal {
+	return &SetDenomMetadataProposal{title, description, metadata}
+}
+
+// GetTitle returns the title of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) GetTitle() string { return sdmp.Title }
+
+// GetDescription returns the description of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) GetDescription() string { return sdmp.Description }
+
+// ProposalRoute returns the routing key of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set denom metadata proposal.
+func (sdmp *SetDenomMetadataProposal) ProposalType() string { return ProposalTypeSetDenomMetadata }
+
+// ValidateBasic runs basic stateless validity checks
+func (sdmp *SetDenomMetadataProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sdmp); err != nil {
+		return err
+	}
+
+	if len(sdmp.Metadata) == 0 {
+		return sdkerrors.Wrap(ErrInvalidDenomMetadata, "proposal must contain at least one denom metadata")
+	}
+
+	seenBases := make(map[string]bool)
+	for _, metadata := range sdmp.Metadata {
+		if seenBases[metadata.Base] {
+			return sdkerrors.Wrapf(ErrInvalidDenomMetadata, "duplicate metadata for base denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidDenomMetadata, err.Error())
+		}
+
+		seenBases[metadata.Base] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sdmp SetDenomMetadataProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Denom Metadata Proposal:
+  Title:       %s
+  Description: %s
+  Metadata:
+`, sdmp.Title, sdmp.Description))
+
+	for _, metadata := range sdmp.Metadata {
+		b.WriteString(fmt.Sprintf("    Base: %s, Display: %s, Description: %s\n",
+			metadata.Base, metadata.Display, metadata.Description))
+	}
+
+	return b.String()
+}
+
+// NewUpdateSendEnabledProposal creates a new proposal setting the send enabled
+// entries of the given denoms and removing the entries of the useDefaultFor denoms.
+func NewUpdateSendEnabledProposal(title, description string, sendEnabled []SendEnabled, useDefaultFor []string) *UpdateSendEnabledProposal {
+	return &UpdateSendEnabledProposal{title, description, sendEnabled, useDefaultFor}
+}
+
+// GetTitle returns the title of an update send enabled proposal.
+func (usep *UpdateSendEnabledProposal) GetTitle() string { return usep.Title }
+
+// GetDescription returns the description of an update send enabled proposal.
+func (usep *UpdateSendEnabledProposal) GetDescription() string { return usep.Description }
+
+// ProposalRoute returns the routing key of an update send enabled proposal.
+func (usep *UpdateSendEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update send enabled proposal.
+func (usep *UpdateSendEnabledProposal) ProposalType() string { return ProposalTypeUpdateSendEnabled }
+
+// ValidateBasic runs basic stateless validity checks
+func (usep *UpdateSendEnabledProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(usep); err != nil {
+		return err
+	}
+
+	if len(usep.SendEnabled) == 0 && len(usep.UseDefaultFor) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal must update at least one denom")
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, se := range usep.SendEnabled {
+		if seenDenoms[se.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %s", se.Denom)
+		}
+
+		if err := validateSendEnabled(se); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		seenDenoms[se.Denom] = true
+	}
+
+	for _, denom := range usep.UseDefaultFor {
+		if seenDenoms[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %s", denom)
+		}
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (usep UpdateSendEnabledProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Send Enabled Proposal:
+  Title:       %s
+  Description: %s
+  Send Enabled:
+`, usep.Title, usep.Description))
+
+	for _, se := range usep.SendEnabled {
+		b.WriteString(fmt.Sprintf("    %s: %t\n", se.Denom, se.Enabled))
+	}
+
+	b.WriteString(fmt.Sprintf("  Use Default For: %s\n", strings.Join(usep.UseDefaultFor, ", ")))
+
+	return b.String()
+}
+
+// NewUpdateBlockedAddressesProposal creates a new proposal blocking and unblocking
+// the given addresses from receiving funds.
+func NewUpdateBlockedAddressesProposal(title, description string, block, unblock []string) *UpdateBlockedAddressesProposal {
+	return &UpdateBlockedAddressesProposal{title, description, block, unblock}
+}
+
+// GetTitle returns the title of an update blocked addresses proposal.
+func (ubap *UpdateBlockedAddressesProposal) GetTitle() string { return ubap.Title }
+
+// GetDescription returns the description of an update blocked addresses proposal.
+func (ubap *UpdateBlockedAddressesProposal) GetDescription() string { return ubap.Description }
+
+// ProposalRoute returns the routing key of an update blocked addresses proposal.
+func (ubap *UpdateBlockedAddressesProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update blocked addresses proposal.
+func (ubap *UpdateBlockedAddressesProposal) ProposalType() string {
+	return ProposalTypeUpdateBlockedAddresses
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ubap *UpdateBlockedAddressesProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ubap); err != nil {
+		return err
+	}
+
+	if len(ubap.Block) == 0 && len(ubap.Unblock) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal must update at least one address")
+	}
+
+	seenAddrs := make(map[string]bool)
+	for _, addr := range append(append([]string{}, ubap.Block...), ubap.Unblock...) {
+		if seenAddrs[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address %s", addr)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s: %s", addr, err)
+		}
+
+		seenAddrs[addr] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ubap UpdateBlockedAddressesProposal) String() string {
+	return fmt.Sprintf(`Update Blocked Addresses Proposal:
+  Title:       %s
+  Description: %s
+  Block:       %s
+  Unblock:     %s
+`, ubap.Title, ubap.Description, strings.Join(ubap.Block, ", "), strings.Join(ubap.Unblock, ", "))
+}
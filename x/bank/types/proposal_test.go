@@ -0,0 +1,172 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func atomMetadata() types.Metadata {
+	return types.Metadata{
+		Description: "The native staking token of the Cosmos Hub.",
+		DenomUnits: []*types.DenomUnit{
+			{Denom: "uatom", Exponent: uint32(0), Aliases: []string{"microatom"}},
+			{Denom: "atom", Exponent: uint32(6)},
+		},
+		Base:    "uatom",
+		Display: "atom",
+	}
+}
+
+func TestSetDenomMetadataProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.SetDenomMetadataProposal
+		expErr   bool
+	}{
+		{
+			"valid proposal",
+			types.NewSetDenomMetadataProposal("title", "description", []types.Metadata{atomMetadata()}),
+			false,
+		},
+		{
+			"empty title",
+			types.NewSetDenomMetadataProposal("", "description", []types.Metadata{atomMetadata()}),
+			true,
+		},
+		{
+			"no metadata",
+			types.NewSetDenomMetadataProposal("title", "description", nil),
+			true,
+		},
+		{
+			"invalid metadata",
+			types.NewSetDenomMetadataProposal("title", "description", []types.Metadata{{Base: "uatom"}}),
+			true,
+		},
+		{
+			"duplicate base denom",
+			types.NewSetDenomMetadataProposal("title", "description", []types.Metadata{atomMetadata(), atomMetadata()}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+				require.Equal(t, types.ProposalTypeSetDenomMetadata, tc.proposal.ProposalType())
+			}
+		})
+	}
+}
+
+func TestUpdateSendEnabledProposalValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name     string
+		proposal *types.UpdateSendEnabledProposal
+		expErr   bool
+	}{
+		{
+			"valid proposal",
+			types.NewUpdateSendEnabledProposal("title", "description", []types.SendEnabled{{Denom: "foo", Enabled: false}}, []string{"bar"}),
+			false,
+		},
+		{
+			"empty description",
+			types.NewUpdateSendEnabledProposal("title", "", []types.SendEnabled{{Denom: "foo", Enabled: false}}, nil),
+			true,
+		},
+		{
+			"no update",
+			types.NewUpdateSendEnabledProposal("title", "description", nil, nil),
+			true,
+		},
+		{
+			"invalid denom",
+			types.NewUpdateSendEnabledProposal("title", "description", []types.SendEnabled{{Denom: "1foo", Enabled: false}}, nil),
+			true,
+		},
+		{
+			"invalid default denom",
+			types.NewUpdateSendEnabledProposal("title", "description", nil, []string{"1bar"}),
+			true,
+		},
+		{
+			"duplicate denom",
+			types.NewUpdateSendEnabledProposal("title", "description", []types.SendEnabled{{Denom: "foo", Enabled: false}}, []string{"foo"}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.ProposalTypeUpdateSendEnabled, tc.proposal.ProposalType())
+			}
+		})
+	}
+}
+
+func TestUpdateBlockedAddressesProposalValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+
+	testCases := []struct {
+		name     string
+		proposal *types.UpdateBlockedAddressesProposal
+		expErr   bool
+	}{
+		{
+			"valid proposal",
+			types.NewUpdateBlockedAddressesProposal("title", "description", []string{addr1}, []string{addr2}),
+			false,
+		},
+		{
+			"empty title",
+			types.NewUpdateBlockedAddressesProposal("", "description", []string{addr1}, nil),
+			true,
+		},
+		{
+			"no update",
+			types.NewUpdateBlockedAddressesProposal("title", "description", nil, nil),
+			true,
+		},
+		{
+			"invalid address",
+			types.NewUpdateBlockedAddressesProposal("title", "description", []string{"cosmos1invalid"}, nil),
+			true,
+		},
+		{
+			"duplicate address",
+			types.NewUpdateBlockedAddressesProposal("title", "description", []string{addr1}, []string{addr1}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, types.ProposalTypeUpdateBlockedAddresses, tc.proposal.ProposalType())
+			}
+		})
+	}
+}
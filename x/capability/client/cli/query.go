@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the parent command for all x/capability CLI query commands.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryCapabilities(),
+		GetCmdQueryCapability(),
+		GetCmdQueryModuleCapabilities(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryCapabilities implements the query capabilities command.
+func GetCmdQueryCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capabilities",
+		Short: "Query all the capabilities and their owners",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the capabilities along with the module and name pairs owning them.
+
+Example:
+$ %s query %s capabilities
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Capabilities(context.Background(), &types.QueryCapabilitiesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "capabilities")
+
+	return cmd
+}
+
+// GetCmdQueryCapability implements the query capability command.
+func GetCmdQueryCapability() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capability [index]",
+		Short: "Query the owners of a capability by index",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the module and name pairs owning the capability with the given index.
+
+Example:
+$ %s query %s capability 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("capability index %s not a valid uint, please input a valid index", args[0])
+			}
+
+			res, err := queryClient.Capability(context.Background(), &types.QueryCapabilityRequest{Index: index})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Capability)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryModuleCapabilities implements the query module-capabilities command.
+func GetCmdQueryModuleCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-capabilities [module]",
+		Short: "Query the capabilities owned by a module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the capabilities owned by a module along with the name it owns them under.
+
+Example:
+$ %s query %s module-capabilities transfer
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ModuleCapabilities(context.Background(), &types.QueryModuleCapabilitiesRequest{
+				Module:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module capabilities")
+
+	return cmd
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Capabilities implements the Query/Capabilities gRPC method
+func (k Keeper) Capabilities(c context.Context, req *types.QueryCapabilitiesRequest) (*types.QueryCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.IndexedCapabilityOwners
+	pageRes, err := query.Paginate(prefixStore, req.Pagination, func(key []byte, value []byte) error {
+		var owners types.CapabilityOwners
+		if err := k.cdc.UnmarshalBinaryBare(value, &owners); err != nil {
+			return err
+		}
+
+		capabilities = append(capabilities, types.IndexedCapabilityOwners{
+			Index:  types.IndexFromKey(key),
+			Owners: owners.Owners,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCapabilitiesResponse{
+		LatestIndex:  k.GetLatestIndex(ctx),
+		Capabilities: capabilities,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// Capability implements the Query/Capability gRPC method
+func (k Keeper) Capability(c context.Context, req *types.QueryCapabilityRequest) (*types.QueryCapabilityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Index == 0 {
+		return nil, status.Error(codes.InvalidArgument, "capability index cannot be zero")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owners, ok := k.GetOwners(ctx, req.Index)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "capability with index %d not found", req.Index)
+	}
+
+	return &types.QueryCapabilityResponse{
+		Capability: types.IndexedCapabilityOwners{
+			Index:  req.Index,
+			Owners: owners.Owners,
+		},
+	}, nil
+}
+
+// ModuleCapabilities implements the Query/ModuleCapabilities gRPC method
+func (k Keeper) ModuleCapabilities(c context.Context, req *types.QueryModuleCapabilitiesRequest) (*types.QueryModuleCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	var capabilities []types.ModuleCapability
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var owners types.CapabilityOwners
+		if err := k.cdc.UnmarshalBinaryBare(value, &owners); err != nil {
+			return false, err
+		}
+
+		var found bool
+		for _, owner := range owners.Owners {
+			if owner.Module != req.Module {
+				continue
+			}
+
+			found = true
+			if accumulate {
+				capabilities = append(capabilities, types.ModuleCapability{
+					Index: types.IndexFromKey(key),
+					Name:  owner.Name,
+				})
+			}
+		}
+
+		return found, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleCapabilitiesResponse{
+		Capabilities: capabilities,
+		Pagination:   pageRes,
+	}, nil
+}
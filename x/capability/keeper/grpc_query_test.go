@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryCapabilities() {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, *suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	bankSk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	stakingSk := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	prevIndex := suite.keeper.GetLatestIndex(suite.ctx)
+
+	cap1, err := bankSk.NewCapability(suite.ctx, "bank-1")
+	suite.Require().NoError(err)
+	cap2, err := bankSk.NewCapability(suite.ctx, "bank-2")
+	suite.Require().NoError(err)
+	suite.Require().NoError(stakingSk.ClaimCapability(suite.ctx, cap2, "staking-2"))
+	_, err = stakingSk.NewCapability(suite.ctx, "staking-3")
+	suite.Require().NoError(err)
+
+	res, err := queryClient.Capabilities(gocontext.Background(), &types.QueryCapabilitiesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(prevIndex+3, res.LatestIndex)
+
+	// capabilities created at genesis are returned first
+	total := len(res.Capabilities)
+	created := res.Capabilities[total-3:]
+	suite.Require().Equal(cap1.GetIndex(), created[0].Index)
+	suite.Require().Equal([]types.Owner{types.NewOwner(banktypes.ModuleName, "bank-1")}, created[0].Owners)
+	suite.Require().Len(created[1].Owners, 2)
+
+	res, err = queryClient.Capabilities(gocontext.Background(), &types.QueryCapabilitiesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Capabilities, 2)
+	suite.Require().Equal(uint64(total), res.Pagination.Total)
+
+	capRes, err := queryClient.Capability(gocontext.Background(), &types.QueryCapabilityRequest{Index: cap2.GetIndex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(cap2.GetIndex(), capRes.Capability.Index)
+	suite.Require().Len(capRes.Capability.Owners, 2)
+
+	_, err = queryClient.Capability(gocontext.Background(), &types.QueryCapabilityRequest{Index: prevIndex + 10})
+	suite.Require().Error(err)
+
+	_, err = queryClient.Capability(gocontext.Background(), &types.QueryCapabilityRequest{})
+	suite.Require().Error(err)
+
+	modRes, err := queryClient.ModuleCapabilities(gocontext.Background(), &types.QueryModuleCapabilitiesRequest{Module: stakingtypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Index: cap2.GetIndex(), Name: "staking-2"},
+		{Index: prevIndex + 2, Name: "staking-3"},
+	}, modRes.Capabilities)
+
+	_, err = queryClient.ModuleCapabilities(gocontext.Background(), &types.QueryModuleCapabilitiesRequest{})
+	suite.Require().Error(err)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -90,6 +91,51 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// RunInvariants runs the registered invariants of a module, only those of the
+// given routes if any, and returns their outcomes by full route. Unlike
+// AssertInvariants, it does not panic when an invariant is broken. Each
+// invariant is run on its own cache of the state, which is discarded.
+func (k Keeper) RunInvariants(ctx sdk.Context, moduleName string, routes []string) ([]types.InvariantResult, error) {
+	var moduleRoutes []types.InvarRoute
+	for _, ir := range k.routes {
+		if ir.ModuleName == moduleName {
+			moduleRoutes = append(moduleRoutes, ir)
+		}
+	}
+
+	selected := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		found := false
+		for _, ir := range moduleRoutes {
+			if ir.Route == route {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrUnknownInvariant, "%s/%s", moduleName, route)
+		}
+		selected[route] = true
+	}
+
+	results := make([]types.InvariantResult, 0, len(moduleRoutes))
+	for _, ir := range moduleRoutes {
+		if len(selected) > 0 && !selected[ir.Route] {
+			continue
+		}
+
+		cacheCtx, _ := ctx.CacheContext()
+		res, broken := ir.Invar(cacheCtx)
+		results = append(results, types.InvariantResult{Route: ir.FullRoute(), Broken: broken, Message: res})
+	}
+
+	if len(results) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownInvariant, "no invariant registered for module %s", moduleName)
+	}
+
+	return results, nil
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +46,33 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestRunInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.NewContext(true, tmproto.Header{})
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(ctx sdk.Context) (string, bool) {
+		// state changes made by an invariant are discarded
+		app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{Base: "test"})
+		return "ok", false
+	})
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+
+	_, err := app.CrisisKeeper.RunInvariants(ctx, "unknownModule", nil)
+	require.ErrorIs(t, err, types.ErrUnknownInvariant)
+	_, err = app.CrisisKeeper.RunInvariants(ctx, "testModule", []string{"unknownRoute"})
+	require.ErrorIs(t, err, types.ErrUnknownInvariant)
+
+	res, err := app.CrisisKeeper.RunInvariants(ctx, "testModule", nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.InvariantResult{
+		{Route: "testModule/testRoute1", Broken: false, Message: "ok"},
+		{Route: "testModule/testRoute2", Broken: true, Message: "broken"},
+	}, res)
+	require.Empty(t, app.BankKeeper.GetDenomMetaData(ctx, "test").Base)
+
+	res, err = app.CrisisKeeper.RunInvariants(ctx, "testModule", []string{"testRoute2"})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.True(t, res[0].Broken)
+}
@@ -0,0 +1,17 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/keeper"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+// EndBlocker executes the schedules due at the current height.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ExecuteDueSchedules(ctx)
+}
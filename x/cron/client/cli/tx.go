@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Schedule flags
+const (
+	FlagStartHeight = "start-height"
+	FlagInterval    = "interval"
+	FlagExecutions  = "executions"
+	FlagGasLimit    = "gas-limit"
+	FlagFee         = "execution-fee"
+	FlagMaxRetries  = "max-retries"
+)
+
+// scheduleArgs holds the schedule parameters shared by the create-schedule
+// command and the schedule proposal.
+type scheduleArgs struct {
+	startHeight int64
+	interval    uint64
+	executions  uint64
+	gasLimit    uint64
+	maxRetries  uint32
+}
+
+// NewTxCmd returns the transaction commands for the cron module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Cron transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdCreateSchedule(),
+		NewCmdCancelSchedule(),
+	)
+
+	return txCmd
+}
+
+// NewCmdCreateSchedule implements scheduling messages for a later execution.
+func NewCmdCreateSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-schedule [owner] [msg-tx-json-file]",
+		Short: "Schedule messages for execution at a future height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule the messages of a transaction generated with the --generate-only
+flag and signed by the owner for execution at a future height, once or at a
+fixed block interval. The fee of every execution attempt, retries included, is
+escrowed upfront and refunded for the attempts which did not happen. Note, the
+'--from' flag is ignored as it is implied from [owner].
+
+Example:
+$ %s tx bank send [owner] [recipient] 10stake --generate-only > msg_tx.json
+$ %s tx cron create-schedule [owner] msg_tx.json --start-height 1000 --interval 100 --executions 10 --gas-limit 200000 --execution-fee 200stake
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			sa, err := parseScheduleFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			feeStr, err := cmd.Flags().GetString(FlagFee)
+			if err != nil {
+				return err
+			}
+			fee, err := sdk.ParseCoinsNormalized(feeStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateSchedule(
+				clientCtx.GetFromAddress(), theTx.GetMsgs(), sa.startHeight, sa.interval, sa.executions,
+				sa.gasLimit, fee, sa.maxRetries,
+			)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addScheduleFlags(cmd)
+	cmd.Flags().String(FlagFee, "", "Fee paid for each execution attempt")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCancelSchedule implements cancelling a pending schedule.
+func NewCmdCancelSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-schedule [schedule-id]",
+		Short: "Cancel a pending schedule and refund its remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := parseScheduleID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSchedule(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdSubmitScheduleProposal implements the command to submit a schedule
+// proposal.
+func GetCmdSubmitScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [msg-tx-json-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to schedule messages signed by the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to schedule the messages of a transaction generated with
+the --generate-only flag and signed by the governance module account, along
+with an initial deposit. Governance schedules are not charged any fee.
+
+Example:
+$ %s tx bank send [gov-module-account] [recipient] 10stake --generate-only > msg_tx.json
+$ %s tx gov submit-proposal schedule msg_tx.json --title "Grant" --description "Monthly grant" --interval 432000 --executions 12 --gas-limit 200000 --deposit 1000stake --from [key_or_address]
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			sa, err := parseScheduleFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content, err := types.NewScheduleProposal(
+				title, description, theTx.GetMsgs(), sa.startHeight, sa.interval, sa.executions, sa.gasLimit,
+				sa.maxRetries,
+			)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addScheduleFlags(cmd)
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func addScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64(FlagStartHeight, 0, "Height of the first execution (defaults to the next block)")
+	cmd.Flags().Uint64(FlagInterval, 0, "Number of blocks between two executions, zero for a single execution")
+	cmd.Flags().Uint64(FlagExecutions, 1, "Number of executions")
+	cmd.Flags().Uint64(FlagGasLimit, 0, "Gas limit of each execution")
+	cmd.Flags().Uint32(FlagMaxRetries, 0, "Number of consecutive failed executions retried at the next block before the schedule is dropped")
+}
+
+func parseScheduleFlags(fs *pflag.FlagSet) (scheduleArgs, error) {
+	var (
+		sa  scheduleArgs
+		err error
+	)
+
+	if sa.startHeight, err = fs.GetInt64(FlagStartHeight); err != nil {
+		return sa, err
+	}
+	if sa.interval, err = fs.GetUint64(FlagInterval); err != nil {
+		return sa, err
+	}
+	if sa.executions, err = fs.GetUint64(FlagExecutions); err != nil {
+		return sa, err
+	}
+	if sa.gasLimit, err = fs.GetUint64(FlagGasLimit); err != nil {
+		return sa, err
+	}
+	if sa.maxRetries, err = fs.GetUint32(FlagMaxRetries); err != nil {
+		return sa, err
+	}
+
+	return sa, nil
+}
+
+func parseScheduleID(arg string) (uint64, error) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("schedule-id %s not a valid uint, please input a valid schedule-id", arg)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/cron/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/cron/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// ScheduleProposalHandler is the schedule proposal handler.
+var ScheduleProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitScheduleProposal, rest.ProposalRESTHandler)
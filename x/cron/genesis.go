@@ -0,0 +1,42 @@
+package cron
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/keeper"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+// InitGenesis initializes the cron module's state from a given genesis state.
+// The cron module account must hold the deposits of all the schedules.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetScheduleSeq(ctx, data.ScheduleSeq)
+
+	deposits := sdk.NewCoins()
+	for _, schedule := range data.Schedules {
+		k.SetSchedule(ctx, schedule)
+		deposits = deposits.Add(schedule.Deposit...)
+	}
+
+	moduleAcc := ak.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	if balances := bk.GetAllBalances(ctx, moduleAcc.GetAddress()); !balances.IsAllGTE(deposits) {
+		panic(fmt.Sprintf("%s module balance %s does not cover the schedule deposits %s", types.ModuleName, balances, deposits))
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	schedules := []types.Schedule{}
+	k.IterateSchedules(ctx, func(schedule types.Schedule) bool {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), k.GetScheduleSeq(ctx), schedules)
+}
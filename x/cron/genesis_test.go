@@ -0,0 +1,51 @@
+package cron_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/cron"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	send := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	_, err := app.CronKeeper.CreateSchedule(ctx, addrs[0], []sdk.Msg{send}, 10, 5, 3, 100000, fee, 1)
+	require.NoError(t, err)
+
+	exported := cron.ExportGenesis(ctx, app.CronKeeper)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, uint64(1), exported.ScheduleSeq)
+	require.Len(t, exported.Schedules, 1)
+
+	// the genesis state survives a JSON round trip, including the scheduled
+	// messages
+	cdc := app.AppCodec()
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(exported), &genesis)
+
+	// the cron module account must hold the schedule deposits
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+	require.Panics(t, func() {
+		cron.InitGenesis(ctx2, app2.CronKeeper, app2.AccountKeeper, app2.BankKeeper, genesis)
+	})
+
+	app2 = simapp.Setup(false)
+	ctx2 = app2.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+	funder := simapp.AddTestAddrsIncremental(app2, ctx2, 1, sdk.NewInt(30000000))[0]
+	require.NoError(t, app2.BankKeeper.SendCoinsFromAccountToModule(ctx2, funder, types.ModuleName, exported.Schedules[0].Deposit))
+	cron.InitGenesis(ctx2, app2.CronKeeper, app2.AccountKeeper, app2.BankKeeper, genesis)
+
+	require.Equal(t, cdc.MustMarshalJSON(exported), cdc.MustMarshalJSON(cron.ExportGenesis(ctx2, app2.CronKeeper)))
+}
@@ -0,0 +1,83 @@
+package cron
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/keeper"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewHandler creates an sdk.Handler for all the cron type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgCreateSchedule:
+			res, err := msgServer.CreateSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelSchedule:
+			res, err := msgServer.CancelSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+// NewProposalHandler creates a governance handler to manage the cron proposal
+// types: scheduling messages signed by the governance module account.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ScheduleProposal:
+			return handleScheduleProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cron proposal content type: %T", c)
+		}
+	}
+}
+
+// handleScheduleProposal creates a schedule owned by the governance module
+// account. Governance schedules are not charged any fee and are not subject
+// to the authorized accounts. A start height in the past schedules the first
+// execution at the next block.
+func handleScheduleProposal(ctx sdk.Context, k keeper.Keeper, p *types.ScheduleProposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	nextHeight := p.StartHeight
+	if nextHeight <= ctx.BlockHeight() {
+		nextHeight = ctx.BlockHeight() + 1
+	}
+
+	owner := authtypes.NewModuleAddress(govtypes.ModuleName)
+	id, err := k.CreateSchedule(
+		ctx, owner, msgs, nextHeight, p.Interval, p.Executions, p.GasLimit, sdk.NewCoins(), p.MaxRetries,
+	)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", nextHeight)),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the params of the cron module
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Schedule queries a pending schedule by its ID
+func (k Keeper) Schedule(c context.Context, req *types.QueryScheduleRequest) (*types.QueryScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "schedule id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schedule, found := k.GetSchedule(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "schedule %d doesn't exist", req.Id)
+	}
+
+	return &types.QueryScheduleResponse{Schedule: schedule}, nil
+}
+
+// Schedules queries all the pending schedules
+func (k Keeper) Schedules(c context.Context, req *types.QuerySchedulesRequest) (*types.QuerySchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var schedules []types.Schedule
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var schedule types.Schedule
+		if err := k.cdc.UnmarshalBinaryBare(value, &schedule); err != nil {
+			return err
+		}
+
+		schedules = append(schedules, schedule)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// SchedulesByOwner queries the pending schedules of an owner
+func (k Keeper) SchedulesByOwner(c context.Context, req *types.QuerySchedulesByOwnerRequest) (*types.QuerySchedulesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var schedules []types.Schedule
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SchedulesByOwnerKey(owner))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		schedule, found := k.GetSchedule(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return types.ErrScheduleNotFound
+		}
+
+		schedules = append(schedules, schedule)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySchedulesByOwnerResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
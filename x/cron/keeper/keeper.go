@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the cron store
+type Keeper struct {
+	storeKey         sdk.StoreKey
+	cdc              codec.BinaryMarshaler
+	paramSpace       paramtypes.Subspace
+	accountKeeper    types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+
+	// router and msgServiceRouter are used to execute the scheduled messages.
+	router           sdk.Router
+	msgServiceRouter *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new cron Keeper instance. The router and
+// msgServiceRouter are the application's legacy message router and Msg
+// service router, they are used to execute the scheduled messages on behalf of
+// their owner.
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, router sdk.Router, msgServiceRouter *baseapp.MsgServiceRouter,
+	feeCollectorName string,
+) Keeper {
+	// ensure cron module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		paramSpace:       paramSpace,
+		accountKeeper:    ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+		router:           router,
+		msgServiceRouter: msgServiceRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of cron parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of cron parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetScheduleSeq returns the last schedule ID that was assigned.
+func (k Keeper) GetScheduleSeq(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ScheduleSeqKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetScheduleSeq sets the last schedule ID that was assigned.
+func (k Keeper) SetScheduleSeq(ctx sdk.Context, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(types.ScheduleSeqKey, sdk.Uint64ToBigEndian(seq))
+}
@@ -0,0 +1,413 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/cron"
+	"github.com/cosmos/cosmos-sdk/x/cron/keeper"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	sendAmount = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	fee        = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200))
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CronKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.msgServer = keeper.NewMsgServerImpl(app.CronKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	params := app.CronKeeper.GetParams(ctx)
+	params.AuthorizedAccounts = []string{suite.addrs[0].String()}
+	app.CronKeeper.SetParams(ctx, params)
+}
+
+// createSendSchedule schedules a send of amount from addrs[0] to addrs[1].
+func (suite *KeeperTestSuite) createSendSchedule(
+	amount sdk.Coins, startHeight int64, interval, executions, gasLimit uint64, maxRetries uint32,
+) (uint64, error) {
+	send := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], amount)
+	msg, err := types.NewMsgCreateSchedule(
+		suite.addrs[0], []sdk.Msg{send}, startHeight, interval, executions, gasLimit, fee, maxRetries,
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.CreateSchedule(sdk.WrapSDKContext(suite.ctx), msg)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Id, nil
+}
+
+// endBlock executes the schedules due at height and returns the emitted
+// events.
+func (suite *KeeperTestSuite) endBlock(height int64) sdk.Events {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	cron.EndBlocker(suite.ctx, suite.app.CronKeeper)
+
+	return suite.ctx.EventManager().Events()
+}
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, sdk.DefaultBondDenom).Amount
+}
+
+func (suite *KeeperTestSuite) feeCollectorBalance() sdk.Int {
+	return suite.balance(suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+}
+
+func (suite *KeeperTestSuite) moduleBalance() sdk.Int {
+	return suite.balance(suite.app.AccountKeeper.GetModuleAddress(types.ModuleName))
+}
+
+func hasEvent(events sdk.Events, eventType string) bool {
+	for _, e := range events {
+		if e.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (suite *KeeperTestSuite) TestCreateSchedule() {
+	params := suite.app.CronKeeper.GetParams(suite.ctx)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		start      int64
+		interval   uint64
+		executions uint64
+		gasLimit   uint64
+		maxRetries uint32
+		expErr     bool
+	}{
+		{"valid single execution", func() {}, 10, 0, 1, 200000, 0, false},
+		{"valid recurring schedule", func() {}, 10, 5, 3, 200000, 1, false},
+		{"next block by default", func() {}, 0, 0, 1, 200000, 0, false},
+		{"start height in the past", func() {}, 1, 0, 1, 200000, 0, true},
+		{"gas limit above max", func() {}, 10, 0, 1, params.MaxGasLimit + 1, 0, true},
+		{"max retries above max", func() {}, 10, 0, 1, 200000, params.MaxRetries + 1, true},
+		{
+			"unauthorized owner",
+			func() {
+				p := params
+				p.AuthorizedAccounts = []string{suite.addrs[2].String()}
+				suite.app.CronKeeper.SetParams(suite.ctx, p)
+			},
+			10, 0, 1, 200000, 0, true,
+		},
+		{
+			"no authorized account",
+			func() {
+				suite.app.CronKeeper.SetParams(suite.ctx, types.DefaultParams())
+			},
+			10, 0, 1, 200000, 0, true,
+		},
+		{
+			"fee below min gas prices",
+			func() {
+				p := params
+				p.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+				suite.app.CronKeeper.SetParams(suite.ctx, p)
+			},
+			10, 0, 1, 200000, 0, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			tc.malleate()
+			before := suite.balance(suite.addrs[0])
+
+			id, err := suite.createSendSchedule(sendAmount, tc.start, tc.interval, tc.executions, tc.gasLimit, tc.maxRetries)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().True(suite.moduleBalance().IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), id)
+
+			// the deposit covers every attempt of the executions
+			deposit := fee.AmountOf(sdk.DefaultBondDenom).MulRaw(int64(tc.executions) * int64(tc.maxRetries+1))
+			suite.Require().Equal(before.Sub(deposit), suite.balance(suite.addrs[0]))
+			suite.Require().Equal(deposit, suite.moduleBalance())
+
+			schedule, found := suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.addrs[0].String(), schedule.Owner)
+			suite.Require().Equal(tc.executions, schedule.RemainingExecutions)
+			if tc.start == 0 {
+				suite.Require().Equal(suite.ctx.BlockHeight()+1, schedule.NextHeight)
+			} else {
+				suite.Require().Equal(tc.start, schedule.NextHeight)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelSchedule() {
+	before := suite.balance(suite.addrs[0])
+
+	id, err := suite.createSendSchedule(sendAmount, 10, 5, 3, 200000, 0)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.msgServer.CancelSchedule(ctx, types.NewMsgCancelSchedule(suite.addrs[1], id))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.CancelSchedule(ctx, types.NewMsgCancelSchedule(suite.addrs[0], id+1))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.CancelSchedule(ctx, types.NewMsgCancelSchedule(suite.addrs[0], id))
+	suite.Require().NoError(err)
+
+	_, found := suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+	suite.Require().Equal(before, suite.balance(suite.addrs[0]))
+	suite.Require().True(suite.moduleBalance().IsZero())
+
+	// the schedule is no longer queued
+	suite.Require().False(hasEvent(suite.endBlock(10), types.EventTypeExecuteSchedule))
+}
+
+func (suite *KeeperTestSuite) TestExecuteSchedule() {
+	id, err := suite.createSendSchedule(sendAmount, 10, 5, 2, 200000, 0)
+	suite.Require().NoError(err)
+
+	senderBefore := suite.balance(suite.addrs[0])
+	recipientBefore := suite.balance(suite.addrs[1])
+	feeCollectorBefore := suite.feeCollectorBalance()
+	amount := sendAmount.AmountOf(sdk.DefaultBondDenom)
+	feeAmount := fee.AmountOf(sdk.DefaultBondDenom)
+
+	// not due yet
+	suite.Require().False(hasEvent(suite.endBlock(9), types.EventTypeExecuteSchedule))
+
+	events := suite.endBlock(10)
+	suite.Require().True(hasEvent(events, types.EventTypeExecuteSchedule))
+	suite.Require().True(hasEvent(events, banktypes.EventTypeTransfer))
+	suite.Require().Equal(senderBefore.Sub(amount), suite.balance(suite.addrs[0]))
+	suite.Require().Equal(recipientBefore.Add(amount), suite.balance(suite.addrs[1]))
+	suite.Require().Equal(feeCollectorBefore.Add(feeAmount), suite.feeCollectorBalance())
+
+	schedule, found := suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(15), schedule.NextHeight)
+	suite.Require().Equal(uint64(1), schedule.RemainingExecutions)
+	suite.Require().Equal(fee, schedule.Deposit)
+
+	suite.Require().False(hasEvent(suite.endBlock(14), types.EventTypeExecuteSchedule))
+
+	events = suite.endBlock(15)
+	suite.Require().True(hasEvent(events, types.EventTypeExecuteSchedule))
+	suite.Require().True(hasEvent(events, types.EventTypeScheduleComplete))
+	suite.Require().Equal(senderBefore.Sub(amount.MulRaw(2)), suite.balance(suite.addrs[0]))
+	suite.Require().Equal(feeCollectorBefore.Add(feeAmount.MulRaw(2)), suite.feeCollectorBalance())
+	suite.Require().True(suite.moduleBalance().IsZero())
+
+	_, found = suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExecuteServiceMsgSchedule() {
+	// the bank Msg service method has no legacy route, it can only be
+	// executed through the MsgServiceRouter
+	send := sdk.ServiceMsg{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], sendAmount),
+	}
+	msg, err := types.NewMsgCreateSchedule(suite.addrs[0], []sdk.Msg{send}, 10, 0, 1, 200000, fee, 0)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.CreateSchedule(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	schedule, found := suite.app.CronKeeper.GetSchedule(suite.ctx, res.Id)
+	suite.Require().True(found)
+	msgs, err := schedule.GetMsgs()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Msg{send}, msgs)
+
+	senderBefore := suite.balance(suite.addrs[0])
+	recipientBefore := suite.balance(suite.addrs[1])
+	amount := sendAmount.AmountOf(sdk.DefaultBondDenom)
+
+	events := suite.endBlock(10)
+	suite.Require().True(hasEvent(events, types.EventTypeExecuteSchedule))
+	suite.Require().True(hasEvent(events, banktypes.EventTypeTransfer))
+	suite.Require().Equal(senderBefore.Sub(amount), suite.balance(suite.addrs[0]))
+	suite.Require().Equal(recipientBefore.Add(amount), suite.balance(suite.addrs[1]))
+
+	_, found = suite.app.CronKeeper.GetSchedule(suite.ctx, res.Id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduleRetries() {
+	// the owner can not afford the send
+	tooMuch := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+	id, err := suite.createSendSchedule(tooMuch, 10, 0, 1, 200000, 1)
+	suite.Require().NoError(err)
+
+	ownerBefore := suite.balance(suite.addrs[0])
+	recipientBefore := suite.balance(suite.addrs[1])
+	feeCollectorBefore := suite.feeCollectorBalance()
+
+	events := suite.endBlock(10)
+	suite.Require().True(hasEvent(events, types.EventTypeScheduleRetry))
+	suite.Require().False(hasEvent(events, types.EventTypeExecuteSchedule))
+	suite.Require().Equal(recipientBefore, suite.balance(suite.addrs[1]))
+
+	schedule, found := suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(11), schedule.NextHeight)
+	suite.Require().Equal(uint32(1), schedule.Failures)
+
+	events = suite.endBlock(11)
+	suite.Require().True(hasEvent(events, types.EventTypeScheduleFailed))
+
+	_, found = suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+
+	// failed executions are charged as well, nothing is left to refund
+	suite.Require().Equal(ownerBefore, suite.balance(suite.addrs[0]))
+	suite.Require().Equal(feeCollectorBefore.Add(fee.AmountOf(sdk.DefaultBondDenom).MulRaw(2)), suite.feeCollectorBalance())
+	suite.Require().True(suite.moduleBalance().IsZero())
+}
+
+func (suite *KeeperTestSuite) TestExecuteScheduleOutOfGas() {
+	id, err := suite.createSendSchedule(sendAmount, 10, 0, 1, 10, 0)
+	suite.Require().NoError(err)
+
+	recipientBefore := suite.balance(suite.addrs[1])
+
+	events := suite.endBlock(10)
+	suite.Require().True(hasEvent(events, types.EventTypeScheduleFailed))
+	suite.Require().Equal(recipientBefore, suite.balance(suite.addrs[1]))
+
+	_, found := suite.app.CronKeeper.GetSchedule(suite.ctx, id)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMaxExecutionsPerBlock() {
+	params := suite.app.CronKeeper.GetParams(suite.ctx)
+	params.MaxExecutionsPerBlock = 1
+	suite.app.CronKeeper.SetParams(suite.ctx, params)
+
+	id1, err := suite.createSendSchedule(sendAmount, 10, 0, 1, 200000, 0)
+	suite.Require().NoError(err)
+	id2, err := suite.createSendSchedule(sendAmount, 10, 0, 1, 200000, 0)
+	suite.Require().NoError(err)
+
+	suite.endBlock(10)
+	_, found := suite.app.CronKeeper.GetSchedule(suite.ctx, id1)
+	suite.Require().False(found)
+	_, found = suite.app.CronKeeper.GetSchedule(suite.ctx, id2)
+	suite.Require().True(found)
+
+	// the remaining due schedule is executed at the next block
+	suite.endBlock(11)
+	_, found = suite.app.CronKeeper.GetSchedule(suite.ctx, id2)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestScheduleProposal() {
+	govAddr := suite.app.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+	suite.Require().NoError(
+		suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.addrs[2], govtypes.ModuleName, sendAmount),
+	)
+
+	send := banktypes.NewMsgSend(govAddr, suite.addrs[1], sendAmount)
+	proposal, err := types.NewScheduleProposal("title", "description", []sdk.Msg{send}, 0, 0, 1, 200000, 0)
+	suite.Require().NoError(err)
+	suite.Require().NoError(proposal.ValidateBasic())
+
+	handler := cron.NewProposalHandler(suite.app.CronKeeper)
+	suite.Require().NoError(handler(suite.ctx, proposal))
+
+	schedules, err := suite.queryClient.SchedulesByOwner(sdk.WrapSDKContext(suite.ctx), &types.QuerySchedulesByOwnerRequest{
+		Owner: govAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(schedules.Schedules, 1)
+	suite.Require().True(schedules.Schedules[0].Fee.IsZero())
+
+	recipientBefore := suite.balance(suite.addrs[1])
+	suite.endBlock(suite.ctx.BlockHeight() + 1)
+	suite.Require().Equal(recipientBefore.Add(sendAmount.AmountOf(sdk.DefaultBondDenom)), suite.balance(suite.addrs[1]))
+
+	// messages must be signed by the governance module account
+	send = banktypes.NewMsgSend(suite.addrs[0], suite.addrs[1], sendAmount)
+	proposal, err = types.NewScheduleProposal("title", "description", []sdk.Msg{send}, 0, 0, 1, 200000, 0)
+	suite.Require().NoError(err)
+	suite.Require().Error(proposal.ValidateBasic())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	paramsRes, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.CronKeeper.GetParams(suite.ctx), paramsRes.Params)
+
+	_, err = suite.queryClient.Schedule(ctx, &types.QueryScheduleRequest{Id: 1})
+	suite.Require().Error(err)
+
+	id, err := suite.createSendSchedule(sendAmount, 10, 0, 1, 200000, 0)
+	suite.Require().NoError(err)
+
+	scheduleRes, err := suite.queryClient.Schedule(ctx, &types.QueryScheduleRequest{Id: id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(id, scheduleRes.Schedule.Id)
+	msgs, err := scheduleRes.Schedule.GetMsgs()
+	suite.Require().NoError(err)
+	suite.Require().Len(msgs, 1)
+
+	schedulesRes, err := suite.queryClient.Schedules(ctx, &types.QuerySchedulesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(schedulesRes.Schedules, 1)
+
+	byOwnerRes, err := suite.queryClient.SchedulesByOwner(ctx, &types.QuerySchedulesByOwnerRequest{Owner: suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(byOwnerRes.Schedules, 1)
+
+	byOwnerRes, err = suite.queryClient.SchedulesByOwner(ctx, &types.QuerySchedulesByOwnerRequest{Owner: suite.addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(byOwnerRes.Schedules)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
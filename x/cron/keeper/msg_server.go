@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the cron MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) CreateSchedule(goCtx context.Context, msg *types.MsgCreateSchedule) (*types.MsgCreateScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsAuthorized(owner) {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not allowed to create schedules", msg.Owner)
+	}
+	if err := params.ValidateFee(msg.Fee, msg.GasLimit); err != nil {
+		return nil, err
+	}
+
+	nextHeight := msg.StartHeight
+	if nextHeight == 0 {
+		nextHeight = ctx.BlockHeight() + 1
+	}
+
+	id, err := k.Keeper.CreateSchedule(
+		ctx, owner, msgs, nextHeight, msg.Interval, msg.Executions, msg.GasLimit, msg.Fee, msg.MaxRetries,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", nextHeight)),
+		),
+		newMessageEvent(msg.Owner),
+	})
+
+	return &types.MsgCreateScheduleResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelSchedule(goCtx context.Context, msg *types.MsgCancelSchedule) (*types.MsgCancelScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.CancelSchedule(ctx, msg.Id, owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		),
+		newMessageEvent(msg.Owner),
+	})
+
+	return &types.MsgCancelScheduleResponse{}, nil
+}
+
+func newMessageEvent(sender string) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+	)
+}
@@ -0,0 +1,316 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+// GetSchedule returns a pending schedule from its ID.
+func (k Keeper) GetSchedule(ctx sdk.Context, id uint64) (types.Schedule, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ScheduleKey(id))
+	if bz == nil {
+		return types.Schedule{}, false
+	}
+
+	var schedule types.Schedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return schedule, true
+}
+
+// SetSchedule stores a schedule, indexes it by its owner and queues it for
+// execution at its next height. The schedule must not already be queued at
+// another height.
+func (k Keeper) SetSchedule(ctx sdk.Context, schedule types.Schedule) {
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScheduleKey(schedule.Id), k.cdc.MustMarshalBinaryBare(&schedule))
+	store.Set(types.ScheduleByOwnerKey(owner, schedule.Id), []byte{})
+	store.Set(types.ScheduleQueueKey(schedule.NextHeight, schedule.Id), []byte{})
+}
+
+// removeSchedule deletes a schedule along with its indexes.
+func (k Keeper) removeSchedule(ctx sdk.Context, schedule types.Schedule) {
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ScheduleKey(schedule.Id))
+	store.Delete(types.ScheduleByOwnerKey(owner, schedule.Id))
+	store.Delete(types.ScheduleQueueKey(schedule.NextHeight, schedule.Id))
+}
+
+// IterateSchedules iterates over all the pending schedules and performs a
+// callback function.
+func (k Keeper) IterateSchedules(ctx sdk.Context, cb func(schedule types.Schedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ScheduleKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &schedule)
+
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// CreateSchedule creates a schedule executing msgs on behalf of owner at
+// nextHeight and returns its ID. The fee of every attempt of the executions,
+// retries included, is escrowed from owner into the cron module account.
+func (k Keeper) CreateSchedule(
+	ctx sdk.Context, owner sdk.AccAddress, msgs []sdk.Msg, nextHeight int64, interval, executions, gasLimit uint64,
+	fee sdk.Coins, maxRetries uint32,
+) (uint64, error) {
+	if nextHeight <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSchedule, "next height %d must be after the current height %d", nextHeight, ctx.BlockHeight())
+	}
+
+	params := k.GetParams(ctx)
+	if gasLimit > params.MaxGasLimit {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSchedule, "gas limit %d exceeds max gas limit %d", gasLimit, params.MaxGasLimit)
+	}
+	if maxRetries > params.MaxRetries {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSchedule, "max retries %d exceeds %d", maxRetries, params.MaxRetries)
+	}
+
+	deposit, err := types.ScheduleDeposit(fee, executions, maxRetries)
+	if err != nil {
+		return 0, err
+	}
+	if !deposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, deposit); err != nil {
+			return 0, err
+		}
+	}
+
+	id := k.GetScheduleSeq(ctx) + 1
+	schedule := types.Schedule{
+		Id:                  id,
+		Owner:               owner.String(),
+		NextHeight:          nextHeight,
+		Interval:            interval,
+		RemainingExecutions: executions,
+		GasLimit:            gasLimit,
+		Fee:                 fee,
+		Deposit:             deposit,
+		MaxRetries:          maxRetries,
+	}
+	if err := schedule.SetMsgs(msgs); err != nil {
+		return 0, err
+	}
+	if err := schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetScheduleSeq(ctx, id)
+	k.SetSchedule(ctx, schedule)
+
+	return id, nil
+}
+
+// CancelSchedule removes a pending schedule owned by owner and refunds its
+// remaining deposit.
+func (k Keeper) CancelSchedule(ctx sdk.Context, id uint64, owner sdk.AccAddress) error {
+	schedule, found := k.GetSchedule(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrScheduleNotFound, "schedule %d", id)
+	}
+	if schedule.Owner != owner.String() {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the owner of schedule %d", owner, id)
+	}
+
+	return k.closeSchedule(ctx, schedule)
+}
+
+// closeSchedule removes a schedule and refunds its remaining deposit to its
+// owner.
+func (k Keeper) closeSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	if !schedule.Deposit.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, schedule.Deposit); err != nil {
+			return err
+		}
+	}
+
+	k.removeSchedule(ctx, schedule)
+	return nil
+}
+
+// ExecuteDueSchedules executes the schedules queued at or before the current
+// height, up to the maximum number of executions per block. The remaining due
+// schedules are executed in the following blocks.
+func (k Keeper) ExecuteDueSchedules(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.ScheduleQueuePrefix, types.ScheduleQueueHeightKey(ctx.BlockHeight()+1))
+
+	var due []uint64
+	for ; iterator.Valid() && len(due) < int(params.MaxExecutionsPerBlock); iterator.Next() {
+		_, id := types.SplitScheduleQueueKey(iterator.Key())
+		due = append(due, id)
+	}
+	iterator.Close()
+
+	for _, id := range due {
+		schedule, found := k.GetSchedule(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("queued schedule %d not found", id))
+		}
+
+		k.executeSchedule(ctx, schedule)
+	}
+}
+
+// executeSchedule executes a due schedule and pays the fee of the attempt to
+// the fee collector, whether it succeeds or not. On success, the schedule is
+// queued for its next execution or removed once all its executions are done.
+// On failure, the schedule is retried at the next block until it exceeds its
+// maximum number of retries, in which case it is removed and its remaining
+// deposit refunded.
+func (k Keeper) executeSchedule(ctx sdk.Context, schedule types.Schedule) {
+	id := fmt.Sprintf("%d", schedule.Id)
+	k.removeSchedule(ctx, schedule)
+
+	gasUsed, err := k.execMsgs(ctx, schedule)
+
+	if !schedule.Fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, schedule.Fee); err != nil {
+			panic(err)
+		}
+		schedule.Deposit = schedule.Deposit.Sub(schedule.Fee)
+	}
+
+	if err != nil {
+		schedule.Failures++
+
+		if schedule.Failures > schedule.MaxRetries {
+			if err := k.closeSchedule(ctx, schedule); err != nil {
+				panic(err)
+			}
+
+			k.Logger(ctx).Info("schedule failed", "id", id, "err", err)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeScheduleFailed,
+					sdk.NewAttribute(types.AttributeKeyScheduleID, id),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					sdk.NewAttribute(types.AttributeKeyFailures, fmt.Sprintf("%d", schedule.Failures)),
+					sdk.NewAttribute(types.AttributeKeyRefund, schedule.Deposit.String()),
+				),
+			)
+			return
+		}
+
+		schedule.NextHeight = ctx.BlockHeight() + 1
+		k.SetSchedule(ctx, schedule)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduleRetry,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, id),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				sdk.NewAttribute(types.AttributeKeyFailures, fmt.Sprintf("%d", schedule.Failures)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", schedule.NextHeight)),
+			),
+		)
+		return
+	}
+
+	schedule.Failures = 0
+	schedule.RemainingExecutions--
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, id),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+
+	if schedule.RemainingExecutions == 0 {
+		if err := k.closeSchedule(ctx, schedule); err != nil {
+			panic(err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduleComplete,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, id),
+			),
+		)
+		return
+	}
+
+	schedule.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+	k.SetSchedule(ctx, schedule)
+}
+
+// execMsgs routes the messages of a schedule to their handlers in a cached
+// context limited to the gas limit of the schedule. State changes are only
+// committed if all the messages succeed.
+func (k Keeper) execMsgs(ctx sdk.Context, schedule types.Schedule) (gasUsed uint64, err error) {
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		return 0, err
+	}
+	msgs, err := schedule.GetMsgs()
+	if err != nil {
+		return 0, err
+	}
+
+	gasMeter := sdk.NewGasMeter(schedule.GasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	// scheduled messages must never halt the chain, panics (including out of
+	// gas) are turned into execution failures
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasMeter.GasConsumed()
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	if err := types.ValidateMsgs(msgs, owner); err != nil {
+		return 0, err
+	}
+
+	var events sdk.Events
+	for i, msg := range msgs {
+		res, err := baseapp.ExecMsg(cacheCtx, k.router, k.msgServiceRouter, msg)
+		if err != nil {
+			return gasMeter.GasConsumed(), sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		// handlers emit their events into a new EventManager, keep them to
+		// merge them into the context's EventManager once all the messages
+		// succeeded
+		for _, event := range res.GetEvents() {
+			events = append(events, sdk.Event(event))
+		}
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+	return gasMeter.GasConsumed(), nil
+}
@@ -0,0 +1,1489 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/cron/v1beta1/cron.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the cron module.
+type Params struct {
+	// authorized_accounts are the accounts allowed to create schedules. Any
+	// account can create schedules when empty.
+	AuthorizedAccounts []string `protobuf:"bytes,1,rep,name=authorized_accounts,json=authorizedAccounts,proto3" json:"authorized_accounts,omitempty" yaml:"authorized_accounts"`
+	// max_gas_limit is the maximum gas limit of a schedule execution.
+	MaxGasLimit uint64 `protobuf:"varint,2,opt,name=max_gas_limit,json=maxGasLimit,proto3" json:"max_gas_limit,omitempty" yaml:"max_gas_limit"`
+	// min_gas_prices are the minimum gas prices the fee of a schedule execution
+	// must cover for its gas limit.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
+	// max_executions_per_block is the maximum number of schedules executed in
+	// a single block. Due schedules exceeding the limit are executed in the
+	// following blocks.
+	MaxExecutionsPerBlock uint32 `protobuf:"varint,4,opt,name=max_executions_per_block,json=maxExecutionsPerBlock,proto3" json:"max_executions_per_block,omitempty" yaml:"max_executions_per_block"`
+	// max_retries is the maximum number of consecutive failed executions a
+	// schedule can be retried for before being removed.
+	MaxRetries uint32 `protobuf:"varint,5,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty" yaml:"max_retries"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac7515a737a30032, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetAuthorizedAccounts() []string {
+	if m != nil {
+		return m.AuthorizedAccounts
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGasLimit() uint64 {
+	if m != nil {
+		return m.MaxGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetMaxExecutionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxExecutionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetries() uint32 {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return 0
+}
+
+// Schedule defines messages executed on behalf of an owner at a future block
+// height, optionally repeated at a fixed interval.
+type Schedule struct {
+	// id is the unique ID of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the account signing the messages of the schedule.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// msgs are the messages executed, atomically, on each execution.
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// next_height is the height of the next execution.
+	NextHeight int64 `protobuf:"varint,4,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty" yaml:"next_height"`
+	// interval is the number of blocks between two executions. It is zero for
+	// schedules executed once.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// remaining_executions is the number of executions left.
+	RemainingExecutions uint64 `protobuf:"varint,6,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty" yaml:"remaining_executions"`
+	// gas_limit is the gas limit of an execution.
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// fee is the fee paid to the fee collector on each execution attempt.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// deposit is the fee prepaid for the remaining execution attempts, held in
+	// escrow by the cron module account.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// max_retries is the maximum number of consecutive failed executions the
+	// schedule is retried for, at the next block, before being removed.
+	MaxRetries uint32 `protobuf:"varint,10,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty" yaml:"max_retries"`
+	// failures is the number of consecutive failed executions.
+	Failures uint32 `protobuf:"varint,11,opt,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac7515a737a30032, []int{1}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+// ScheduleProposal is a gov Content type to schedule messages signed by the
+// governance module account.
+type ScheduleProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// msgs are the messages executed, atomically, on each execution. They must
+	// be signed by the governance module account only.
+	Msgs []*types1.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// start_height is the height of the first execution. The first execution
+	// happens at the block following the proposal execution when it is in the
+	// past.
+	StartHeight int64 `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// interval is the number of blocks between two executions.
+	Interval uint64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	// executions is the number of executions.
+	Executions uint64 `protobuf:"varint,6,opt,name=executions,proto3" json:"executions,omitempty"`
+	// gas_limit is the gas limit of an execution.
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty" yaml:"gas_limit"`
+	// max_retries is the maximum number of consecutive failed executions the
+	// schedule is retried for.
+	MaxRetries uint32 `protobuf:"varint,8,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty" yaml:"max_retries"`
+}
+
+func (m *ScheduleProposal) Reset()      { *m = ScheduleProposal{} }
+func (*ScheduleProposal) ProtoMessage() {}
+func (*ScheduleProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac7515a737a30032, []int{2}
+}
+func (m *ScheduleProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleProposal.Merge(m, src)
+}
+func (m *ScheduleProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.cron.v1beta1.Params")
+	proto.RegisterType((*Schedule)(nil), "cosmos.cron.v1beta1.Schedule")
+	proto.RegisterType((*ScheduleProposal)(nil), "cosmos.cron.v1beta1.ScheduleProposal")
+}
+
+func init() { proto.RegisterFile("cosmos/cron/v1beta1/cron.proto", fileDescriptor_ac7515a737a30032) }
+
+var fileDescriptor_ac7515a737a30032 = []byte{
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6f, 0xf3, 0x34,
+	0x18, 0xc7, 0x9b, 0xb6, 0xdb, 0x5a, 0x77, 0x9b, 0x26, 0xb7, 0x83, 0xac, 0xa0, 0xa4, 0x0a, 0x97,
+	0x22, 0x44, 0xca, 0xe0, 0x80, 0x54, 0x71, 0x59, 0x07, 0x1a, 0x87, 0x49, 0x54, 0xe6, 0x86, 0x40,
+	0x95, 0x9b, 0x7a, 0xa9, 0xb5, 0x24, 0xae, 0x6c, 0x77, 0xb4, 0x7c, 0x02, 0x8e, 0x3b, 0x72, 0xdc,
+	0x79, 0x5f, 0x84, 0x1d, 0x77, 0x83, 0x53, 0x86, 0x36, 0x09, 0x71, 0xce, 0x27, 0x40, 0x76, 0x92,
+	0xb6, 0xab, 0xaa, 0x57, 0x7d, 0xf5, 0xbe, 0xa7, 0xf6, 0xff, 0x3c, 0xce, 0xdf, 0x7e, 0xfe, 0xfe,
+	0x45, 0x01, 0x96, 0xc7, 0x44, 0xc8, 0x44, 0xc7, 0xe3, 0x2c, 0xea, 0xdc, 0x9c, 0x0e, 0x89, 0xc4,
+	0xa7, 0x5a, 0xb8, 0x13, 0xce, 0x24, 0x83, 0xf5, 0xb4, 0xef, 0xea, 0x52, 0xd6, 0x6f, 0x36, 0x7c,
+	0xe6, 0x33, 0xdd, 0xef, 0xa8, 0x7f, 0xe9, 0xd2, 0xe6, 0x89, 0xcf, 0x98, 0x1f, 0x90, 0x8e, 0x56,
+	0xc3, 0xe9, 0x55, 0x07, 0x47, 0xf3, 0xac, 0x95, 0xef, 0x32, 0xc4, 0x82, 0x2c, 0x77, 0x61, 0x34,
+	0xdb, 0xc5, 0xf9, 0xb3, 0x04, 0x76, 0xfb, 0x98, 0xe3, 0x50, 0xc0, 0x1f, 0x40, 0x1d, 0x4f, 0xe5,
+	0x98, 0x71, 0xfa, 0x1b, 0x19, 0x0d, 0xb0, 0xe7, 0xb1, 0x69, 0x24, 0x85, 0x69, 0xb4, 0x4a, 0xed,
+	0x6a, 0xcf, 0x4a, 0x62, 0xbb, 0x39, 0xc7, 0x61, 0xd0, 0x75, 0x36, 0x2c, 0x72, 0x10, 0x5c, 0x56,
+	0xcf, 0xb2, 0x22, 0xfc, 0x06, 0x1c, 0x84, 0x78, 0x36, 0xf0, 0xb1, 0x18, 0x04, 0x34, 0xa4, 0xd2,
+	0x2c, 0xb6, 0x8c, 0x76, 0xb9, 0x67, 0x26, 0xb1, 0xdd, 0x48, 0xad, 0x5e, 0xb5, 0x1d, 0x54, 0x0b,
+	0xf1, 0xec, 0x02, 0x8b, 0x4b, 0xa5, 0xe0, 0xad, 0x01, 0x0e, 0x43, 0x1a, 0xe9, 0xfe, 0x84, 0x53,
+	0x8f, 0x08, 0xb3, 0xd4, 0x2a, 0xb5, 0x6b, 0x5f, 0x7e, 0xec, 0x66, 0xc9, 0xa8, 0x99, 0xf2, 0x64,
+	0xdc, 0x6f, 0x89, 0x77, 0xce, 0x68, 0xd4, 0xbb, 0x7c, 0x88, 0xed, 0x42, 0x12, 0xdb, 0xc7, 0xd9,
+	0x0e, 0xaf, 0x1c, 0x9c, 0xfb, 0x27, 0xfb, 0x33, 0x9f, 0xca, 0xf1, 0x74, 0xe8, 0x7a, 0x2c, 0xec,
+	0xe4, 0x57, 0xa0, 0x7f, 0x3e, 0x17, 0xa3, 0xeb, 0x8e, 0x9c, 0x4f, 0x88, 0xc8, 0xcd, 0x04, 0xda,
+	0x0f, 0x69, 0x74, 0x81, 0x45, 0x5f, 0x3f, 0x0d, 0x7f, 0x06, 0xa6, 0x3a, 0x31, 0x99, 0x11, 0x6f,
+	0x2a, 0x29, 0x8b, 0xc4, 0x60, 0x42, 0xf8, 0x60, 0x18, 0x30, 0xef, 0xda, 0x2c, 0xb7, 0x8c, 0xf6,
+	0x41, 0xef, 0x93, 0x24, 0xb6, 0xed, 0xe5, 0x6c, 0x9b, 0x56, 0x3a, 0xe8, 0x38, 0xc4, 0xb3, 0xef,
+	0x16, 0x9d, 0x3e, 0xe1, 0x3d, 0x55, 0x87, 0x5f, 0x03, 0x35, 0xff, 0x80, 0x13, 0xc9, 0x29, 0x11,
+	0xe6, 0x8e, 0x36, 0xfc, 0x20, 0x89, 0x6d, 0xb8, 0x34, 0xcc, 0x9a, 0x0e, 0x02, 0x21, 0x9e, 0xa1,
+	0x54, 0x74, 0xcb, 0x7f, 0xdc, 0xd9, 0x05, 0xe7, 0xaf, 0x32, 0xa8, 0xfc, 0xe8, 0x8d, 0xc9, 0x68,
+	0x1a, 0x10, 0x78, 0x08, 0x8a, 0x74, 0x64, 0x1a, 0x2a, 0x6f, 0x54, 0xa4, 0x23, 0xd8, 0x00, 0x3b,
+	0xec, 0xd7, 0x88, 0x70, 0x7d, 0x05, 0x55, 0x94, 0x0a, 0xd8, 0x06, 0xe5, 0x50, 0xf8, 0x79, 0xae,
+	0x0d, 0x37, 0xc5, 0xc8, 0xcd, 0x31, 0x72, 0xcf, 0xa2, 0x39, 0xd2, 0x2b, 0xd4, 0xd9, 0x22, 0x32,
+	0x93, 0x83, 0x31, 0xa1, 0xfe, 0x58, 0xea, 0x61, 0x4b, 0xab, 0x67, 0x5b, 0x69, 0x3a, 0x08, 0x28,
+	0xf5, 0xbd, 0x16, 0xb0, 0x09, 0x2a, 0x34, 0x92, 0x84, 0xdf, 0xe0, 0x40, 0x4f, 0x54, 0x46, 0x0b,
+	0x0d, 0x11, 0x68, 0x70, 0x12, 0x62, 0x1a, 0xd1, 0xc8, 0x5f, 0x89, 0xca, 0xdc, 0xd5, 0x98, 0xd8,
+	0x49, 0x6c, 0x7f, 0x94, 0xba, 0x6f, 0x5a, 0xe5, 0xa0, 0xfa, 0xa2, 0xbc, 0x0c, 0x13, 0x9e, 0x82,
+	0xea, 0x92, 0xb7, 0x3d, 0x6d, 0xd4, 0x48, 0x62, 0xfb, 0x28, 0x35, 0x5a, 0x61, 0xad, 0xe2, 0xe7,
+	0xa0, 0xfd, 0x02, 0x4a, 0x57, 0x84, 0x98, 0x15, 0x1d, 0xc2, 0xc9, 0x46, 0xb8, 0x34, 0x59, 0x5f,
+	0x28, 0xb2, 0xee, 0x9f, 0xec, 0xf6, 0x16, 0x00, 0xa5, 0xf4, 0x28, 0x5f, 0x48, 0xc0, 0xde, 0x88,
+	0x4c, 0x98, 0xa0, 0xd2, 0xac, 0xbe, 0xff, 0x2d, 0x72, 0xef, 0x75, 0x7a, 0xc0, 0xb6, 0xf4, 0xa8,
+	0x1b, 0xba, 0xc2, 0x34, 0x98, 0x72, 0x22, 0xcc, 0x9a, 0x7a, 0x0a, 0x2d, 0x74, 0xb7, 0xfc, 0xbb,
+	0x22, 0xeb, 0xdf, 0x22, 0x38, 0xca, 0xc9, 0xea, 0x73, 0x36, 0x61, 0x02, 0x07, 0x8a, 0x28, 0x49,
+	0x65, 0x40, 0x34, 0x64, 0x55, 0x94, 0x0a, 0xd8, 0x02, 0xb5, 0x11, 0x11, 0x1e, 0xa7, 0x13, 0x75,
+	0x1d, 0x19, 0x6d, 0xab, 0xa5, 0xb7, 0x60, 0xae, 0x0b, 0xf6, 0x85, 0xc4, 0x7c, 0x0d, 0xba, 0x0f,
+	0x93, 0xd8, 0xae, 0xa7, 0x23, 0xad, 0x76, 0x1d, 0x54, 0xd3, 0x72, 0x0b, 0xec, 0x2c, 0x00, 0xd6,
+	0x61, 0x43, 0x80, 0xbc, 0x13, 0x42, 0x6b, 0xe1, 0x57, 0xb6, 0x7e, 0x75, 0xf7, 0x55, 0xc0, 0xea,
+	0xf5, 0xfd, 0xef, 0xce, 0x2e, 0xf4, 0xce, 0x1f, 0x9e, 0x2d, 0xe3, 0xf1, 0xd9, 0x32, 0xfe, 0x79,
+	0xb6, 0x8c, 0xdb, 0x17, 0xab, 0xf0, 0xf8, 0x62, 0x15, 0xfe, 0x7e, 0xb1, 0x0a, 0x3f, 0x7d, 0xfa,
+	0x46, 0x20, 0x66, 0xe9, 0x47, 0x44, 0x73, 0x31, 0xdc, 0xd5, 0x51, 0x7e, 0xf5, 0xff, 0x00, 0xf1,
+	0x54, 0x92, 0x4a, 0x60, 0x06, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxExecutionsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCron(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxGasLimit != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AuthorizedAccounts) > 0 {
+		for iNdEx := len(m.AuthorizedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizedAccounts[iNdEx])
+			copy(dAtA[i:], m.AuthorizedAccounts[iNdEx])
+			i = encodeVarintCron(dAtA, i, uint64(len(m.AuthorizedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failures != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxRetries != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCron(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCron(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCron(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRetries != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.MaxRetries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Executions != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Executions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintCron(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCron(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintCron(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCron(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCron(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuthorizedAccounts) > 0 {
+		for _, s := range m.AuthorizedAccounts {
+			l = len(s)
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	if m.MaxGasLimit != 0 {
+		n += 1 + sovCron(uint64(m.MaxGasLimit))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	if m.MaxExecutionsPerBlock != 0 {
+		n += 1 + sovCron(uint64(m.MaxExecutionsPerBlock))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovCron(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovCron(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovCron(uint64(m.NextHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCron(uint64(m.Interval))
+	}
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovCron(uint64(m.RemainingExecutions))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCron(uint64(m.GasLimit))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovCron(uint64(m.MaxRetries))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovCron(uint64(m.Failures))
+	}
+	return n
+}
+
+func (m *ScheduleProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCron(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCron(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovCron(uint64(m.StartHeight))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovCron(uint64(m.Interval))
+	}
+	if m.Executions != 0 {
+		n += 1 + sovCron(uint64(m.Executions))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCron(uint64(m.GasLimit))
+	}
+	if m.MaxRetries != 0 {
+		n += 1 + sovCron(uint64(m.MaxRetries))
+	}
+	return n
+}
+
+func sovCron(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCron(x uint64) (n int) {
+	return sovCron(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedAccounts = append(m.AuthorizedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimit", wireType)
+			}
+			m.MaxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerBlock", wireType)
+			}
+			m.MaxExecutionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCron
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCron
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types1.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetries", wireType)
+			}
+			m.MaxRetries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCron(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCron
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCron(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCron
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCron
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCron
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCron
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCron
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCron        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCron          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCron = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/cron module sentinel errors
+var (
+	ErrScheduleNotFound = sdkerrors.Register(ModuleName, 2, "schedule not found")
+	ErrInvalidSchedule  = sdkerrors.Register(ModuleName, 3, "invalid schedule")
+	ErrUnauthorized     = sdkerrors.Register(ModuleName, 4, "unauthorized")
+	ErrInsufficientFee  = sdkerrors.Register(ModuleName, 5, "insufficient fee")
+)
@@ -0,0 +1,21 @@
+package types
+
+// cron module event types
+const (
+	EventTypeCreateSchedule   = "create_schedule"
+	EventTypeCancelSchedule   = "cancel_schedule"
+	EventTypeExecuteSchedule  = "execute_schedule"
+	EventTypeScheduleRetry    = "schedule_retry"
+	EventTypeScheduleFailed   = "schedule_failed"
+	EventTypeScheduleComplete = "schedule_complete"
+
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyHeight     = "height"
+	AttributeKeyGasUsed    = "gas_used"
+	AttributeKeyError      = "error"
+	AttributeKeyFailures   = "failures"
+	AttributeKeyRefund     = "refund"
+
+	AttributeValueCategory = ModuleName
+)
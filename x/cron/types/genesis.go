@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, scheduleSeq uint64, schedules []Schedule) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		ScheduleSeq: scheduleSeq,
+		Schedules:   schedules,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the cron module
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0, []Schedule{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[uint64]bool, len(gs.Schedules))
+	for _, s := range gs.Schedules {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("invalid schedule %d: %w", s.Id, err)
+		}
+		if s.Id > gs.ScheduleSeq {
+			return fmt.Errorf("schedule id %d greater than schedule sequence %d", s.Id, gs.ScheduleSeq)
+		}
+		if seen[s.Id] {
+			return fmt.Errorf("duplicate schedule id %d", s.Id)
+		}
+		seen[s.Id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSchedules(unpacker, gs.Schedules)
+}
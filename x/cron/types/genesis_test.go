@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+func newSchedule(t *testing.T, id uint64) types.Schedule {
+	schedule := types.Schedule{
+		Id:                  id,
+		Owner:               owner.String(),
+		NextHeight:          10,
+		Interval:            5,
+		RemainingExecutions: 2,
+		GasLimit:            100000,
+		Fee:                 coins,
+		Deposit:             coins.Add(coins...),
+	}
+	require.NoError(t, schedule.SetMsgs([]sdk.Msg{banktypes.NewMsgSend(owner, recipient, coins)}))
+
+	return schedule
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	invalidSchedule := newSchedule(t, 1)
+	invalidSchedule.RemainingExecutions = 0
+
+	testCases := []struct {
+		name       string
+		genesis    *types.GenesisState
+		expectPass bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{
+			"valid",
+			types.NewGenesisState(types.DefaultParams(), 2, []types.Schedule{newSchedule(t, 1), newSchedule(t, 2)}),
+			true,
+		},
+		{
+			"invalid params",
+			types.NewGenesisState(types.Params{}, 0, nil),
+			false,
+		},
+		{
+			"invalid schedule",
+			types.NewGenesisState(types.DefaultParams(), 1, []types.Schedule{invalidSchedule}),
+			false,
+		},
+		{
+			"schedule id above sequence",
+			types.NewGenesisState(types.DefaultParams(), 1, []types.Schedule{newSchedule(t, 2)}),
+			false,
+		},
+		{
+			"duplicate schedule",
+			types.NewGenesisState(types.DefaultParams(), 1, []types.Schedule{newSchedule(t, 1), newSchedule(t, 1)}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "cron"
+
+	// StoreKey is the store key string for cron
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for cron
+	RouterKey = ModuleName
+)
+
+// Keys for cron store
+// Items are stored with the following key: values
+//
+// - 0x00: scheduleSeq
+//
+// - 0x01<scheduleID_Bytes>: Schedule
+//
+// - 0x02<height_Bytes><scheduleID_Bytes>: []byte{}
+//
+// - 0x03<ownerAddr_Bytes><scheduleID_Bytes>: []byte{}
+var (
+	ScheduleSeqKey        = []byte{0x00}
+	ScheduleKeyPrefix     = []byte{0x01}
+	ScheduleQueuePrefix   = []byte{0x02}
+	ScheduleByOwnerPrefix = []byte{0x03}
+)
+
+// ScheduleKey returns the key of a schedule from its ID
+func ScheduleKey(id uint64) []byte {
+	return append(ScheduleKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduleQueueHeightKey returns the key prefix of the schedules to execute at
+// a given height
+func ScheduleQueueHeightKey(height int64) []byte {
+	return append(ScheduleQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ScheduleQueueKey returns the queue key of a schedule executing at a given
+// height
+func ScheduleQueueKey(height int64, id uint64) []byte {
+	return append(ScheduleQueueHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitScheduleQueueKey returns the height and schedule ID of a queue key
+func SplitScheduleQueueKey(key []byte) (int64, uint64) {
+	return int64(sdk.BigEndianToUint64(key[1:9])), sdk.BigEndianToUint64(key[9:])
+}
+
+// SchedulesByOwnerKey returns the key prefix of the schedules of an owner
+func SchedulesByOwnerKey(owner sdk.AccAddress) []byte {
+	return append(ScheduleByOwnerPrefix, owner.Bytes()...)
+}
+
+// ScheduleByOwnerKey returns the index key of a schedule by its owner
+func ScheduleByOwnerKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(SchedulesByOwnerKey(owner), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// cron message types
+const (
+	TypeMsgCreateSchedule = "create_schedule"
+	TypeMsgCancelSchedule = "cancel_schedule"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateSchedule{}
+	_ sdk.Msg = &MsgCancelSchedule{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgCreateSchedule{}
+)
+
+// NewMsgCreateSchedule creates a new MsgCreateSchedule instance
+//nolint:interfacer
+func NewMsgCreateSchedule(
+	owner sdk.AccAddress, msgs []sdk.Msg, startHeight int64, interval, executions, gasLimit uint64,
+	fee sdk.Coins, maxRetries uint32,
+) (*MsgCreateSchedule, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgCreateSchedule{
+		Owner:       owner.String(),
+		Msgs:        anys,
+		StartHeight: startHeight,
+		Interval:    interval,
+		Executions:  executions,
+		GasLimit:    gasLimit,
+		Fee:         fee,
+		MaxRetries:  maxRetries,
+	}, nil
+}
+
+// GetMsgs unpacks the scheduled messages.
+func (msg MsgCreateSchedule) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(msg.Msgs)
+}
+
+// Route implements Msg
+func (msg MsgCreateSchedule) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateSchedule) Type() string { return TypeMsgCreateSchedule }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateSchedule) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if err := ValidateMsgs(msgs, owner); err != nil {
+		return err
+	}
+	if msg.StartHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "start height cannot be negative")
+	}
+	if err := ValidateExecutions(msg.Interval, msg.Executions); err != nil {
+		return err
+	}
+	if msg.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "gas limit must be positive")
+	}
+	if err := msg.Fee.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateSchedule) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateSchedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, msg.Msgs)
+}
+
+// NewMsgCancelSchedule creates a new MsgCancelSchedule instance
+//nolint:interfacer
+func NewMsgCancelSchedule(owner sdk.AccAddress, id uint64) *MsgCancelSchedule {
+	return &MsgCancelSchedule{
+		Owner: owner.String(),
+		Id:    id,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCancelSchedule) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelSchedule) Type() string { return TypeMsgCancelSchedule }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+	if msg.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelSchedule) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	owner     = sdk.AccAddress("owner_______________")
+	recipient = sdk.AccAddress("recipient___________")
+	coins     = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+)
+
+func mustNewMsgCreateSchedule(
+	t *testing.T, msgs []sdk.Msg, startHeight int64, interval, executions, gasLimit uint64,
+) *types.MsgCreateSchedule {
+	msg, err := types.NewMsgCreateSchedule(owner, msgs, startHeight, interval, executions, gasLimit, coins, 1)
+	require.NoError(t, err)
+	return msg
+}
+
+func TestMsgCreateSchedule(t *testing.T) {
+	send := banktypes.NewMsgSend(owner, recipient, coins)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgCreateSchedule
+		expectPass bool
+	}{
+		{"valid single execution", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, 0, 1, 100000), true},
+		{"valid recurring schedule", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 0, 10, 5, 100000), true},
+		{"invalid owner", &types.MsgCreateSchedule{Owner: "invalid"}, false},
+		{"no messages", mustNewMsgCreateSchedule(t, nil, 10, 0, 1, 100000), false},
+		{
+			"message not signed by the owner",
+			mustNewMsgCreateSchedule(t, []sdk.Msg{banktypes.NewMsgSend(recipient, owner, coins)}, 10, 0, 1, 100000),
+			false,
+		},
+		{
+			"invalid message",
+			mustNewMsgCreateSchedule(t, []sdk.Msg{banktypes.NewMsgSend(owner, recipient, sdk.Coins{})}, 10, 0, 1, 100000),
+			false,
+		},
+		{"negative start height", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, -1, 0, 1, 100000), false},
+		{"no executions", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, 10, 0, 100000), false},
+		{"several executions without interval", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, 0, 2, 100000), false},
+		{"too many executions", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, 10, types.MaxExecutions+1, 100000), false},
+		{"interval too long", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, types.MaxInterval+1, 5, 100000), false},
+		{"executions above max int64", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, 10, 1<<63, 100000), false},
+		{"no gas limit", mustNewMsgCreateSchedule(t, []sdk.Msg{send}, 10, 0, 1, 0), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{owner}, tc.msg.GetSigners())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelSchedule(t *testing.T) {
+	require.NoError(t, types.NewMsgCancelSchedule(owner, 1).ValidateBasic())
+	require.Error(t, types.NewMsgCancelSchedule(owner, 0).ValidateBasic())
+	require.Error(t, (&types.MsgCancelSchedule{Owner: "invalid", Id: 1}).ValidateBasic())
+}
+
+func TestScheduleProposal(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	proposal, err := types.NewScheduleProposal(
+		"title", "description", []sdk.Msg{banktypes.NewMsgSend(govAddr, recipient, coins)}, 10, 10, 5, 100000, 0,
+	)
+	require.NoError(t, err)
+	require.NoError(t, proposal.ValidateBasic())
+	require.Equal(t, types.ProposalTypeSchedule, proposal.ProposalType())
+	require.Equal(t, types.RouterKey, proposal.ProposalRoute())
+
+	proposal, err = types.NewScheduleProposal(
+		"title", "description", []sdk.Msg{banktypes.NewMsgSend(owner, recipient, coins)}, 10, 10, 5, 100000, 0,
+	)
+	require.NoError(t, err)
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal, err = types.NewScheduleProposal(
+		"title", "description", []sdk.Msg{banktypes.NewMsgSend(govAddr, recipient, coins)}, 10, 10, types.MaxExecutions+1, 100000, 0,
+	)
+	require.NoError(t, err)
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal, err = types.NewScheduleProposal(
+		"title", "description", []sdk.Msg{banktypes.NewMsgSend(govAddr, recipient, coins)}, 10, types.MaxInterval+1, 5, 100000, 0,
+	)
+	require.NoError(t, err)
+	require.Error(t, proposal.ValidateBasic())
+}
+
+func TestScheduleDeposit(t *testing.T) {
+	deposit, err := types.ScheduleDeposit(coins, 5, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), deposit)
+
+	deposit, err = types.ScheduleDeposit(sdk.Coins{}, 5, 1)
+	require.NoError(t, err)
+	require.True(t, deposit.IsZero())
+
+	// the amount overflows the 255 bits of sdk.Int
+	huge := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 250))))
+	_, err = types.ScheduleDeposit(huge, types.MaxExecutions, 3)
+	require.Error(t, err)
+}
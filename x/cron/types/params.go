@@ -0,0 +1,184 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyAuthorizedAccounts    = []byte("AuthorizedAccounts")
+	KeyMaxGasLimit           = []byte("MaxGasLimit")
+	KeyMinGasPrices          = []byte("MinGasPrices")
+	KeyMaxExecutionsPerBlock = []byte("MaxExecutionsPerBlock")
+	KeyMaxRetries            = []byte("MaxRetries")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table of the cron module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	authorizedAccounts []string, maxGasLimit uint64, minGasPrices sdk.DecCoins,
+	maxExecutionsPerBlock, maxRetries uint32,
+) Params {
+	return Params{
+		AuthorizedAccounts:    authorizedAccounts,
+		MaxGasLimit:           maxGasLimit,
+		MinGasPrices:          minGasPrices,
+		MaxExecutionsPerBlock: maxExecutionsPerBlock,
+		MaxRetries:            maxRetries,
+	}
+}
+
+// DefaultParams returns the default cron module parameters
+func DefaultParams() Params {
+	return Params{
+		AuthorizedAccounts:    []string{},
+		MaxGasLimit:           1000000,
+		MinGasPrices:          sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3))),
+		MaxExecutionsPerBlock: 10,
+		MaxRetries:            3,
+	}
+}
+
+// Validate performs basic validation of the cron parameters.
+func (p Params) Validate() error {
+	if err := validateAuthorizedAccounts(p.AuthorizedAccounts); err != nil {
+		return err
+	}
+	if err := validateMaxGasLimit(p.MaxGasLimit); err != nil {
+		return err
+	}
+	if err := validateMinGasPrices(p.MinGasPrices); err != nil {
+		return err
+	}
+	if err := validateMaxExecutionsPerBlock(p.MaxExecutionsPerBlock); err != nil {
+		return err
+	}
+
+	return validateMaxRetries(p.MaxRetries)
+}
+
+// IsAuthorized returns true if account is allowed to create schedules. Only
+// governance can schedule messages when no account is authorized.
+func (p Params) IsAuthorized(account sdk.AccAddress) bool {
+	for _, a := range p.AuthorizedAccounts {
+		if a == account.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateFee returns an error if fee does not cover the minimum gas prices
+// for gasLimit. As for transaction fees, covering the minimum gas price of a
+// single denom is enough.
+func (p Params) ValidateFee(fee sdk.Coins, gasLimit uint64) error {
+	if p.MinGasPrices.IsZero() {
+		return nil
+	}
+
+	requiredFees := make(sdk.Coins, len(p.MinGasPrices))
+	glDec := sdk.NewDec(int64(gasLimit))
+	for i, gp := range p.MinGasPrices {
+		requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+
+	if !fee.IsAnyGTE(requiredFees) {
+		return sdkerrors.Wrapf(ErrInsufficientFee, "got: %s required: %s", fee, requiredFees)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAuthorizedAccounts, &p.AuthorizedAccounts, validateAuthorizedAccounts),
+		paramtypes.NewParamSetPair(KeyMaxGasLimit, &p.MaxGasLimit, validateMaxGasLimit),
+		paramtypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
+		paramtypes.NewParamSetPair(KeyMaxExecutionsPerBlock, &p.MaxExecutionsPerBlock, validateMaxExecutionsPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxRetries, &p.MaxRetries, validateMaxRetries),
+	}
+}
+
+func validateAuthorizedAccounts(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, account := range v {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return fmt.Errorf("invalid authorized account %s: %w", account, err)
+		}
+		if seen[account] {
+			return fmt.Errorf("duplicate authorized account: %s", account)
+		}
+		seen[account] = true
+	}
+
+	return nil
+}
+
+func validateMaxGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("max gas limit must be positive")
+	}
+
+	return nil
+}
+
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateMaxExecutionsPerBlock(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("max executions per block must be positive")
+	}
+
+	return nil
+}
+
+func validateMaxRetries(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/cron/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.AuthorizedAccounts = []string{"invalid"}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.AuthorizedAccounts = []string{owner.String(), owner.String()}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxGasLimit = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxExecutionsPerBlock = 0
+	require.Error(t, params.Validate())
+}
+
+func TestParamsIsAuthorized(t *testing.T) {
+	params := types.DefaultParams()
+	require.False(t, params.IsAuthorized(owner))
+
+	params.AuthorizedAccounts = []string{recipient.String()}
+	require.False(t, params.IsAuthorized(owner))
+	require.True(t, params.IsAuthorized(recipient))
+}
+
+func TestParamsValidateFee(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.ValidateFee(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 100000))
+	require.Error(t, params.ValidateFee(sdk.Coins{}, 100000))
+
+	params.MinGasPrices = sdk.DecCoins{}
+	require.NoError(t, params.ValidateFee(sdk.Coins{}, 100000))
+
+	params.MinGasPrices = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 3)),
+	)
+	require.NoError(t, params.ValidateFee(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), 100000))
+	require.NoError(t, params.ValidateFee(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), 100000))
+	require.Error(t, params.ValidateFee(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99)), 100000))
+	require.Error(t, params.ValidateFee(sdk.Coins{}, 100000))
+}
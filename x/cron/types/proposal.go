@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSchedule defines the type for a ScheduleProposal
+	ProposalTypeSchedule = "Schedule"
+)
+
+// Assert ScheduleProposal implements govtypes.Content at compile-time
+var (
+	_ govtypes.Content                   = &ScheduleProposal{}
+	_ codectypes.UnpackInterfacesMessage = ScheduleProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSchedule)
+	govtypes.RegisterProposalTypeCodec(&ScheduleProposal{}, "cosmos-sdk/ScheduleProposal")
+}
+
+// NewScheduleProposal creates a new proposal scheduling messages signed by the
+// governance module account.
+func NewScheduleProposal(
+	title, description string, msgs []sdk.Msg, startHeight int64, interval, executions, gasLimit uint64,
+	maxRetries uint32,
+) (*ScheduleProposal, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduleProposal{
+		Title:       title,
+		Description: description,
+		Msgs:        anys,
+		StartHeight: startHeight,
+		Interval:    interval,
+		Executions:  executions,
+		GasLimit:    gasLimit,
+		MaxRetries:  maxRetries,
+	}, nil
+}
+
+// GetMsgs unpacks the scheduled messages.
+func (sp ScheduleProposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(sp.Msgs)
+}
+
+// GetTitle returns the title of a schedule proposal.
+func (sp *ScheduleProposal) GetTitle() string { return sp.Title }
+
+// GetDescription returns the description of a schedule proposal.
+func (sp *ScheduleProposal) GetDescription() string { return sp.Description }
+
+// ProposalRoute returns the routing key of a schedule proposal.
+func (sp *ScheduleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a schedule proposal.
+func (sp *ScheduleProposal) ProposalType() string { return ProposalTypeSchedule }
+
+// ValidateBasic runs basic stateless validity checks
+func (sp *ScheduleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+
+	msgs, err := sp.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if err := ValidateMsgs(msgs, authtypes.NewModuleAddress(govtypes.ModuleName)); err != nil {
+		return err
+	}
+	if sp.StartHeight < 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "start height cannot be negative")
+	}
+	if err := ValidateExecutions(sp.Interval, sp.Executions); err != nil {
+		return err
+	}
+	if sp.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "gas limit must be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (sp ScheduleProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Schedule Proposal:
+  Title:        %s
+  Description:  %s
+  Start Height: %d
+  Interval:     %d
+  Executions:   %d
+  Gas Limit:    %d
+  Max Retries:  %d
+  Msgs:
+`, sp.Title, sp.Description, sp.StartHeight, sp.Interval, sp.Executions, sp.GasLimit, sp.MaxRetries))
+
+	for _, any := range sp.Msgs {
+		b.WriteString(fmt.Sprintf("    %s\n", any.TypeUrl))
+	}
+
+	return b.String()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (sp ScheduleProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, sp.Msgs)
+}
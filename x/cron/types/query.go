@@ -0,0 +1,34 @@
+package types
+
+import codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+var (
+	_ codectypes.UnpackInterfacesMessage = QueryScheduleResponse{}
+	_ codectypes.UnpackInterfacesMessage = QuerySchedulesResponse{}
+	_ codectypes.UnpackInterfacesMessage = QuerySchedulesByOwnerResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryScheduleResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.Schedule.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QuerySchedulesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSchedules(unpacker, m.Schedules)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QuerySchedulesByOwnerResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackSchedules(unpacker, m.Schedules)
+}
+
+func unpackSchedules(unpacker codectypes.AnyUnpacker, schedules []Schedule) error {
+	for _, s := range schedules {
+		if err := s.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"math"
+	"math/big"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxExecutions is the maximum number of executions of a schedule.
+	MaxExecutions = math.MaxUint32
+	// MaxInterval is the maximum number of blocks between two executions of a
+	// schedule.
+	MaxInterval = math.MaxUint32
+)
+
+var _ codectypes.UnpackInterfacesMessage = Schedule{}
+
+// GetMsgs unpacks the messages of the schedule.
+func (s Schedule) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(s.Msgs)
+}
+
+// SetMsgs packs the messages of the schedule.
+func (s *Schedule) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	s.Msgs = anys
+	return nil
+}
+
+// Validate performs basic validation of a schedule.
+func (s Schedule) Validate() error {
+	if s.Id == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "id cannot be zero")
+	}
+	owner, err := sdk.AccAddressFromBech32(s.Owner)
+	if err != nil {
+		return sdkerrors.Wrap(err, "owner")
+	}
+	msgs, err := s.GetMsgs()
+	if err != nil {
+		return err
+	}
+	if err := ValidateMsgs(msgs, owner); err != nil {
+		return err
+	}
+	if s.NextHeight <= 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "next height must be positive")
+	}
+	if err := ValidateExecutions(s.Interval, s.RemainingExecutions); err != nil {
+		return err
+	}
+	if s.GasLimit == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "gas limit must be positive")
+	}
+	if err := s.Fee.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if err := s.Deposit.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if s.Failures > s.MaxRetries {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "failures %d exceed max retries %d", s.Failures, s.MaxRetries)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s Schedule) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, s.Msgs)
+}
+
+// ValidateMsgs checks that msgs are not empty, pass their basic validation
+// and are signed by owner only.
+func ValidateMsgs(msgs []sdk.Msg, owner sdk.AccAddress) error {
+	if len(msgs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "msgs cannot be empty")
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+		for _, signer := range msg.GetSigners() {
+			if !owner.Equals(signer) {
+				return sdkerrors.Wrapf(ErrUnauthorized, "message %d must only be signed by %s", i, owner)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateExecutions checks that a schedule executes at least once and at most
+// MaxExecutions times, that only schedules with an interval execute more than
+// once and that the interval does not exceed MaxInterval.
+func ValidateExecutions(interval, executions uint64) error {
+	if executions == 0 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "executions must be positive")
+	}
+	if executions > MaxExecutions {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "executions %d exceed %d", executions, uint64(MaxExecutions))
+	}
+	if interval > MaxInterval {
+		return sdkerrors.Wrapf(ErrInvalidSchedule, "interval %d exceeds %d", interval, uint64(MaxInterval))
+	}
+	if interval == 0 && executions != 1 {
+		return sdkerrors.Wrap(ErrInvalidSchedule, "schedules without interval must execute once")
+	}
+
+	return nil
+}
+
+// ScheduleDeposit returns the deposit covering the fee of every attempt of the
+// executions of a schedule, each execution being attempted up to maxRetries
+// times after the first failure. An error is returned if the deposit amount
+// overflows.
+func ScheduleDeposit(fee sdk.Coins, executions uint64, maxRetries uint32) (sdk.Coins, error) {
+	attempts := new(big.Int).Mul(new(big.Int).SetUint64(executions), big.NewInt(int64(maxRetries)+1))
+
+	deposit := sdk.NewCoins()
+	for _, coin := range fee {
+		amount := new(big.Int).Mul(coin.Amount.BigInt(), attempts)
+		if amount.BitLen() > 255 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "deposit of %s overflows", coin.Denom)
+		}
+		deposit = deposit.Add(sdk.NewCoin(coin.Denom, sdk.NewIntFromBigInt(amount)))
+	}
+
+	return deposit, nil
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		switch msg := msg.(type) {
+		case sdk.ServiceMsg:
+			anys[i], err = codectypes.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		default:
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return anys, nil
+}
+
+func unpackMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		if isServiceMsg(any.TypeUrl) {
+			req, ok := any.GetCachedValue().(sdk.MsgRequest)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.MsgRequest: %T", i, any.GetCachedValue())
+			}
+			msgs[i] = sdk.ServiceMsg{MethodName: any.TypeUrl, Request: req}
+			continue
+		}
+
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.Msg: %T", i, any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+func unpackMsgAnys(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		// Anys whose type URL is a service method name unpack into the
+		// request of a ServiceMsg as per ADR-031.
+		if isServiceMsg(any.TypeUrl) {
+			var req sdk.MsgRequest
+			if err := unpacker.UnpackAny(any, &req); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isServiceMsg checks if a type URL corresponds to a service method name,
+// i.e. /cosmos.bank.v1beta1.Msg/Send vs /cosmos.bank.v1beta1.MsgSend
+func isServiceMsg(typeURL string) bool {
+	return strings.Count(typeURL, "/") >= 2
+}
@@ -12,7 +12,9 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block
+// and distribute rewards for the previous block. The scheduled rate change is
+// applied after the allocation, so that the fees collected from its activation
+// height on are distributed at the new rates.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -32,7 +34,22 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 		k.AllocateTokens(ctx, sumPreviousPrecommitPower, previousTotalPower, previousProposer, req.LastCommitInfo.GetVotes())
 	}
 
+	k.ApplyScheduledRateChange(ctx)
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// EndBlocker compounds the rewards of the auto-compounding delegators,
+// withdraws the commission of the validators due at the current height, pays
+// the due funding streams from the community pool and prunes the historical
+// rewards.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.CompoundDueRewards(ctx)
+	k.WithdrawDueCommissions(ctx)
+	k.PayDueFundingStreams(ctx)
+	k.PruneHistoricalRewards(ctx)
+}
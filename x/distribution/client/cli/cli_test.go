@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/suite"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
@@ -19,8 +20,12 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/client/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -53,6 +58,22 @@ func (s *IntegrationTestSuite) SetupTest() {
 	mintDataBz, err := cfg.Codec.MarshalJSON(&mintData)
 	s.Require().NoError(err)
 	genesisState[minttypes.ModuleName] = mintDataBz
+
+	var bankData banktypes.GenesisState
+	s.Require().NoError(cfg.Codec.UnmarshalJSON(genesisState[banktypes.ModuleName], &bankData))
+
+	bankData.DenomMetadata = append(bankData.DenomMetadata, banktypes.Metadata{
+		Base: sdk.DefaultBondDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: sdk.DefaultBondDenom, Exponent: 0},
+			{Denom: "mstake", Exponent: 3},
+		},
+		Display: "mstake",
+	})
+
+	bankDataBz, err := cfg.Codec.MarshalJSON(&bankData)
+	s.Require().NoError(err)
+	genesisState[banktypes.ModuleName] = bankDataBz
 	cfg.GenesisState = genesisState
 
 	s.cfg = cfg
@@ -79,14 +100,25 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","withdraw_addr_enabled":true,"auto_compound_interval":"0","max_auto_compounds_per_block":100,"auto_compound_gas_limit":"1000000","historical_retention_periods":"0","historical_pruning_interval":"1000","scheduled_rate_change":{"activation_height":"0","community_tax":"0.000000000000000000","base_proposer_reward":"0.000000000000000000","bonus_proposer_reward":"0.000000000000000000"},"max_commission_withdrawals_per_block":100}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`base_proposer_reward: "0.010000000000000000"
+			`auto_compound_gas_limit: "1000000"
+auto_compound_interval: "0"
+base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
+historical_pruning_interval: "1000"
+historical_retention_periods: "0"
+max_auto_compounds_per_block: 100
+max_commission_withdrawals_per_block: 100
+scheduled_rate_change:
+  activation_height: "0"
+  base_proposer_reward: "0.000000000000000000"
+  bonus_proposer_reward: "0.000000000000000000"
+  community_tax: "0.000000000000000000"
 withdraw_addr_enabled: true`,
 		},
 	}
@@ -290,6 +322,28 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidatorSlashes() {
 			false,
 			"pagination:\n  next_key: null\n  total: \"0\"\nslashes: []",
 		},
+		{
+			"invalid page key",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				fmt.Sprintf("--%s=foo", flags.FlagPageKey),
+				sdk.ValAddress(val.Address).String(), "1", "3",
+			},
+			true,
+			"",
+		},
+		{
+			"with pagination",
+			[]string{
+				fmt.Sprintf("--%s=3", flags.FlagHeight),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+				fmt.Sprintf("--%s=AAAAAAAAAAIAAAAAAAAAAQ==", flags.FlagPageKey),
+				sdk.ValAddress(val.Address).String(), "1", "3",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"{\"slashes\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -350,7 +404,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRewards() {
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
 			false,
-			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"387.100000000000000000"}]}],"total":[{"denom":"stake","amount":"387.100000000000000000"}]}`, valAddr.String()),
+			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"387.100000000000000000"}]}],"total":[{"denom":"stake","amount":"387.100000000000000000"}],"pagination":null,"display_totals":[]}`, valAddr.String()),
 		},
 		{
 			"json output (specific validator)",
@@ -370,7 +424,9 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDelegatorRewards() {
 				addr.String(),
 			},
 			false,
-			fmt.Sprintf(`rewards:
+			fmt.Sprintf(`display_totals: []
+pagination: null
+rewards:
 - reward:
   - amount: "387.100000000000000000"
     denom: stake
@@ -391,6 +447,91 @@ total:
 - amount: "387.100000000000000000"
   denom: stake`,
 		},
+		{
+			"csv output",
+			[]string{
+				fmt.Sprintf("--%s=%s", tmcli.OutputFlag, cli.OutputFormatCSV),
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				addr.String(),
+			},
+			false,
+			fmt.Sprintf(`delegator_address,validator_address,denom,amount
+%s,%s,stake,387.100000000000000000`, addr.String(), valAddr.String()),
+		},
+		{
+			"csv output (specific validator)",
+			[]string{
+				fmt.Sprintf("--%s=%s", tmcli.OutputFlag, cli.OutputFormatCSV),
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				addr.String(), valAddr.String(),
+			},
+			false,
+			fmt.Sprintf(`delegator_address,validator_address,denom,amount
+%s,%s,stake,387.100000000000000000`, addr.String(), valAddr.String()),
+		},
+		{
+			"invalid denom",
+			[]string{
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				fmt.Sprintf("--%s=1", cli.FlagDenom),
+				addr.String(),
+			},
+			true,
+			"",
+		},
+		{
+			"json output with display denom",
+			[]string{
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				fmt.Sprintf("--%s=mstake", cli.FlagDisplayDenom),
+				addr.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"387.100000000000000000"}]}],"total":[{"denom":"stake","amount":"387.100000000000000000"}],"pagination":null,"display_totals":[{"validator_address":"%s","total":{"denom":"mstake","amount":"0.387100000000000000"}}]}`, valAddr.String(), valAddr.String()),
+		},
+		{
+			"display denom without metadata",
+			[]string{
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				fmt.Sprintf("--%s=node0token", cli.FlagDisplayDenom),
+				addr.String(),
+			},
+			true,
+			"",
+		},
+		{
+			"display denom (specific validator)",
+			[]string{
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				fmt.Sprintf("--%s=mstake", cli.FlagDisplayDenom),
+				addr.String(), valAddr.String(),
+			},
+			true,
+			"",
+		},
+		{
+			"json output with denom",
+			[]string{
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				fmt.Sprintf("--%s=stake", cli.FlagDenom),
+				addr.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			fmt.Sprintf(`{"rewards":[{"validator_address":"%s","reward":[{"denom":"stake","amount":"387.100000000000000000"}]}],"total":[{"denom":"stake","amount":"387.100000000000000000"}],"pagination":null,"display_totals":[]}`, valAddr.String()),
+		},
+		{
+			"json output with denom without rewards (specific validator)",
+			[]string{
+				fmt.Sprintf("--%s=10", flags.FlagHeight),
+				fmt.Sprintf("--%s=node0token", cli.FlagDenom),
+				addr.String(), valAddr.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			`{"rewards":[]}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -411,6 +552,58 @@ total:
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorDelegatorsRewards() {
+	val := s.network.Validators[0]
+
+	_, err := s.network.WaitForHeight(3)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid validator address",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"invalid page key",
+			[]string{val.ValAddress.String(), fmt.Sprintf("--%s=!", flags.FlagPageKey), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"json output",
+			[]string{val.ValAddress.String(), fmt.Sprintf("--%s=1", flags.FlagLimit), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorDelegatorsRewards()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryValidatorDelegatorsRewardsResponse
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Len(res.Rewards, 1)
+				s.Require().Equal(val.Address.String(), res.Rewards[0].DelegatorAddress)
+				s.Require().False(res.Rewards[0].Reward.IsZero(), out.String())
+				s.Require().Nil(res.Pagination.NextKey)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryCommunityPool() {
 	val := s.network.Validators[0]
 
@@ -450,50 +643,44 @@ func (s *IntegrationTestSuite) TestGetCmdQueryCommunityPool() {
 	}
 }
 
-func (s *IntegrationTestSuite) TestNewWithdrawRewardsCmd() {
+func (s *IntegrationTestSuite) TestGetCmdQueryWithdrawAddress() {
 	val := s.network.Validators[0]
 
 	testCases := []struct {
-		name         string
-		valAddr      fmt.Stringer
-		args         []string
-		expectErr    bool
-		respType     proto.Message
-		expectedCode uint32
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
 	}{
+		{
+			"invalid delegator address",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"",
+		},
 		{
 			"invalid validator address",
-			val.Address,
-			[]string{
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			true, nil, 0,
+			[]string{val.Address.String(), "foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"",
 		},
 		{
-			"valid transaction",
-			sdk.ValAddress(val.Address),
-			[]string{
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			false, &sdk.TxResponse{}, 0,
+			"json output",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			fmt.Sprintf(`{"withdraw_address":"%s"}`, val.Address),
 		},
 		{
-			"valid transaction (with commission)",
-			sdk.ValAddress(val.Address),
-			[]string{
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=true", cli.FlagCommission),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			false, &sdk.TxResponse{}, 0,
+			"json output of a delegation",
+			[]string{val.Address.String(), val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			fmt.Sprintf(`{"withdraw_address":"%s"}`, val.Address),
+		},
+		{
+			"text output",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			false,
+			fmt.Sprintf(`withdraw_address: %s`, val.Address),
 		},
 	}
 
@@ -501,54 +688,34 @@ func (s *IntegrationTestSuite) TestNewWithdrawRewardsCmd() {
 		tc := tc
 
 		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryWithdrawAddress()
 			clientCtx := val.ClientCtx
 
-			ctx := context.Background()
-			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
-
-			bz, err := distrtestutil.MsgWithdrawDelegatorRewardExec(clientCtx, tc.valAddr, tc.args...)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
 				s.Require().Error(err)
 			} else {
 				s.Require().NoError(err)
-				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz, tc.respType), string(bz))
-
-				txResp := tc.respType.(*sdk.TxResponse)
-				s.Require().Equal(tc.expectedCode, txResp.Code)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
 			}
 		})
 	}
 }
 
-func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
+func (s *IntegrationTestSuite) TestGetCmdQueryAutoCompound() {
 	val := s.network.Validators[0]
 
 	testCases := []struct {
-		name         string
-		args         []string
-		expectErr    bool
-		respType     proto.Message
-		expectedCode uint32
+		name string
+		args []string
 	}{
 		{
-			"valid transaction (offline)",
-			[]string{
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagOffline),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			true, nil, 0,
+			"invalid delegator address",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
 		},
 		{
-			"valid transaction",
-			[]string{
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			false, &sdk.TxResponse{}, 0,
+			"no auto-compounding",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
 		},
 	}
 
@@ -556,54 +723,29 @@ func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
 		tc := tc
 
 		s.Run(tc.name, func() {
-			cmd := cli.NewWithdrawAllRewardsCmd()
+			cmd := cli.GetCmdQueryAutoCompound()
 			clientCtx := val.ClientCtx
 
-			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
-			if tc.expectErr {
-				s.Require().Error(err)
-			} else {
-				s.Require().NoError(err)
-				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
-
-				txResp := tc.respType.(*sdk.TxResponse)
-				s.Require().Equal(tc.expectedCode, txResp.Code)
-			}
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().Error(err)
 		})
 	}
 }
 
-func (s *IntegrationTestSuite) TestNewSetWithdrawAddrCmd() {
+func (s *IntegrationTestSuite) TestGetCmdQueryCommissionWithdrawSchedule() {
 	val := s.network.Validators[0]
 
 	testCases := []struct {
-		name         string
-		args         []string
-		expectErr    bool
-		respType     proto.Message
-		expectedCode uint32
+		name string
+		args []string
 	}{
 		{
-			"invalid withdraw address",
-			[]string{
-				"foo",
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			true, nil, 0,
+			"invalid validator address",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
 		},
 		{
-			"valid transaction",
-			[]string{
-				val.Address.String(),
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
-			},
-			false, &sdk.TxResponse{}, 0,
+			"no commission withdrawal schedule",
+			[]string{val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
 		},
 	}
 
@@ -611,40 +753,676 @@ func (s *IntegrationTestSuite) TestNewSetWithdrawAddrCmd() {
 		tc := tc
 
 		s.Run(tc.name, func() {
-			cmd := cli.NewSetWithdrawAddrCmd()
+			cmd := cli.GetCmdQueryCommissionWithdrawSchedule()
 			clientCtx := val.ClientCtx
 
-			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
-			if tc.expectErr {
-				s.Require().Error(err)
-			} else {
-				s.Require().NoError(err)
-				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
-
-				txResp := tc.respType.(*sdk.TxResponse)
-				s.Require().Equal(tc.expectedCode, txResp.Code)
-			}
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().Error(err)
 		})
 	}
 }
 
-func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
+func (s *IntegrationTestSuite) TestGetCmdQueryRewardsProjection() {
 	val := s.network.Validators[0]
 
 	testCases := []struct {
-		name         string
-		args         []string
-		expectErr    bool
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"invalid address",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+		},
+		{
+			"delegator",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+		{
+			"validator",
+			[]string{val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryRewardsProjection()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryRewardsProjectionResponse
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().True(res.StakingApr.IsPositive(), out.String())
+				s.Require().True(res.Apr.IsPositive(), out.String())
+				s.Require().False(res.AnnualRewards.IsZero(), out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryInvariants() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedRes []string
+	}{
+		{
+			"unknown route",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			nil,
+		},
+		{
+			"all invariants",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			[]string{"nonnegative-outstanding", "can-withdraw", "reference-count", "module-account"},
+		},
+		{
+			"specific invariant",
+			[]string{"reference-count", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			[]string{"reference-count"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryInvariants()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var res types.QueryInvariantsResponse
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &res), out.String())
+				s.Require().Len(res.Invariants, len(tc.expectedRes))
+				for i, invariant := range res.Invariants {
+					s.Require().Equal(tc.expectedRes[i], invariant.Route)
+					s.Require().False(invariant.Broken, invariant.Message)
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryFundingStreams() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		cmd            *cobra.Command
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{
+			"invalid stream id",
+			cli.GetCmdQueryFundingStream(),
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"",
+		},
+		{
+			"unknown stream",
+			cli.GetCmdQueryFundingStream(),
+			[]string{"1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"",
+		},
+		{
+			"json output",
+			cli.GetCmdQueryFundingStreams(),
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			`{"streams":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"text output",
+			cli.GetCmdQueryFundingStreams(),
+			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			false,
+			`pagination:
+  next_key: null
+  total: "0"
+streams: []`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewWithdrawRewardsCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		valAddr      fmt.Stringer
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid validator address",
+			val.Address,
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction",
+			sdk.ValAddress(val.Address),
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"valid transaction (with commission)",
+			sdk.ValAddress(val.Address),
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=true", cli.FlagCommission),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			ctx := context.Background()
+			ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+
+			bz, err := distrtestutil.MsgWithdrawDelegatorRewardExec(clientCtx, tc.valAddr, tc.args...)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(bz, tc.respType), string(bz))
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewWithdrawRewardsPartialCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid validator address",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=0.5", cli.FlagPercentage),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"neither amount nor percentage",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"invalid percentage",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=1.5", cli.FlagPercentage),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"insufficient rewards",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagAmount, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(1000000000000))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, types.ErrInsufficientRewards.ABCICode(),
+		},
+		{
+			"valid transaction",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=0.5", cli.FlagPercentage),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewWithdrawRewardsPartialCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewWithdrawAllRewardsCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"valid transaction (offline)",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagOffline),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewWithdrawAllRewardsCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetWithdrawAddrCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid withdraw address",
+			[]string{
+				"foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetWithdrawAddrCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetDelegationWithdrawAddrCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid validator address",
+			[]string{
+				"foo", val.Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"invalid withdraw address",
+			[]string{
+				val.ValAddress.String(), "foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction",
+			[]string{
+				val.ValAddress.String(), val.Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"valid transaction without withdraw address",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetDelegationWithdrawAddrCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid funding amount",
+			[]string{
+				"-43foocoin",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction",
+			[]string{
+				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431))).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewFundCommunityPoolCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetAutoCompoundCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid validator address",
+			[]string{
+				"foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"auto-compounding disabled",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, types.ErrAutoCompoundDisabled.ABCICode(),
+		},
+		{
+			"valid transaction disabling auto-compounding",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetAutoCompoundCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
+	val := s.network.Validators[0]
+	invalidProp := `{
+  "title": "",
+  "description": "Pay me some Atoms!",
+  "recipient": "foo",
+  "amount": "-343foocoin",
+  "deposit": -324foocoin
+}`
+	invalidPropFile := testutil.WriteToNewTempFile(s.T(), invalidProp)
+	validProp := fmt.Sprintf(`{
+  "title": "Community Pool Spend",
+  "description": "Pay me some Atoms!",
+  "recipient": "%s",
+  "amount": "%s",
+  "deposit": "%s"
+}`, val.Address.String(), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)))
+	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
 		respType     proto.Message
 		expectedCode uint32
 	}{
 		{
-			"invalid funding amount",
+			"invalid proposal",
 			[]string{
-				"-43foocoin",
+				invalidPropFile.Name(),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
 			true, nil, 0,
@@ -652,10 +1430,10 @@ func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
 		{
 			"valid transaction",
 			[]string{
-				sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431))).String(),
+				validPropFile.Name(),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync), // sync mode as there are no funds yet
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 			},
 			false, &sdk.TxResponse{}, 0,
@@ -666,8 +1444,9 @@ func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
 		tc := tc
 
 		s.Run(tc.name, func() {
-			cmd := cli.NewFundCommunityPoolCmd()
+			cmd := cli.GetCmdSubmitProposal()
 			clientCtx := val.ClientCtx
+			flags.AddTxFlagsToCmd(cmd)
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
@@ -677,30 +1456,99 @@ func (s *IntegrationTestSuite) TestNewFundCommunityPoolCmd() {
 				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
 
 				txResp := tc.respType.(*sdk.TxResponse)
-				s.Require().Equal(tc.expectedCode, txResp.Code)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
 			}
 		})
 	}
 }
 
-func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
+func (s *IntegrationTestSuite) TestGetCmdSubmitStreamProposals() {
 	val := s.network.Validators[0]
-	invalidProp := `{
-  "title": "",
-  "description": "Pay me some Atoms!",
-  "recipient": "foo",
-  "amount": "-343foocoin",
-  "deposit": -324foocoin
-}`
+	invalidProp := fmt.Sprintf(`{
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every day!",
+  "recipient": "%s",
+  "amount": "%s",
+  "interval": "0",
+  "deposit": "%s"
+}`, val.Address.String(), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)))
 	invalidPropFile := testutil.WriteToNewTempFile(s.T(), invalidProp)
 	validProp := fmt.Sprintf(`{
-  "title": "Community Pool Spend",
-  "description": "Pay me some Atoms!",
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every day!",
   "recipient": "%s",
   "amount": "%s",
+  "interval": "14400",
   "deposit": "%s"
 }`, val.Address.String(), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)), sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)))
 	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
+	cancelProp := fmt.Sprintf(`{
+  "title": "Cancel Community Pool Stream",
+  "description": "Stop paying me Atoms",
+  "stream_id": "1",
+  "deposit": "%s"
+}`, sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431)))
+	cancelPropFile := testutil.WriteToNewTempFile(s.T(), cancelProp)
+
+	txFlags := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{
+			"invalid stream proposal",
+			cli.GetCmdSubmitStreamProposal(),
+			append([]string{invalidPropFile.Name()}, txFlags...),
+			true, 0,
+		},
+		{
+			"valid stream proposal",
+			cli.GetCmdSubmitStreamProposal(),
+			append([]string{validPropFile.Name()}, txFlags...),
+			false, 0,
+		},
+		{
+			// the proposal content is checked against the state on submission
+			"cancel proposal of an unknown stream",
+			cli.GetCmdSubmitCancelStreamProposal(),
+			append([]string{cancelPropFile.Name()}, txFlags...),
+			false, govtypes.ErrInvalidProposalContent.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			flags.AddTxFlagsToCmd(tc.cmd)
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+
+				var txResp sdk.TxResponse
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewSetCommissionWithdrawScheduleCmd() {
+	val := s.network.Validators[0]
+
 	testCases := []struct {
 		name         string
 		args         []string
@@ -709,9 +1557,217 @@ func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
 		expectedCode uint32
 	}{
 		{
-			"invalid proposal",
+			"invalid interval",
 			[]string{
-				invalidPropFile.Name(),
+				"foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction",
+			[]string{
+				"100",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetCommissionWithdrawScheduleCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	cmd := cli.GetCmdQueryCommissionWithdrawSchedule()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{val.ValAddress.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var schedule types.CommissionWithdrawSchedule
+	s.Require().NoError(val.ClientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), &schedule), out.String())
+	s.Require().Equal(val.ValAddress.String(), schedule.ValidatorAddress)
+	s.Require().Equal(uint64(100), schedule.Interval)
+}
+
+func (s *IntegrationTestSuite) TestNewSetLockedRewardsCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid enabled",
+			[]string{
+				"foo",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"not a vesting account",
+			[]string{
+				"true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, types.ErrRewardsNotLockable.ABCICode(),
+		},
+		{
+			"valid transaction",
+			[]string{
+				"false",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewSetLockedRewardsCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONMarshaler.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryLockedRewards() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{
+			"invalid address",
+			[]string{"foo", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			"",
+		},
+		{
+			"json output",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			`{"enabled":false}`,
+		},
+		{
+			"text output",
+			[]string{val.Address.String(), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			false,
+			`enabled: false`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLockedRewards()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestNewCommunityPoolSpendProposalCmd() {
+	val := s.network.Validators[0]
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(5431))).String()
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid recipient",
+			[]string{
+				"foo", amount,
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Community Pool Spend"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Pay me some Atoms!"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, amount),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"missing title",
+			[]string{
+				val.Address.String(), amount,
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Pay me some Atoms!"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, amount),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, nil, 0,
+		},
+		{
+			"invalid deposit",
+			[]string{
+				val.Address.String(), amount,
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Community Pool Spend"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Pay me some Atoms!"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, "-324foocoin"),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
@@ -722,7 +1778,10 @@ func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
 		{
 			"valid transaction",
 			[]string{
-				validPropFile.Name(),
+				val.Address.String(), amount,
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Community Pool Spend"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Pay me some Atoms!"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, amount),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync), // sync mode as there are no funds yet
@@ -736,9 +1795,8 @@ func (s *IntegrationTestSuite) TestGetCmdSubmitProposal() {
 		tc := tc
 
 		s.Run(tc.name, func() {
-			cmd := cli.GetCmdSubmitProposal()
+			cmd := cli.NewCommunityPoolSpendProposalCmd()
 			clientCtx := val.ClientCtx
-			flags.AddTxFlagsToCmd(cmd)
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErr {
@@ -1,12 +1,16 @@
 package cli
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/csv"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -15,6 +19,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
+// OutputFormatCSV is the --output format printing rewards as CSV rows.
+const OutputFormatCSV = "csv"
+
+// Query flags for the x/distribution module
+var (
+	FlagDenom        = "denom"
+	FlagDisplayDenom = "display-denom"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	distQueryCmd := &cobra.Command{
@@ -31,7 +44,16 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorCommission(),
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
+		GetCmdQueryValidatorDelegatorsRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryWithdrawAddress(),
+		GetCmdQueryAutoCompound(),
+		GetCmdQueryCommissionWithdrawSchedule(),
+		GetCmdQueryRewardsProjection(),
+		GetCmdQueryInvariants(),
+		GetCmdQueryFundingStream(),
+		GetCmdQueryFundingStreams(),
+		GetCmdQueryLockedRewards(),
 	)
 
 	return distQueryCmd
@@ -88,14 +110,14 @@ $ %s query distribution validator-outstanding-rewards %s1lwjmdnks33xwnmfayc64ycp
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.ValidatorOutstandingRewards(
 				context.Background(),
-				&types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: validatorAddr.String()},
+				&types.QueryValidatorOutstandingRewardsRequest{ValidatorAddress: clientCtx.ValAddressString(validatorAddr)},
 			)
 			if err != nil {
 				return err
@@ -133,14 +155,14 @@ $ %s query distribution commission %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			res, err := queryClient.ValidatorCommission(
 				context.Background(),
-				&types.QueryValidatorCommissionRequest{ValidatorAddress: validatorAddr.String()},
+				&types.QueryValidatorCommissionRequest{ValidatorAddress: clientCtx.ValAddressString(validatorAddr)},
 			)
 			if err != nil {
 				return err
@@ -163,12 +185,15 @@ func GetCmdQueryValidatorSlashes() *cobra.Command {
 		Args:  cobra.ExactArgs(3),
 		Short: "Query distribution validator slashes",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query all slashes of a validator for a given block range.
+			fmt.Sprintf(`Query all slashes of a validator for a given block range. The results
+are paginated, the next page being queried by passing the pagination next_key of
+the output to the --page-key flag.
 
 Example:
-$ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 0 100
+$ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 0 100 --limit 10
+$ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 0 100 --limit 10 --page-key AAAAAAAAAAoAAAAAAAAAAw==
 `,
-				version.AppName, bech32PrefixValAddr,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -178,7 +203,7 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			validatorAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -198,10 +223,18 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 
+			// the slash event keys are binary, the page key is given as the base64
+			// encoded next_key of a previous output
+			pageKey := string(pageReq.Key)
+			pageReq.Key, err = base64.StdEncoding.DecodeString(pageKey)
+			if err != nil {
+				return fmt.Errorf("page-key %s not a valid base64 string: %w", pageKey, err)
+			}
+
 			res, err := queryClient.ValidatorSlashes(
 				context.Background(),
 				&types.QueryValidatorSlashesRequest{
-					ValidatorAddress: validatorAddr.String(),
+					ValidatorAddress: clientCtx.ValAddressString(validatorAddr),
 					StartingHeight:   startHeight,
 					EndingHeight:     endHeight,
 					Pagination:       pageReq,
@@ -220,6 +253,71 @@ $ %s query distribution slashes %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryValidatorDelegatorsRewards implements the query validator
+// delegators rewards command.
+func GetCmdQueryValidatorDelegatorsRewards() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-delegators-rewards [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending rewards of each delegator of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending rewards of each delegator of a validator, ordered by delegator
+address. The results are paginated, the next page being queried by passing the
+pagination next_key of the output to the --page-key flag.
+
+Example:
+$ %s query distribution validator-delegators-rewards %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --limit 100
+$ %s query distribution validator-delegators-rewards %svaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --limit 100 --page-key RJ9S1zN2y1sypsH+OyG5Wd7Lafs=
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := clientCtx.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// the page key is the base64 encoded next_key of a previous output
+			pageKey := string(pageReq.Key)
+			pageReq.Key, err = base64.StdEncoding.DecodeString(pageKey)
+			if err != nil {
+				return fmt.Errorf("page-key %s not a valid base64 string: %w", pageKey, err)
+			}
+
+			res, err := queryClient.ValidatorDelegatorsRewards(
+				context.Background(),
+				&types.QueryValidatorDelegatorsRewardsRequest{
+					ValidatorAddress: clientCtx.ValAddressString(validatorAddr),
+					Pagination:       pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator delegators rewards")
+	return cmd
+}
+
 // GetCmdQueryDelegatorRewards implements the query delegator rewards command.
 func GetCmdQueryDelegatorRewards() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -231,12 +329,28 @@ func GetCmdQueryDelegatorRewards() *cobra.Command {
 		Short: "Query all distribution delegator rewards or rewards from a particular validator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query all rewards earned by a delegator, optionally restrict to rewards from a single validator.
+The rewards earned as of a past block are queried with the --height flag, the node having to
+retain the state of that block (see its pruning settings).
+
+With --output csv, the rewards are printed as CSV rows of delegator address, validator address,
+denom and amount, one per validator and denom, for bookkeeping tools to ingest.
+
+With --denom, only the rewards in the given denom are queried.
+
+With --display-denom, the rewards from each validator are also totaled in the given display denom,
+converting the units of its bank denom metadata. Rewards in other denoms are left out of the totals.
 
 Example:
 $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --height 100000
+$ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --output csv > rewards.csv
+$ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --denom uatom
+$ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --display-denom atom
 `,
 				version.AppName, bech32PrefixAccAddr, version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+				version.AppName, bech32PrefixAccAddr, version.AppName, bech32PrefixAccAddr,
+				version.AppName, bech32PrefixAccAddr, version.AppName, bech32PrefixAccAddr,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -246,45 +360,105 @@ $ %s query distribution rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1ggh
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			delegatorAddr, err := clientCtx.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			displayDenom, err := cmd.Flags().GetString(FlagDisplayDenom)
 			if err != nil {
 				return err
 			}
 
 			// query for rewards from a particular delegation
 			if len(args) == 2 {
-				validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+				if displayDenom != "" {
+					return fmt.Errorf("--%s is only supported when querying the rewards from all validators", FlagDisplayDenom)
+				}
+
+				validatorAddr, err := clientCtx.ValAddressFromBech32(args[1])
 				if err != nil {
 					return err
 				}
 
 				res, err := queryClient.DelegationRewards(
 					context.Background(),
-					&types.QueryDelegationRewardsRequest{DelegatorAddress: delegatorAddr.String(), ValidatorAddress: validatorAddr.String()},
+					&types.QueryDelegationRewardsRequest{
+						DelegatorAddress: clientCtx.AccAddressString(delegatorAddr),
+						ValidatorAddress: clientCtx.ValAddressString(validatorAddr),
+						Denom:            denom,
+					},
 				)
 				if err != nil {
 					return err
 				}
 
+				if clientCtx.OutputFormat == OutputFormatCSV {
+					return printRewardsCSV(clientCtx, clientCtx.AccAddressString(delegatorAddr), []types.DelegationDelegatorReward{
+						{ValidatorAddress: clientCtx.ValAddressString(validatorAddr), Reward: res.Rewards},
+					})
+				}
+
 				return clientCtx.PrintProto(res)
 			}
 
 			res, err := queryClient.DelegationTotalRewards(
 				context.Background(),
-				&types.QueryDelegationTotalRewardsRequest{DelegatorAddress: delegatorAddr.String()},
+				&types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: clientCtx.AccAddressString(delegatorAddr),
+					Denom:            denom,
+					DisplayDenom:     displayDenom,
+				},
 			)
 			if err != nil {
 				return err
 			}
 
+			if clientCtx.OutputFormat == OutputFormatCSV {
+				return printRewardsCSV(clientCtx, clientCtx.AccAddressString(delegatorAddr), res.Rewards)
+			}
+
 			return clientCtx.PrintProto(res)
 		},
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Lookup(tmcli.OutputFlag).Usage = "Output format (text|json|csv)"
+	cmd.Flags().String(FlagDenom, "", "Only query the rewards in this denom")
+	cmd.Flags().String(FlagDisplayDenom, "", "Also total the rewards from each validator in this display denom")
 	return cmd
 }
 
+// printRewardsCSV prints the rewards of a delegator as CSV rows of delegator
+// address, validator address, denom and amount, preceded by a header row.
+func printRewardsCSV(clientCtx client.Context, delegator string, rewards []types.DelegationDelegatorReward) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"delegator_address", "validator_address", "denom", "amount"}); err != nil {
+		return err
+	}
+	for _, reward := range rewards {
+		for _, coin := range reward.Reward {
+			if err := w.Write([]string{delegator, reward.ValidatorAddress, coin.Denom, coin.Amount.String()}); err != nil {
+				return err
+			}
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	return clientCtx.PrintBytes(buf.Bytes())
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info.
 func GetCmdQueryCommunityPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -319,3 +493,383 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryWithdrawAddress implements the query withdraw address command.
+func GetCmdQueryWithdrawAddress() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-address [delegator-addr] [validator-addr]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the address the rewards of a delegator are withdrawn to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the address the rewards of a delegator are withdrawn to, optionally the one the
+rewards from a single validator are withdrawn to, which defaults to the former.
+
+Example:
+$ %s query distribution withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query distribution withdraw-address %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, version.AppName, bech32PrefixAccAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// query for the withdraw address of a particular delegation
+			if len(args) == 2 {
+				validatorAddr, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.DelegationWithdrawAddress(
+					context.Background(),
+					&types.QueryDelegationWithdrawAddressRequest{
+						DelegatorAddress: delegatorAddr.String(),
+						ValidatorAddress: validatorAddr.String(),
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			res, err := queryClient.DelegatorWithdrawAddress(
+				context.Background(),
+				&types.QueryDelegatorWithdrawAddressRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAutoCompound implements the query auto-compounding command.
+func GetCmdQueryAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the auto-compounding of the rewards of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators whose delegation rewards are automatically re-delegated
+for a delegator, and the height at which they are next compounded.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoCompound(
+				context.Background(),
+				&types.QueryAutoCompoundRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.AutoCompound)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCommissionWithdrawSchedule implements the query commission
+// withdrawal schedule command.
+func GetCmdQueryCommissionWithdrawSchedule() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "commission-withdraw-schedule [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the commission withdrawal schedule of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of blocks between two automatic withdrawals of the commission
+of a validator, and the height at which it is next withdrawn.
+
+Example:
+$ %s query distribution commission-withdraw-schedule %s1lwjmdnks33xwnmfayc64ycprww49n33mtm92ne
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validatorAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CommissionWithdrawSchedule(
+				context.Background(),
+				&types.QueryCommissionWithdrawScheduleRequest{ValidatorAddress: validatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Schedule)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRewardsProjection implements the query rewards projection command.
+func GetCmdQueryRewardsProjection() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "rewards-projection [delegator-addr|validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Estimate the annualized rewards rate of a delegator or of the delegators of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the annualized rewards rate (APR) of the delegations of a delegator, or of the
+delegations to a validator net of its commission, along with their projected rewards over a year.
+The estimation is based on the current inflation, community tax and bonded ratio, and does not
+take transaction fees into account.
+
+Example:
+$ %s query distribution rewards-projection %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query distribution rewards-projection %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixAccAddr, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardsProjectionRequest{}
+			if validatorAddr, err := clientCtx.ValAddressFromBech32(args[0]); err == nil {
+				req.ValidatorAddress = clientCtx.ValAddressString(validatorAddr)
+			} else {
+				delegatorAddr, err := clientCtx.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				req.DelegatorAddress = clientCtx.AccAddressString(delegatorAddr)
+			}
+
+			res, err := queryClient.RewardsProjection(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryInvariants implements the query invariants command.
+func GetCmdQueryInvariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants [route]...",
+		Args:  cobra.ArbitraryArgs,
+		Short: "Run the distribution invariants against the state of a node",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the distribution invariants, as registered with the crisis module, against the
+state of a node and report whether each of them is broken along with its details. Only
+the invariants of the given routes are run, if any.
+
+Example:
+$ %s query distribution invariants
+$ %s query distribution invariants can-withdraw reference-count
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Invariants(context.Background(), &types.QueryInvariantsRequest{Routes: args})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFundingStream implements the query funding stream command.
+func GetCmdQueryFundingStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-stream [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a funding stream from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a funding stream from the community pool by id.
+
+Example:
+$ %s query distribution funding-stream 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("stream id %s not a valid uint: %w", args[0], err)
+			}
+
+			res, err := queryClient.FundingStream(context.Background(), &types.QueryFundingStreamRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Stream)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFundingStreams implements the query funding streams command.
+func GetCmdQueryFundingStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-streams",
+		Args:  cobra.NoArgs,
+		Short: "Query all funding streams from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all funding streams from the community pool, ordered by id.
+
+Example:
+$ %s query distribution funding-streams --limit 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FundingStreams(context.Background(), &types.QueryFundingStreamsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funding streams")
+	return cmd
+}
+
+// GetCmdQueryLockedRewards implements the query locked rewards command.
+func GetCmdQueryLockedRewards() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "locked-rewards [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the rewards withdrawn to a vesting account are locked",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the rewards and commission withdrawn to a vesting account are
+added to its vesting coins instead of being spendable.
+
+Example:
+$ %s query distribution locked-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LockedRewards(
+				context.Background(),
+				&types.QueryLockedRewardsRequest{Address: addr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
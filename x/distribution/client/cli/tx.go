@@ -3,10 +3,10 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -14,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -21,6 +22,8 @@ import (
 var (
 	FlagCommission       = "commission"
 	FlagMaxMessagesPerTx = "max-msgs"
+	FlagAmount           = "amount"
+	FlagPercentage       = "percentage"
 )
 
 const (
@@ -39,23 +42,33 @@ func NewTxCmd() *cobra.Command {
 
 	distTxCmd.AddCommand(
 		NewWithdrawRewardsCmd(),
+		NewWithdrawRewardsPartialCmd(),
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
+		NewSetDelegationWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoCompoundCmd(),
+		NewSetCommissionWithdrawScheduleCmd(),
+		NewSetLockedRewardsCmd(),
+		NewCommunityPoolSpendProposalCmd(),
 	)
 
 	return distTxCmd
 }
 
-type newGenerateOrBroadcastFunc func(client.Context, *pflag.FlagSet, ...sdk.Msg) error
+type newGenerateOrBroadcastFunc func(client.Context, tx.Factory, ...sdk.Msg) error
 
+// newSplitAndApply splits msgs into chunks of at most chunkSize messages, or a
+// single chunk if chunkSize is 0, and generates or broadcasts a transaction for
+// each of them. The sequence of the factory is incremented from a chunk to the
+// next one, as the transactions are broadcast before the previous ones are committed.
 func newSplitAndApply(
 	genOrBroadcastFn newGenerateOrBroadcastFunc, clientCtx client.Context,
-	fs *pflag.FlagSet, msgs []sdk.Msg, chunkSize int,
+	txf tx.Factory, msgs []sdk.Msg, chunkSize int,
 ) error {
 
 	if chunkSize == 0 {
-		return genOrBroadcastFn(clientCtx, fs, msgs...)
+		return genOrBroadcastFn(clientCtx, txf, msgs...)
 	}
 
 	// split messages into slices of length chunkSize
@@ -68,9 +81,11 @@ func newSplitAndApply(
 		}
 
 		msgChunk := msgs[i:sliceEnd]
-		if err := genOrBroadcastFn(clientCtx, fs, msgChunk...); err != nil {
+		if err := genOrBroadcastFn(clientCtx, txf, msgChunk...); err != nil {
 			return err
 		}
+
+		txf = txf.WithSequence(txf.Sequence() + 1)
 	}
 
 	return nil
@@ -100,7 +115,7 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 				return err
 			}
 			delAddr := clientCtx.GetFromAddress()
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -127,17 +142,82 @@ $ %s tx distribution withdraw-rewards %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+func NewWithdrawRewardsPartialCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards-partial [validator-addr]",
+		Short: "Withdraw part of the rewards from a given delegation address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw either an amount or a percentage of the rewards from a given delegation
+address. The remaining rewards are left unclaimed and keep accruing.
+
+Example:
+$ %s tx distribution withdraw-rewards-partial %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --amount 100stake --from mykey
+$ %s tx distribution withdraw-rewards-partial %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --percentage 0.25 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := clientCtx.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var amount sdk.Coins
+			if amountStr, _ := cmd.Flags().GetString(FlagAmount); amountStr != "" {
+				amount, err = sdk.ParseCoinsNormalized(amountStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var percentage sdk.Dec
+			if percentageStr, _ := cmd.Flags().GetString(FlagPercentage); percentageStr != "" {
+				percentage, err = sdk.NewDecFromStr(percentageStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgWithdrawDelegatorRewardPartial(delAddr, valAddr, amount, percentage)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "Amount of rewards to withdraw")
+	cmd.Flags().String(FlagPercentage, "", "Ratio of the rewards to withdraw, in (0, 1]")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewWithdrawAllRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-all-rewards",
 		Short: "withdraw all delegations rewards for a delegator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator. The withdrawals are split into
+several transactions, of at most --max-msgs messages each, so that they stay below
+the gas limits. The transactions are signed with consecutive sequences, starting
+from the account sequence or --sequence.
 
 Example:
 $ %s tx distribution withdraw-all-rewards --from mykey
+$ %s tx distribution withdraw-all-rewards --from mykey --max-msgs 10
 `,
-				version.AppName,
+				version.AppName, version.AppName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -164,7 +244,7 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 			// build multi-message transaction
 			msgs := make([]sdk.Msg, 0, len(validators))
 			for _, valAddr := range validators {
-				val, err := sdk.ValAddressFromBech32(valAddr)
+				val, err := clientCtx.ValAddressFromBech32(valAddr)
 				if err != nil {
 					return err
 				}
@@ -176,8 +256,18 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 				msgs = append(msgs, msg)
 			}
 
+			// the account number and sequence are retrieved once, the sequence
+			// of the transactions of the following chunks being incremented
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if !clientCtx.GenerateOnly {
+				txf, err = tx.PrepareFactory(clientCtx, txf)
+				if err != nil {
+					return err
+				}
+			}
+
 			chunkSize, _ := cmd.Flags().GetInt(FlagMaxMessagesPerTx)
-			return newSplitAndApply(tx.GenerateOrBroadcastTxCLI, clientCtx, cmd.Flags(), msgs, chunkSize)
+			return newSplitAndApply(tx.GenerateOrBroadcastTxWithFactory, clientCtx, txf, msgs, chunkSize)
 		},
 	}
 
@@ -209,7 +299,7 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 			delAddr := clientCtx.GetFromAddress()
-			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			withdrawAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -228,6 +318,59 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+func NewSetDelegationWithdrawAddrCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-delegation-withdraw-addr [validator-addr] [withdraw-addr]",
+		Short: "change the withdraw address for the rewards of a delegation to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the withdraw address for the rewards of a delegation to a validator,
+overriding the delegator withdraw address. Without withdraw address, the rewards
+of the delegation are withdrawn to the delegator withdraw address again.
+
+Example:
+$ %s tx distribution set-delegation-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+$ %s tx distribution set-delegation-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixAccAddr, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := clientCtx.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var withdrawAddr sdk.AccAddress
+			if len(args) > 1 {
+				withdrawAddr, err = clientCtx.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetDelegationWithdrawAddress(delAddr, valAddr, withdrawAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-community-pool [amount]",
@@ -267,6 +410,220 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr]...",
+		Short: "Automatically re-delegate the rewards from the given validators",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the validators whose delegation rewards are periodically withdrawn and
+re-delegated to them at the end of a block, replacing the previously set ones.
+Only the rewards in the bond denom are re-delegated. The rewards must be
+withdrawn to the delegator address. Without validators, auto-compounding is
+disabled for the delegator.
+
+Example:
+$ %s tx distribution set-auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey
+$ %s tx distribution set-auto-compound --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, version.AppName,
+			),
+		),
+		Args: cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+
+			valAddrs := make([]sdk.ValAddress, len(args))
+			for i, arg := range args {
+				valAddrs[i], err = clientCtx.ValAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddrs)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetCommissionWithdrawScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-commission-withdraw-schedule [interval]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Automatically withdraw the commission of a validator every interval blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the number of blocks between two automatic withdrawals of the commission of
+the validator operated by the sender to its withdraw address, replacing the
+previous schedule. The commission is first withdrawn one interval after the
+inclusion of the transaction. A zero interval removes the schedule.
+
+Example:
+$ %s tx distribution set-commission-withdraw-schedule 14400 --from mykey
+$ %s tx distribution set-commission-withdraw-schedule 0 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			interval, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCommissionWithdrawSchedule(valAddr, interval)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewSetLockedRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-locked-rewards [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Lock the rewards withdrawn to a vesting account in its vesting schedule",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set whether the rewards and commission withdrawn to the vesting account of the
+sender are added to its vesting coins, delegatable but locked until they vest
+along with the other coins of the account, instead of being spendable. Only
+continuous, delayed and periodic vesting accounts can lock their rewards.
+
+Example:
+$ %s tx distribution set-locked-rewards true --from mykey
+$ %s tx distribution set-locked-rewards false --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLockedRewards(clientCtx.GetFromAddress(), enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCommunityPoolSpendProposalCmd implements the command to submit a
+// community-pool-spend proposal from flags instead of a proposal file.
+func NewCommunityPoolSpendProposalCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "community-pool-spend-proposal [recipient] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a community pool spend proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to pay the given amount from the community pool to the
+recipient, along with an initial deposit.
+
+Example:
+$ %s tx distribution community-pool-spend-proposal %s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq 1000stake --title "Community Pool Spend" --description "Pay me some Atoms!" --deposit 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recpAddr, err := clientCtx.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCommunityPoolSpendProposal(title, description, recpAddr, amount)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -316,7 +673,7 @@ Where proposal.json contains:
 			}
 
 			from := clientCtx.GetFromAddress()
-			recpAddr, err := sdk.AccAddressFromBech32(proposal.Recipient)
+			recpAddr, err := clientCtx.AccAddressFromBech32(proposal.Recipient)
 			if err != nil {
 				return err
 			}
@@ -337,3 +694,135 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitStreamProposal implements the command to submit a community-pool-stream proposal
+func GetCmdSubmitStreamProposal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool stream proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool stream proposal along with an initial deposit, paying
+the amount from the community pool to the recipient every interval blocks until the stream
+is cancelled. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Stream",
+  "description": "Pay me some Atoms every day!",
+  "recipient": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": "1000stake",
+  "interval": "14400",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseCommunityPoolStreamProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(proposal.Amount)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			recpAddr, err := clientCtx.AccAddressFromBech32(proposal.Recipient)
+			if err != nil {
+				return err
+			}
+			content := types.NewCommunityPoolStreamProposal(proposal.Title, proposal.Description, recpAddr, amount, proposal.Interval)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelStreamProposal implements the command to submit a cancel-community-pool-stream proposal
+func GetCmdSubmitCancelStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-community-pool-stream [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a community pool stream",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel a community pool stream along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-community-pool-stream <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Community Pool Stream",
+  "description": "Stop paying me Atoms",
+  "stream_id": "1",
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseCancelCommunityPoolStreamProposalWithDeposit(clientCtx.JSONMarshaler, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewCancelCommunityPoolStreamProposal(proposal.Title, proposal.Description, proposal.StreamId)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
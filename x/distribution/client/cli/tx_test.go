@@ -3,8 +3,6 @@ package cli
 import (
 	"testing"
 
-	"github.com/spf13/pflag"
-
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -15,13 +13,14 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func Test_splitAndCall_NoMessages(t *testing.T) {
 	clientCtx := client.Context{}
 
-	err := newSplitAndApply(nil, clientCtx, nil, nil, 10)
+	err := newSplitAndApply(nil, clientCtx, tx.Factory{}, nil, 10)
 	assert.NoError(t, err, "")
 }
 
@@ -44,12 +43,15 @@ func Test_splitAndCall_Splitting(t *testing.T) {
 
 	callCount := 0
 	err := newSplitAndApply(
-		func(clientCtx client.Context, fs *pflag.FlagSet, msgs ...sdk.Msg) error {
+		func(clientCtx client.Context, txf tx.Factory, msgs ...sdk.Msg) error {
 			callCount++
 
 			assert.NotNil(t, clientCtx)
 			assert.NotNil(t, msgs)
 
+			// the transactions of the chunks have consecutive sequences
+			assert.Equal(t, uint64(6+callCount), txf.Sequence())
+
 			if callCount < 3 {
 				assert.Equal(t, len(msgs), 2)
 			} else {
@@ -58,7 +60,7 @@ func Test_splitAndCall_Splitting(t *testing.T) {
 
 			return nil
 		},
-		clientCtx, nil, msgs, chunkSize)
+		clientCtx, tx.Factory{}.WithSequence(7), msgs, chunkSize)
 
 	assert.NoError(t, err, "")
 	assert.Equal(t, 3, callCount)
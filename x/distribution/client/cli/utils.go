@@ -22,3 +22,35 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONMarshaler, proposa
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolStreamProposalWithDeposit reads and parses a CommunityPoolStreamProposalWithDeposit from a file.
+func ParseCommunityPoolStreamProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.CommunityPoolStreamProposalWithDeposit, error) {
+	proposal := types.CommunityPoolStreamProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelCommunityPoolStreamProposalWithDeposit reads and parses a CancelCommunityPoolStreamProposalWithDeposit from a file.
+func ParseCancelCommunityPoolStreamProposalWithDeposit(cdc codec.JSONMarshaler, proposalFile string) (types.CancelCommunityPoolStreamProposalWithDeposit, error) {
+	proposal := types.CancelCommunityPoolStreamProposalWithDeposit{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -6,7 +6,10 @@ import (
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 )
 
-// ProposalHandler is the community spend proposal handler.
+// ProposalHandler is the community spend proposal handler, StreamProposalHandler
+// and CancelStreamProposalHandler the community pool stream proposal handlers.
 var (
-	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	ProposalHandler             = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+	StreamProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitStreamProposal, rest.StreamProposalRESTHandler)
+	CancelStreamProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelStreamProposal, rest.CancelStreamProposalRESTHandler)
 )
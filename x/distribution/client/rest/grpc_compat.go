@@ -0,0 +1,283 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// RegisterGRPCCompatHandlers registers the x/distribution legacy REST query
+// handlers served from the gRPC query service instead of the legacy querier.
+// Their responses have the same shape as the ones of the handlers registered by
+// RegisterHandlers, which they take precedence over when registered first.
+func RegisterGRPCCompatHandlers(clientCtx client.Context, rtr *mux.Router) {
+	r := clientrest.WithHTTPDeprecationHeaders(rtr)
+
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards",
+		grpcDelegatorRewardsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/rewards/{validatorAddr}",
+		grpcDelegationRewardsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/withdraw_address",
+		grpcDelegatorWithdrawalAddrHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}",
+		grpcValidatorInfoHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/rewards",
+		grpcValidatorRewardsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/validators/{validatorAddr}/outstanding_rewards",
+		grpcOutstandingRewardsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/parameters",
+		grpcParamsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/distribution/community_pool",
+		grpcCommunityPoolHandlerFn(clientCtx),
+	).Methods("GET")
+}
+
+// HTTP request handler to query the total rewards balance from all delegations
+// from the gRPC query service
+func grpcDelegatorRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).DelegationTotalRewards(context.Background(), &types.QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: delegatorAddr.String(),
+		}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res)
+	}
+}
+
+// HTTP request handler to query a delegation rewards from the gRPC query
+// service
+func grpcDelegationRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		rewards, header, err := queryGRPCDelegationRewards(clientCtx, delegatorAddr, validatorAddr)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, rewards)
+	}
+}
+
+// HTTP request handler to query the rewards withdrawal address of a delegator
+// from the gRPC query service
+func grpcDelegatorWithdrawalAddrHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).DelegatorWithdrawAddress(context.Background(), &types.QueryDelegatorWithdrawAddressRequest{
+			DelegatorAddress: delegatorAddr.String(),
+		}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		withdrawAddr, err := sdk.AccAddressFromBech32(res.WithdrawAddress)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, withdrawAddr)
+	}
+}
+
+// HTTP request handler to query validator's distribution information from the
+// gRPC query service
+func grpcValidatorInfoHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		commission, err := types.NewQueryClient(clientCtx).ValidatorCommission(context.Background(), &types.QueryValidatorCommissionRequest{
+			ValidatorAddress: valAddr.String(),
+		})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		// self bond rewards
+		delAddr := sdk.AccAddress(valAddr)
+		rewards, header, err := queryGRPCDelegationRewards(clientCtx, delAddr, valAddr)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, NewValidatorDistInfo(delAddr, rewards, commission.Commission))
+	}
+}
+
+// HTTP request handler to query validator's self-delegation rewards from the
+// gRPC query service
+func grpcValidatorRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		rewards, header, err := queryGRPCDelegationRewards(clientCtx, sdk.AccAddress(validatorAddr), validatorAddr)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, rewards)
+	}
+}
+
+// HTTP request handler to query the outstanding rewards from the gRPC query
+// service
+func grpcOutstandingRewardsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		clientCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).ValidatorOutstandingRewards(context.Background(), &types.QueryValidatorOutstandingRewardsRequest{
+			ValidatorAddress: validatorAddr.String(),
+		}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Rewards)
+	}
+}
+
+// HTTP request handler to query the distribution params values from the gRPC
+// query service
+func grpcParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).Params(context.Background(), &types.QueryParamsRequest{}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Params)
+	}
+}
+
+// HTTP request handler to query the amount held in the community pool from the
+// gRPC query service
+func grpcCommunityPoolHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).CommunityPool(context.Background(), &types.QueryCommunityPoolRequest{}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Pool)
+	}
+}
+
+func queryGRPCDelegationRewards(
+	clientCtx client.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+) (sdk.DecCoins, metadata.MD, error) {
+	var header metadata.MD
+	res, err := types.NewQueryClient(clientCtx).DelegationRewards(context.Background(), &types.QueryDelegationRewardsRequest{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}, grpc.Header(&header))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return res.Rewards, header, nil
+}
@@ -0,0 +1,55 @@
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	distrrest "github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
+)
+
+func (s *IntegrationTestSuite) TestGRPCCompatHandlers() {
+	val := s.network.Validators[0]
+
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	distrrest.RegisterGRPCCompatHandlers(val.ClientCtx, router)
+
+	paths := []string{
+		fmt.Sprintf("/distribution/delegators/%s/rewards", val.Address),
+		fmt.Sprintf("/distribution/delegators/%s/rewards/%s", val.Address, val.ValAddress),
+		fmt.Sprintf("/distribution/delegators/%s/withdraw_address", val.Address),
+		fmt.Sprintf("/distribution/validators/%s", val.ValAddress),
+		fmt.Sprintf("/distribution/validators/%s/rewards", val.ValAddress),
+		fmt.Sprintf("/distribution/validators/%s/outstanding_rewards", val.ValAddress),
+		"/distribution/parameters",
+		"/distribution/community_pool",
+	}
+
+	for _, path := range paths {
+		path := path
+		s.Run(path, func() {
+			// query both handlers at the same height, as rewards change every block
+			sep := "?"
+			if strings.Contains(path, "?") {
+				sep = "&"
+			}
+			path = fmt.Sprintf("%s%sheight=%d", path, sep, height)
+
+			legacy, err := rest.GetRequest(val.APIAddress + path)
+			s.Require().NoError(err)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+			s.Require().Equal("true", rec.Header().Get("Deprecation"))
+			s.Require().JSONEq(string(legacy), rec.Body.String())
+		})
+	}
+}
@@ -23,14 +23,34 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.WithdrawDelegatorReward(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgWithdrawDelegatorRewardPartial:
+			res, err := msgServer.WithdrawDelegatorRewardPartial(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgWithdrawValidatorCommission:
 			res, err := msgServer.WithdrawValidatorCommission(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetCommissionWithdrawSchedule:
+			res, err := msgServer.SetCommissionWithdrawSchedule(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetLockedRewards:
+			res, err := msgServer.SetLockedRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		case *types.MsgFundCommunityPool:
 			res, err := msgServer.FundCommunityPool(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgSetDelegationWithdrawAddress:
+			res, err := msgServer.SetDelegationWithdrawAddress(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetAutoCompound:
+			res, err := msgServer.SetAutoCompound(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -43,6 +63,12 @@ func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
 		case *types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case *types.CommunityPoolStreamProposal:
+			return keeper.HandleCommunityPoolStreamProposal(ctx, k, c)
+
+		case *types.CancelCommunityPoolStreamProposal:
+			return keeper.HandleCancelCommunityPoolStreamProposal(ctx, k, c)
+
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distr proposal content type: %T", c)
 		}
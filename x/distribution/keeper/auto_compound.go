@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetAutoCompound returns the auto-compounding of the rewards of a delegator.
+func (k Keeper) GetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) (types.AutoCompound, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAutoCompoundKey(delAddr))
+	if bz == nil {
+		return types.AutoCompound{}, false
+	}
+
+	var autoCompound types.AutoCompound
+	k.cdc.MustUnmarshalBinaryBare(bz, &autoCompound)
+	return autoCompound, true
+}
+
+// SetAutoCompound stores the auto-compounding of the rewards of a delegator
+// and queues it at its next height. The auto-compounding must not already be
+// queued at another height.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, autoCompound types.AutoCompound) {
+	delAddr, err := sdk.AccAddressFromBech32(autoCompound.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundKey(delAddr), k.cdc.MustMarshalBinaryBare(&autoCompound))
+	store.Set(types.GetAutoCompoundQueueKey(autoCompound.NextHeight, delAddr), []byte{})
+}
+
+// removeAutoCompound deletes the auto-compounding of the rewards of a
+// delegator along with its queue entry.
+func (k Keeper) removeAutoCompound(ctx sdk.Context, autoCompound types.AutoCompound) {
+	delAddr, err := sdk.AccAddressFromBech32(autoCompound.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundKey(delAddr))
+	store.Delete(types.GetAutoCompoundQueueKey(autoCompound.NextHeight, delAddr))
+}
+
+// IterateAutoCompounds iterates over the auto-compounding delegators and
+// performs a callback function.
+func (k Keeper) IterateAutoCompounds(ctx sdk.Context, cb func(autoCompound types.AutoCompound) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AutoCompoundPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var autoCompound types.AutoCompound
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &autoCompound)
+
+		if cb(autoCompound) {
+			break
+		}
+	}
+}
+
+// EnableAutoCompound sets the validators whose delegation rewards are
+// periodically withdrawn and re-delegated on behalf of a delegator, replacing
+// the previous ones. The rewards are first compounded one interval after the
+// current height.
+func (k Keeper) EnableAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) error {
+	interval := k.GetAutoCompoundInterval(ctx)
+	if interval == 0 {
+		return types.ErrAutoCompoundDisabled
+	}
+	if len(valAddrs) == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidAutoCompound, "no validator for delegator %s", delAddr)
+	}
+
+	validators := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return sdkerrors.Wrapf(types.ErrNoDelegationExists, "delegator %s, validator %s", delAddr, valAddr)
+		}
+		if withdrawAddr := k.GetDelegationWithdrawAddr(ctx, delAddr, valAddr); !withdrawAddr.Equals(delAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidAutoCompound, "rewards of %s from %s are withdrawn to %s", delAddr, valAddr, withdrawAddr)
+		}
+		validators[i] = valAddr.String()
+	}
+
+	k.DisableAutoCompound(ctx, delAddr)
+
+	autoCompound := types.NewAutoCompound(delAddr, validators, ctx.BlockHeight()+int64(interval))
+	if err := autoCompound.Validate(); err != nil {
+		return err
+	}
+	k.SetAutoCompound(ctx, autoCompound)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, strings.Join(validators, ",")),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", autoCompound.NextHeight)),
+		),
+	)
+
+	return nil
+}
+
+// DisableAutoCompound stops the auto-compounding of the rewards of a
+// delegator, if any.
+func (k Keeper) DisableAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) {
+	autoCompound, found := k.GetAutoCompound(ctx, delAddr)
+	if !found {
+		return
+	}
+
+	k.removeAutoCompound(ctx, autoCompound)
+}
+
+// removeAutoCompoundValidator stops compounding the rewards of a delegator
+// from a validator it no longer delegates to. The auto-compounding of the
+// delegator is removed once no validator is left.
+func (k Keeper) removeAutoCompoundValidator(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	autoCompound, found := k.GetAutoCompound(ctx, delAddr)
+	if !found {
+		return
+	}
+
+	validators := make([]string, 0, len(autoCompound.ValidatorAddresses))
+	for _, validator := range autoCompound.ValidatorAddresses {
+		if validator != valAddr.String() {
+			validators = append(validators, validator)
+		}
+	}
+	if len(validators) == len(autoCompound.ValidatorAddresses) {
+		return
+	}
+
+	if len(validators) == 0 {
+		k.removeAutoCompound(ctx, autoCompound)
+		return
+	}
+
+	autoCompound.ValidatorAddresses = validators
+	k.SetAutoCompound(ctx, autoCompound)
+}
+
+// CompoundDueRewards compounds the rewards of the delegators queued at or
+// before the current height, up to the maximum number of auto-compounds per
+// block. The remaining due delegators are compounded in the following blocks.
+// Nothing is compounded while auto-compounding is disabled.
+func (k Keeper) CompoundDueRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundInterval == 0 {
+		return
+	}
+
+	for _, addr := range k.dueQueueAddresses(ctx, types.AutoCompoundQueuePrefix, params.MaxAutoCompoundsPerBlock) {
+		delAddr := sdk.AccAddress(addr)
+		autoCompound, found := k.GetAutoCompound(ctx, delAddr)
+		if !found {
+			panic(fmt.Sprintf("queued auto-compounding of %s not found", delAddr))
+		}
+
+		k.compoundRewards(ctx, autoCompound, params)
+	}
+}
+
+// compoundRewards compounds the rewards of a due delegator and queues its
+// next auto-compounding one interval later, whether the compounding succeeded
+// or not.
+func (k Keeper) compoundRewards(ctx sdk.Context, autoCompound types.AutoCompound, params types.Params) {
+	k.removeAutoCompound(ctx, autoCompound)
+
+	gasUsed, err := k.withdrawAndDelegate(ctx, autoCompound, params.AutoCompoundGasLimit)
+	if err != nil {
+		k.Logger(ctx).Info("auto-compounding failed", "delegator", autoCompound.DelegatorAddress, "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompoundFailed,
+				sdk.NewAttribute(types.AttributeKeyDelegator, autoCompound.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+			),
+		)
+	}
+
+	autoCompound.NextHeight = ctx.BlockHeight() + int64(params.AutoCompoundInterval)
+	k.SetAutoCompound(ctx, autoCompound)
+}
+
+// withdrawAndDelegate withdraws the rewards of a delegator from the
+// auto-compounded validators and re-delegates their bond denom part, in a
+// cached context limited to gasLimit. Validators the delegator no longer
+// delegates to are skipped. State changes are only committed if all the
+// re-delegations succeed.
+func (k Keeper) withdrawAndDelegate(ctx sdk.Context, autoCompound types.AutoCompound, gasLimit uint64) (gasUsed uint64, err error) {
+	delAddr, err := sdk.AccAddressFromBech32(autoCompound.DelegatorAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	// auto-compounding must never halt the chain, panics (including out of
+	// gas) are turned into failures
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = gasMeter.GasConsumed()
+			if oog, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+			} else {
+				err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+			}
+		}
+	}()
+
+	bondDenom := k.stakingKeeper.BondDenom(cacheCtx)
+	for _, validator := range autoCompound.ValidatorAddresses {
+		valAddr, err := sdk.ValAddressFromBech32(validator)
+		if err != nil {
+			return gasMeter.GasConsumed(), err
+		}
+
+		val, found := k.stakingKeeper.GetValidator(cacheCtx, valAddr)
+		if !found || k.stakingKeeper.Delegation(cacheCtx, delAddr, valAddr) == nil {
+			continue
+		}
+
+		if withdrawAddr := k.GetDelegationWithdrawAddr(cacheCtx, delAddr, valAddr); !withdrawAddr.Equals(delAddr) {
+			return gasMeter.GasConsumed(), sdkerrors.Wrapf(types.ErrInvalidAutoCompound, "rewards from %s are withdrawn to %s", valAddr, withdrawAddr)
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(cacheCtx, delAddr, valAddr)
+		if err != nil {
+			return gasMeter.GasConsumed(), err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if amount.IsPositive() {
+			if _, err := k.stakingKeeper.Delegate(cacheCtx, delAddr, amount, stakingtypes.Unbonded, val, true); err != nil {
+				return gasMeter.GasConsumed(), err
+			}
+		}
+
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			),
+		)
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return gasMeter.GasConsumed(), nil
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+// setupAutoCompound creates a validator with zero commission and a delegation
+// of 100 tokens from another account to it.
+func setupAutoCompound(t *testing.T) (*simapp.SimApp, sdk.Context, sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = teststaking.ZeroCommission()
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	return app, ctx, addrs[1], valAddrs[0]
+}
+
+func TestEnableAutoCompound(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoCompound(t)
+	otherValAddr := sdk.ValAddress(delAddr)
+
+	// auto-compounding is disabled by default
+	err := app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr})
+	require.ErrorIs(t, err, types.ErrAutoCompoundDisabled)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	app.DistrKeeper.SetParams(ctx, params)
+
+	err = app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr, otherValAddr})
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, delAddr, sdk.AccAddress(valAddr))
+	err = app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr})
+	require.ErrorIs(t, err, types.ErrInvalidAutoCompound)
+	app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, delAddr, delAddr)
+
+	require.NoError(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, sdk.AccAddress(valAddr)))
+	err = app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr})
+	require.ErrorIs(t, err, types.ErrInvalidAutoCompound)
+	require.NoError(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, nil))
+
+	require.NoError(t, app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr}))
+	autoCompound, found := app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, types.NewAutoCompound(delAddr, []string{valAddr.String()}, 11), autoCompound)
+
+	// enabling it again replaces the previous auto-compounding
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr}))
+	autoCompound, found = app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, int64(15), autoCompound.NextHeight)
+
+	queue := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.AutoCompoundQueuePrefix)
+	var queued []int64
+	for ; queue.Valid(); queue.Next() {
+		height, _ := types.GetAutoCompoundQueueHeightAddress(queue.Key())
+		queued = append(queued, height)
+	}
+	queue.Close()
+	require.Equal(t, []int64{15}, queued)
+
+	app.DistrKeeper.DisableAutoCompound(ctx, delAddr)
+	_, found = app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.False(t, found)
+}
+
+func TestCompoundDueRewards(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoCompound(t)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	app.DistrKeeper.SetParams(ctx, params)
+	require.NoError(t, app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr}))
+
+	// allocate 100 tokens, half of them to the delegation
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)))
+	balance := app.BankKeeper.GetAllBalances(ctx, delAddr)
+
+	// nothing is compounded before the next height
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.CompoundDueRewards(ctx)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+
+	// running out of gas leaves the rewards untouched until the next interval
+	params.AutoCompoundGasLimit = 1000
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.CompoundDueRewards(ctx)
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeAutoCompoundFailed, events[0].Type)
+	autoCompound, _ := app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.Equal(t, int64(21), autoCompound.NextHeight)
+
+	// no delegator is compounded when the maximum per block is zero
+	params.AutoCompoundGasLimit = types.DefaultAutoCompoundGasLimit
+	params.MaxAutoCompoundsPerBlock = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(21)
+	app.DistrKeeper.CompoundDueRewards(ctx)
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.Equal(t, sdk.NewDec(100), delegation.Shares)
+
+	// the rewards are re-delegated once the delegator is processed, even after
+	// its height
+	params.MaxAutoCompoundsPerBlock = types.DefaultMaxAutoCompoundsPerBlock
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(22).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.CompoundDueRewards(ctx)
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.Equal(t, sdk.NewDec(150), delegation.Shares)
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, delAddr))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeAutoCompound,
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewInt64Coin(sdk.DefaultBondDenom, 50).String()),
+	))
+	autoCompound, _ = app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.Equal(t, int64(32), autoCompound.NextHeight)
+
+	// auto-compounding stops while disabled
+	params.AutoCompoundInterval = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)))
+	ctx = ctx.WithBlockHeight(32)
+	app.DistrKeeper.CompoundDueRewards(ctx)
+	delegation, _ = app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.Equal(t, sdk.NewDec(150), delegation.Shares)
+}
+
+func TestAutoCompoundDelegationRemoved(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoCompound(t)
+
+	// a second validator keeps the auto-compounding alive when the delegation
+	// to the first one is removed
+	otherValAddr := sdk.ValAddress(delAddr)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.Commission = teststaking.ZeroCommission()
+	tstaking.CreateValidator(otherValAddr, valConsPk2, sdk.NewInt(100), true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 10
+	app.DistrKeeper.SetParams(ctx, params)
+	require.NoError(t, app.DistrKeeper.EnableAutoCompound(ctx, delAddr, []sdk.ValAddress{valAddr, otherValAddr}))
+
+	undelegate := func(valAddr sdk.ValAddress) {
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+		require.True(t, found)
+		_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, delegation.Shares)
+		require.NoError(t, err)
+	}
+
+	undelegate(valAddr)
+	autoCompound, found := app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, types.NewAutoCompound(delAddr, []string{otherValAddr.String()}, 11), autoCompound)
+
+	// the auto-compounding is removed along with the last delegation
+	undelegate(otherValAddr)
+	_, found = app.DistrKeeper.GetAutoCompound(ctx, delAddr)
+	require.False(t, found)
+
+	queue := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.AutoCompoundQueuePrefix)
+	defer queue.Close()
+	require.False(t, queue.Valid())
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetCommissionWithdrawSchedule returns the commission withdrawal schedule of
+// a validator.
+func (k Keeper) GetCommissionWithdrawSchedule(ctx sdk.Context, valAddr sdk.ValAddress) (types.CommissionWithdrawSchedule, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetCommissionWithdrawScheduleKey(valAddr))
+	if bz == nil {
+		return types.CommissionWithdrawSchedule{}, false
+	}
+
+	var schedule types.CommissionWithdrawSchedule
+	k.cdc.MustUnmarshalBinaryBare(bz, &schedule)
+	return schedule, true
+}
+
+// SetCommissionWithdrawSchedule stores the commission withdrawal schedule of a
+// validator and queues it at its next height. The schedule must not already be
+// queued at another height.
+func (k Keeper) SetCommissionWithdrawSchedule(ctx sdk.Context, schedule types.CommissionWithdrawSchedule) {
+	valAddr, err := sdk.ValAddressFromBech32(schedule.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCommissionWithdrawScheduleKey(valAddr), k.cdc.MustMarshalBinaryBare(&schedule))
+	store.Set(types.GetCommissionWithdrawQueueKey(schedule.NextHeight, valAddr), []byte{})
+}
+
+// removeCommissionWithdrawSchedule deletes the commission withdrawal schedule
+// of a validator along with its queue entry.
+func (k Keeper) removeCommissionWithdrawSchedule(ctx sdk.Context, schedule types.CommissionWithdrawSchedule) {
+	valAddr, err := sdk.ValAddressFromBech32(schedule.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCommissionWithdrawScheduleKey(valAddr))
+	store.Delete(types.GetCommissionWithdrawQueueKey(schedule.NextHeight, valAddr))
+}
+
+// IterateCommissionWithdrawSchedules iterates over the commission withdrawal
+// schedules and performs a callback function.
+func (k Keeper) IterateCommissionWithdrawSchedules(ctx sdk.Context, cb func(schedule types.CommissionWithdrawSchedule) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.CommissionWithdrawSchedulePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.CommissionWithdrawSchedule
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &schedule)
+
+		if cb(schedule) {
+			break
+		}
+	}
+}
+
+// EnableCommissionWithdrawSchedule withdraws the commission of a validator to
+// its withdraw address every interval blocks, replacing the previous schedule.
+// The commission is first withdrawn one interval after the current height.
+func (k Keeper) EnableCommissionWithdrawSchedule(ctx sdk.Context, valAddr sdk.ValAddress, interval uint64) error {
+	if interval == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidCommissionWithdrawSchedule, "interval must be positive for validator %s", valAddr)
+	}
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	k.DisableCommissionWithdrawSchedule(ctx, valAddr)
+
+	schedule := types.NewCommissionWithdrawSchedule(valAddr, interval, ctx.BlockHeight()+int64(interval))
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	k.SetCommissionWithdrawSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCommissionWithdrawSchedule,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", interval)),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", schedule.NextHeight)),
+		),
+	)
+
+	return nil
+}
+
+// DisableCommissionWithdrawSchedule stops the scheduled withdrawals of the
+// commission of a validator, if any.
+func (k Keeper) DisableCommissionWithdrawSchedule(ctx sdk.Context, valAddr sdk.ValAddress) {
+	schedule, found := k.GetCommissionWithdrawSchedule(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	k.removeCommissionWithdrawSchedule(ctx, schedule)
+}
+
+// WithdrawDueCommissions withdraws the commission of the validators queued at
+// or before the current height, up to the maximum number of commission
+// withdrawals per block, and queues their next withdrawal one interval later.
+// The remaining due validators are withdrawn in the following blocks. A failed
+// withdrawal is skipped until the next interval.
+func (k Keeper) WithdrawDueCommissions(ctx sdk.Context) {
+	limit := k.GetParams(ctx).MaxCommissionWithdrawalsPerBlock
+	for _, addr := range k.dueQueueAddresses(ctx, types.CommissionWithdrawQueuePrefix, limit) {
+		valAddr := sdk.ValAddress(addr)
+		schedule, found := k.GetCommissionWithdrawSchedule(ctx, valAddr)
+		if !found {
+			panic(fmt.Sprintf("queued commission withdrawal of %s not found", valAddr))
+		}
+
+		k.removeCommissionWithdrawSchedule(ctx, schedule)
+		k.withdrawScheduledCommission(ctx, valAddr)
+
+		schedule.NextHeight = ctx.BlockHeight() + int64(schedule.Interval)
+		k.SetCommissionWithdrawSchedule(ctx, schedule)
+	}
+}
+
+// withdrawScheduledCommission withdraws the commission of a validator in a
+// cached context, which is only committed if the withdrawal succeeds. Having
+// no commission to withdraw is not a failure.
+func (k Keeper) withdrawScheduledCommission(ctx sdk.Context, valAddr sdk.ValAddress) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	_, err := k.WithdrawValidatorCommission(cacheCtx, valAddr)
+	switch {
+	case err == nil:
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	case errors.Is(err, types.ErrNoValidatorCommission):
+		// nothing accrued since the last withdrawal
+
+	default:
+		k.Logger(ctx).Info("scheduled commission withdrawal failed", "validator", valAddr.String(), "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawCommissionFailed,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+	}
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestWithdrawDueCommissions(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	err := app.DistrKeeper.EnableCommissionWithdrawSchedule(ctx, valAddrs[0], 0)
+	require.ErrorIs(t, err, types.ErrInvalidCommissionWithdrawSchedule)
+	err = app.DistrKeeper.EnableCommissionWithdrawSchedule(ctx, valAddrs[1], 10)
+	require.ErrorIs(t, err, types.ErrNoValidatorExists)
+
+	require.NoError(t, app.DistrKeeper.EnableCommissionWithdrawSchedule(ctx, valAddrs[0], 10))
+	schedule, found := app.DistrKeeper.GetCommissionWithdrawSchedule(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewCommissionWithdrawSchedule(valAddrs[0], 10, 11), schedule)
+
+	commission := sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10))
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: commission})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: commission})
+	balance := app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom)
+
+	// nothing is withdrawn before the next height
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.WithdrawDueCommissions(ctx)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.WithdrawDueCommissions(ctx)
+	require.Equal(t, balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeWithdrawCommission,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10).String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddrs[0].String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, addrs[0].String()),
+		sdk.NewAttribute(types.AttributeKeyDenomAmount, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10).String()),
+	))
+	schedule, _ = app.DistrKeeper.GetCommissionWithdrawSchedule(ctx, valAddrs[0])
+	require.Equal(t, int64(21), schedule.NextHeight)
+
+	// no commission is withdrawn when the maximum per block is zero
+	params := app.DistrKeeper.GetParams(ctx)
+	params.MaxCommissionWithdrawalsPerBlock = 0
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(21)
+	app.DistrKeeper.WithdrawDueCommissions(ctx)
+	schedule, _ = app.DistrKeeper.GetCommissionWithdrawSchedule(ctx, valAddrs[0])
+	require.Equal(t, int64(21), schedule.NextHeight)
+
+	// having no commission to withdraw is not a failure, and the validator is
+	// processed even after its height
+	params.MaxCommissionWithdrawalsPerBlock = types.DefaultMaxCommissionWithdrawalsPerBlock
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(22).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.WithdrawDueCommissions(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	schedule, _ = app.DistrKeeper.GetCommissionWithdrawSchedule(ctx, valAddrs[0])
+	require.Equal(t, int64(32), schedule.NextHeight)
+
+	app.DistrKeeper.DisableCommissionWithdrawSchedule(ctx, valAddrs[0])
+	_, found = app.DistrKeeper.GetCommissionWithdrawSchedule(ctx, valAddrs[0])
+	require.False(t, found)
+	queue := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.CommissionWithdrawQueuePrefix)
+	require.False(t, queue.Valid())
+	queue.Close()
+
+	// the schedule is removed along with the validator
+	require.NoError(t, app.DistrKeeper.EnableCommissionWithdrawSchedule(ctx, valAddrs[0], 10))
+	app.DistrKeeper.Hooks().AfterValidatorRemoved(ctx, nil, valAddrs[0])
+	_, found = app.DistrKeeper.GetCommissionWithdrawSchedule(ctx, valAddrs[0])
+	require.False(t, found)
+}
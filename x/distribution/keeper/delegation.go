@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -52,8 +53,15 @@ func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, val stakingty
 	return
 }
 
-// calculate the total rewards accrued by a delegation
-func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins) {
+// calculate the total rewards owed to a delegation, including the rewards left
+// unclaimed by partial withdrawals
+func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) sdk.DecCoins {
+	unclaimed := k.GetDelegationUnclaimedRewards(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	return k.calculateAccruedDelegationRewards(ctx, val, del, endingPeriod).Add(unclaimed.Rewards...)
+}
+
+// calculate the rewards accrued by a delegation since its starting period
+func (k Keeper) calculateAccruedDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, endingPeriod uint64) (rewards sdk.DecCoins) {
 	// fetch starting info for delegation
 	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
 
@@ -136,7 +144,9 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.Val
 	return rewards
 }
 
-func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
+// withdrawDelegationRewards withdraws all the rewards of a delegation. Forced
+// withdrawals are those the staking hooks make, which must not fail.
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, forced bool) (sdk.Coins, error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
 		return nil, types.ErrEmptyDelegationDistInfo
@@ -166,9 +176,12 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 
 	// add coins to user account
 	if !coins.IsZero() {
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
-		if err != nil {
+		withdrawAddr := k.GetDelegationWithdrawAddr(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
+		send := k.sendRewards
+		if forced {
+			send = k.sendForcedRewards
+		}
+		if err := send(ctx, withdrawAddr, coins); err != nil {
 			return nil, err
 		}
 	}
@@ -185,8 +198,62 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 	startingPeriod := startingInfo.PreviousPeriod
 	k.decrementReferenceCount(ctx, del.GetValidatorAddr(), startingPeriod)
 
-	// remove delegator starting info
+	// remove delegator starting info and the rewards it left unclaimed
+	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	k.DeleteDelegationUnclaimedRewards(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+
+	return coins, nil
+}
+
+// withdrawDelegationRewardsPartial withdraws either amount or, if amount is
+// empty, percentage of the rewards of a delegation. The remaining rewards are
+// left unclaimed in the outstanding rewards of the validator, including their
+// decimal part, and are owed to the delegation along with its next rewards.
+func (k Keeper) withdrawDelegationRewardsPartial(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI, amount sdk.Coins, percentage sdk.Dec) (sdk.Coins, error) {
+	// check existence of delegator starting info
+	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
+		return nil, types.ErrEmptyDelegationDistInfo
+	}
+
+	// end current period and calculate rewards
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+	outstanding := k.GetValidatorOutstandingRewardsCoins(ctx, del.GetValidatorAddr())
+	rewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod).Intersect(outstanding)
+
+	coins := amount
+	if coins.Empty() {
+		coins, _ = rewards.MulDecTruncate(percentage).TruncateDecimal()
+	}
+
+	unclaimed, hasNeg := rewards.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if hasNeg {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientRewards, "%s is more than the rewards %s", coins, rewards)
+	}
+
+	// add coins to user account
+	if !coins.IsZero() {
+		withdrawAddr := k.GetDelegationWithdrawAddr(ctx, del.GetDelegatorAddr(), del.GetValidatorAddr())
+		err := k.sendRewards(ctx, withdrawAddr, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// update the outstanding rewards only if the transaction was successful,
+	// the unclaimed rewards remain in them
+	k.SetValidatorOutstandingRewards(ctx, del.GetValidatorAddr(), types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(coins...))})
+
+	// decrement reference count of starting period
+	startingInfo := k.GetDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	k.decrementReferenceCount(ctx, del.GetValidatorAddr(), startingInfo.PreviousPeriod)
+
+	// remove delegator starting info and keep the unclaimed rewards
 	k.DeleteDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	if unclaimed.IsZero() {
+		k.DeleteDelegationUnclaimedRewards(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr())
+	} else {
+		k.SetDelegationUnclaimedRewards(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr(), types.DelegationUnclaimedRewards{Rewards: unclaimed})
+	}
 
 	return coins, nil
 }
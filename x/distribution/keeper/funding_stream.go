@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetFundingStream returns a funding stream from the community pool.
+func (k Keeper) GetFundingStream(ctx sdk.Context, id uint64) (types.FundingStream, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetFundingStreamKey(id))
+	if bz == nil {
+		return types.FundingStream{}, false
+	}
+
+	var stream types.FundingStream
+	k.cdc.MustUnmarshalBinaryBare(bz, &stream)
+	return stream, true
+}
+
+// SetFundingStream stores a funding stream and queues it at its next height.
+// The stream must not already be queued at another height.
+func (k Keeper) SetFundingStream(ctx sdk.Context, stream types.FundingStream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFundingStreamKey(stream.Id), k.cdc.MustMarshalBinaryBare(&stream))
+	store.Set(types.GetFundingStreamQueueKey(stream.NextHeight, stream.Id), []byte{})
+}
+
+// removeFundingStream deletes a funding stream along with its queue entry.
+func (k Keeper) removeFundingStream(ctx sdk.Context, stream types.FundingStream) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFundingStreamKey(stream.Id))
+	store.Delete(types.GetFundingStreamQueueKey(stream.NextHeight, stream.Id))
+}
+
+// IterateFundingStreams iterates over the funding streams by id and performs
+// a callback function.
+func (k Keeper) IterateFundingStreams(ctx sdk.Context, cb func(stream types.FundingStream) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FundingStreamPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.FundingStream
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stream)
+
+		if cb(stream) {
+			break
+		}
+	}
+}
+
+// GetNextFundingStreamID returns the id of the next funding stream created.
+func (k Keeper) GetNextFundingStreamID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextFundingStreamIDKey)
+	if bz == nil {
+		return types.DefaultStartingFundingStreamID
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextFundingStreamID sets the id of the next funding stream created.
+func (k Keeper) SetNextFundingStreamID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextFundingStreamIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateFundingStream pays an amount from the community pool to a recipient
+// every interval blocks, until the stream is cancelled. The recipient is first
+// paid one interval after the current height.
+func (k Keeper) CreateFundingStream(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, interval uint64) (uint64, error) {
+	if k.isBlockedAddr(ctx, recipient) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient)
+	}
+
+	id := k.GetNextFundingStreamID(ctx)
+	stream := types.NewFundingStream(id, recipient, amount, interval, ctx.BlockHeight()+int64(interval))
+	if err := stream.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetFundingStream(ctx, stream)
+	k.SetNextFundingStreamID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateFundingStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", interval)),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", stream.NextHeight)),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelFundingStream stops the payments of a funding stream.
+func (k Keeper) CancelFundingStream(ctx sdk.Context, id uint64) error {
+	stream, found := k.GetFundingStream(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoFundingStreamExists, "stream %d", id)
+	}
+
+	k.removeFundingStream(ctx, stream)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelFundingStream,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+		),
+	)
+
+	return nil
+}
+
+// PayDueFundingStreams pays the funding streams queued at or before the
+// current height from the community pool and queues their next payment one
+// interval later. A payment the community pool cannot cover is skipped until
+// the next interval.
+func (k Keeper) PayDueFundingStreams(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.FundingStreamQueuePrefix, types.GetFundingStreamQueueHeightKey(ctx.BlockHeight()+1))
+
+	var due []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.GetFundingStreamQueueHeightID(iterator.Key())
+		due = append(due, id)
+	}
+	iterator.Close()
+
+	for _, id := range due {
+		stream, found := k.GetFundingStream(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("queued funding stream %d not found", id))
+		}
+
+		k.removeFundingStream(ctx, stream)
+		k.payFundingStream(ctx, stream)
+
+		stream.NextHeight = ctx.BlockHeight() + int64(stream.Interval)
+		k.SetFundingStream(ctx, stream)
+	}
+}
+
+// payFundingStream pays a funding stream in a cached context, which is only
+// committed if the payment succeeds.
+func (k Keeper) payFundingStream(ctx sdk.Context, stream types.FundingStream) {
+	recipient, err := sdk.AccAddressFromBech32(stream.Recipient)
+	if err != nil {
+		panic(err)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.DistributeFromFeePool(cacheCtx, stream.Amount, recipient); err != nil {
+		k.Logger(ctx).Info("funding stream payment failed", "stream", stream.Id, "err", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundingStreamPaymentFailed,
+				sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			),
+		)
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFundingStreamPayment,
+			sdk.NewAttribute(types.AttributeKeyStreamID, fmt.Sprintf("%d", stream.Id)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, stream.Amount.String()),
+		),
+	)
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestPayDueFundingStreams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 25))
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	_, err := app.DistrKeeper.CreateFundingStream(ctx, addrs[0], amount, 0)
+	require.ErrorIs(t, err, types.ErrInvalidFundingStream)
+	_, err = app.DistrKeeper.CreateFundingStream(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), amount, 10)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	id, err := app.DistrKeeper.CreateFundingStream(ctx, addrs[0], amount, 10)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultStartingFundingStreamID, id)
+	require.Equal(t, id+1, app.DistrKeeper.GetNextFundingStreamID(ctx))
+	stream, found := app.DistrKeeper.GetFundingStream(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.NewFundingStream(id, addrs[0], amount, 10, 11), stream)
+
+	balance := app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom)
+
+	// nothing is paid before the next height
+	ctx = ctx.WithBlockHeight(10)
+	app.DistrKeeper.PayDueFundingStreams(ctx)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+
+	for _, height := range []int64{11, 21} {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		app.DistrKeeper.PayDueFundingStreams(ctx)
+		balance = balance.Add(amount[0])
+		require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+		require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+			types.EventTypeFundingStreamPayment,
+			sdk.NewAttribute(types.AttributeKeyStreamID, "1"),
+			sdk.NewAttribute(types.AttributeKeyRecipient, addrs[0].String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		))
+	}
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 5)), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// a payment the community pool cannot cover is skipped
+	ctx = ctx.WithBlockHeight(31).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.PayDueFundingStreams(ctx)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeFundingStreamPaymentFailed,
+		sdk.NewAttribute(types.AttributeKeyStreamID, "1"),
+		sdk.NewAttribute(types.AttributeKeyError, types.ErrBadDistribution.Error()),
+	))
+	stream, _ = app.DistrKeeper.GetFundingStream(ctx, id)
+	require.Equal(t, int64(41), stream.NextHeight)
+
+	require.ErrorIs(t, app.DistrKeeper.CancelFundingStream(ctx, id+1), types.ErrNoFundingStreamExists)
+	require.NoError(t, app.DistrKeeper.CancelFundingStream(ctx, id))
+	_, found = app.DistrKeeper.GetFundingStream(ctx, id)
+	require.False(t, found)
+	queue := sdk.KVStorePrefixIterator(ctx.KVStore(app.GetKey(types.StoreKey)), types.FundingStreamQueuePrefix)
+	require.False(t, queue.Valid())
+	queue.Close()
+
+	// the ids of cancelled streams are not reused
+	id, err = app.DistrKeeper.CreateFundingStream(ctx, addrs[1], amount, 10)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultStartingFundingStreamID+1, id)
+}
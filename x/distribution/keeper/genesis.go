@@ -85,6 +85,51 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, autoCompound := range data.AutoCompounds {
+		k.SetAutoCompound(ctx, autoCompound)
+	}
+	for _, dwi := range data.DelegationWithdrawInfos {
+		delegatorAddress, err := sdk.AccAddressFromBech32(dwi.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		validatorAddress, err := sdk.ValAddressFromBech32(dwi.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		withdrawAddress, err := sdk.AccAddressFromBech32(dwi.WithdrawAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setDelegationWithdrawAddr(ctx, delegatorAddress, validatorAddress, withdrawAddress)
+	}
+	for _, unclaimed := range data.DelegationUnclaimedRewards {
+		delegatorAddress, err := sdk.AccAddressFromBech32(unclaimed.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		validatorAddress, err := sdk.ValAddressFromBech32(unclaimed.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetDelegationUnclaimedRewards(ctx, validatorAddress, delegatorAddress, unclaimed.UnclaimedRewards)
+	}
+	for _, schedule := range data.CommissionWithdrawSchedules {
+		k.SetCommissionWithdrawSchedule(ctx, schedule)
+	}
+	for _, stream := range data.FundingStreams {
+		k.SetFundingStream(ctx, stream)
+	}
+	if data.NextFundingStreamId != 0 {
+		k.SetNextFundingStreamID(ctx, data.NextFundingStreamId)
+	}
+	for _, account := range data.LockedRewardsAccounts {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			panic(err)
+		}
+		k.setLockedRewards(ctx, addr, true)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -191,5 +236,65 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]types.AutoCompound, 0)
+	k.IterateAutoCompounds(ctx,
+		func(autoCompound types.AutoCompound) (stop bool) {
+			autoCompounds = append(autoCompounds, autoCompound)
+			return false
+		},
+	)
+
+	delegationDwi := make([]types.DelegationWithdrawInfo, 0)
+	k.IterateDelegationWithdrawAddrs(ctx, func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool) {
+		delegationDwi = append(delegationDwi, types.DelegationWithdrawInfo{
+			DelegatorAddress: del.String(),
+			ValidatorAddress: val.String(),
+			WithdrawAddress:  addr.String(),
+		})
+		return false
+	})
+
+	unclaimedRewards := make([]types.DelegationUnclaimedRewardsRecord, 0)
+	k.IterateDelegationUnclaimedRewards(ctx, func(val sdk.ValAddress, del sdk.AccAddress, rewards types.DelegationUnclaimedRewards) (stop bool) {
+		unclaimedRewards = append(unclaimedRewards, types.DelegationUnclaimedRewardsRecord{
+			DelegatorAddress: del.String(),
+			ValidatorAddress: val.String(),
+			UnclaimedRewards: rewards,
+		})
+		return false
+	})
+
+	schedules := make([]types.CommissionWithdrawSchedule, 0)
+	k.IterateCommissionWithdrawSchedules(ctx,
+		func(schedule types.CommissionWithdrawSchedule) (stop bool) {
+			schedules = append(schedules, schedule)
+			return false
+		},
+	)
+
+	streams := make([]types.FundingStream, 0)
+	k.IterateFundingStreams(ctx,
+		func(stream types.FundingStream) (stop bool) {
+			streams = append(streams, stream)
+			return false
+		},
+	)
+
+	lockedRewardsAccounts := make([]string, 0)
+	k.IterateLockedRewardsAccounts(ctx,
+		func(addr sdk.AccAddress) (stop bool) {
+			lockedRewardsAccounts = append(lockedRewardsAccounts, addr.String())
+			return false
+		},
+	)
+
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.AutoCompounds = autoCompounds
+	gs.DelegationWithdrawInfos = delegationDwi
+	gs.DelegationUnclaimedRewards = unclaimedRewards
+	gs.CommissionWithdrawSchedules = schedules
+	gs.FundingStreams = streams
+	gs.NextFundingStreamId = k.GetNextFundingStreamID(ctx)
+	gs.LockedRewardsAccounts = lockedRewardsAccounts
+	return gs
 }
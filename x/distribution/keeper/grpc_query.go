@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -10,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -91,6 +94,12 @@ func (k Keeper) ValidatorSlashes(c context.Context, req *types.QueryValidatorSla
 	slashesStore := prefix.NewStore(store, types.GetValidatorSlashEventPrefix(valAddr))
 
 	pageRes, err := query.FilteredPaginate(slashesStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// the slash events are keyed by height, then period
+		height := binary.BigEndian.Uint64(key[:8])
+		if height < req.StartingHeight || height > req.EndingHeight {
+			return false, nil
+		}
+
 		var result types.ValidatorSlashEvent
 		err := k.cdc.UnmarshalBinaryBare(value, &result)
 
@@ -98,10 +107,6 @@ func (k Keeper) ValidatorSlashes(c context.Context, req *types.QueryValidatorSla
 			return false, err
 		}
 
-		if result.ValidatorPeriod < req.StartingHeight || result.ValidatorPeriod > req.EndingHeight {
-			return false, nil
-		}
-
 		if accumulate {
 			events = append(events, result)
 		}
@@ -129,6 +134,10 @@ func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationR
 		return nil, status.Error(codes.InvalidArgument, "empty validator address")
 	}
 
+	if err := validateDenomFilter(req.Denom); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
@@ -151,7 +160,7 @@ func (k Keeper) DelegationRewards(c context.Context, req *types.QueryDelegationR
 	}
 
 	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-	rewards := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	rewards := filterDenom(k.CalculateDelegationRewards(ctx, val, del, endingPeriod), req.Denom)
 
 	return &types.QueryDelegationRewardsResponse{Rewards: rewards}, nil
 }
@@ -166,31 +175,204 @@ func (k Keeper) DelegationTotalRewards(c context.Context, req *types.QueryDelega
 		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
 	}
 
+	if err := validateDenomFilter(req.Denom); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
+	var displayExponents map[string]uint32
+	if req.DisplayDenom != "" {
+		var err error
+		if displayExponents, err = k.displayDenomExponents(ctx, req.DisplayDenom); err != nil {
+			return nil, err
+		}
+	}
+
 	total := sdk.DecCoins{}
 	var delRewards []types.DelegationDelegatorReward
+	var displayTotals []types.DelegationDisplayTotal
 
 	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	k.stakingKeeper.IterateDelegations(
-		ctx, delAdr,
-		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
-			valAddr := del.GetValidatorAddr()
-			val := k.stakingKeeper.Validator(ctx, valAddr)
-			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+	onDelegation := func(del stakingtypes.DelegationI) {
+		valAddr := del.GetValidatorAddr()
+		val := k.stakingKeeper.Validator(ctx, valAddr)
+		endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+		delReward := filterDenom(k.CalculateDelegationRewards(ctx, val, del, endingPeriod), req.Denom)
 
-			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
-			total = total.Add(delReward...)
-			return false
+		delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
+		total = total.Add(delReward...)
+
+		if displayExponents != nil {
+			displayTotals = append(displayTotals, types.DelegationDisplayTotal{
+				ValidatorAddress: valAddr.String(),
+				Total:            toDisplayDenom(delReward, req.DisplayDenom, displayExponents),
+			})
+		}
+	}
+
+	if req.Pagination == nil {
+		k.stakingKeeper.IterateDelegations(
+			ctx, delAdr,
+			func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+				onDelegation(del)
+				return false
+			},
+		)
+
+		return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total, DisplayTotals: displayTotals}, nil
+	}
+
+	pageRes, err := k.stakingKeeper.IterateDelegationsPaginated(
+		ctx, delAdr, req.Pagination,
+		func(del stakingtypes.DelegationI) error {
+			onDelegation(del)
+			return nil
 		},
 	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
+	return &types.QueryDelegationTotalRewardsResponse{
+		Rewards: delRewards, Total: total, Pagination: pageRes, DisplayTotals: displayTotals,
+	}, nil
+}
+
+// ValidatorDelegatorsRewards queries the pending rewards of each delegator of a
+// validator, ordered by delegator address.
+func (k Keeper) ValidatorDelegatorsRewards(c context.Context, req *types.QueryValidatorDelegatorsRewardsRequest) (*types.QueryValidatorDelegatorsRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	val := k.stakingKeeper.Validator(ctx, valAdr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	var rewards []types.ValidatorDelegatorReward
+	endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+
+	// every delegation to the validator has a starting info, keyed by the
+	// delegator address under the validator prefix
+	startingInfoStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDelegatorStartingInfoPrefix(valAdr))
+	pageRes, err := query.Paginate(startingInfoStore, req.Pagination, func(key []byte, _ []byte) error {
+		delAdr := sdk.AccAddress(key)
+		del := k.stakingKeeper.Delegation(ctx, delAdr, valAdr)
+		if del == nil {
+			return nil
+		}
+
+		reward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+		rewards = append(rewards, types.NewValidatorDelegatorReward(delAdr, reward))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryValidatorDelegatorsRewardsResponse{Rewards: rewards, Pagination: pageRes}, nil
+}
+
+// validateDenomFilter checks the optional denom filter of a rewards query.
+func validateDenomFilter(denom string) error {
+	if denom == "" {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid denom %s: %s", denom, err)
+	}
+
+	return nil
+}
+
+// filterDenom restricts rewards to a single denom, an empty denom keeping all
+// of them.
+func filterDenom(rewards sdk.DecCoins, denom string) sdk.DecCoins {
+	if denom == "" {
+		return rewards
+	}
+
+	amount := rewards.AmountOf(denom)
+	if amount.IsZero() {
+		return sdk.DecCoins{}
+	}
+
+	return sdk.DecCoins{sdk.NewDecCoinFromDec(denom, amount)}
+}
+
+// displayDenomExponents returns the exponents of the units of the bank denom
+// metadata declaring a display denom, by unit denom and alias.
+func (k Keeper) displayDenomExponents(ctx sdk.Context, displayDenom string) (map[string]uint32, error) {
+	if err := sdk.ValidateDenom(displayDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid display denom %s: %s", displayDenom, err)
+	}
+
+	var exponents map[string]uint32
+	k.bankKeeper.IterateAllDenomMetaData(ctx, func(metadata banktypes.Metadata) (stop bool) {
+		units := make(map[string]uint32)
+		for _, unit := range metadata.DenomUnits {
+			units[unit.Denom] = unit.Exponent
+			for _, alias := range unit.Aliases {
+				units[alias] = unit.Exponent
+			}
+		}
+
+		if _, ok := units[displayDenom]; ok {
+			exponents = units
+			return true
+		}
+		return false
+	})
+	if exponents == nil {
+		return nil, status.Errorf(codes.NotFound, "no denom metadata declares the display denom %s", displayDenom)
+	}
+
+	// the rewards are scaled by powers of ten of the exponents, which must stay
+	// within the decimal precision
+	for unit, exponent := range exponents {
+		if exponent > sdk.Precision {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "exponent %d of unit %s of the display denom %s exceeds %d", exponent, unit, displayDenom, sdk.Precision,
+			)
+		}
+	}
+
+	return exponents, nil
+}
+
+// toDisplayDenom sums rewards converted to a display denom, given the exponents
+// of the units of its denom metadata. The rewards in other denoms are left out
+// and the converted amounts are truncated to the decimal precision.
+func toDisplayDenom(rewards sdk.DecCoins, displayDenom string, exponents map[string]uint32) sdk.DecCoin {
+	displayUnit := sdk.NewIntWithDecimal(1, int(exponents[displayDenom]))
+
+	total := sdk.ZeroDec()
+	for _, reward := range rewards {
+		exponent, ok := exponents[reward.Denom]
+		if !ok {
+			continue
+		}
+		total = total.Add(reward.Amount.MulInt(sdk.NewIntWithDecimal(1, int(exponent))).QuoInt(displayUnit))
+	}
+
+	return sdk.NewDecCoinFromDec(displayDenom, total)
 }
 
 // DelegatorValidators queries the validators list of a delegator
@@ -241,6 +423,35 @@ func (k Keeper) DelegatorWithdrawAddress(c context.Context, req *types.QueryDele
 	return &types.QueryDelegatorWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
 }
 
+// DelegationWithdrawAddress queries Query/delegationWithdrawAddress
+func (k Keeper) DelegationWithdrawAddress(c context.Context, req *types.QueryDelegationWithdrawAddressRequest) (*types.QueryDelegationWithdrawAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	withdrawAddr := k.GetDelegationWithdrawAddr(ctx, delAdr, valAdr)
+
+	return &types.QueryDelegationWithdrawAddressResponse{WithdrawAddress: withdrawAddr.String()}, nil
+}
+
 // CommunityPool queries the community pool coins
 func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRequest) (*types.QueryCommunityPoolResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -248,3 +459,191 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// AutoCompound queries the auto-compounding of the rewards of a delegator
+func (k Keeper) AutoCompound(c context.Context, req *types.QueryAutoCompoundRequest) (*types.QueryAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	autoCompound, found := k.GetAutoCompound(ctx, delAdr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no auto-compounding for delegator %s", req.DelegatorAddress)
+	}
+
+	return &types.QueryAutoCompoundResponse{AutoCompound: autoCompound}, nil
+}
+
+// CommissionWithdrawSchedule queries the commission withdrawal schedule of a
+// validator
+func (k Keeper) CommissionWithdrawSchedule(c context.Context, req *types.QueryCommissionWithdrawScheduleRequest) (*types.QueryCommissionWithdrawScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedule, found := k.GetCommissionWithdrawSchedule(ctx, valAdr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no commission withdrawal schedule for validator %s", req.ValidatorAddress)
+	}
+
+	return &types.QueryCommissionWithdrawScheduleResponse{Schedule: schedule}, nil
+}
+
+// RewardsProjection queries the projected annualized rewards rate of a
+// delegator or of the delegators of a validator
+func (k Keeper) RewardsProjection(c context.Context, req *types.QueryRewardsProjectionRequest) (*types.QueryRewardsProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if (req.DelegatorAddress == "") == (req.ValidatorAddress == "") {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of delegator and validator address must be set")
+	}
+
+	if k.mintKeeper == nil {
+		return nil, status.Error(codes.Unimplemented, "rewards projection requires the mint module")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	inflation, bondedRatio, stakingAPR := k.StakingAPR(ctx)
+
+	var (
+		apr           sdk.Dec
+		annualRewards sdk.DecCoins
+	)
+	if req.ValidatorAddress != "" {
+		valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		val := k.stakingKeeper.Validator(ctx, valAdr)
+		if val == nil {
+			return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+		}
+
+		apr, annualRewards = k.ValidatorAPR(ctx, stakingAPR, val)
+	} else {
+		delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		apr, annualRewards = k.DelegatorAPR(ctx, stakingAPR, delAdr)
+	}
+
+	return &types.QueryRewardsProjectionResponse{
+		Inflation:     inflation,
+		BondedRatio:   bondedRatio,
+		StakingApr:    stakingAPR,
+		Apr:           apr,
+		AnnualRewards: annualRewards,
+	}, nil
+}
+
+// Invariants runs the distribution invariants registered with the crisis
+// module against the current state
+func (k Keeper) Invariants(c context.Context, req *types.QueryInvariantsRequest) (*types.QueryInvariantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if k.crisisKeeper == nil {
+		return nil, status.Error(codes.Unimplemented, "running the invariants requires the crisis module")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	invariants, err := k.crisisKeeper.RunInvariants(ctx, types.ModuleName, req.Routes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results := make([]types.InvariantResult, 0, len(invariants))
+	for _, invar := range invariants {
+		results = append(results, types.InvariantResult{
+			Route:   strings.TrimPrefix(invar.Route, types.ModuleName+"/"),
+			Broken:  invar.Broken,
+			Message: invar.Message,
+		})
+	}
+
+	return &types.QueryInvariantsResponse{Invariants: results}, nil
+}
+
+// FundingStream queries a funding stream from the community pool
+func (k Keeper) FundingStream(c context.Context, req *types.QueryFundingStreamRequest) (*types.QueryFundingStreamResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stream, found := k.GetFundingStream(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "funding stream %d not found", req.Id)
+	}
+
+	return &types.QueryFundingStreamResponse{Stream: stream}, nil
+}
+
+// FundingStreams queries all funding streams from the community pool
+func (k Keeper) FundingStreams(c context.Context, req *types.QueryFundingStreamsRequest) (*types.QueryFundingStreamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	streams := make([]types.FundingStream, 0)
+	streamsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FundingStreamPrefix)
+
+	pageRes, err := query.Paginate(streamsStore, req.Pagination, func(_ []byte, value []byte) error {
+		var stream types.FundingStream
+		if err := k.cdc.UnmarshalBinaryBare(value, &stream); err != nil {
+			return err
+		}
+
+		streams = append(streams, stream)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFundingStreamsResponse{Streams: streams, Pagination: pageRes}, nil
+}
+
+// LockedRewards queries whether the rewards withdrawn to a vesting account are
+// locked in its vesting schedule
+func (k Keeper) LockedRewards(c context.Context, req *types.QueryLockedRewardsRequest) (*types.QueryLockedRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty address")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryLockedRewardsResponse{Enabled: k.HasLockedRewards(ctx, addr)}, nil
+}
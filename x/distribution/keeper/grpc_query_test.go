@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
@@ -70,10 +73,18 @@ func (suite *KeeperTestSuite) TestGRPCParams() {
 			"valid request",
 			func() {
 				params = types.Params{
-					CommunityTax:        sdk.NewDecWithPrec(3, 1),
-					BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-					BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-					WithdrawAddrEnabled: true,
+					CommunityTax:               sdk.NewDecWithPrec(3, 1),
+					BaseProposerReward:         sdk.NewDecWithPrec(2, 1),
+					BonusProposerReward:        sdk.NewDecWithPrec(1, 1),
+					WithdrawAddrEnabled:        true,
+					AutoCompoundInterval:       10,
+					MaxAutoCompoundsPerBlock:   5,
+					AutoCompoundGasLimit:       200000,
+					HistoricalRetentionPeriods: 100,
+					HistoricalPruningInterval:  50,
+					ScheduledRateChange: types.NewScheduledRateChange(
+						100, sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1),
+					),
 				}
 
 				app.DistrKeeper.SetParams(ctx, params)
@@ -319,6 +330,42 @@ func (suite *KeeperTestSuite) TestGRPCValidatorSlashes() {
 			},
 			true,
 		},
+		{
+			"request slashes of a height range",
+			func() {
+				req = &types.QueryValidatorSlashesRequest{
+					ValidatorAddress: valAddrs[0].String(),
+					StartingHeight:   3,
+					EndingHeight:     4,
+				}
+
+				expRes = &types.QueryValidatorSlashesResponse{
+					Slashes: slashes[1:3],
+				}
+			},
+			true,
+		},
+		{
+			"request slashes with the next key of a previous page",
+			func() {
+				req = &types.QueryValidatorSlashesRequest{
+					ValidatorAddress: valAddrs[0].String(),
+					StartingHeight:   3,
+					EndingHeight:     10,
+					Pagination:       &query.PageRequest{Limit: 1},
+				}
+
+				res, err := queryClient.ValidatorSlashes(gocontext.Background(), req)
+				suite.Require().NoError(err)
+				suite.Require().Equal(slashes[1:2], res.Slashes)
+
+				req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+				expRes = &types.QueryValidatorSlashesResponse{
+					Slashes: slashes[2:4],
+				}
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -420,6 +467,45 @@ func (suite *KeeperTestSuite) TestGRPCDelegationRewards() {
 			},
 			true,
 		},
+		{
+			"request with invalid denom",
+			func() {
+				req = &types.QueryDelegationRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Denom:            "1",
+				}
+			},
+			false,
+		},
+		{
+			"valid request with denom",
+			func() {
+				req = &types.QueryDelegationRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Denom:            sdk.DefaultBondDenom,
+				}
+
+				expRes = &types.QueryDelegationRewardsResponse{
+					Rewards: sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(initial / 2)}},
+				}
+			},
+			true,
+		},
+		{
+			"valid request with denom without rewards",
+			func() {
+				req = &types.QueryDelegationRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					ValidatorAddress: valAddrs[0].String(),
+					Denom:            "mytoken",
+				}
+
+				expRes = &types.QueryDelegationRewardsResponse{}
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -444,6 +530,23 @@ func (suite *KeeperTestSuite) TestGRPCDelegationRewards() {
 		expTotalRewardsRes *types.QueryDelegationTotalRewardsResponse
 	)
 
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base: sdk.DefaultBondDenom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: sdk.DefaultBondDenom, Exponent: 0},
+			{Denom: "mstake", Exponent: 3, Aliases: []string{"millistake"}},
+		},
+		Display: "mstake",
+	})
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base: "utoken",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utoken", Exponent: 0},
+			{Denom: "bigtoken", Exponent: 100},
+		},
+		Display: "bigtoken",
+	})
+
 	testCases = []struct {
 		msg      string
 		malleate func()
@@ -473,6 +576,81 @@ func (suite *KeeperTestSuite) TestGRPCDelegationRewards() {
 			},
 			true,
 		},
+		{
+			"request with invalid denom",
+			func() {
+				totalRewardsReq = &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					Denom:            "1",
+				}
+			},
+			false,
+		},
+		{
+			"valid total delegation rewards with denom without rewards",
+			func() {
+				totalRewardsReq = &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					Denom:            "mytoken",
+				}
+
+				expTotalRewardsRes = &types.QueryDelegationTotalRewardsResponse{
+					Rewards: []types.DelegationDelegatorReward{types.NewDelegationDelegatorReward(valAddrs[0], nil)},
+				}
+			},
+			true,
+		},
+		{
+			"valid total delegation rewards with display denom",
+			func() {
+				totalRewardsReq = &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					DisplayDenom:     "millistake",
+				}
+
+				expectedDelReward := types.NewDelegationDelegatorReward(valAddrs[0],
+					sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5)})
+
+				expTotalRewardsRes = &types.QueryDelegationTotalRewardsResponse{
+					Rewards: []types.DelegationDelegatorReward{expectedDelReward},
+					Total:   expectedDelReward.Reward,
+					DisplayTotals: []types.DelegationDisplayTotal{
+						{ValidatorAddress: valAddrs[0].String(), Total: sdk.NewDecCoinFromDec("millistake", sdk.NewDecWithPrec(5, 3))},
+					},
+				}
+			},
+			true,
+		},
+		{
+			"request with invalid display denom",
+			func() {
+				totalRewardsReq = &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					DisplayDenom:     "1",
+				}
+			},
+			false,
+		},
+		{
+			"request with display denom exponent exceeding the precision",
+			func() {
+				totalRewardsReq = &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					DisplayDenom:     "bigtoken",
+				}
+			},
+			false,
+		},
+		{
+			"request with display denom without metadata",
+			func() {
+				totalRewardsReq = &types.QueryDelegationTotalRewardsRequest{
+					DelegatorAddress: addrs[0].String(),
+					DisplayDenom:     "atom",
+				}
+			},
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -552,6 +730,138 @@ func (suite *KeeperTestSuite) TestGRPCDelegationRewards() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCDelegationTotalRewardsPagination() {
+	app, ctx := suite.app, suite.ctx
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[2], valConsPk3, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[0], valAddrs[1], sdk.NewInt(100))
+	tstaking.Delegate(addrs[0], valAddrs[2], sdk.NewInt(100))
+
+	// delegations are paginated by validator address
+	sorted := []sdk.ValAddress{valAddrs[0], valAddrs[1], valAddrs[2]}
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.DistrKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	validators := func(res *types.QueryDelegationTotalRewardsResponse) []string {
+		var validators []string
+		for _, reward := range res.Rewards {
+			validators = append(validators, reward.ValidatorAddress)
+		}
+		return validators
+	}
+
+	res, err := queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: addrs[0].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{sorted[0].String(), sorted[1].String(), sorted[2].String()}, validators(res))
+	suite.Require().Nil(res.Pagination)
+
+	res, err = queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: addrs[0].String(),
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{sorted[0].String(), sorted[1].String()}, validators(res))
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().Equal(sorted[2].Bytes(), res.Pagination.NextKey)
+
+	res, err = queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: addrs[0].String(),
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{sorted[2].String()}, validators(res))
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: addrs[0].String(),
+		Pagination:       &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{sorted[1].String()}, validators(res))
+	suite.Require().Equal(sorted[2].Bytes(), res.Pagination.NextKey)
+
+	_, err = queryClient.DelegationTotalRewards(gocontext.Background(), &types.QueryDelegationTotalRewardsRequest{
+		DelegatorAddress: addrs[0].String(),
+		Pagination:       &query.PageRequest{Key: sorted[1].Bytes(), Offset: 1},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCValidatorDelegatorsRewards() {
+	app, ctx := suite.app, suite.ctx
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.Commission = teststaking.ZeroCommission()
+	tstaking.CreateValidator(valAddr, valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddr, sdk.NewInt(100))
+	tstaking.Delegate(addrs[2], valAddr, sdk.NewInt(200))
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(400)}})
+
+	amounts := map[string]int64{addrs[0].String(): 100, addrs[1].String(): 100, addrs[2].String(): 200}
+
+	// the rewards are ordered by delegator address
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+	var expRewards []types.ValidatorDelegatorReward
+	for _, addr := range addrs {
+		reward := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(amounts[addr.String()])}}
+		expRewards = append(expRewards, types.NewValidatorDelegatorReward(addr, reward))
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.DistrKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.ValidatorDelegatorsRewards(gocontext.Background(), &types.QueryValidatorDelegatorsRewardsRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ValidatorDelegatorsRewards(gocontext.Background(), &types.QueryValidatorDelegatorsRewardsRequest{
+		ValidatorAddress: sdk.ValAddress(suite.addrs[0]).String(),
+	})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ValidatorDelegatorsRewards(gocontext.Background(), &types.QueryValidatorDelegatorsRewardsRequest{
+		ValidatorAddress: valAddr.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRewards, res.Rewards)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.ValidatorDelegatorsRewards(gocontext.Background(), &types.QueryValidatorDelegatorsRewardsRequest{
+		ValidatorAddress: valAddr.String(),
+		Pagination:       &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRewards[:2], res.Rewards)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.ValidatorDelegatorsRewards(gocontext.Background(), &types.QueryValidatorDelegatorsRewardsRequest{
+		ValidatorAddress: valAddr.String(),
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expRewards[2:], res.Rewards)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestGRPCDelegatorWithdrawAddress() {
 	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
 
@@ -652,6 +962,170 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCRewardsProjection() {
+	app, ctx, queryClient, addrs, valAddrs := suite.app, suite.ctx, suite.queryClient, suite.addrs, suite.valAddrs
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.Inflation = sdk.NewDecWithPrec(1, 1)
+	app.MintKeeper.SetMinter(ctx, minter)
+	mintParams := app.MintKeeper.GetParams(ctx)
+	mintParams.CommunityPoolProportion = sdk.NewDecWithPrec(2, 1)
+	app.MintKeeper.SetParams(ctx, mintParams)
+	params := app.DistrKeeper.GetParams(ctx)
+	params.CommunityTax = sdk.NewDecWithPrec(1, 1)
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// create a validator with 10% commission
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDec(0))
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1000)))))
+
+	// the bonded tokens earn 10% * 80% * 90% / 10% = 72% before the commission
+	expRes := &types.QueryRewardsProjectionResponse{
+		Inflation:     minter.Inflation,
+		BondedRatio:   sdk.NewDecWithPrec(1, 1),
+		StakingApr:    sdk.NewDecWithPrec(72, 2),
+		Apr:           sdk.NewDecWithPrec(648, 3),
+		AnnualRewards: sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(648, 3).MulInt(sdk.TokensFromConsensusPower(100)))},
+	}
+
+	var req *types.QueryRewardsProjectionRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryRewardsProjectionRequest{}
+			},
+			false,
+		},
+		{
+			"both delegator and validator addresses",
+			func() {
+				req = &types.QueryRewardsProjectionRequest{DelegatorAddress: addrs[0].String(), ValidatorAddress: valAddrs[0].String()}
+			},
+			false,
+		},
+		{
+			"unknown validator",
+			func() {
+				req = &types.QueryRewardsProjectionRequest{ValidatorAddress: valAddrs[1].String()}
+			},
+			false,
+		},
+		{
+			"valid validator request",
+			func() {
+				req = &types.QueryRewardsProjectionRequest{ValidatorAddress: valAddrs[0].String()}
+			},
+			true,
+		},
+		{
+			"valid delegator request",
+			func() {
+				req = &types.QueryRewardsProjectionRequest{DelegatorAddress: addrs[0].String()}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.RewardsProjection(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+
+	// a delegator without delegations earns nothing
+	res, err := queryClient.RewardsProjection(gocontext.Background(), &types.QueryRewardsProjectionRequest{DelegatorAddress: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Apr.IsZero())
+	suite.Require().True(res.AnnualRewards.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestGRPCInvariants() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.Invariants(gocontext.Background(), &types.QueryInvariantsRequest{Routes: []string{"foo"}})
+	suite.Require().Error(err)
+
+	res, err := queryClient.Invariants(gocontext.Background(), &types.QueryInvariantsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Invariants, 4)
+	for _, invariant := range res.Invariants {
+		suite.Require().False(invariant.Broken, invariant.Message)
+	}
+
+	// credit the community pool without funding the module account
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	res, err = queryClient.Invariants(gocontext.Background(), &types.QueryInvariantsRequest{
+		Routes: []string{"module-account", "reference-count"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Invariants, 2)
+	suite.Require().Equal("reference-count", res.Invariants[0].Route)
+	suite.Require().False(res.Invariants[0].Broken)
+	suite.Require().Equal("module-account", res.Invariants[1].Route)
+	suite.Require().True(res.Invariants[1].Broken)
+	suite.Require().Contains(res.Invariants[1].Message, "expected ModuleAccount coins")
+}
+
+func (suite *KeeperTestSuite) TestGRPCFundingStreams() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	_, err := queryClient.FundingStream(gocontext.Background(), &types.QueryFundingStreamRequest{Id: 1})
+	suite.Require().Error(err)
+
+	res, err := queryClient.FundingStreams(gocontext.Background(), &types.QueryFundingStreamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Streams)
+
+	var streams []types.FundingStream
+	for i := 0; i < 3; i++ {
+		amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(i+1)))
+		id, err := app.DistrKeeper.CreateFundingStream(ctx, addrs[i%len(addrs)], amount, 10)
+		suite.Require().NoError(err)
+
+		stream, _ := app.DistrKeeper.GetFundingStream(ctx, id)
+		streams = append(streams, stream)
+	}
+
+	streamRes, err := queryClient.FundingStream(gocontext.Background(), &types.QueryFundingStreamRequest{Id: streams[1].Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(streams[1], streamRes.Stream)
+
+	res, err = queryClient.FundingStreams(gocontext.Background(), &types.QueryFundingStreamsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(streams[:2], res.Streams)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.FundingStreams(gocontext.Background(), &types.QueryFundingStreamsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(streams[2:], res.Streams)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// dueQueueAddresses returns the addresses queued in a height queue at or
+// before the current height, in queue order and at most limit of them. The
+// addresses left out remain queued and are returned first at the next height.
+func (k Keeper) dueQueueAddresses(ctx sdk.Context, queuePrefix []byte, limit uint32) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(queuePrefix, types.GetHeightQueueHeightKey(queuePrefix, ctx.BlockHeight()+1))
+	defer iterator.Close()
+
+	var due [][]byte
+	for ; iterator.Valid() && len(due) < int(limit); iterator.Next() {
+		_, addr := types.GetHeightQueueHeightAddress(iterator.Key())
+		due = append(due, addr)
+	}
+
+	return due
+}
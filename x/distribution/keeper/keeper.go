@@ -20,6 +20,8 @@ type Keeper struct {
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
+	mintKeeper    types.MintKeeper
+	crisisKeeper  types.CrisisKeeper
 
 	blockedAddrs map[string]bool
 
@@ -55,14 +57,44 @@ func NewKeeper(
 	}
 }
 
+// SetMintKeeper sets the mint keeper the staking rewards are projected from.
+// It is set after the creation of the keeper, as the mint keeper depends on it.
+func (k *Keeper) SetMintKeeper(mk types.MintKeeper) *Keeper {
+	if k.mintKeeper != nil {
+		panic("cannot set mint keeper twice")
+	}
+
+	k.mintKeeper = mk
+
+	return k
+}
+
+// SetCrisisKeeper sets the crisis keeper the invariants are run through. It is
+// set after the creation of the keeper, as the crisis keeper is created later.
+func (k *Keeper) SetCrisisKeeper(ck types.CrisisKeeper) *Keeper {
+	if k.crisisKeeper != nil {
+		panic("cannot set crisis keeper twice")
+	}
+
+	k.crisisKeeper = ck
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// isBlockedAddr returns whether an address is not allowed to receive external
+// funds, either statically or because governance blocked it in the bank module.
+func (k Keeper) isBlockedAddr(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.blockedAddrs[addr.String()] || k.bankKeeper.BlockedAddr(ctx, addr)
+}
+
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
-	if k.blockedAddrs[withdrawAddr.String()] {
+	if k.isBlockedAddr(ctx, withdrawAddr) {
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
 	}
 
@@ -81,6 +113,39 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
+// SetDelegationWithdrawAddr sets a new address that will receive the rewards of
+// a delegation to a validator upon withdrawal, instead of the delegator
+// withdraw address. An empty withdrawAddr removes it.
+func (k Keeper) SetDelegationWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if withdrawAddr.Empty() {
+		k.deleteDelegationWithdrawAddr(ctx, delegatorAddr, validatorAddr)
+	} else {
+		if k.isBlockedAddr(ctx, withdrawAddr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+		}
+
+		if !k.GetWithdrawAddrEnabled(ctx) {
+			return types.ErrSetWithdrawAddrDisabled
+		}
+
+		if k.stakingKeeper.Validator(ctx, validatorAddr) == nil {
+			return types.ErrNoValidatorExists
+		}
+
+		k.setDelegationWithdrawAddr(ctx, delegatorAddr, validatorAddr, withdrawAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, k.GetDelegationWithdrawAddr(ctx, delegatorAddr, validatorAddr).String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, validatorAddr.String()),
+		),
+	)
+
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -94,18 +159,69 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	}
 
 	// withdraw rewards
-	rewards, err := k.withdrawDelegationRewards(ctx, val, del)
+	startingPeriod := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr).PreviousPeriod
+	rewards, err := k.withdrawDelegationRewards(ctx, val, del, false)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawRewards,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
-			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
-		),
-	)
+	ctx.EventManager().EmitEvent(k.newWithdrawRewardsEvent(ctx, delAddr, valAddr, rewards, startingPeriod))
+
+	// reinitialize the delegation
+	k.initializeDelegation(ctx, valAddr, delAddr)
+	return rewards, nil
+}
+
+// newWithdrawRewardsEvent returns the event of a withdrawal of the rewards of a
+// delegation, which were accrued from the starting period to the period ended
+// by the withdrawal.
+func (k Keeper) newWithdrawRewardsEvent(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, rewards sdk.Coins, startingPeriod uint64) sdk.Event {
+	endingPeriod := k.GetValidatorCurrentRewards(ctx, valAddr).Period - 1
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, k.GetDelegationWithdrawAddr(ctx, delAddr, valAddr).String()),
+		sdk.NewAttribute(types.AttributeKeyStartingPeriod, fmt.Sprintf("%d", startingPeriod)),
+		sdk.NewAttribute(types.AttributeKeyEndingPeriod, fmt.Sprintf("%d", endingPeriod)),
+	}
+
+	return sdk.NewEvent(types.EventTypeWithdrawRewards, append(attrs, denomAmountAttributes(rewards)...)...)
+}
+
+// denomAmountAttributes returns a denom_amount attribute for each denom of the
+// withdrawn coins, for indexers not to split the amount attribute.
+func denomAmountAttributes(coins sdk.Coins) []sdk.Attribute {
+	attrs := make([]sdk.Attribute, len(coins))
+	for i, coin := range coins {
+		attrs[i] = sdk.NewAttribute(types.AttributeKeyDenomAmount, coin.String())
+	}
+
+	return attrs
+}
+
+// WithdrawDelegationRewardsPartial withdraws either the given amount or, if the
+// amount is empty, the given percentage of the rewards of a delegation. The
+// remaining rewards keep accruing.
+func (k Keeper) WithdrawDelegationRewardsPartial(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins, percentage sdk.Dec) (sdk.Coins, error) {
+	val := k.stakingKeeper.Validator(ctx, valAddr)
+	if val == nil {
+		return nil, types.ErrNoValidatorDistInfo
+	}
+
+	del := k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	if del == nil {
+		return nil, types.ErrEmptyDelegationDistInfo
+	}
+
+	startingPeriod := k.GetDelegatorStartingInfo(ctx, valAddr, delAddr).PreviousPeriod
+	rewards, err := k.withdrawDelegationRewardsPartial(ctx, val, del, amount, percentage)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(k.newWithdrawRewardsEvent(ctx, delAddr, valAddr, rewards, startingPeriod))
 
 	// reinitialize the delegation
 	k.initializeDelegation(ctx, valAddr, delAddr)
@@ -127,20 +243,21 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr).Rewards
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		err := k.sendRewards(ctx, withdrawAddr, commission)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+	}
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawCommission,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
-		),
+		sdk.NewEvent(types.EventTypeWithdrawCommission, append(attrs, denomAmountAttributes(commission)...)...),
 	)
 
 	return commission, nil
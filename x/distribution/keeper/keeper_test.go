@@ -34,6 +34,140 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], distrAcc.GetAddress()))
 }
 
+func TestSetDelegationWithdrawAddr(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoCompound(t)
+	withdrawAddr := sdk.AccAddress([]byte("withdrawAddr________"))
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.WithdrawAddrEnabled = false
+	app.DistrKeeper.SetParams(ctx, params)
+
+	err := app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, withdrawAddr)
+	require.ErrorIs(t, err, types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	app.DistrKeeper.SetParams(ctx, params)
+
+	err = app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, sdk.ValAddress(withdrawAddr), withdrawAddr)
+	require.ErrorIs(t, err, types.ErrNoValidatorExists)
+	require.Error(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, distrAcc.GetAddress()))
+
+	require.NoError(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, withdrawAddr))
+	require.Equal(t, withdrawAddr, app.DistrKeeper.GetDelegationWithdrawAddr(ctx, delAddr, valAddr))
+	require.Equal(t, delAddr, app.DistrKeeper.GetDelegatorWithdrawAddr(ctx, delAddr))
+
+	// the rewards of the delegation are withdrawn to its withdraw address
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)))
+	ctx = ctx.WithBlockHeight(2)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), app.BankKeeper.GetAllBalances(ctx, withdrawAddr))
+
+	// an empty withdraw address removes it
+	require.NoError(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, nil))
+	require.Equal(t, delAddr, app.DistrKeeper.GetDelegationWithdrawAddr(ctx, delAddr, valAddr))
+
+	// the withdraw address is removed along with the delegation, after its
+	// rewards are withdrawn to it
+	require.NoError(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, withdrawAddr))
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, delegation.Shares)
+	require.NoError(t, err)
+	require.Equal(t, delAddr, app.DistrKeeper.GetDelegationWithdrawAddr(ctx, delAddr, valAddr))
+	app.DistrKeeper.IterateDelegationWithdrawAddrs(ctx, func(del sdk.AccAddress, val sdk.ValAddress, _ sdk.AccAddress) (stop bool) {
+		require.Fail(t, "unexpected delegation withdraw address", "%s %s", del, val)
+		return false
+	})
+}
+
+func TestWithdrawAddrBlockedByGovernance(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoCompound(t)
+	valWithdrawAddr := sdk.AccAddress([]byte("valWithdrawAddr_____"))
+	delWithdrawAddr := sdk.AccAddress([]byte("delWithdrawAddr_____"))
+
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, sdk.AccAddress(valAddr), valWithdrawAddr))
+	require.NoError(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, delWithdrawAddr))
+
+	// governance blocks the withdraw addresses after they were set
+	app.BankKeeper.SetBlockedAddr(ctx, valWithdrawAddr)
+	app.BankKeeper.SetBlockedAddr(ctx, delWithdrawAddr)
+	require.Error(t, app.DistrKeeper.SetWithdrawAddr(ctx, delAddr, valWithdrawAddr))
+	require.Error(t, app.DistrKeeper.SetDelegationWithdrawAddr(ctx, delAddr, valAddr, valWithdrawAddr))
+
+	// the rewards of the delegation forcibly withdrawn when its shares are
+	// modified go to the community pool
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)))
+	ctx = ctx.WithBlockHeight(2)
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.NotPanics(t, func() { app.DistrKeeper.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr) })
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, delWithdrawAddr).IsZero())
+	require.Equal(t, communityPool.Add(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 50)), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+
+	// the commission forcibly withdrawn when the validator is removed goes to
+	// the community pool
+	commission := sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10))
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+	communityPool = app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+	require.NotPanics(t, func() { app.DistrKeeper.Hooks().AfterValidatorRemoved(ctx, nil, valAddr) })
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, valWithdrawAddr).IsZero())
+	require.Equal(t, communityPool.Add(commission...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+func TestWithdrawDelegationRewardsPartial(t *testing.T) {
+	app, ctx, delAddr, valAddr := setupAutoCompound(t)
+
+	// allocate 101 tokens, 50.5 of them to the delegation
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 101)))
+	ctx = ctx.WithBlockHeight(2)
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	_, err := app.DistrKeeper.WithdrawDelegationRewardsPartial(ctx, delAddr, valAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 51)), sdk.Dec{})
+	require.ErrorIs(t, err, types.ErrInsufficientRewards)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	coins, err := app.DistrKeeper.WithdrawDelegationRewardsPartial(ctx, delAddr, valAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), sdk.Dec{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), coins)
+	// the failed withdrawal above, not being reverted here, also ended a period
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeWithdrawRewards,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, delAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyStartingPeriod, "2"),
+		sdk.NewAttribute(types.AttributeKeyEndingPeriod, "4"),
+		sdk.NewAttribute(types.AttributeKeyDenomAmount, coins[0].String()),
+	))
+	require.Equal(t, balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+
+	// the rest of the rewards is left unclaimed, even in the same block
+	unclaimed := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(305, 1))}
+	require.Equal(t, unclaimed, app.DistrKeeper.GetDelegationUnclaimedRewards(ctx, valAddr, delAddr).Rewards)
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 81)}, app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr))
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	del := app.StakingKeeper.Delegation(ctx, delAddr, valAddr)
+	require.Equal(t, unclaimed, app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod))
+
+	// half of the rewards is withdrawn, truncated
+	ctx = ctx.WithBlockHeight(3)
+	coins, err = app.DistrKeeper.WithdrawDelegationRewardsPartial(ctx, delAddr, valAddr, nil, sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), coins)
+
+	// a full withdrawal includes the unclaimed rewards
+	coins, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), coins)
+	require.True(t, app.DistrKeeper.GetDelegationUnclaimedRewards(ctx, valAddr, delAddr).Rewards.IsZero())
+	require.Equal(t, balance.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)), app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom))
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -68,8 +202,17 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: valCommission})
 
 	// withdraw commission
-	_, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	commission, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
 	require.NoError(t, err)
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeWithdrawCommission,
+		sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddrs[0].String()),
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, addr[0].String()),
+		sdk.NewAttribute(types.AttributeKeyDenomAmount, "1mytoken"),
+		sdk.NewAttribute(types.AttributeKeyDenomAmount, "1stake"),
+	))
 
 	// check balance increase
 	balance = app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0]))
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// HasLockedRewards returns true if the rewards withdrawn to an account are
+// locked in its vesting schedule.
+func (k Keeper) HasLockedRewards(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetLockedRewardsKey(addr))
+}
+
+// setLockedRewards stores whether the rewards withdrawn to an account are
+// locked in its vesting schedule.
+func (k Keeper) setLockedRewards(ctx sdk.Context, addr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetLockedRewardsKey(addr), []byte{})
+	} else {
+		store.Delete(types.GetLockedRewardsKey(addr))
+	}
+}
+
+// IterateLockedRewardsAccounts iterates over the accounts locking their
+// withdrawn rewards and performs a callback function.
+func (k Keeper) IterateLockedRewardsAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LockedRewardsPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.GetLockedRewardsAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// EnableLockedRewards locks the rewards and commission later withdrawn to a
+// vesting account in its vesting schedule. Clawback vesting accounts are not
+// supported, as their funder could claw the rewards back.
+func (k Keeper) EnableLockedRewards(ctx sdk.Context, addr sdk.AccAddress) error {
+	if !isLockableAccount(k.authKeeper.GetAccount(ctx, addr)) {
+		return sdkerrors.Wrapf(types.ErrRewardsNotLockable, "%s is not a continuous, delayed or periodic vesting account", addr)
+	}
+
+	k.setLockedRewards(ctx, addr, true)
+	k.emitSetLockedRewardsEvent(ctx, addr, true)
+	return nil
+}
+
+// DisableLockedRewards makes the rewards later withdrawn to an account
+// spendable again. The rewards locked so far keep vesting.
+func (k Keeper) DisableLockedRewards(ctx sdk.Context, addr sdk.AccAddress) {
+	k.setLockedRewards(ctx, addr, false)
+	k.emitSetLockedRewardsEvent(ctx, addr, false)
+}
+
+func (k Keeper) emitSetLockedRewardsEvent(ctx sdk.Context, addr sdk.AccAddress, enabled bool) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetLockedRewards,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, fmt.Sprintf("%t", enabled)),
+		),
+	)
+}
+
+// sendRewards sends withdrawn rewards or commission from the module account to
+// a withdraw address, locking them in its vesting schedule if it opted in.
+func (k Keeper) sendRewards(ctx sdk.Context, withdrawAddr sdk.AccAddress, coins sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+		return err
+	}
+
+	if k.HasLockedRewards(ctx, withdrawAddr) {
+		k.lockRewards(ctx, withdrawAddr, coins)
+	}
+
+	return nil
+}
+
+// sendForcedRewards sends rewards or commission withdrawn by the staking hooks
+// to a withdraw address. As governance can block a withdraw address after it
+// was set, the rewards of a blocked address go to the community pool instead,
+// so that the hooks do not fail.
+func (k Keeper) sendForcedRewards(ctx sdk.Context, withdrawAddr sdk.AccAddress, coins sdk.Coins) error {
+	if !k.isBlockedAddr(ctx, withdrawAddr) {
+		return k.sendRewards(ctx, withdrawAddr, coins)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	k.SetFeePool(ctx, feePool)
+
+	k.Logger(ctx).Info("rewards of a blocked withdraw address sent to the community pool", "address", withdrawAddr.String(), "amount", coins.String())
+
+	return nil
+}
+
+// lockRewards adds the rewards received by a vesting account to its original
+// vesting coins, so that they are delegatable but locked until they vest along
+// with the other coins of the account. The rewards received by a periodic
+// vesting account are added to its last period. Nothing is locked once the
+// vesting of the account has ended.
+func (k Keeper) lockRewards(ctx sdk.Context, addr sdk.AccAddress, rewards sdk.Coins) {
+	acc := k.authKeeper.GetAccount(ctx, addr)
+	if !isLockableAccount(acc) {
+		return
+	}
+
+	switch acc := acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount:
+		if ctx.BlockTime().Unix() >= acc.EndTime {
+			return
+		}
+		acc.OriginalVesting = acc.OriginalVesting.Add(rewards...)
+
+	case *vestingtypes.DelayedVestingAccount:
+		if ctx.BlockTime().Unix() >= acc.EndTime {
+			return
+		}
+		acc.OriginalVesting = acc.OriginalVesting.Add(rewards...)
+
+	case *vestingtypes.PeriodicVestingAccount:
+		if ctx.BlockTime().Unix() >= acc.EndTime || len(acc.VestingPeriods) == 0 {
+			return
+		}
+		acc.OriginalVesting = acc.OriginalVesting.Add(rewards...)
+		last := &acc.VestingPeriods[len(acc.VestingPeriods)-1]
+		last.Amount = last.Amount.Add(rewards...)
+	}
+
+	k.authKeeper.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockRewards,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, addr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+		),
+	)
+}
+
+// isLockableAccount returns true if the rewards withdrawn to an account can be
+// locked in its vesting schedule.
+func isLockableAccount(acc authtypes.AccountI) bool {
+	switch acc.(type) {
+	case *vestingtypes.ContinuousVestingAccount, *vestingtypes.DelayedVestingAccount, *vestingtypes.PeriodicVestingAccount:
+		return true
+	default:
+		return false
+	}
+}
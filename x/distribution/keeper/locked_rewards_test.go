@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestLockedRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1000, 0)})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// turn the other accounts into delayed, periodic and clawback vesting
+	// accounts, all vesting 100 coins until 2500
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)) }
+	periods := vestingtypes.Periods{{Length: 1000, Amount: coins(50)}, {Length: 1000, Amount: coins(50)}}
+	baseAccount := func(addr sdk.AccAddress) *authtypes.BaseAccount {
+		return app.AccountKeeper.GetAccount(ctx, addr).(*authtypes.BaseAccount)
+	}
+	app.AccountKeeper.SetAccount(ctx, vestingtypes.NewDelayedVestingAccount(baseAccount(addrs[1]), coins(100), 2500))
+	app.AccountKeeper.SetAccount(ctx, vestingtypes.NewPeriodicVestingAccount(baseAccount(addrs[2]), coins(100), 500, periods))
+	app.AccountKeeper.SetAccount(ctx, vestingtypes.NewClawbackVestingAccount(baseAccount(addrs[3]), addrs[0], 500, periods))
+
+	withdrawCommission := func(withdrawAddr sdk.AccAddress) {
+		commission := types.ValidatorAccumulatedCommission{Commission: sdk.NewDecCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 10))}
+		app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, addrs[0], withdrawAddr)
+		app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission.Commission})
+		app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, commission)
+		_, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+		require.NoError(t, err)
+	}
+
+	// only continuous, delayed and periodic vesting accounts can lock rewards
+	require.ErrorIs(t, app.DistrKeeper.EnableLockedRewards(ctx, addrs[0]), types.ErrRewardsNotLockable)
+	require.ErrorIs(t, app.DistrKeeper.EnableLockedRewards(ctx, addrs[3]), types.ErrRewardsNotLockable)
+
+	// the rewards are spendable until the account opts in
+	withdrawCommission(addrs[1])
+	require.Equal(t, coins(100), app.BankKeeper.LockedCoins(ctx, addrs[1]))
+	require.Equal(t, coins(1010), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+
+	require.NoError(t, app.DistrKeeper.EnableLockedRewards(ctx, addrs[1]))
+	require.True(t, app.DistrKeeper.HasLockedRewards(ctx, addrs[1]))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	withdrawCommission(addrs[1])
+	require.Equal(t, coins(110), app.BankKeeper.LockedCoins(ctx, addrs[1]))
+	require.Equal(t, coins(1020), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeLockRewards,
+		sdk.NewAttribute(types.AttributeKeyWithdrawAddress, addrs[1].String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins(10).String()),
+	))
+
+	// the rewards of a periodic vesting account vest with its last period
+	require.NoError(t, app.DistrKeeper.EnableLockedRewards(ctx, addrs[2]))
+	withdrawCommission(addrs[2])
+	periodic := app.AccountKeeper.GetAccount(ctx, addrs[2]).(*vestingtypes.PeriodicVestingAccount)
+	require.NoError(t, periodic.Validate())
+	require.Equal(t, coins(60), periodic.VestingPeriods[1].Amount)
+	require.Equal(t, coins(110), app.BankKeeper.LockedCoins(ctx, addrs[2]))
+	require.Equal(t, coins(60), app.BankKeeper.LockedCoins(ctx.WithBlockTime(time.Unix(1500, 0)), addrs[2]))
+
+	// nothing is locked once the vesting has ended
+	withdrawCommission(addrs[2])
+	require.Equal(t, coins(120), app.BankKeeper.LockedCoins(ctx, addrs[2]))
+	ctx = ctx.WithBlockTime(time.Unix(2500, 0))
+	withdrawCommission(addrs[2])
+	require.Empty(t, app.BankKeeper.LockedCoins(ctx, addrs[2]))
+	require.Equal(t, coins(120), app.AccountKeeper.GetAccount(ctx, addrs[2]).(*vestingtypes.PeriodicVestingAccount).OriginalVesting)
+
+	// the rewards locked so far keep vesting after the account opts out
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	app.DistrKeeper.DisableLockedRewards(ctx, addrs[1])
+	require.False(t, app.DistrKeeper.HasLockedRewards(ctx, addrs[1]))
+	withdrawCommission(addrs[1])
+	require.Equal(t, coins(110), app.BankKeeper.LockedCoins(ctx, addrs[1]))
+	require.Equal(t, coins(1030), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+}
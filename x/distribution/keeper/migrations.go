@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the params missing from
+// the param store of version 1 to their default values: the auto-compounding,
+// historical rewards pruning and scheduled commission withdrawal params, and
+// the scheduled rate change with no change scheduled.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundInterval, defaultParams.AutoCompoundInterval)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxAutoCompoundsPerBlock, defaultParams.MaxAutoCompoundsPerBlock)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundGasLimit, defaultParams.AutoCompoundGasLimit)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyHistoricalRetentionPeriods, defaultParams.HistoricalRetentionPeriods)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyHistoricalPruningInterval, defaultParams.HistoricalPruningInterval)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyScheduledRateChange, defaultParams.ScheduledRateChange)
+	m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxCommissionWithdrawals, defaultParams.MaxCommissionWithdrawalsPerBlock)
+	return nil
+}
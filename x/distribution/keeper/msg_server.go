@@ -87,6 +87,44 @@ func (k msgServer) WithdrawDelegatorReward(goCtx context.Context, msg *types.Msg
 	return &types.MsgWithdrawDelegatorRewardResponse{}, nil
 }
 
+func (k msgServer) WithdrawDelegatorRewardPartial(goCtx context.Context, msg *types.MsgWithdrawDelegatorRewardPartial) (*types.MsgWithdrawDelegatorRewardPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.WithdrawDelegationRewardsPartial(ctx, delegatorAddress, valAddr, msg.Amount, msg.Percentage)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+	return &types.MsgWithdrawDelegatorRewardPartialResponse{}, nil
+}
+
 func (k msgServer) WithdrawValidatorCommission(goCtx context.Context, msg *types.MsgWithdrawValidatorCommission) (*types.MsgWithdrawValidatorCommissionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -143,3 +181,121 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 
 	return &types.MsgFundCommunityPoolResponse{}, nil
 }
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.ValidatorAddresses) == 0 {
+		k.DisableAutoCompound(ctx, delegatorAddress)
+	} else {
+		valAddrs := make([]sdk.ValAddress, len(msg.ValidatorAddresses))
+		for i, validator := range msg.ValidatorAddresses {
+			valAddrs[i], err = sdk.ValAddressFromBech32(validator)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err := k.EnableAutoCompound(ctx, delegatorAddress, valAddrs); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) SetDelegationWithdrawAddress(goCtx context.Context, msg *types.MsgSetDelegationWithdrawAddress) (*types.MsgSetDelegationWithdrawAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	validatorAddress, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	var withdrawAddress sdk.AccAddress
+	if msg.WithdrawAddress != "" {
+		withdrawAddress, err = sdk.AccAddressFromBech32(msg.WithdrawAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = k.SetDelegationWithdrawAddr(ctx, delegatorAddress, validatorAddress, withdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgSetDelegationWithdrawAddressResponse{}, nil
+}
+
+func (k msgServer) SetCommissionWithdrawSchedule(goCtx context.Context, msg *types.MsgSetCommissionWithdrawSchedule) (*types.MsgSetCommissionWithdrawScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Interval == 0 {
+		k.DisableCommissionWithdrawSchedule(ctx, valAddr)
+	} else if err := k.EnableCommissionWithdrawSchedule(ctx, valAddr, msg.Interval); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgSetCommissionWithdrawScheduleResponse{}, nil
+}
+
+func (k msgServer) SetLockedRewards(goCtx context.Context, msg *types.MsgSetLockedRewards) (*types.MsgSetLockedRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Enabled {
+		k.DisableLockedRewards(ctx, addr)
+	} else if err := k.EnableLockedRewards(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgSetLockedRewardsResponse{}, nil
+}
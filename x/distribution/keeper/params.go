@@ -35,9 +35,55 @@ func (k Keeper) GetBonusProposerReward(ctx sdk.Context) (percent sdk.Dec) {
 	return percent
 }
 
+// GetScheduledRateChange returns the change of the community tax and proposer
+// reward rates scheduled at a future height, if any.
+func (k Keeper) GetScheduledRateChange(ctx sdk.Context) (change types.ScheduledRateChange) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyScheduledRateChange, &change)
+	return change
+}
+
+// ApplyScheduledRateChange sets the community tax and proposer reward rates of
+// the scheduled rate change once its activation height is reached, and clears
+// the schedule.
+func (k Keeper) ApplyScheduledRateChange(ctx sdk.Context) {
+	change := k.GetScheduledRateChange(ctx)
+	if !change.IsScheduled() || ctx.BlockHeight() < change.ActivationHeight {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	params.CommunityTax = change.CommunityTax
+	params.BaseProposerReward = change.BaseProposerReward
+	params.BonusProposerReward = change.BonusProposerReward
+	params.ScheduledRateChange = types.DefaultParams().ScheduledRateChange
+	k.SetParams(ctx, params)
+
+	k.Logger(ctx).Info(
+		"applied scheduled rate change",
+		"community_tax", change.CommunityTax,
+		"base_proposer_reward", change.BaseProposerReward,
+		"bonus_proposer_reward", change.BonusProposerReward,
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateChange,
+			sdk.NewAttribute(types.AttributeKeyCommunityTax, change.CommunityTax.String()),
+			sdk.NewAttribute(types.AttributeKeyBaseProposer, change.BaseProposerReward.String()),
+			sdk.NewAttribute(types.AttributeKeyBonusProposer, change.BonusProposerReward.String()),
+		),
+	)
+}
+
 // GetWithdrawAddrEnabled returns the current distribution withdraw address
 // enabled parameter.
 func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetAutoCompoundInterval returns the current distribution auto-compounding
+// interval, zero when auto-compounding is disabled.
+func (k Keeper) GetAutoCompoundInterval(ctx sdk.Context) (interval uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundInterval, &interval)
+	return interval
+}
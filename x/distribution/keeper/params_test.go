@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func TestApplyScheduledRateChange(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+	communityTax := app.DistrKeeper.GetCommunityTax(ctx)
+
+	// the change is scheduled through a param change proposal
+	handler := params.NewParamChangeProposalHandler(app.ParamsKeeper)
+	content := proposal.NewParameterChangeProposal("rate change", "description", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyScheduledRateChange),
+			`{"activation_height":"10","community_tax":"0.050000000000000000","base_proposer_reward":"0.020000000000000000","bonus_proposer_reward":"0.030000000000000000"}`),
+	})
+	require.NoError(t, handler(ctx, content))
+
+	change := types.NewScheduledRateChange(10, sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(2, 2), sdk.NewDecWithPrec(3, 2))
+	require.Equal(t, change, app.DistrKeeper.GetScheduledRateChange(ctx))
+
+	// the rates are unchanged before the activation height
+	ctx = ctx.WithBlockHeight(9)
+	app.DistrKeeper.ApplyScheduledRateChange(ctx)
+	require.Equal(t, communityTax, app.DistrKeeper.GetCommunityTax(ctx))
+	require.Equal(t, change, app.DistrKeeper.GetScheduledRateChange(ctx))
+
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	app.DistrKeeper.ApplyScheduledRateChange(ctx)
+	require.Equal(t, change.CommunityTax, app.DistrKeeper.GetCommunityTax(ctx))
+	require.Equal(t, change.BaseProposerReward, app.DistrKeeper.GetBaseProposerReward(ctx))
+	require.Equal(t, change.BonusProposerReward, app.DistrKeeper.GetBonusProposerReward(ctx))
+	require.False(t, app.DistrKeeper.GetScheduledRateChange(ctx).IsScheduled())
+	require.Contains(t, ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventTypeRateChange,
+		sdk.NewAttribute(types.AttributeKeyCommunityTax, change.CommunityTax.String()),
+		sdk.NewAttribute(types.AttributeKeyBaseProposer, change.BaseProposerReward.String()),
+		sdk.NewAttribute(types.AttributeKeyBonusProposer, change.BonusProposerReward.String()),
+	))
+
+	// an invalid change is rejected by the proposal
+	content = proposal.NewParameterChangeProposal("rate change", "description", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyScheduledRateChange),
+			`{"activation_height":"20","community_tax":"0","base_proposer_reward":"0.600000000000000000","bonus_proposer_reward":"0.600000000000000000"}`),
+	})
+	require.Error(t, handler(ctx, content))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// StakingAPR estimates the annualized rewards rate of the bonded tokens, before
+// commission, from the current inflation, the share of the minted tokens paid
+// to the fee collector, the community tax and the bonded ratio. Transaction
+// fees are not taken into account. The mint keeper must be set.
+func (k Keeper) StakingAPR(ctx sdk.Context) (inflation, bondedRatio, apr sdk.Dec) {
+	minter := k.mintKeeper.GetMinter(ctx)
+	mintParams := k.mintKeeper.GetParams(ctx)
+
+	inflation = minter.Inflation
+	bondedRatio = k.stakingKeeper.BondedRatio(ctx)
+	if !bondedRatio.IsPositive() {
+		return inflation, bondedRatio, sdk.ZeroDec()
+	}
+
+	stakersProportion := sdk.OneDec().Sub(mintParams.CommunityPoolProportion).Sub(mintParams.TotalRecipientsWeight())
+	apr = inflation.Mul(stakersProportion).Mul(sdk.OneDec().Sub(k.GetCommunityTax(ctx))).Quo(bondedRatio)
+	return inflation, bondedRatio, apr
+}
+
+// ValidatorAPR returns the annualized rewards rate of the delegations to a
+// validator, net of its commission, and their projected rewards over a year in
+// the minted denom, given the staking APR.
+func (k Keeper) ValidatorAPR(ctx sdk.Context, stakingAPR sdk.Dec, val stakingtypes.ValidatorI) (apr sdk.Dec, annualRewards sdk.DecCoins) {
+	apr = validatorAPR(stakingAPR, val)
+	annual := val.GetTokens().ToDec().Mul(apr)
+	return apr, sdk.NewDecCoins(sdk.NewDecCoinFromDec(k.mintKeeper.GetParams(ctx).MintDenom, annual))
+}
+
+// DelegatorAPR returns the annualized rewards rate of the delegations of a
+// delegator, weighted by their tokens, and their projected rewards over a year
+// in the minted denom, given the staking APR.
+func (k Keeper) DelegatorAPR(ctx sdk.Context, stakingAPR sdk.Dec, delAddr sdk.AccAddress) (apr sdk.Dec, annualRewards sdk.DecCoins) {
+	total := sdk.ZeroDec()
+	annual := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		val := k.stakingKeeper.Validator(ctx, del.GetValidatorAddr())
+		tokens := val.TokensFromShares(del.GetShares())
+
+		total = total.Add(tokens)
+		annual = annual.Add(tokens.Mul(validatorAPR(stakingAPR, val)))
+		return false
+	})
+
+	apr = sdk.ZeroDec()
+	if total.IsPositive() {
+		apr = annual.Quo(total)
+	}
+
+	return apr, sdk.NewDecCoins(sdk.NewDecCoinFromDec(k.mintKeeper.GetParams(ctx).MintDenom, annual))
+}
+
+// validatorAPR returns the annualized rewards rate of the delegations to a
+// validator, net of its commission. Validators which are not bonded earn no
+// rewards.
+func validatorAPR(stakingAPR sdk.Dec, val stakingtypes.ValidatorI) sdk.Dec {
+	if !val.IsBonded() {
+		return sdk.ZeroDec()
+	}
+
+	return stakingAPR.Mul(sdk.OneDec().Sub(val.GetCommission()))
+}
@@ -27,3 +27,33 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.Commun
 
 	return nil
 }
+
+// HandleCommunityPoolStreamProposal is a handler for executing a passed community pool stream proposal
+func HandleCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolStreamProposal) error {
+	recipient, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		return err
+	}
+
+	id, err := k.CreateFundingStream(ctx, recipient, p.Amount, p.Interval)
+	if err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("created funding stream from the community pool", "id", id, "amount", p.Amount.String(), "recipient", p.Recipient, "interval", p.Interval)
+
+	return nil
+}
+
+// HandleCancelCommunityPoolStreamProposal is a handler for executing a passed cancel community pool stream proposal
+func HandleCancelCommunityPoolStreamProposal(ctx sdk.Context, k Keeper, p *types.CancelCommunityPoolStreamProposal) error {
+	if err := k.CancelFundingStream(ctx, p.StreamId); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("cancelled funding stream from the community pool", "id", p.StreamId)
+
+	return nil
+}
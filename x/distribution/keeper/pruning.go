@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// PruneHistoricalRewards deletes, at the heights multiple of the pruning
+// interval, the slash events older than the retention, in periods of their
+// validator, along with the historical rewards only they reference. A slash
+// event is kept as long as a delegation which started at or before its height
+// needs it to calculate its rewards. Nothing is pruned while the retention is
+// zero.
+func (k Keeper) PruneHistoricalRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.HistoricalRetentionPeriods == 0 || ctx.BlockHeight()%int64(params.HistoricalPruningInterval) != 0 {
+		return
+	}
+
+	type slashEvent struct {
+		val    sdk.ValAddress
+		height uint64
+		period uint64
+	}
+
+	var prunable []slashEvent
+	startingHeights := make(map[string]uint64)
+	k.IterateValidatorSlashEvents(ctx, func(val sdk.ValAddress, height uint64, event types.ValidatorSlashEvent) (stop bool) {
+		if event.ValidatorPeriod+params.HistoricalRetentionPeriods >= k.GetValidatorCurrentRewards(ctx, val).Period {
+			return false
+		}
+
+		startingHeight, ok := startingHeights[val.String()]
+		if !ok {
+			startingHeight = k.minDelegatorStartingHeight(ctx, val)
+			startingHeights[val.String()] = startingHeight
+		}
+
+		if height < startingHeight {
+			prunable = append(prunable, slashEvent{val: val, height: height, period: event.ValidatorPeriod})
+		}
+		return false
+	})
+
+	for _, event := range prunable {
+		k.DeleteValidatorSlashEvent(ctx, event.val, event.height, event.period)
+		k.decrementReferenceCount(ctx, event.val, event.period)
+	}
+
+	if len(prunable) > 0 {
+		k.Logger(ctx).Info("pruned historical rewards", "slash_events", len(prunable))
+	}
+}
+
+// minDelegatorStartingHeight returns the lowest starting height of the
+// delegations to a validator, the maximum height if there are none.
+func (k Keeper) minDelegatorStartingHeight(ctx sdk.Context, val sdk.ValAddress) uint64 {
+	minHeight := uint64(math.MaxUint64)
+	k.IterateValidatorDelegatorStartingInfos(ctx, val, func(_ sdk.AccAddress, info types.DelegatorStartingInfo) (stop bool) {
+		if info.Height < minHeight {
+			minHeight = info.Height
+		}
+		return false
+	})
+
+	return minHeight
+}
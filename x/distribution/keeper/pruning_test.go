@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestPruneHistoricalRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(1)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(100000000))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	valPower := int64(50)
+	tstaking.CreateValidatorWithValPower(valAddr, valConsPk1, valPower, true)
+	tstaking.Delegate(addrs[1], valAddr, sdk.TokensFromConsensusPower(50))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.HistoricalRetentionPeriods = 2
+	params.HistoricalPruningInterval = 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	// slash the validator by 50% at height 3
+	ctx = ctx.WithBlockHeight(3)
+	app.StakingKeeper.Slash(ctx, valConsAddr1, ctx.BlockHeight(), 2*valPower, sdk.NewDecWithPrec(5, 1))
+	var slashPeriod uint64
+	app.DistrKeeper.IterateValidatorSlashEvents(ctx, func(_ sdk.ValAddress, height uint64, event types.ValidatorSlashEvent) (stop bool) {
+		require.Equal(t, uint64(3), height)
+		slashPeriod = event.ValidatorPeriod
+		return true
+	})
+
+	isPruned := func() bool {
+		_, found := app.DistrKeeper.GetValidatorSlashEvent(ctx, valAddr, 3, slashPeriod)
+		return !found
+	}
+
+	// end a few periods for the slash event to be out of the retention
+	ctx = ctx.WithBlockHeight(4)
+	_, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, addrs[0], valAddr)
+	require.NoError(t, err)
+	val := app.StakingKeeper.Validator(ctx, valAddr)
+	app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+	app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+
+	// the slash event is kept while a delegation started before it
+	app.DistrKeeper.PruneHistoricalRewards(ctx)
+	require.False(t, isPruned())
+
+	// nothing is pruned in between the pruning interval
+	ctx = ctx.WithBlockHeight(5)
+	_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, addrs[1], valAddr)
+	require.NoError(t, err)
+	app.DistrKeeper.PruneHistoricalRewards(ctx)
+	require.False(t, isPruned())
+
+	// the slash event is kept within the retention
+	ctx = ctx.WithBlockHeight(6)
+	params.HistoricalRetentionPeriods = 10
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.PruneHistoricalRewards(ctx)
+	require.False(t, isPruned())
+
+	// it is pruned along with its historical rewards once out of the retention
+	// and no delegation needs it
+	params.HistoricalRetentionPeriods = 2
+	app.DistrKeeper.SetParams(ctx, params)
+	app.DistrKeeper.PruneHistoricalRewards(ctx)
+	require.True(t, isPruned())
+	require.Zero(t, app.DistrKeeper.GetValidatorHistoricalRewards(ctx, valAddr, slashPeriod).ReferenceCount)
+
+	_, broken := keeper.ReferenceCountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+}
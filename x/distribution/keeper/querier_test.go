@@ -127,10 +127,18 @@ func TestQueries(t *testing.T) {
 
 	// test param queries
 	params := types.Params{
-		CommunityTax:        sdk.NewDecWithPrec(3, 1),
-		BaseProposerReward:  sdk.NewDecWithPrec(2, 1),
-		BonusProposerReward: sdk.NewDecWithPrec(1, 1),
-		WithdrawAddrEnabled: true,
+		CommunityTax:               sdk.NewDecWithPrec(3, 1),
+		BaseProposerReward:         sdk.NewDecWithPrec(2, 1),
+		BonusProposerReward:        sdk.NewDecWithPrec(1, 1),
+		WithdrawAddrEnabled:        true,
+		AutoCompoundInterval:       10,
+		MaxAutoCompoundsPerBlock:   5,
+		AutoCompoundGasLimit:       200000,
+		HistoricalRetentionPeriods: 100,
+		HistoricalPruningInterval:  50,
+		ScheduledRateChange: types.NewScheduledRateChange(
+			100, sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1),
+		),
 	}
 
 	app.DistrKeeper.SetParams(ctx, params)
@@ -43,6 +43,43 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get the withdraw address of a delegation, defaulting to the delegator
+// withdraw address
+func (k Keeper) GetDelegationWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegationWithdrawAddrKey(delAddr, valAddr))
+	if b == nil {
+		return k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	}
+	return sdk.AccAddress(b)
+}
+
+// set the withdraw address of a delegation
+func (k Keeper) setDelegationWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegationWithdrawAddrKey(delAddr, valAddr), withdrawAddr.Bytes())
+}
+
+// delete the withdraw address of a delegation
+func (k Keeper) deleteDelegationWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationWithdrawAddrKey(delAddr, valAddr))
+}
+
+// iterate over the delegation withdraw addrs
+func (k Keeper) IterateDelegationWithdrawAddrs(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress, addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegationWithdrawAddrPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := sdk.AccAddress(iter.Value())
+		del, val := types.GetDelegationWithdrawAddrAddresses(iter.Key())
+		if handler(del, val, addr) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -124,6 +161,60 @@ func (k Keeper) IterateDelegatorStartingInfos(ctx sdk.Context, handler func(val
 	}
 }
 
+// iterate over the delegator starting infos of a validator
+func (k Keeper) IterateValidatorDelegatorStartingInfos(ctx sdk.Context, val sdk.ValAddress, handler func(del sdk.AccAddress, info types.DelegatorStartingInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorStartingInfoPrefix(val))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.DelegatorStartingInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		_, del := types.GetDelegatorStartingInfoAddresses(iter.Key())
+		if handler(del, info) {
+			break
+		}
+	}
+}
+
+// get the rewards of a delegation left unclaimed by partial withdrawals
+func (k Keeper) GetDelegationUnclaimedRewards(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) (rewards types.DelegationUnclaimedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetDelegationUnclaimedRewardsKey(val, del))
+	if b == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(b, &rewards)
+	return
+}
+
+// set the rewards of a delegation left unclaimed by partial withdrawals
+func (k Keeper) SetDelegationUnclaimedRewards(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress, rewards types.DelegationUnclaimedRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinaryBare(&rewards)
+	store.Set(types.GetDelegationUnclaimedRewardsKey(val, del), b)
+}
+
+// delete the rewards of a delegation left unclaimed by partial withdrawals
+func (k Keeper) DeleteDelegationUnclaimedRewards(ctx sdk.Context, val sdk.ValAddress, del sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegationUnclaimedRewardsKey(val, del))
+}
+
+// iterate over the unclaimed rewards of delegations
+func (k Keeper) IterateDelegationUnclaimedRewards(ctx sdk.Context, handler func(val sdk.ValAddress, del sdk.AccAddress, rewards types.DelegationUnclaimedRewards) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegationUnclaimedRewardsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rewards types.DelegationUnclaimedRewards
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &rewards)
+		val, del := types.GetDelegationUnclaimedRewardsAddresses(iter.Key())
+		if handler(val, del, rewards) {
+			break
+		}
+	}
+}
+
 // get historical rewards for a particular period
 func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, val sdk.ValAddress, period uint64) (rewards types.ValidatorHistoricalRewards) {
 	store := ctx.KVStore(k.storeKey)
@@ -329,6 +420,12 @@ func (k Keeper) SetValidatorSlashEvent(ctx sdk.Context, val sdk.ValAddress, heig
 	store.Set(types.GetValidatorSlashEventKey(val, height, period), b)
 }
 
+// delete a slash event
+func (k Keeper) DeleteValidatorSlashEvent(ctx sdk.Context, val sdk.ValAddress, height, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorSlashEventKey(val, height, period))
+}
+
 // iterate over slash events between heights, inclusive
 func (k Keeper) IterateValidatorSlashEventsBetween(ctx sdk.Context, val sdk.ValAddress, startingHeight uint64, endingHeight uint64,
 	handler func(height uint64, event types.ValidatorSlashEvent) (stop bool)) {
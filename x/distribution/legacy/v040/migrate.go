@@ -92,6 +92,17 @@ func Migrate(oldDistributionState v038distribution.GenesisState) *v040distributi
 			BaseProposerReward:  oldDistributionState.Params.BaseProposerReward,
 			BonusProposerReward: oldDistributionState.Params.BonusProposerReward,
 			WithdrawAddrEnabled: oldDistributionState.Params.WithdrawAddrEnabled,
+			// auto-compounding did not exist in v0.39
+			AutoCompoundInterval:     v040distribution.DefaultAutoCompoundInterval,
+			MaxAutoCompoundsPerBlock: v040distribution.DefaultMaxAutoCompoundsPerBlock,
+			AutoCompoundGasLimit:     v040distribution.DefaultAutoCompoundGasLimit,
+			// neither did the pruning of the historical rewards
+			HistoricalRetentionPeriods: v040distribution.DefaultHistoricalRetentionPeriods,
+			HistoricalPruningInterval:  v040distribution.DefaultHistoricalPruningInterval,
+			// nor the scheduling of rate changes
+			ScheduledRateChange: v040distribution.DefaultParams().ScheduledRateChange,
+			// or of commission withdrawals
+			MaxCommissionWithdrawalsPerBlock: v040distribution.DefaultMaxCommissionWithdrawalsPerBlock,
 		},
 		FeePool: v040distribution.FeePool{
 			CommunityPool: oldDistributionState.FeePool.CommunityPool,
@@ -104,5 +115,6 @@ func Migrate(oldDistributionState v038distribution.GenesisState) *v040distributi
 		ValidatorCurrentRewards:         newValidatorCurrentRewards,
 		DelegatorStartingInfos:          newDelegatorStartingInfos,
 		ValidatorSlashEvents:            newValidatorSlashEvents,
+		NextFundingStreamId:             v040distribution.DefaultStartingFundingStreamID,
 	}
 }
@@ -71,3 +71,27 @@ func TestProposalHandlerFailed(t *testing.T) {
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
 }
+
+func TestStreamProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
+
+	tp := types.NewCommunityPoolStreamProposal("Test", "description", delAddr1, amount, 10)
+	require.NoError(t, hdlr(ctx, tp))
+
+	stream, found := app.DistrKeeper.GetFundingStream(ctx, types.DefaultStartingFundingStreamID)
+	require.True(t, found)
+	require.Equal(t, delAddr1.String(), stream.Recipient)
+	require.Equal(t, amount, stream.Amount)
+	require.Equal(t, uint64(10), stream.Interval)
+
+	ctp := types.NewCancelCommunityPoolStreamProposal("Test", "description", stream.Id)
+	require.NoError(t, hdlr(ctx, ctp))
+	_, found = app.DistrKeeper.GetFundingStream(ctx, stream.Id)
+	require.False(t, found)
+
+	// the stream is already cancelled
+	require.ErrorIs(t, hdlr(ctx, ctp), types.ErrNoFundingStreamExists)
+}
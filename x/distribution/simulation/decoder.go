@@ -63,6 +63,54 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundPrefix):
+			var autoCompoundA, autoCompoundB types.AutoCompound
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &autoCompoundA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &autoCompoundB)
+			return fmt.Sprintf("%v\n%v", autoCompoundA, autoCompoundB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundQueuePrefix):
+			heightA, delAddrA := types.GetAutoCompoundQueueHeightAddress(kvA.Key)
+			heightB, delAddrB := types.GetAutoCompoundQueueHeightAddress(kvB.Key)
+			return fmt.Sprintf("%d %v\n%d %v", heightA, delAddrA, heightB, delAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegationWithdrawAddrPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.DelegationUnclaimedRewardsPrefix):
+			var rewardsA, rewardsB types.DelegationUnclaimedRewards
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &rewardsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &rewardsB)
+			return fmt.Sprintf("%v\n%v", rewardsA, rewardsB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommissionWithdrawSchedulePrefix):
+			var scheduleA, scheduleB types.CommissionWithdrawSchedule
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &scheduleA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &scheduleB)
+			return fmt.Sprintf("%v\n%v", scheduleA, scheduleB)
+
+		case bytes.Equal(kvA.Key[:1], types.CommissionWithdrawQueuePrefix):
+			heightA, valAddrA := types.GetCommissionWithdrawQueueHeightAddress(kvA.Key)
+			heightB, valAddrB := types.GetCommissionWithdrawQueueHeightAddress(kvB.Key)
+			return fmt.Sprintf("%d %v\n%d %v", heightA, valAddrA, heightB, valAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.FundingStreamPrefix):
+			var streamA, streamB types.FundingStream
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &streamA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &streamB)
+			return fmt.Sprintf("%v\n%v", streamA, streamB)
+
+		case bytes.Equal(kvA.Key[:1], types.FundingStreamQueuePrefix):
+			heightA, idA := types.GetFundingStreamQueueHeightID(kvA.Key)
+			heightB, idB := types.GetFundingStreamQueueHeightID(kvB.Key)
+			return fmt.Sprintf("%d %d\n%d %d", heightA, idA, heightB, idB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextFundingStreamIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.LockedRewardsPrefix):
+			return fmt.Sprintf("%v\n%v", types.GetLockedRewardsAddress(kvA.Key), types.GetLockedRewardsAddress(kvB.Key))
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -34,6 +34,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	autoCompound := types.NewAutoCompound(delAddr1, []string{valAddr1.String()}, 20)
+	unclaimed := types.DelegationUnclaimedRewards{Rewards: decCoins}
+	schedule := types.NewCommissionWithdrawSchedule(valAddr1, 10, 30)
+	stream := types.NewFundingStream(1, delAddr1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), 10, 40)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -46,6 +50,16 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryBare(&slashEvent)},
+			{Key: types.GetAutoCompoundKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&autoCompound)},
+			{Key: types.GetAutoCompoundQueueKey(20, delAddr1), Value: []byte{}},
+			{Key: types.GetDelegationWithdrawAddrKey(delAddr1, valAddr1), Value: delAddr1.Bytes()},
+			{Key: types.GetDelegationUnclaimedRewardsKey(valAddr1, delAddr1), Value: cdc.MustMarshalBinaryBare(&unclaimed)},
+			{Key: types.GetCommissionWithdrawScheduleKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&schedule)},
+			{Key: types.GetCommissionWithdrawQueueKey(30, valAddr1), Value: []byte{}},
+			{Key: types.GetFundingStreamKey(1), Value: cdc.MustMarshalBinaryBare(&stream)},
+			{Key: types.GetFundingStreamQueueKey(40, 1), Value: []byte{}},
+			{Key: types.NextFundingStreamIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetLockedRewardsKey(delAddr1), Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +77,16 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoCompound", fmt.Sprintf("%v\n%v", autoCompound, autoCompound)},
+		{"AutoCompoundQueue", fmt.Sprintf("20 %v\n20 %v", delAddr1, delAddr1)},
+		{"DelegationWithdrawAddr", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"DelegationUnclaimedRewards", fmt.Sprintf("%v\n%v", unclaimed, unclaimed)},
+		{"CommissionWithdrawSchedule", fmt.Sprintf("%v\n%v", schedule, schedule)},
+		{"CommissionWithdrawQueue", fmt.Sprintf("30 %v\n30 %v", valAddr1, valAddr1)},
+		{"FundingStream", fmt.Sprintf("%v\n%v", stream, stream)},
+		{"FundingStreamQueue", "40 1\n40 1"},
+		{"NextFundingStreamID", "2\n2"},
+		{"LockedRewards", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
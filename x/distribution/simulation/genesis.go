@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -18,6 +19,13 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	AutoCompoundInterval             = "auto_compound_interval"
+	MaxAutoCompoundsPerBlock         = "max_auto_compounds_per_block"
+	AutoCompoundGasLimit             = "auto_compound_gas_limit"
+	HistoricalRetentionPeriods       = "historical_retention_periods"
+	HistoricalPruningInterval        = "historical_pruning_interval"
+	MaxCommissionWithdrawalsPerBlock = "max_commission_withdrawals_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +48,43 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundInterval returns a randomized AutoCompoundInterval parameter.
+func GenAutoCompoundInterval(r *rand.Rand) uint64 {
+	if r.Int63n(101) <= 20 { // 20% chance of auto-compounding being disabled
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenMaxAutoCompoundsPerBlock returns a randomized MaxAutoCompoundsPerBlock parameter.
+func GenMaxAutoCompoundsPerBlock(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 200))
+}
+
+// GenMaxCommissionWithdrawalsPerBlock returns a randomized
+// MaxCommissionWithdrawalsPerBlock parameter.
+func GenMaxCommissionWithdrawalsPerBlock(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 200))
+}
+
+// GenAutoCompoundGasLimit returns a randomized AutoCompoundGasLimit parameter.
+func GenAutoCompoundGasLimit(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100_000, 2_000_000))
+}
+
+// GenHistoricalRetentionPeriods returns a randomized HistoricalRetentionPeriods parameter.
+func GenHistoricalRetentionPeriods(r *rand.Rand) uint64 {
+	if r.Int63n(101) <= 50 { // 50% chance of pruning being disabled
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 1, 50))
+}
+
+// GenHistoricalPruningInterval returns a randomized HistoricalPruningInterval parameter.
+func GenHistoricalPruningInterval(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,14 +111,58 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundInterval, &autoCompoundInterval, simState.Rand,
+		func(r *rand.Rand) { autoCompoundInterval = GenAutoCompoundInterval(r) },
+	)
+
+	var maxAutoCompoundsPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoCompoundsPerBlock, &maxAutoCompoundsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxAutoCompoundsPerBlock = GenMaxAutoCompoundsPerBlock(r) },
+	)
+
+	var autoCompoundGasLimit uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundGasLimit, &autoCompoundGasLimit, simState.Rand,
+		func(r *rand.Rand) { autoCompoundGasLimit = GenAutoCompoundGasLimit(r) },
+	)
+
+	var historicalRetentionPeriods uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HistoricalRetentionPeriods, &historicalRetentionPeriods, simState.Rand,
+		func(r *rand.Rand) { historicalRetentionPeriods = GenHistoricalRetentionPeriods(r) },
+	)
+
+	var historicalPruningInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, HistoricalPruningInterval, &historicalPruningInterval, simState.Rand,
+		func(r *rand.Rand) { historicalPruningInterval = GenHistoricalPruningInterval(r) },
+	)
+
+	var maxCommissionWithdrawalsPerBlock uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxCommissionWithdrawalsPerBlock, &maxCommissionWithdrawalsPerBlock, simState.Rand,
+		func(r *rand.Rand) { maxCommissionWithdrawalsPerBlock = GenMaxCommissionWithdrawalsPerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:                     communityTax,
+			BaseProposerReward:               baseProposerReward,
+			BonusProposerReward:              bonusProposerReward,
+			WithdrawAddrEnabled:              withdrawEnabled,
+			AutoCompoundInterval:             autoCompoundInterval,
+			MaxAutoCompoundsPerBlock:         maxAutoCompoundsPerBlock,
+			AutoCompoundGasLimit:             autoCompoundGasLimit,
+			HistoricalRetentionPeriods:       historicalRetentionPeriods,
+			HistoricalPruningInterval:        historicalPruningInterval,
+			ScheduledRateChange:              types.DefaultParams().ScheduledRateChange,
+			MaxCommissionWithdrawalsPerBlock: maxCommissionWithdrawalsPerBlock,
 		},
+		NextFundingStreamId: types.DefaultStartingFundingStreamID,
 	}
 
 	bz, err := json.MarshalIndent(&distrGenesis, "", " ")
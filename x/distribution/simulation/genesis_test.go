@@ -47,6 +47,13 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, uint64(22), distrGenesis.Params.AutoCompoundInterval)
+	require.Equal(t, uint32(104), distrGenesis.Params.MaxAutoCompoundsPerBlock)
+	require.Equal(t, uint64(1024728), distrGenesis.Params.AutoCompoundGasLimit)
+	require.Equal(t, uint64(0), distrGenesis.Params.HistoricalRetentionPeriods)
+	require.Equal(t, uint64(19), distrGenesis.Params.HistoricalPruningInterval)
+	require.Equal(t, types.NewScheduledRateChange(0, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), distrGenesis.Params.ScheduledRateChange)
+	require.Equal(t, uint32(18), distrGenesis.Params.MaxCommissionWithdrawalsPerBlock)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
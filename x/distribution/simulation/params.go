@@ -16,6 +16,7 @@ const (
 	keyCommunityTax        = "communitytax"
 	keyBaseProposerReward  = "baseproposerreward"
 	keyBonusProposerReward = "bonusproposerreward"
+	keyScheduledRateChange = "scheduledratechange"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +38,13 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenBonusProposerReward(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyScheduledRateChange,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf(
+					`{"activation_height":"%d","community_tax":"%s","base_proposer_reward":"%s","bonus_proposer_reward":"%s"}`,
+					simtypes.RandIntBetween(r, 1, 1000), GenCommunityTax(r), GenBaseProposerReward(r), GenBonusProposerReward(r),
+				)
+			},
+		),
 	}
 }
@@ -22,11 +22,12 @@ func TestParamChanges(t *testing.T) {
 		{"distribution/communitytax", "communitytax", "\"0.120000000000000000\"", "distribution"},
 		{"distribution/baseproposerreward", "baseproposerreward", "\"0.280000000000000000\"", "distribution"},
 		{"distribution/bonusproposerreward", "bonusproposerreward", "\"0.180000000000000000\"", "distribution"},
+		{"distribution/scheduledratechange", "scheduledratechange", `{"activation_height":"984","community_tax":"0.020000000000000000","base_proposer_reward":"0.190000000000000000","bonus_proposer_reward":"0.260000000000000000"}`, "distribution"},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 4)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -11,8 +11,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
-const OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+// Simulation proposal weights constants
+const (
+	OpWeightSubmitCommunitySpendProposal        = "op_weight_submit_community_spend_proposal"
+	OpWeightSubmitCommunityStreamProposal       = "op_weight_submit_community_stream_proposal"
+	OpWeightSubmitCancelCommunityStreamProposal = "op_weight_submit_cancel_community_stream_proposal"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
@@ -22,6 +26,16 @@ func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
 			simappparams.DefaultWeightCommunitySpendProposal,
 			SimulateCommunityPoolSpendProposalContent(k),
 		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitCommunityStreamProposal,
+			simappparams.DefaultWeightCommunityStreamProposal,
+			SimulateCommunityPoolStreamProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitCancelCommunityStreamProposal,
+			simappparams.DefaultWeightCancelCommunityStreamProposal,
+			SimulateCancelCommunityPoolStreamProposalContent(k),
+		),
 	}
 }
 
@@ -49,3 +63,49 @@ func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) simtypes.Content
 		)
 	}
 }
+
+// SimulateCommunityPoolStreamProposalContent generates random community-pool-stream proposal content
+func SimulateCommunityPoolStreamProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		balance := k.GetFeePool(ctx).CommunityPool
+		if balance.Empty() {
+			return nil
+		}
+
+		denomIndex := r.Intn(len(balance))
+		amount, err := simtypes.RandPositiveInt(r, balance[denomIndex].Amount.TruncateInt())
+		if err != nil {
+			return nil
+		}
+
+		return types.NewCommunityPoolStreamProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			simAccount.Address,
+			sdk.NewCoins(sdk.NewCoin(balance[denomIndex].Denom, amount)),
+			uint64(simtypes.RandIntBetween(r, 1, 100)),
+		)
+	}
+}
+
+// SimulateCancelCommunityPoolStreamProposalContent generates random cancel-community-pool-stream proposal content
+func SimulateCancelCommunityPoolStreamProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		var ids []uint64
+		k.IterateFundingStreams(ctx, func(stream types.FundingStream) (stop bool) {
+			ids = append(ids, stream.Id)
+			return false
+		})
+		if len(ids) == 0 {
+			return nil
+		}
+
+		return types.NewCancelCommunityPoolStreamProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			ids[r.Intn(len(ids))],
+		)
+	}
+}
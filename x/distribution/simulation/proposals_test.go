@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestProposalContents(t *testing.T) {
@@ -26,9 +27,11 @@ func TestProposalContents(t *testing.T) {
 
 	// execute ProposalContents function
 	weightedProposalContent := simulation.ProposalContents(app.DistrKeeper)
-	require.Len(t, weightedProposalContent, 1)
+	require.Len(t, weightedProposalContent, 3)
 
 	w0 := weightedProposalContent[0]
+	w1 := weightedProposalContent[1]
+	w2 := weightedProposalContent[2]
 
 	// tests w0 interface:
 	require.Equal(t, simulation.OpWeightSubmitCommunitySpendProposal, w0.AppParamsKey())
@@ -46,4 +49,28 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, "xKGLwQvuyN", content.GetTitle())
 	require.Equal(t, "distribution", content.ProposalRoute())
 	require.Equal(t, "CommunityPoolSpend", content.ProposalType())
+
+	// tests w1 interface:
+	require.Equal(t, simulation.OpWeightSubmitCommunityStreamProposal, w1.AppParamsKey())
+	require.Equal(t, simappparams.DefaultWeightCommunityStreamProposal, w1.DefaultWeight())
+
+	content = w1.ContentSimulatorFn()(r, ctx, accounts)
+	require.Equal(t, "distribution", content.ProposalRoute())
+	require.Equal(t, "CommunityPoolStream", content.ProposalType())
+	require.NoError(t, content.ValidateBasic())
+
+	// tests w2 interface:
+	require.Equal(t, simulation.OpWeightSubmitCancelCommunityStreamProposal, w2.AppParamsKey())
+	require.Equal(t, simappparams.DefaultWeightCancelCommunityStreamProposal, w2.DefaultWeight())
+
+	// there is no funding stream to cancel yet
+	require.Nil(t, w2.ContentSimulatorFn()(r, ctx, accounts))
+
+	id, err := app.DistrKeeper.CreateFundingStream(ctx, accounts[0].Address, amount, 10)
+	require.NoError(t, err)
+
+	content = w2.ContentSimulatorFn()(r, ctx, accounts)
+	require.Equal(t, "distribution", content.ProposalRoute())
+	require.Equal(t, "CancelCommunityPoolStream", content.ProposalType())
+	require.Equal(t, id, content.(*types.CancelCommunityPoolStreamProposal).StreamId)
 }
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAutoCompound creates a new AutoCompound instance
+func NewAutoCompound(delAddr sdk.AccAddress, validators []string, nextHeight int64) AutoCompound {
+	return AutoCompound{
+		DelegatorAddress:   delAddr.String(),
+		ValidatorAddresses: validators,
+		NextHeight:         nextHeight,
+	}
+}
+
+// Validate performs a stateless validation of an AutoCompound.
+func (ac AutoCompound) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(ac.DelegatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address %s: %s", ac.DelegatorAddress, err)
+	}
+	if len(ac.ValidatorAddresses) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidAutoCompound, "no validator for delegator %s", ac.DelegatorAddress)
+	}
+	if ac.NextHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidAutoCompound, "next height must be positive: %d", ac.NextHeight)
+	}
+
+	return validateAutoCompoundValidators(ac.ValidatorAddresses)
+}
@@ -16,7 +16,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValidatorCommission", nil)
 	cdc.RegisterConcrete(&MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress", nil)
 	cdc.RegisterConcrete(&MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound", nil)
+	cdc.RegisterConcrete(&MsgSetDelegationWithdrawAddress{}, "cosmos-sdk/MsgSetDelegationWithdrawAddress", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDelegatorRewardPartial{}, "cosmos-sdk/MsgWithdrawDelegationRewardPartial", nil)
+	cdc.RegisterConcrete(&MsgSetCommissionWithdrawSchedule{}, "cosmos-sdk/MsgSetCommissionWithdrawSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetLockedRewards{}, "cosmos-sdk/MsgSetLockedRewards", nil)
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal", nil)
+	cdc.RegisterConcrete(&CancelCommunityPoolStreamProposal{}, "cosmos-sdk/CancelCommunityPoolStreamProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -26,10 +33,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawValidatorCommission{},
 		&MsgSetWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgSetAutoCompound{},
+		&MsgSetDelegationWithdrawAddress{},
+		&MsgWithdrawDelegatorRewardPartial{},
+		&MsgSetCommissionWithdrawSchedule{},
+		&MsgSetLockedRewards{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CommunityPoolSpendProposal{},
+		&CommunityPoolStreamProposal{},
+		&CancelCommunityPoolStreamProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewCommissionWithdrawSchedule creates a new CommissionWithdrawSchedule instance
+func NewCommissionWithdrawSchedule(valAddr sdk.ValAddress, interval uint64, nextHeight int64) CommissionWithdrawSchedule {
+	return CommissionWithdrawSchedule{
+		ValidatorAddress: valAddr.String(),
+		Interval:         interval,
+		NextHeight:       nextHeight,
+	}
+}
+
+// Validate performs a stateless validation of a CommissionWithdrawSchedule.
+func (s CommissionWithdrawSchedule) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(s.ValidatorAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %s", s.ValidatorAddress, err)
+	}
+	if s.Interval == 0 {
+		return sdkerrors.Wrapf(ErrInvalidCommissionWithdrawSchedule, "interval must be positive for validator %s", s.ValidatorAddress)
+	}
+	if s.NextHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidCommissionWithdrawSchedule, "next height must be positive: %d", s.NextHeight)
+	}
+
+	return nil
+}
@@ -2,15 +2,41 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress            = "set_withdraw_address"
+	EventTypeRewards                       = "rewards"
+	EventTypeCommission                    = "commission"
+	EventTypeWithdrawRewards               = "withdraw_rewards"
+	EventTypeWithdrawCommission            = "withdraw_commission"
+	EventTypeProposerReward                = "proposer_reward"
+	EventTypeSetAutoCompound               = "set_auto_compound"
+	EventTypeAutoCompound                  = "auto_compound"
+	EventTypeAutoCompoundFailed            = "auto_compound_failed"
+	EventTypeSetCommissionWithdrawSchedule = "set_commission_withdraw_schedule"
+	EventTypeWithdrawCommissionFailed      = "withdraw_commission_failed"
+	EventTypeCreateFundingStream           = "create_funding_stream"
+	EventTypeCancelFundingStream           = "cancel_funding_stream"
+	EventTypeFundingStreamPayment          = "funding_stream_payment"
+	EventTypeFundingStreamPaymentFailed    = "funding_stream_payment_failed"
+	EventTypeRateChange                    = "rate_change"
+	EventTypeSetLockedRewards              = "set_locked_rewards"
+	EventTypeLockRewards                   = "lock_rewards"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyNextHeight      = "next_height"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyError           = "error"
+	AttributeKeyInterval        = "interval"
+	AttributeKeyDenomAmount     = "denom_amount"
+	AttributeKeyStartingPeriod  = "starting_period"
+	AttributeKeyEndingPeriod    = "ending_period"
+	AttributeKeyStreamID        = "stream_id"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyCommunityTax    = "community_tax"
+	AttributeKeyBaseProposer    = "base_proposer_reward"
+	AttributeKeyBonusProposer   = "bonus_proposer_reward"
+	AttributeKeyEnabled         = "enabled"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewFundingStream creates a new FundingStream instance
+//nolint:interfacer
+func NewFundingStream(id uint64, recipient sdk.AccAddress, amount sdk.Coins, interval uint64, nextHeight int64) FundingStream {
+	return FundingStream{
+		Id:         id,
+		Recipient:  recipient.String(),
+		Amount:     amount,
+		Interval:   interval,
+		NextHeight: nextHeight,
+	}
+}
+
+// Validate performs a stateless validation of a FundingStream.
+func (s FundingStream) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s: %s", s.Recipient, err)
+	}
+	if !s.Amount.IsValid() || s.Amount.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidFundingStream, "invalid amount %s of stream %d", s.Amount, s.Id)
+	}
+	if s.Interval == 0 {
+		return sdkerrors.Wrapf(ErrInvalidFundingStream, "interval must be positive for stream %d", s.Id)
+	}
+	if s.NextHeight <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidFundingStream, "next height must be positive: %d", s.NextHeight)
+	}
+
+	return nil
+}
@@ -2,8 +2,12 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// DefaultStartingFundingStreamID is the id of the first funding stream created
+const DefaultStartingFundingStreamID uint64 = 1
+
 //nolint:interfacer
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
@@ -38,6 +42,13 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompounds:                   []AutoCompound{},
+		DelegationWithdrawInfos:         []DelegationWithdrawInfo{},
+		DelegationUnclaimedRewards:      []DelegationUnclaimedRewardsRecord{},
+		CommissionWithdrawSchedules:     []CommissionWithdrawSchedule{},
+		FundingStreams:                  []FundingStream{},
+		NextFundingStreamId:             DefaultStartingFundingStreamID,
+		LockedRewardsAccounts:           []string{},
 	}
 }
 
@@ -46,5 +57,53 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+
+	delegators := make(map[string]bool, len(gs.AutoCompounds))
+	for _, autoCompound := range gs.AutoCompounds {
+		if err := autoCompound.Validate(); err != nil {
+			return err
+		}
+		if delegators[autoCompound.DelegatorAddress] {
+			return sdkerrors.Wrapf(ErrInvalidAutoCompound, "duplicate delegator %s", autoCompound.DelegatorAddress)
+		}
+		delegators[autoCompound.DelegatorAddress] = true
+	}
+
+	validators := make(map[string]bool, len(gs.CommissionWithdrawSchedules))
+	for _, schedule := range gs.CommissionWithdrawSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+		if validators[schedule.ValidatorAddress] {
+			return sdkerrors.Wrapf(ErrInvalidCommissionWithdrawSchedule, "duplicate validator %s", schedule.ValidatorAddress)
+		}
+		validators[schedule.ValidatorAddress] = true
+	}
+
+	streams := make(map[uint64]bool, len(gs.FundingStreams))
+	for _, stream := range gs.FundingStreams {
+		if err := stream.Validate(); err != nil {
+			return err
+		}
+		if streams[stream.Id] {
+			return sdkerrors.Wrapf(ErrInvalidFundingStream, "duplicate stream %d", stream.Id)
+		}
+		if stream.Id >= gs.NextFundingStreamId {
+			return sdkerrors.Wrapf(ErrInvalidFundingStream, "stream %d not below the next stream id %d", stream.Id, gs.NextFundingStreamId)
+		}
+		streams[stream.Id] = true
+	}
+
+	accounts := make(map[string]bool, len(gs.LockedRewardsAccounts))
+	for _, account := range gs.LockedRewardsAccounts {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return err
+		}
+		if accounts[account] {
+			return sdkerrors.Wrapf(ErrRewardsNotLockable, "duplicate account %s", account)
+		}
+		accounts[account] = true
+	}
+
 	return gs.FeePool.ValidateGenesis()
 }
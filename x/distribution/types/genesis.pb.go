@@ -68,6 +68,51 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// DelegationWithdrawInfo is the address where the rewards of a delegation to a
+// validator are withdrawn to, instead of the delegator withdraw address. This
+// struct is only used at genesis.
+type DelegationWithdrawInfo struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// withdraw_address is the address to withdraw the delegation rewards to.
+	WithdrawAddress string `protobuf:"bytes,3,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty" yaml:"withdraw_address"`
+}
+
+func (m *DelegationWithdrawInfo) Reset()         { *m = DelegationWithdrawInfo{} }
+func (m *DelegationWithdrawInfo) String() string { return proto.CompactTextString(m) }
+func (*DelegationWithdrawInfo) ProtoMessage()    {}
+func (*DelegationWithdrawInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *DelegationWithdrawInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationWithdrawInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationWithdrawInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationWithdrawInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationWithdrawInfo.Merge(m, src)
+}
+func (m *DelegationWithdrawInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationWithdrawInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationWithdrawInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationWithdrawInfo proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -80,7 +125,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{2}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +167,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,7 +211,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +252,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -250,7 +295,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -279,6 +324,49 @@ func (m *DelegatorStartingInfoRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorStartingInfoRecord proto.InternalMessageInfo
 
+// DelegationUnclaimedRewardsRecord is used for import / export via genesis json.
+type DelegationUnclaimedRewardsRecord struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// unclaimed_rewards defines the unclaimed rewards of the delegation.
+	UnclaimedRewards DelegationUnclaimedRewards `protobuf:"bytes,3,opt,name=unclaimed_rewards,json=unclaimedRewards,proto3" json:"unclaimed_rewards" yaml:"unclaimed_rewards"`
+}
+
+func (m *DelegationUnclaimedRewardsRecord) Reset()         { *m = DelegationUnclaimedRewardsRecord{} }
+func (m *DelegationUnclaimedRewardsRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegationUnclaimedRewardsRecord) ProtoMessage()    {}
+func (*DelegationUnclaimedRewardsRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *DelegationUnclaimedRewardsRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationUnclaimedRewardsRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationUnclaimedRewardsRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationUnclaimedRewardsRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationUnclaimedRewardsRecord.Merge(m, src)
+}
+func (m *DelegationUnclaimedRewardsRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationUnclaimedRewardsRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationUnclaimedRewardsRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationUnclaimedRewardsRecord proto.InternalMessageInfo
+
 // ValidatorSlashEventRecord is used for import / export via genesis json.
 type ValidatorSlashEventRecord struct {
 	// validator_address is the address of the validator.
@@ -295,7 +383,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,13 +434,31 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// auto_compounds defines the auto-compounding delegators at genesis.
+	AutoCompounds []AutoCompound `protobuf:"bytes,11,rep,name=auto_compounds,json=autoCompounds,proto3" json:"auto_compounds" yaml:"auto_compounds"`
+	// delegation_withdraw_infos defines the delegation withdraw infos at genesis.
+	DelegationWithdrawInfos []DelegationWithdrawInfo `protobuf:"bytes,12,rep,name=delegation_withdraw_infos,json=delegationWithdrawInfos,proto3" json:"delegation_withdraw_infos" yaml:"delegation_withdraw_infos"`
+	// delegation_unclaimed_rewards defines the unclaimed rewards of the
+	// delegations at genesis.
+	DelegationUnclaimedRewards []DelegationUnclaimedRewardsRecord `protobuf:"bytes,13,rep,name=delegation_unclaimed_rewards,json=delegationUnclaimedRewards,proto3" json:"delegation_unclaimed_rewards" yaml:"delegation_unclaimed_rewards"`
+	// commission_withdraw_schedules defines the commission withdrawal schedules
+	// of the validators at genesis.
+	CommissionWithdrawSchedules []CommissionWithdrawSchedule `protobuf:"bytes,14,rep,name=commission_withdraw_schedules,json=commissionWithdrawSchedules,proto3" json:"commission_withdraw_schedules" yaml:"commission_withdraw_schedules"`
+	// funding_streams defines the funding streams from the community pool at
+	// genesis.
+	FundingStreams []FundingStream `protobuf:"bytes,15,rep,name=funding_streams,json=fundingStreams,proto3" json:"funding_streams" yaml:"funding_streams"`
+	// next_funding_stream_id defines the id of the next funding stream created.
+	NextFundingStreamId uint64 `protobuf:"varint,16,opt,name=next_funding_stream_id,json=nextFundingStreamId,proto3" json:"next_funding_stream_id,omitempty" yaml:"next_funding_stream_id"`
+	// locked_rewards_accounts defines the vesting accounts locking the rewards
+	// withdrawn to them at genesis.
+	LockedRewardsAccounts []string `protobuf:"bytes,17,rep,name=locked_rewards_accounts,json=lockedRewardsAccounts,proto3" json:"locked_rewards_accounts,omitempty" yaml:"locked_rewards_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{9}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,11 +489,13 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*DelegationWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegationWithdrawInfo")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
+	proto.RegisterType((*DelegationUnclaimedRewardsRecord)(nil), "cosmos.distribution.v1beta1.DelegationUnclaimedRewardsRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
@@ -397,71 +505,91 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x45,
-	0x04, 0x55, 0xac, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
-	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0xc2, 0x3f, 0x00, 0x47,
-	0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a, 0x28,
-	0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x33, 0xb3, 0x5f, 0xf6, 0xda, 0x38, 0xa1, 0x39, 0x25,
-	0x1e, 0xff, 0xf6, 0xbd, 0xf7, 0x7b, 0xf3, 0xfb, 0x58, 0x83, 0xd7, 0x3d, 0xca, 0x3b, 0x94, 0x57,
-	0x7d, 0xc2, 0x05, 0x23, 0xf5, 0x9e, 0x20, 0x34, 0xa8, 0xf6, 0x37, 0xeb, 0x58, 0xb8, 0x9b, 0xd5,
-	0x26, 0x0e, 0x30, 0x27, 0xdc, 0xee, 0x32, 0x2a, 0xa8, 0xb9, 0xaa, 0x42, 0xed, 0x74, 0xa8, 0xad,
-	0x43, 0xcb, 0x8b, 0x4d, 0xda, 0xa4, 0x32, 0xae, 0x1a, 0xfe, 0xa7, 0x1e, 0x29, 0x57, 0x34, 0x7a,
-	0xdd, 0xe5, 0x38, 0x46, 0xf5, 0x28, 0x09, 0xf4, 0xf7, 0xf6, 0x38, 0xf6, 0x0c, 0x8f, 0x8c, 0x87,
-	0x4f, 0x0d, 0xb0, 0xf4, 0x00, 0xb7, 0x71, 0xd3, 0x15, 0x94, 0x7d, 0x46, 0x44, 0xcb, 0x67, 0xee,
-	0xc1, 0xa3, 0xa0, 0x41, 0xcd, 0x47, 0xe0, 0x15, 0x3f, 0xfa, 0xa2, 0xe6, 0xfa, 0x3e, 0xc3, 0x9c,
-	0x97, 0x8c, 0x75, 0x63, 0xe3, 0xaa, 0xb3, 0x76, 0x76, 0x6c, 0x95, 0x0e, 0xdd, 0x4e, 0x7b, 0x1b,
-	0x0e, 0x85, 0x40, 0xb4, 0x10, 0x9f, 0xed, 0xa8, 0x23, 0xf3, 0x21, 0x58, 0x38, 0xd0, 0xd0, 0x31,
-	0x52, 0x51, 0x22, 0xad, 0x9e, 0x1d, 0x5b, 0x2b, 0x0a, 0x69, 0x30, 0x02, 0xa2, 0xeb, 0xd1, 0x91,
-	0xc6, 0xd9, 0x9e, 0xfd, 0xf6, 0xc8, 0x2a, 0xfc, 0x75, 0x64, 0x15, 0xe0, 0x93, 0x22, 0xb8, 0xfd,
-	0xa9, 0xdb, 0x26, 0x7e, 0x48, 0xf3, 0xb8, 0x27, 0xb8, 0x70, 0x03, 0x9f, 0x04, 0x4d, 0x84, 0x0f,
-	0x5c, 0xe6, 0x73, 0x84, 0x3d, 0xca, 0xfc, 0x30, 0x85, 0x7e, 0x14, 0x34, 0x3a, 0x85, 0xa1, 0x10,
-	0x88, 0x16, 0xe2, 0xb3, 0x28, 0x85, 0x23, 0x03, 0xdc, 0xa0, 0x09, 0x4f, 0x8d, 0x29, 0xa2, 0x52,
-	0x71, 0x7d, 0x6a, 0x63, 0x6e, 0x6b, 0x4d, 0xdb, 0x6e, 0x87, 0xd7, 0x12, 0xdd, 0xa0, 0xfd, 0x00,
-	0x7b, 0xbb, 0x94, 0x04, 0xce, 0x27, 0xcf, 0x8e, 0xad, 0xc2, 0xd9, 0xb1, 0x55, 0x56, 0x7c, 0x39,
-	0x30, 0xf0, 0xe7, 0x3f, 0xac, 0x7b, 0x4d, 0x22, 0x5a, 0xbd, 0xba, 0xed, 0xd1, 0x4e, 0x55, 0x5f,
-	0xa2, 0xfa, 0xf3, 0x06, 0xf7, 0xbf, 0xac, 0x8a, 0xc3, 0x2e, 0xe6, 0x11, 0x22, 0x47, 0x26, 0x1d,
-	0xca, 0x39, 0xe5, 0xce, 0xdf, 0x06, 0xb8, 0x1b, 0xbb, 0xb3, 0xe3, 0x79, 0xbd, 0x4e, 0xaf, 0xed,
-	0x0a, 0xec, 0xef, 0xd2, 0x4e, 0x87, 0x70, 0x4e, 0x68, 0xf0, 0xe2, 0x0d, 0x3a, 0x04, 0x73, 0x6e,
-	0xc2, 0x24, 0xaf, 0x77, 0x6e, 0xeb, 0x3d, 0x7b, 0x4c, 0x85, 0xdb, 0xe3, 0x25, 0x3a, 0x65, 0x6d,
-	0x9b, 0xa9, 0x54, 0xa4, 0xd0, 0x21, 0x4a, 0x73, 0xa5, 0x12, 0xff, 0xc7, 0x00, 0xeb, 0x31, 0xea,
-	0xc7, 0x84, 0x0b, 0xca, 0x88, 0xe7, 0xb6, 0x2f, 0xad, 0x2a, 0x96, 0xc1, 0x74, 0x17, 0x33, 0x42,
-	0x55, 0xbe, 0x57, 0x90, 0xfe, 0x64, 0x12, 0x30, 0x13, 0x15, 0xc8, 0x94, 0x34, 0xe2, 0x9d, 0xc9,
-	0x8c, 0x18, 0x92, 0xec, 0x2c, 0x6b, 0x13, 0x5e, 0x56, 0xaa, 0xa2, 0x7a, 0x41, 0x11, 0x7e, 0x2a,
-	0xf9, 0xdf, 0x0d, 0x70, 0x2b, 0x46, 0xda, 0xed, 0x31, 0x86, 0x03, 0x71, 0x69, 0x99, 0x37, 0x92,
-	0x0c, 0xd5, 0x55, 0xbf, 0x35, 0x59, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x69, 0x11, 0xac, 0xc6,
-	0x93, 0x6a, 0x5f, 0xb8, 0x4c, 0x90, 0xa0, 0x19, 0x4e, 0xaa, 0x24, 0xb9, 0x17, 0x35, 0xaf, 0x72,
-	0x7d, 0x2a, 0x5e, 0xc8, 0xa7, 0x1e, 0xb8, 0xc6, 0xb5, 0xd6, 0x1a, 0x09, 0x1a, 0x54, 0xd7, 0xc3,
-	0xd6, 0x58, 0xb7, 0x72, 0xd3, 0x74, 0xd6, 0xb4, 0x57, 0x8b, 0x8a, 0x3e, 0x03, 0x0b, 0xd1, 0x3c,
-	0x4f, 0xc5, 0xa6, 0x6c, 0xfb, 0xb1, 0x08, 0x6e, 0xc6, 0xee, 0xef, 0xb7, 0x5d, 0xde, 0xfa, 0xb0,
-	0x2f, 0x2f, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0x25, 0xa2, 0x5e, 0x50, 0x9f, 0x52, 0x3d,
-	0x32, 0x95, 0xe9, 0x91, 0xaf, 0xc1, 0x52, 0x82, 0xcb, 0x43, 0x61, 0x35, 0x1c, 0x2a, 0x2b, 0x5d,
-	0x91, 0x0e, 0xdd, 0x9f, 0xac, 0x9e, 0x92, 0x8c, 0x9c, 0x45, 0xed, 0xcf, 0xbc, 0x12, 0x2d, 0xc1,
-	0x20, 0xba, 0xd1, 0x1f, 0x0e, 0x4d, 0xd9, 0xf3, 0xcd, 0x1c, 0x98, 0xff, 0x48, 0x2d, 0xe5, 0x7d,
-	0xe1, 0x0a, 0x6c, 0x22, 0x30, 0xdd, 0x75, 0x99, 0xdb, 0x51, 0x36, 0xcc, 0x6d, 0xdd, 0x19, 0xab,
-	0x63, 0x4f, 0x86, 0x3a, 0x4b, 0x9a, 0xfa, 0x9a, 0xa2, 0x56, 0x00, 0x10, 0x69, 0x24, 0xf3, 0x73,
-	0x30, 0xdb, 0xc0, 0xb8, 0xd6, 0xa5, 0xb4, 0xad, 0xbb, 0xe5, 0xee, 0x58, 0xd4, 0x87, 0x18, 0xef,
-	0x51, 0xda, 0x76, 0x56, 0x34, 0xec, 0x75, 0x05, 0x1b, 0x61, 0x40, 0x34, 0xd3, 0x50, 0x11, 0xe6,
-	0x0f, 0x06, 0x28, 0x25, 0x25, 0x1d, 0xaf, 0xd0, 0xb0, 0x24, 0xc2, 0xd1, 0x33, 0x35, 0x79, 0xa9,
-	0xa5, 0x77, 0xbf, 0xf3, 0x9a, 0x26, 0xb6, 0x06, 0x9b, 0x26, 0xcb, 0x00, 0xd1, 0xb2, 0x9f, 0xf7,
-	0xbc, 0xec, 0xa0, 0x2e, 0xc3, 0x7d, 0x42, 0x7b, 0xbc, 0xd6, 0x65, 0xb4, 0x4b, 0x39, 0x66, 0xf2,
-	0x62, 0x33, 0x75, 0x35, 0x14, 0x02, 0xd1, 0x42, 0x74, 0xb6, 0xa7, 0x8f, 0xcc, 0xef, 0x47, 0x6c,
-	0xde, 0x97, 0x64, 0x76, 0x1f, 0x4c, 0x56, 0x26, 0xa3, 0x5e, 0x11, 0x1c, 0xf8, 0xdf, 0xbb, 0x39,
-	0x6f, 0xd9, 0x9a, 0xbf, 0x1a, 0xe0, 0x76, 0xaa, 0x2d, 0x92, 0x6d, 0x54, 0xf3, 0xe2, 0x0d, 0xc6,
-	0x4b, 0xd3, 0x52, 0xe3, 0xce, 0xff, 0xd8, 0x82, 0x5a, 0xe6, 0x7d, 0x2d, 0x73, 0x63, 0xa8, 0x21,
-	0xf3, 0x99, 0x21, 0xb2, 0xfa, 0x63, 0x71, 0xb9, 0xf9, 0x8b, 0x01, 0xd6, 0x12, 0x9c, 0x56, 0xbc,
-	0x79, 0x62, 0x83, 0x67, 0xa4, 0xf8, 0xf7, 0x2f, 0xb8, 0xb9, 0xb4, 0xf0, 0x7b, 0x5a, 0xf8, 0x9d,
-	0x41, 0xe1, 0xc3, 0x84, 0x10, 0x95, 0xfb, 0x23, 0xe1, 0xc2, 0x17, 0xb0, 0x9b, 0xc9, 0xd3, 0x9e,
-	0x5a, 0x23, 0xb1, 0xd6, 0x59, 0xa9, 0x75, 0xfb, 0x22, 0x3b, 0x48, 0x0b, 0xdd, 0xd0, 0x42, 0xd7,
-	0x07, 0x85, 0x0e, 0x50, 0x41, 0xb4, 0xd2, 0xcf, 0x07, 0x32, 0x9f, 0x64, 0x9a, 0x31, 0x33, 0x9f,
-	0x79, 0xe9, 0xaa, 0x54, 0xf8, 0xee, 0xf9, 0xe7, 0xbe, 0xd6, 0x37, 0xb2, 0x25, 0xb3, 0x3c, 0xe9,
-	0x96, 0x4c, 0xa3, 0xf0, 0xb0, 0x8f, 0x96, 0x73, 0x07, 0x2e, 0x2f, 0x01, 0xa9, 0xed, 0xed, 0xf3,
-	0x4e, 0x5c, 0xad, 0xec, 0x55, 0xad, 0xec, 0xd6, 0xa0, 0x73, 0x69, 0x0e, 0x88, 0x16, 0x73, 0x06,
-	0x71, 0x6a, 0xbf, 0x3b, 0x8f, 0x7f, 0x3a, 0xa9, 0x18, 0xcf, 0x4e, 0x2a, 0xc6, 0xf3, 0x93, 0x8a,
-	0xf1, 0xe7, 0x49, 0xc5, 0xf8, 0xee, 0xb4, 0x52, 0x78, 0x7e, 0x5a, 0x29, 0xfc, 0x76, 0x5a, 0x29,
-	0x7c, 0xb1, 0x39, 0xf6, 0xed, 0xf8, 0xab, 0xec, 0xef, 0x1d, 0xf9, 0xb2, 0x5c, 0x9f, 0x96, 0xbf,
-	0x70, 0xde, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xee, 0xfe, 0x4a, 0x91, 0x0d, 0x00, 0x00,
+	// 1333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0x45,
+	0x1b, 0xf6, 0xda, 0xf9, 0xf2, 0x33, 0xf9, 0x73, 0x36, 0x89, 0xb3, 0x71, 0x12, 0xdb, 0x99, 0xe6,
+	0x13, 0x29, 0x05, 0xbb, 0x49, 0x11, 0xa0, 0x20, 0x90, 0xb2, 0x29, 0x85, 0x9c, 0x1a, 0x26, 0xa2,
+	0xa0, 0x5e, 0xac, 0xcd, 0xee, 0xd8, 0x5e, 0xd5, 0xde, 0xb1, 0x76, 0x76, 0x9d, 0x86, 0x13, 0x27,
+	0xc4, 0x11, 0x09, 0x21, 0x81, 0xca, 0x21, 0x47, 0x40, 0x1c, 0x7b, 0xe7, 0x5a, 0x89, 0x4b, 0x8f,
+	0x1c, 0x50, 0x40, 0xc9, 0x85, 0x73, 0x0e, 0x1c, 0x38, 0xa1, 0xdd, 0x99, 0xfd, 0xb5, 0xbd, 0x71,
+	0x52, 0x22, 0xf5, 0xd4, 0x7a, 0xf6, 0x9d, 0xe7, 0x79, 0xde, 0x77, 0xdf, 0xbf, 0x2c, 0xb8, 0xa9,
+	0x12, 0xda, 0x22, 0xb4, 0xa2, 0xe9, 0xd4, 0x32, 0xf5, 0x03, 0xdb, 0xd2, 0x89, 0x51, 0xe9, 0x6c,
+	0x1c, 0x60, 0x4b, 0xd9, 0xa8, 0xd4, 0xb1, 0x81, 0xa9, 0x4e, 0xcb, 0x6d, 0x93, 0x58, 0x44, 0x5c,
+	0x62, 0xa6, 0xe5, 0xb0, 0x69, 0x99, 0x9b, 0xe6, 0xe7, 0xea, 0xa4, 0x4e, 0x5c, 0xbb, 0x8a, 0xf3,
+	0x3f, 0x76, 0x25, 0x5f, 0xe0, 0xe8, 0x07, 0x0a, 0xc5, 0x3e, 0xaa, 0x4a, 0x74, 0x83, 0x3f, 0x2f,
+	0x27, 0xb1, 0x47, 0x78, 0x5c, 0x7b, 0xf8, 0x54, 0x00, 0xf3, 0x77, 0x71, 0x13, 0xd7, 0x15, 0x8b,
+	0x98, 0x9f, 0xe8, 0x56, 0x43, 0x33, 0x95, 0xc3, 0x5d, 0xa3, 0x46, 0xc4, 0x5d, 0x30, 0xa3, 0x79,
+	0x0f, 0xaa, 0x8a, 0xa6, 0x99, 0x98, 0x52, 0x49, 0x28, 0x09, 0xeb, 0x63, 0xf2, 0xf2, 0xf9, 0x49,
+	0x51, 0x3a, 0x52, 0x5a, 0xcd, 0x2d, 0xd8, 0x65, 0x02, 0x51, 0xd6, 0x3f, 0xdb, 0x66, 0x47, 0xe2,
+	0x3d, 0x90, 0x3d, 0xe4, 0xd0, 0x3e, 0x52, 0xda, 0x45, 0x5a, 0x3a, 0x3f, 0x29, 0x2e, 0x30, 0xa4,
+	0xb8, 0x05, 0x44, 0xd3, 0xde, 0x11, 0xc7, 0xd9, 0x1a, 0xfd, 0xf2, 0xb8, 0x98, 0xfa, 0xeb, 0xb8,
+	0x98, 0x82, 0x9f, 0xa7, 0x41, 0x8e, 0xcb, 0xd6, 0x89, 0x71, 0x5d, 0xba, 0x77, 0xc1, 0x4c, 0x47,
+	0x69, 0xea, 0x5a, 0x04, 0x2a, 0x1d, 0x87, 0xea, 0x32, 0x81, 0x28, 0xeb, 0x9f, 0x25, 0x85, 0x20,
+	0xf3, 0x42, 0x21, 0x78, 0x92, 0x06, 0xab, 0x0f, 0x3c, 0x9a, 0xfb, 0xb6, 0x45, 0x2d, 0xc5, 0xd0,
+	0x74, 0xa3, 0x8e, 0xf0, 0xa1, 0x62, 0x6a, 0x14, 0x61, 0x95, 0x98, 0x5a, 0x6f, 0x17, 0x84, 0x2b,
+	0xb9, 0x70, 0x2c, 0x80, 0x59, 0x12, 0xf0, 0x54, 0x4d, 0x46, 0x24, 0xa5, 0x4b, 0x99, 0xf5, 0xf1,
+	0xcd, 0x65, 0x9e, 0x79, 0x65, 0x27, 0x33, 0xbd, 0x24, 0x2e, 0xdf, 0xc5, 0xea, 0x0e, 0xd1, 0x0d,
+	0xf9, 0xa3, 0x67, 0x27, 0xc5, 0xd4, 0xf9, 0x49, 0x31, 0xcf, 0xf8, 0x7a, 0xc0, 0xc0, 0x9f, 0xfe,
+	0x28, 0xde, 0xaa, 0xeb, 0x56, 0xc3, 0x3e, 0x28, 0xab, 0xa4, 0x55, 0xe1, 0x79, 0xcc, 0xfe, 0x79,
+	0x9d, 0x6a, 0x8f, 0x2a, 0xd6, 0x51, 0x1b, 0x53, 0x0f, 0x91, 0x22, 0x91, 0x74, 0xf9, 0x1c, 0x8a,
+	0xce, 0xdf, 0x02, 0x58, 0xf3, 0xa3, 0xb3, 0xad, 0xaa, 0x76, 0xcb, 0x6e, 0x2a, 0x16, 0xd6, 0x76,
+	0x48, 0xab, 0xa5, 0x53, 0xaa, 0x13, 0xe3, 0xbf, 0x0f, 0xd0, 0x11, 0x18, 0x57, 0x02, 0x26, 0x37,
+	0x51, 0xc6, 0x37, 0xdf, 0x29, 0x27, 0x14, 0x79, 0x39, 0x59, 0xa2, 0x9c, 0xe7, 0x61, 0x13, 0x99,
+	0x8a, 0x10, 0x3a, 0x44, 0x61, 0xae, 0x90, 0xe3, 0xff, 0x08, 0xa0, 0xe4, 0xa3, 0x7e, 0xa8, 0x53,
+	0x8b, 0x98, 0xba, 0xaa, 0x34, 0xaf, 0x2d, 0x2b, 0x72, 0x60, 0xb8, 0x8d, 0x4d, 0x9d, 0x30, 0x7f,
+	0x87, 0x10, 0xff, 0x25, 0xea, 0x60, 0xc4, 0x4b, 0x90, 0x8c, 0x1b, 0x88, 0xb7, 0x06, 0x0b, 0x44,
+	0x97, 0x64, 0x39, 0xc7, 0x83, 0x30, 0xc5, 0x54, 0x79, 0xf9, 0x82, 0x3c, 0xfc, 0x90, 0xf3, 0xbf,
+	0x0b, 0x60, 0xc5, 0x47, 0xda, 0xb1, 0x4d, 0x13, 0x1b, 0xd6, 0xb5, 0x79, 0x5e, 0x0b, 0x3c, 0x64,
+	0xaf, 0xfa, 0x8d, 0xc1, 0x3c, 0x8c, 0xea, 0xba, 0x8c, 0x7b, 0x4f, 0xd3, 0x60, 0xc9, 0x6f, 0xd6,
+	0xfb, 0x96, 0x62, 0x5a, 0xba, 0x51, 0x77, 0x9a, 0x5e, 0xe0, 0xdc, 0x4b, 0xd8, 0xfa, 0x6c, 0x30,
+	0x49, 0xb9, 0xd6, 0xaa, 0x6e, 0xd4, 0x08, 0xcf, 0x87, 0xcd, 0xc4, 0x68, 0xf5, 0x74, 0x53, 0x5e,
+	0xe6, 0xb1, 0x9a, 0x63, 0xf4, 0x11, 0x58, 0x88, 0x26, 0x68, 0xc8, 0x36, 0x14, 0xb6, 0x5f, 0xd3,
+	0xa0, 0x14, 0x0c, 0x8b, 0x8f, 0x0d, 0xb5, 0xa9, 0xe8, 0x2d, 0xac, 0x75, 0x25, 0xc6, 0x4b, 0x18,
+	0xbb, 0x2f, 0x04, 0x30, 0x63, 0x7b, 0x82, 0xab, 0x97, 0x29, 0xa8, 0xfe, 0x0e, 0xcb, 0x25, 0x1e,
+	0x45, 0x2e, 0xa4, 0x0b, 0x1f, 0xa2, 0xac, 0x1d, 0xbb, 0x13, 0x8a, 0xe6, 0xf7, 0x69, 0xb0, 0xe8,
+	0xe7, 0xf2, 0x7e, 0x53, 0xa1, 0x8d, 0xf7, 0x3b, 0x6e, 0x3a, 0x5f, 0x43, 0x67, 0x69, 0x60, 0xbd,
+	0xde, 0xb0, 0xbc, 0xce, 0xc2, 0x7e, 0x85, 0x3a, 0x4e, 0x26, 0xd2, 0x71, 0x3e, 0x03, 0xf3, 0x01,
+	0x2e, 0x75, 0x84, 0x55, 0xb1, 0xa3, 0x4c, 0x1a, 0x72, 0xc3, 0x75, 0x7b, 0xb0, 0xea, 0x0c, 0x3c,
+	0x92, 0xe7, 0x78, 0x9c, 0x26, 0x98, 0x68, 0x17, 0x0c, 0xa2, 0xd9, 0x4e, 0xb7, 0x69, 0x28, 0x3c,
+	0xdf, 0x8a, 0x60, 0xe2, 0x03, 0xb6, 0xe5, 0xed, 0x5b, 0x8a, 0x85, 0x45, 0x04, 0x86, 0xdb, 0x8a,
+	0xa9, 0xb4, 0x58, 0x18, 0xc6, 0x37, 0x6f, 0x24, 0xea, 0xd8, 0x73, 0x4d, 0xe5, 0x79, 0x4e, 0x3d,
+	0xc9, 0xa8, 0x19, 0x00, 0x44, 0x1c, 0x49, 0xfc, 0x14, 0x8c, 0xd6, 0x30, 0xae, 0xb6, 0x09, 0x69,
+	0xf2, 0xde, 0xb3, 0x96, 0x88, 0x7a, 0x0f, 0xe3, 0x3d, 0x42, 0x9a, 0xf2, 0x02, 0x87, 0x9d, 0x66,
+	0xb0, 0x1e, 0x06, 0x44, 0x23, 0x35, 0x66, 0x21, 0x7e, 0x23, 0x00, 0x29, 0x48, 0x72, 0x7f, 0x21,
+	0x71, 0x0a, 0xcc, 0xc9, 0xbb, 0xcc, 0xe0, 0x85, 0x1b, 0x5e, 0xca, 0xe4, 0x57, 0x38, 0x71, 0x31,
+	0x5e, 0x46, 0x51, 0x06, 0x88, 0x72, 0x5a, 0xaf, 0xfb, 0x6e, 0x4d, 0xb5, 0x4d, 0xdc, 0xd1, 0x89,
+	0x4d, 0xab, 0x6d, 0x93, 0xb4, 0x09, 0xc5, 0xa6, 0x34, 0x14, 0xcf, 0xab, 0x2e, 0x13, 0x88, 0xb2,
+	0xde, 0xd9, 0x1e, 0x3f, 0x12, 0xbf, 0xee, 0xb3, 0xc7, 0xfc, 0xcf, 0xf5, 0xee, 0xbd, 0xc1, 0xd2,
+	0xa4, 0xdf, 0xc2, 0x25, 0xc3, 0x8b, 0x37, 0x9d, 0x5e, 0xab, 0x8b, 0xf8, 0x8b, 0x00, 0x56, 0x43,
+	0x65, 0x11, 0xcc, 0xf6, 0xaa, 0xea, 0xef, 0x03, 0x54, 0x1a, 0x76, 0x35, 0x6e, 0xbf, 0xc0, 0x4e,
+	0xc1, 0x65, 0xde, 0xe6, 0x32, 0xd7, 0xbb, 0x0a, 0xb2, 0x37, 0x33, 0x44, 0xc5, 0x4e, 0x22, 0x2e,
+	0x15, 0x7f, 0x16, 0xc0, 0x72, 0x80, 0xd3, 0xf0, 0xe7, 0xb8, 0x1f, 0xe0, 0x11, 0x57, 0xfc, 0xbb,
+	0x57, 0xdc, 0x03, 0xb8, 0xf0, 0x5b, 0x5c, 0xf8, 0x8d, 0xb8, 0xf0, 0x6e, 0x42, 0x88, 0xf2, 0x9d,
+	0xbe, 0x70, 0xce, 0x3a, 0xbb, 0x18, 0xdc, 0x56, 0xd9, 0x50, 0xf6, 0xb5, 0x8e, 0xba, 0x5a, 0xb7,
+	0xae, 0x32, 0xd1, 0xb9, 0xd0, 0x75, 0x2e, 0xb4, 0x14, 0x17, 0x1a, 0xa3, 0x82, 0x68, 0xa1, 0xd3,
+	0x1b, 0x48, 0x7c, 0x12, 0x29, 0xc6, 0xc8, 0xb4, 0xa3, 0xd2, 0x98, 0xab, 0xf0, 0xed, 0xcb, 0x4f,
+	0x51, 0xae, 0xaf, 0x6f, 0x49, 0x46, 0x79, 0xc2, 0x25, 0x19, 0x46, 0xa1, 0x4e, 0x1d, 0xe5, 0x7a,
+	0x36, 0x5c, 0x2a, 0x01, 0x57, 0xdb, 0x9b, 0x97, 0xed, 0xb8, 0x5c, 0xd9, 0xff, 0xb9, 0xb2, 0x95,
+	0x78, 0xe4, 0xc2, 0x1c, 0x10, 0xcd, 0xf5, 0x68, 0xc4, 0x54, 0x24, 0x60, 0x4a, 0xb1, 0x2d, 0xe2,
+	0xe4, 0x6e, 0x9b, 0xd8, 0x86, 0x46, 0xa5, 0x71, 0x57, 0xcc, 0xcd, 0x44, 0x31, 0xdb, 0xb6, 0x45,
+	0x76, 0xf8, 0x0d, 0x79, 0x85, 0xf3, 0xcf, 0x33, 0xfe, 0x28, 0x1c, 0x44, 0x93, 0x4a, 0xc8, 0x98,
+	0x8a, 0xdf, 0x09, 0x60, 0x51, 0xf3, 0x87, 0x6d, 0xbc, 0x65, 0x4e, 0xb8, 0xe4, 0x77, 0x06, 0x1c,
+	0xd5, 0x91, 0x9e, 0x19, 0x4b, 0xa0, 0xbe, 0x1c, 0x10, 0x2d, 0x68, 0x3d, 0x11, 0x58, 0x49, 0x86,
+	0xee, 0x75, 0x6f, 0x12, 0x93, 0x03, 0x94, 0xe4, 0x45, 0xab, 0x53, 0xbc, 0x24, 0x93, 0x08, 0x21,
+	0xca, 0x6b, 0x7d, 0xe1, 0xc4, 0x1f, 0x05, 0xb0, 0x12, 0xf4, 0x9c, 0xc0, 0x4d, 0xaa, 0x36, 0xb0,
+	0x66, 0x37, 0x31, 0x95, 0xa6, 0x4a, 0x99, 0x0b, 0x37, 0x9f, 0xa0, 0x27, 0x79, 0xc1, 0xd8, 0xe7,
+	0xf7, 0xe5, 0xd7, 0xb8, 0xd2, 0x35, 0xa6, 0x34, 0x91, 0x0b, 0xa2, 0x25, 0xb5, 0x2f, 0x12, 0x15,
+	0x29, 0x98, 0xae, 0xd9, 0xac, 0xaf, 0x53, 0xcb, 0xc4, 0xce, 0x7c, 0x9f, 0x76, 0xc5, 0xbd, 0x9a,
+	0x3c, 0x89, 0xd9, 0x9d, 0x7d, 0xf7, 0x8a, 0x5c, 0xe0, 0x7a, 0x72, 0x7c, 0x1e, 0x47, 0x01, 0x21,
+	0x9a, 0xaa, 0x85, 0xcd, 0xa9, 0xf8, 0x00, 0xe4, 0x0c, 0xfc, 0xd8, 0xaa, 0x46, 0x0d, 0xab, 0xba,
+	0x26, 0x65, 0x9d, 0x55, 0x48, 0x5e, 0x0d, 0xaa, 0xa6, 0xb7, 0x1d, 0x44, 0xb3, 0xce, 0x83, 0x88,
+	0x8a, 0x5d, 0x4d, 0x7c, 0x08, 0x16, 0x9a, 0x44, 0x7d, 0x14, 0xbc, 0x27, 0x67, 0x0c, 0x10, 0xdb,
+	0x29, 0xe5, 0x99, 0x52, 0x66, 0x7d, 0x4c, 0x86, 0xe7, 0x27, 0xc5, 0x02, 0x03, 0xee, 0x63, 0x08,
+	0xd1, 0x3c, 0x7b, 0xc2, 0x5f, 0xe6, 0x36, 0x3f, 0x0f, 0x56, 0x23, 0xf9, 0xfe, 0x0f, 0xa7, 0x05,
+	0xe1, 0xd9, 0x69, 0x41, 0x78, 0x7e, 0x5a, 0x10, 0xfe, 0x3c, 0x2d, 0x08, 0x5f, 0x9d, 0x15, 0x52,
+	0xcf, 0xcf, 0x0a, 0xa9, 0xdf, 0xce, 0x0a, 0xa9, 0x87, 0x1b, 0x89, 0x7f, 0xfc, 0x3f, 0x8e, 0x7e,
+	0xd1, 0x72, 0xbf, 0x05, 0x1c, 0x0c, 0xbb, 0xdf, 0xb0, 0xee, 0xfc, 0x3b, 0x00, 0xfd, 0xf0, 0xed,
+	0x8a, 0x73, 0x13, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -501,6 +629,50 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationWithdrawInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationWithdrawInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -717,6 +889,53 @@ func (m *DelegatorStartingInfoRecord) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegationUnclaimedRewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationUnclaimedRewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationUnclaimedRewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnclaimedRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorSlashEventRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -787,6 +1006,94 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LockedRewardsAccounts) > 0 {
+		for iNdEx := len(m.LockedRewardsAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedRewardsAccounts[iNdEx])
+			copy(dAtA[i:], m.LockedRewardsAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockedRewardsAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.NextFundingStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextFundingStreamId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.FundingStreams) > 0 {
+		for iNdEx := len(m.FundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.CommissionWithdrawSchedules) > 0 {
+		for iNdEx := len(m.CommissionWithdrawSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionWithdrawSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DelegationUnclaimedRewards) > 0 {
+		for iNdEx := len(m.DelegationUnclaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationUnclaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DelegationWithdrawInfos) > 0 {
+		for iNdEx := len(m.DelegationWithdrawInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationWithdrawInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AutoCompounds) > 0 {
+		for iNdEx := len(m.AutoCompounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -943,6 +1250,27 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelegationWithdrawInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1029,6 +1357,25 @@ func (m *DelegatorStartingInfoRecord) Size() (n int) {
 	return n
 }
 
+func (m *DelegationUnclaimedRewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.UnclaimedRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *ValidatorSlashEventRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1106,20 +1453,59 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+	if len(m.AutoCompounds) > 0 {
+		for _, e := range m.AutoCompounds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationWithdrawInfos) > 0 {
+		for _, e := range m.DelegationWithdrawInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationUnclaimedRewards) > 0 {
+		for _, e := range m.DelegationUnclaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionWithdrawSchedules) > 0 {
+		for _, e := range m.CommissionWithdrawSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingStreams) > 0 {
+		for _, e := range m.FundingStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextFundingStreamId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextFundingStreamId))
+	}
+	if len(m.LockedRewardsAccounts) > 0 {
+		for _, s := range m.LockedRewardsAccounts {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1229,6 +1615,152 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegationWithdrawInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationWithdrawInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationWithdrawInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1856,7 +2388,7 @@ func (m *DelegatorStartingInfoRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
+func (m *DelegationUnclaimedRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1879,15 +2411,15 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegationUnclaimedRewardsRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegationUnclaimedRewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1915,13 +2447,13 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1931,33 +2463,27 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1984,7 +2510,7 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnclaimedRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2009,7 +2535,7 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2032,17 +2558,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEventRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2052,30 +2578,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2085,7 +2610,161 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorSlashEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2374,6 +3053,227 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompounds = append(m.AutoCompounds, AutoCompound{})
+			if err := m.AutoCompounds[len(m.AutoCompounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationWithdrawInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationWithdrawInfos = append(m.DelegationWithdrawInfos, DelegationWithdrawInfo{})
+			if err := m.DelegationWithdrawInfos[len(m.DelegationWithdrawInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationUnclaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationUnclaimedRewards = append(m.DelegationUnclaimedRewards, DelegationUnclaimedRewardsRecord{})
+			if err := m.DelegationUnclaimedRewards[len(m.DelegationUnclaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionWithdrawSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionWithdrawSchedules = append(m.CommissionWithdrawSchedules, CommissionWithdrawSchedule{})
+			if err := m.CommissionWithdrawSchedules[len(m.CommissionWithdrawSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingStreams = append(m.FundingStreams, FundingStream{})
+			if err := m.FundingStreams[len(m.FundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFundingStreamId", wireType)
+			}
+			m.NextFundingStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFundingStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedRewardsAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedRewardsAccounts = append(m.LockedRewardsAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -40,6 +40,26 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes>: AutoCompound
+//
+// - 0x0A<height><accAddr_Bytes>: []byte{}
+//
+// - 0x0B<accAddr_Bytes><valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x0C<valAddr_Bytes><accAddr_Bytes>: DelegationUnclaimedRewards
+//
+// - 0x0D<valAddr_Bytes>: CommissionWithdrawSchedule
+//
+// - 0x0E<height><valAddr_Bytes>: []byte{}
+//
+// - 0x0F<streamID_Bytes>: FundingStream
+//
+// - 0x10<height><streamID_Bytes>: []byte{}
+//
+// - 0x11: uint64
+//
+// - 0x12<accAddr_Bytes>: []byte{}
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +71,16 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	AutoCompoundPrefix                   = []byte{0x09} // key for delegator auto-compounding
+	AutoCompoundQueuePrefix              = []byte{0x0A} // key for the queue of auto-compounding delegators
+	DelegationWithdrawAddrPrefix         = []byte{0x0B} // key for delegation withdraw address
+	DelegationUnclaimedRewardsPrefix     = []byte{0x0C} // key for delegation unclaimed rewards
+	CommissionWithdrawSchedulePrefix     = []byte{0x0D} // key for validator commission withdrawal schedule
+	CommissionWithdrawQueuePrefix        = []byte{0x0E} // key for the queue of scheduled commission withdrawals
+	FundingStreamPrefix                  = []byte{0x0F} // key for community pool funding stream
+	FundingStreamQueuePrefix             = []byte{0x10} // key for the queue of funding stream payments
+	NextFundingStreamIDKey               = []byte{0x11} // key for the id of the next funding stream
+	LockedRewardsPrefix                  = []byte{0x12} // key for the accounts locking their withdrawn rewards
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -71,6 +101,21 @@ func GetDelegatorWithdrawInfoAddress(key []byte) (delAddr sdk.AccAddress) {
 	return sdk.AccAddress(addr)
 }
 
+// gets the addresses from a delegation withdraw address key
+func GetDelegationWithdrawAddrAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
 // gets the addresses from a delegator starting info key
 func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -86,6 +131,21 @@ func GetDelegatorStartingInfoAddresses(key []byte) (valAddr sdk.ValAddress, delA
 	return
 }
 
+// gets the addresses from a delegation unclaimed rewards key
+func GetDelegationUnclaimedRewardsAddresses(key []byte) (valAddr sdk.ValAddress, delAddr sdk.AccAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	return
+}
+
 // gets the address & period from a validator's historical rewards key
 func GetValidatorHistoricalRewardsAddressPeriod(key []byte) (valAddr sdk.ValAddress, period uint64) {
 	addr := key[1 : 1+sdk.AddrLen]
@@ -142,11 +202,26 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, delAddr.Bytes()...)
 }
 
+// gets the key for a delegation's withdraw addr
+func GetDelegationWithdrawAddrKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(DelegationWithdrawAddrPrefix, delAddr.Bytes()...), valAddr.Bytes()...)
+}
+
 // gets the key for a delegator's starting info
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, v.Bytes()...), d.Bytes()...)
 }
 
+// gets the prefix for the starting infos of the delegations to a validator
+func GetDelegatorStartingInfoPrefix(v sdk.ValAddress) []byte {
+	return append(DelegatorStartingInfoPrefix, v.Bytes()...)
+}
+
+// gets the key for a delegation's unclaimed rewards
+func GetDelegationUnclaimedRewardsKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
+	return append(append(DelegationUnclaimedRewardsPrefix, v.Bytes()...), d.Bytes()...)
+}
+
 // gets the prefix key for a validator's historical rewards
 func GetValidatorHistoricalRewardsPrefix(v sdk.ValAddress) []byte {
 	return append(ValidatorHistoricalRewardsPrefix, v.Bytes()...)
@@ -191,3 +266,108 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the key for a delegator's auto-compounding
+func GetAutoCompoundKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundPrefix, delAddr.Bytes()...)
+}
+
+// gets the prefix key for the delegators auto-compounding at a height
+func GetAutoCompoundQueueHeightKey(height int64) []byte {
+	return GetHeightQueueHeightKey(AutoCompoundQueuePrefix, height)
+}
+
+// gets the queue key for a delegator auto-compounding at a height
+func GetAutoCompoundQueueKey(height int64, delAddr sdk.AccAddress) []byte {
+	return GetHeightQueueKey(AutoCompoundQueuePrefix, height, delAddr)
+}
+
+// gets the height & delegator address from an auto-compounding queue key
+func GetAutoCompoundQueueHeightAddress(key []byte) (height int64, delAddr sdk.AccAddress) {
+	height, addr := GetHeightQueueHeightAddress(key)
+	return height, sdk.AccAddress(addr)
+}
+
+// gets the key for a validator's commission withdrawal schedule
+func GetCommissionWithdrawScheduleKey(valAddr sdk.ValAddress) []byte {
+	return append(CommissionWithdrawSchedulePrefix, valAddr.Bytes()...)
+}
+
+// gets the prefix key for the validators withdrawing their commission at a height
+func GetCommissionWithdrawQueueHeightKey(height int64) []byte {
+	return GetHeightQueueHeightKey(CommissionWithdrawQueuePrefix, height)
+}
+
+// gets the queue key for a validator withdrawing its commission at a height
+func GetCommissionWithdrawQueueKey(height int64, valAddr sdk.ValAddress) []byte {
+	return GetHeightQueueKey(CommissionWithdrawQueuePrefix, height, valAddr)
+}
+
+// gets the height & validator address from a commission withdrawal queue key
+func GetCommissionWithdrawQueueHeightAddress(key []byte) (height int64, valAddr sdk.ValAddress) {
+	height, addr := GetHeightQueueHeightAddress(key)
+	return height, sdk.ValAddress(addr)
+}
+
+// gets the prefix key for the addresses queued at a height in a height queue
+func GetHeightQueueHeightKey(queuePrefix []byte, height int64) []byte {
+	return append(queuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// gets the key for an address queued at a height in a height queue
+func GetHeightQueueKey(queuePrefix []byte, height int64, addr []byte) []byte {
+	return append(GetHeightQueueHeightKey(queuePrefix, height), addr...)
+}
+
+// gets the height & address from a height queue key
+func GetHeightQueueHeightAddress(key []byte) (height int64, addr []byte) {
+	b := key[1:9]
+	if len(b) != 8 {
+		panic("unexpected key length")
+	}
+	height = int64(binary.BigEndian.Uint64(b))
+	addr = key[9:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return
+}
+
+// gets the key for a funding stream
+func GetFundingStreamKey(id uint64) []byte {
+	return append(FundingStreamPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the prefix key for the funding streams paid at a height
+func GetFundingStreamQueueHeightKey(height int64) []byte {
+	return append(FundingStreamQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// gets the queue key for a funding stream paid at a height
+func GetFundingStreamQueueKey(height int64, id uint64) []byte {
+	return append(GetFundingStreamQueueHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// gets the height & funding stream id from a funding stream queue key
+func GetFundingStreamQueueHeightID(key []byte) (height int64, id uint64) {
+	if len(key) != 17 {
+		panic("unexpected key length")
+	}
+	height = int64(binary.BigEndian.Uint64(key[1:9]))
+	id = binary.BigEndian.Uint64(key[9:])
+	return
+}
+
+// gets the key for an account locking its withdrawn rewards
+func GetLockedRewardsKey(addr sdk.AccAddress) []byte {
+	return append(LockedRewardsPrefix, addr.Bytes()...)
+}
+
+// gets the address from a locked rewards key
+func GetLockedRewardsAddress(key []byte) sdk.AccAddress {
+	addr := key[1:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	return sdk.AccAddress(addr)
+}
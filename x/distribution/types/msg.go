@@ -8,14 +8,21 @@ import (
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetWithdrawAddress             = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward        = "withdraw_delegator_reward"
+	TypeMsgWithdrawValidatorCommission    = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool              = "fund_community_pool"
+	TypeMsgSetAutoCompound                = "set_auto_compound"
+	TypeMsgSetDelegationWithdrawAddress   = "set_delegation_withdraw_address"
+	TypeMsgWithdrawDelegatorRewardPartial = "withdraw_delegator_reward_partial"
+	TypeMsgSetCommissionWithdrawSchedule  = "set_commission_withdraw_schedule"
+	TypeMsgSetLockedRewards               = "set_locked_rewards"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{},
+	&MsgSetAutoCompound{}, &MsgSetDelegationWithdrawAddress{}, &MsgWithdrawDelegatorRewardPartial{},
+	&MsgSetCommissionWithdrawSchedule{}, &MsgSetLockedRewards{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -54,6 +61,56 @@ func (msg MsgSetWithdrawAddress) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetDelegationWithdrawAddress returns a new MsgSetDelegationWithdrawAddress
+// setting the withdraw address of a delegation, an empty withdrawAddr removes
+// it.
+func NewMsgSetDelegationWithdrawAddress(delAddr sdk.AccAddress, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) *MsgSetDelegationWithdrawAddress {
+	msg := &MsgSetDelegationWithdrawAddress{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+	if !withdrawAddr.Empty() {
+		msg.WithdrawAddress = withdrawAddr.String()
+	}
+	return msg
+}
+
+// Route returns the MsgSetDelegationWithdrawAddress message route.
+func (msg MsgSetDelegationWithdrawAddress) Route() string { return ModuleName }
+
+// Type returns the MsgSetDelegationWithdrawAddress message type.
+func (msg MsgSetDelegationWithdrawAddress) Type() string { return TypeMsgSetDelegationWithdrawAddress }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetDelegationWithdrawAddress) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetDelegationWithdrawAddress
+// message that the expected signer needs to sign.
+func (msg MsgSetDelegationWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetDelegationWithdrawAddress message
+// validation. The withdraw address may be empty to remove it.
+func (msg MsgSetDelegationWithdrawAddress) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	return nil
+}
+
 func NewMsgWithdrawDelegatorReward(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgWithdrawDelegatorReward {
 	return &MsgWithdrawDelegatorReward{
 		DelegatorAddress: delAddr.String(),
@@ -90,6 +147,70 @@ func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgWithdrawDelegatorRewardPartial returns a new
+// MsgWithdrawDelegatorRewardPartial withdrawing either the given amount or the
+// given percentage of the rewards of a delegation.
+func NewMsgWithdrawDelegatorRewardPartial(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coins, percentage sdk.Dec) *MsgWithdrawDelegatorRewardPartial {
+	return &MsgWithdrawDelegatorRewardPartial{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Amount:           amount,
+		Percentage:       percentage,
+	}
+}
+
+// Route returns the MsgWithdrawDelegatorRewardPartial message route.
+func (msg MsgWithdrawDelegatorRewardPartial) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawDelegatorRewardPartial message type.
+func (msg MsgWithdrawDelegatorRewardPartial) Type() string {
+	return TypeMsgWithdrawDelegatorRewardPartial
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawDelegatorRewardPartial) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawDelegatorRewardPartial
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawDelegatorRewardPartial) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawDelegatorRewardPartial message
+// validation. Exactly one of the amount and the percentage must be set.
+func (msg MsgWithdrawDelegatorRewardPartial) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	hasPercentage := !msg.Percentage.IsNil() && !msg.Percentage.IsZero()
+	switch {
+	case msg.Amount.Empty() == !hasPercentage:
+		return sdkerrors.Wrap(ErrInvalidPartialWithdrawal, "exactly one of amount and percentage must be set")
+	case !msg.Amount.Empty():
+		if !msg.Amount.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+		}
+	default:
+		if msg.Percentage.IsNegative() || msg.Percentage.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidPartialWithdrawal, "percentage must be in (0, 1], got %s", msg.Percentage)
+		}
+	}
+
+	return nil
+}
+
 func NewMsgWithdrawValidatorCommission(valAddr sdk.ValAddress) *MsgWithdrawValidatorCommission {
 	return &MsgWithdrawValidatorCommission{
 		ValidatorAddress: valAddr.String(),
@@ -165,3 +286,155 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound with a delegator and
+// the validators whose delegation rewards are auto-compounded.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddrs []sdk.ValAddress) *MsgSetAutoCompound {
+	validators := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		validators[i] = valAddr.String()
+	}
+
+	return &MsgSetAutoCompound{
+		DelegatorAddress:   delAddr.String(),
+		ValidatorAddresses: validators,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that
+// the expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if msg.DelegatorAddress == "" {
+		return ErrEmptyDelegatorAddr
+	}
+
+	return validateAutoCompoundValidators(msg.ValidatorAddresses)
+}
+
+// validateAutoCompoundValidators checks that the auto-compounded validators
+// are valid and not duplicated.
+func validateAutoCompoundValidators(validators []string) error {
+	seen := make(map[string]bool, len(validators))
+	for _, validator := range validators {
+		if validator == "" {
+			return ErrEmptyValidatorAddr
+		}
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %s", validator, err)
+		}
+		if seen[validator] {
+			return sdkerrors.Wrapf(ErrInvalidAutoCompound, "duplicate validator %s", validator)
+		}
+		seen[validator] = true
+	}
+
+	return nil
+}
+
+// NewMsgSetCommissionWithdrawSchedule returns a new
+// MsgSetCommissionWithdrawSchedule withdrawing the commission of a validator
+// every interval blocks, a zero interval removes the schedule.
+func NewMsgSetCommissionWithdrawSchedule(valAddr sdk.ValAddress, interval uint64) *MsgSetCommissionWithdrawSchedule {
+	return &MsgSetCommissionWithdrawSchedule{
+		ValidatorAddress: valAddr.String(),
+		Interval:         interval,
+	}
+}
+
+// Route returns the MsgSetCommissionWithdrawSchedule message route.
+func (msg MsgSetCommissionWithdrawSchedule) Route() string { return ModuleName }
+
+// Type returns the MsgSetCommissionWithdrawSchedule message type.
+func (msg MsgSetCommissionWithdrawSchedule) Type() string {
+	return TypeMsgSetCommissionWithdrawSchedule
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetCommissionWithdrawSchedule) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetCommissionWithdrawSchedule
+// message that the expected signer needs to sign.
+func (msg MsgSetCommissionWithdrawSchedule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetCommissionWithdrawSchedule message
+// validation.
+func (msg MsgSetCommissionWithdrawSchedule) ValidateBasic() error {
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+
+	return nil
+}
+
+// NewMsgSetLockedRewards returns a new MsgSetLockedRewards enabling or
+// disabling the locking of the rewards withdrawn to a vesting account.
+func NewMsgSetLockedRewards(addr sdk.AccAddress, enabled bool) *MsgSetLockedRewards {
+	return &MsgSetLockedRewards{
+		Address: addr.String(),
+		Enabled: enabled,
+	}
+}
+
+// Route returns the MsgSetLockedRewards message route.
+func (msg MsgSetLockedRewards) Route() string { return ModuleName }
+
+// Type returns the MsgSetLockedRewards message type.
+func (msg MsgSetLockedRewards) Type() string { return TypeMsgSetLockedRewards }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetLockedRewards) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetLockedRewards message that
+// the expected signer needs to sign.
+func (msg MsgSetLockedRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetLockedRewards message validation.
+func (msg MsgSetLockedRewards) ValidateBasic() error {
+	if msg.Address == "" {
+		return ErrEmptyWithdrawAddr
+	}
+
+	return nil
+}
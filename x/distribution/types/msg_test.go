@@ -32,6 +32,30 @@ func TestMsgSetWithdrawAddress(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSetDelegationWithdrawAddress
+func TestMsgSetDelegationWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		withdrawAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, delAddr2, true},
+		{delAddr1, valAddr1, emptyDelAddr, true},
+		{emptyDelAddr, valAddr1, delAddr2, false},
+		{delAddr1, emptyValAddr, delAddr2, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetDelegationWithdrawAddress(tc.delegatorAddr, tc.validatorAddr, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawDelegatorReward
 func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	tests := []struct {
@@ -54,6 +78,38 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawDelegatorRewardPartial
+func TestMsgWithdrawDelegatorRewardPartial(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		amount        sdk.Coins
+		percentage    sdk.Dec
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, amount, sdk.Dec{}, true},
+		{delAddr1, valAddr1, nil, sdk.NewDecWithPrec(5, 1), true},
+		{delAddr1, valAddr1, nil, sdk.OneDec(), true},
+		{delAddr1, valAddr1, nil, sdk.Dec{}, false},
+		{delAddr1, valAddr1, nil, sdk.ZeroDec(), false},
+		{delAddr1, valAddr1, nil, sdk.NewDecWithPrec(-5, 1), false},
+		{delAddr1, valAddr1, nil, sdk.NewDecWithPrec(15, 1), false},
+		{delAddr1, valAddr1, amount, sdk.NewDecWithPrec(5, 1), false},
+		{delAddr1, valAddr1, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, sdk.Dec{}, false},
+		{emptyDelAddr, valAddr1, amount, sdk.Dec{}, false},
+		{delAddr1, emptyValAddr, amount, sdk.Dec{}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawDelegatorRewardPartial(tc.delegatorAddr, tc.validatorAddr, tc.amount, tc.percentage)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgWithdrawValidatorCommission
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	tests := []struct {
@@ -93,3 +149,68 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr  sdk.AccAddress
+		validatorAddrs []sdk.ValAddress
+		expectPass     bool
+	}{
+		{delAddr1, []sdk.ValAddress{valAddr1, valAddr2}, true},
+		{delAddr1, nil, true},
+		{emptyDelAddr, []sdk.ValAddress{valAddr1}, false},
+		{delAddr1, []sdk.ValAddress{emptyValAddr}, false},
+		{delAddr1, []sdk.ValAddress{valAddr1, valAddr1}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddrs)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetCommissionWithdrawSchedule
+func TestMsgSetCommissionWithdrawSchedule(t *testing.T) {
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		interval      uint64
+		expectPass    bool
+	}{
+		{valAddr1, 100, true},
+		{valAddr1, 0, true},
+		{emptyValAddr, 100, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionWithdrawSchedule(tc.validatorAddr, tc.interval)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetLockedRewards
+func TestMsgSetLockedRewards(t *testing.T) {
+	tests := []struct {
+		addr       sdk.AccAddress
+		enabled    bool
+		expectPass bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetLockedRewards(tc.addr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
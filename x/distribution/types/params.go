@@ -11,12 +11,37 @@ import (
 
 // Parameter keys
 var (
-	ParamStoreKeyCommunityTax        = []byte("communitytax")
-	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
-	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
-	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyCommunityTax               = []byte("communitytax")
+	ParamStoreKeyBaseProposerReward         = []byte("baseproposerreward")
+	ParamStoreKeyBonusProposerReward        = []byte("bonusproposerreward")
+	ParamStoreKeyWithdrawAddrEnabled        = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoCompoundInterval       = []byte("autocompoundinterval")
+	ParamStoreKeyMaxAutoCompoundsPerBlock   = []byte("maxautocompoundsperblock")
+	ParamStoreKeyAutoCompoundGasLimit       = []byte("autocompoundgaslimit")
+	ParamStoreKeyHistoricalRetentionPeriods = []byte("historicalretentionperiods")
+	ParamStoreKeyHistoricalPruningInterval  = []byte("historicalpruninginterval")
+	ParamStoreKeyScheduledRateChange        = []byte("scheduledratechange")
+	ParamStoreKeyMaxCommissionWithdrawals   = []byte("maxcommissionwithdrawalsperblock")
 )
 
+// Default auto-compounding parameters, auto-compounding is disabled by default.
+var (
+	DefaultAutoCompoundInterval     uint64 = 0
+	DefaultMaxAutoCompoundsPerBlock uint32 = 100
+	DefaultAutoCompoundGasLimit     uint64 = 1_000_000
+)
+
+// Default historical rewards pruning parameters, pruning is disabled by
+// default.
+var (
+	DefaultHistoricalRetentionPeriods uint64 = 0
+	DefaultHistoricalPruningInterval  uint64 = 1000
+)
+
+// DefaultMaxCommissionWithdrawalsPerBlock is the default maximum number of
+// scheduled commission withdrawals per block.
+var DefaultMaxCommissionWithdrawalsPerBlock uint32 = 100
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -25,13 +50,38 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:                     sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:               sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:              sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:              true,
+		AutoCompoundInterval:             DefaultAutoCompoundInterval,
+		MaxAutoCompoundsPerBlock:         DefaultMaxAutoCompoundsPerBlock,
+		AutoCompoundGasLimit:             DefaultAutoCompoundGasLimit,
+		HistoricalRetentionPeriods:       DefaultHistoricalRetentionPeriods,
+		HistoricalPruningInterval:        DefaultHistoricalPruningInterval,
+		ScheduledRateChange:              NewScheduledRateChange(0, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MaxCommissionWithdrawalsPerBlock: DefaultMaxCommissionWithdrawalsPerBlock,
+	}
+}
+
+// NewScheduledRateChange creates a new change of the community tax and
+// proposer reward rates applied at the activation height.
+func NewScheduledRateChange(
+	activationHeight int64, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
+) ScheduledRateChange {
+	return ScheduledRateChange{
+		ActivationHeight:    activationHeight,
+		CommunityTax:        communityTax,
+		BaseProposerReward:  baseProposerReward,
+		BonusProposerReward: bonusProposerReward,
 	}
 }
 
+// IsScheduled returns true if the rate change has an activation height.
+func (c ScheduledRateChange) IsScheduled() bool {
+	return c.ActivationHeight != 0
+}
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -44,6 +94,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundInterval, &p.AutoCompoundInterval, validateAutoCompoundInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxAutoCompoundsPerBlock, &p.MaxAutoCompoundsPerBlock, validateMaxAutoCompoundsPerBlock),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundGasLimit, &p.AutoCompoundGasLimit, validateAutoCompoundGasLimit),
+		paramtypes.NewParamSetPair(ParamStoreKeyHistoricalRetentionPeriods, &p.HistoricalRetentionPeriods, validateHistoricalRetentionPeriods),
+		paramtypes.NewParamSetPair(ParamStoreKeyHistoricalPruningInterval, &p.HistoricalPruningInterval, validateHistoricalPruningInterval),
+		paramtypes.NewParamSetPair(ParamStoreKeyScheduledRateChange, &p.ScheduledRateChange, validateScheduledRateChange),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxCommissionWithdrawals, &p.MaxCommissionWithdrawalsPerBlock, validateMaxCommissionWithdrawalsPerBlock),
 	}
 }
 
@@ -69,6 +126,15 @@ func (p Params) ValidateBasic() error {
 			"sum of base and bonus proposer reward cannot greater than one: %s", v,
 		)
 	}
+	if err := validateAutoCompoundGasLimit(p.AutoCompoundGasLimit); err != nil {
+		return err
+	}
+	if err := validateHistoricalPruningInterval(p.HistoricalPruningInterval); err != nil {
+		return err
+	}
+	if err := validateScheduledRateChange(p.ScheduledRateChange); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -138,3 +204,94 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxAutoCompoundsPerBlock(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxCommissionWithdrawalsPerBlock(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundGasLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("auto-compound gas limit must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateHistoricalRetentionPeriods(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateHistoricalPruningInterval(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("historical pruning interval must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateScheduledRateChange(i interface{}) error {
+	v, ok := i.(ScheduledRateChange)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.ActivationHeight < 0 {
+		return fmt.Errorf("rate change activation height must be positive: %d", v.ActivationHeight)
+	}
+	if !v.IsScheduled() {
+		return nil
+	}
+
+	if err := validateCommunityTax(v.CommunityTax); err != nil {
+		return err
+	}
+	if err := validateBaseProposerReward(v.BaseProposerReward); err != nil {
+		return err
+	}
+	if err := validateBonusProposerReward(v.BonusProposerReward); err != nil {
+		return err
+	}
+	if sum := v.BaseProposerReward.Add(v.BonusProposerReward); sum.GT(sdk.OneDec()) {
+		return fmt.Errorf("sum of scheduled base and bonus proposer reward cannot be greater than one: %s", sum)
+	}
+
+	return nil
+}
@@ -32,3 +32,26 @@ func Test_validateAuxFuncs(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateScheduledRateChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  interface{}
+		wantErr bool
+	}{
+		{"wrong type", sdk.NewDec(1), true},
+		{"not scheduled", ScheduledRateChange{}, false},
+		{"negative height", NewScheduledRateChange(-1, sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), true},
+		{"valid", NewScheduledRateChange(10, sdk.NewDecWithPrec(3, 2), sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(4, 2)), false},
+		{"nil community tax", NewScheduledRateChange(10, sdk.Dec{}, sdk.ZeroDec(), sdk.ZeroDec()), true},
+		{"negative base proposer reward", NewScheduledRateChange(10, sdk.ZeroDec(), sdk.NewDec(-1), sdk.ZeroDec()), true},
+		{"bonus proposer reward too large", NewScheduledRateChange(10, sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(2)), true},
+		{"proposer rewards too large", NewScheduledRateChange(10, sdk.ZeroDec(), sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(6, 1)), true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateScheduledRateChange(tt.change) != nil)
+		})
+	}
+}
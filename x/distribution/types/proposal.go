@@ -5,20 +5,33 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
 	// ProposalTypeCommunityPoolSpend defines the type for a CommunityPoolSpendProposal
 	ProposalTypeCommunityPoolSpend = "CommunityPoolSpend"
+	// ProposalTypeCommunityPoolStream defines the type for a CommunityPoolStreamProposal
+	ProposalTypeCommunityPoolStream = "CommunityPoolStream"
+	// ProposalTypeCancelCommunityPoolStream defines the type for a CancelCommunityPoolStreamProposal
+	ProposalTypeCancelCommunityPoolStream = "CancelCommunityPoolStream"
 )
 
-// Assert CommunityPoolSpendProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CommunityPoolSpendProposal{}
+// Assert the distribution proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CommunityPoolSpendProposal{}
+	_ govtypes.Content = &CommunityPoolStreamProposal{}
+	_ govtypes.Content = &CancelCommunityPoolStreamProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCommunityPoolSpend)
 	govtypes.RegisterProposalTypeCodec(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(&CommunityPoolStreamProposal{}, "cosmos-sdk/CommunityPoolStreamProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelCommunityPoolStream)
+	govtypes.RegisterProposalTypeCodec(&CancelCommunityPoolStreamProposal{}, "cosmos-sdk/CancelCommunityPoolStreamProposal")
 }
 
 // NewCommunityPoolSpendProposal creates a new community pool spned proposal.
@@ -66,3 +79,91 @@ func (csp CommunityPoolSpendProposal) String() string {
 `, csp.Title, csp.Description, csp.Recipient, csp.Amount))
 	return b.String()
 }
+
+// NewCommunityPoolStreamProposal creates a new community pool stream proposal.
+//nolint:interfacer
+func NewCommunityPoolStreamProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins, interval uint64) *CommunityPoolStreamProposal {
+	return &CommunityPoolStreamProposal{title, description, recipient.String(), amount, interval}
+}
+
+// GetTitle returns the title of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool stream proposal.
+func (csp *CommunityPoolStreamProposal) ProposalType() string { return ProposalTypeCommunityPoolStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *CommunityPoolStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(csp)
+	if err != nil {
+		return err
+	}
+	if !csp.Amount.IsValid() || csp.Amount.IsZero() {
+		return ErrInvalidProposalAmount
+	}
+	if csp.Recipient == "" {
+		return ErrEmptyProposalRecipient
+	}
+	if csp.Interval == 0 {
+		return sdkerrors.Wrap(ErrInvalidFundingStream, "interval must be positive")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (csp CommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Interval:    %d
+`, csp.Title, csp.Description, csp.Recipient, csp.Amount, csp.Interval))
+	return b.String()
+}
+
+// NewCancelCommunityPoolStreamProposal creates a new cancel community pool
+// stream proposal.
+func NewCancelCommunityPoolStreamProposal(title, description string, streamID uint64) *CancelCommunityPoolStreamProposal {
+	return &CancelCommunityPoolStreamProposal{title, description, streamID}
+}
+
+// GetTitle returns the title of a cancel community pool stream proposal.
+func (ccsp *CancelCommunityPoolStreamProposal) GetTitle() string { return ccsp.Title }
+
+// GetDescription returns the description of a cancel community pool stream
+// proposal.
+func (ccsp *CancelCommunityPoolStreamProposal) GetDescription() string { return ccsp.Description }
+
+// ProposalRoute returns the routing key of a cancel community pool stream
+// proposal.
+func (ccsp *CancelCommunityPoolStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel community pool stream proposal.
+func (ccsp *CancelCommunityPoolStreamProposal) ProposalType() string {
+	return ProposalTypeCancelCommunityPoolStream
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccsp *CancelCommunityPoolStreamProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ccsp)
+}
+
+// String implements the Stringer interface.
+func (ccsp CancelCommunityPoolStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Cancel Community Pool Stream Proposal:
+  Title:       %s
+  Description: %s
+  Stream ID:   %d
+`, ccsp.Title, ccsp.Description, ccsp.StreamId))
+	return b.String()
+}
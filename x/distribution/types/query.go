@@ -38,3 +38,9 @@ func NewDelegationDelegatorReward(valAddr sdk.ValAddress,
 	reward sdk.DecCoins) DelegationDelegatorReward {
 	return DelegationDelegatorReward{ValidatorAddress: valAddr.String(), Reward: reward}
 }
+
+// NewValidatorDelegatorReward constructs a ValidatorDelegatorReward.
+//nolint:interfacer
+func NewValidatorDelegatorReward(delAddr sdk.AccAddress, reward sdk.DecCoins) ValidatorDelegatorReward {
+	return ValidatorDelegatorReward{DelegatorAddress: delAddr.String(), Reward: reward}
+}
@@ -0,0 +1,63 @@
+package epochs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// BeginBlocker ticks every epoch timer. When the running epoch of a timer has
+// ended, the AfterEpochEnd hook is called, the next epoch is started and the
+// BeforeEpochStart hook is called. The first epoch of a timer starts on the
+// first block at or after its start time.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// epoch timers are collected first as they are updated in the loop
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		shouldInitialEpochStart := !epoch.EpochCountingStarted && !epoch.StartTime.After(ctx.BlockTime())
+		epochEndTime := epoch.EndTime()
+		shouldEpochStart := shouldInitialEpochStart ||
+			(epoch.EpochCountingStarted && !ctx.BlockTime().Before(epochEndTime))
+
+		if !shouldEpochStart {
+			continue
+		}
+
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+
+		if shouldInitialEpochStart {
+			epoch.EpochCountingStarted = true
+			epoch.CurrentEpoch = 1
+			epoch.CurrentEpochStartTime = epoch.StartTime
+			k.Logger(ctx).Info(fmt.Sprintf("starting epoch timer %s", epoch.Identifier))
+		} else {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epoch.Identifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epoch.CurrentEpoch)),
+				),
+			)
+			k.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+			epoch.CurrentEpoch++
+			epoch.CurrentEpochStartTime = epochEndTime
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprintf("%d", epoch.CurrentEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEpochStartTime, epoch.CurrentEpochStartTime.Format(time.RFC3339Nano)),
+			),
+		)
+		k.SetEpochInfo(ctx, epoch)
+		k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+	}
+}
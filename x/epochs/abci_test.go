@@ -0,0 +1,125 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+type hookCall struct {
+	hook        string
+	identifier  string
+	epochNumber int64
+}
+
+type mockEpochHooks struct {
+	calls []hookCall
+}
+
+func (h *mockEpochHooks) AfterEpochEnd(_ sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.calls = append(h.calls, hookCall{hook: "end", identifier: epochIdentifier, epochNumber: epochNumber})
+}
+
+func (h *mockEpochHooks) BeforeEpochStart(_ sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.calls = append(h.calls, hookCall{hook: "start", identifier: epochIdentifier, epochNumber: epochNumber})
+}
+
+func setupKeeperWithHooks(t *testing.T) (keeper.Keeper, sdk.Context, *mockEpochHooks) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	hooks := &mockEpochHooks{}
+	k := keeper.NewKeeper(app.AppCodec(), app.GetKey(types.StoreKey)).SetHooks(hooks)
+
+	// remove the epoch timers set at genesis
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		k.DeleteEpochInfo(ctx, epoch.Identifier)
+	}
+	require.Empty(t, k.AllEpochInfos(ctx))
+
+	return *k, ctx, hooks
+}
+
+func TestBeginBlockerEpochProgression(t *testing.T) {
+	k, ctx, hooks := setupKeeperWithHooks(t)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.NewEpochInfo("hourly", start, time.Hour))
+
+	// the timer does not start before its start time
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(start.Add(-time.Second))
+	epochs.BeginBlocker(ctx, k)
+	epoch, found := k.GetEpochInfo(ctx, "hourly")
+	require.True(t, found)
+	require.False(t, epoch.EpochCountingStarted)
+	require.Empty(t, hooks.calls)
+
+	// the first epoch starts at the start time
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(start.Add(time.Second))
+	epochs.BeginBlocker(ctx, k)
+	epoch, _ = k.GetEpochInfo(ctx, "hourly")
+	require.True(t, epoch.EpochCountingStarted)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Equal(t, start, epoch.CurrentEpochStartTime.UTC())
+	require.Equal(t, int64(2), epoch.CurrentEpochStartHeight)
+	require.Equal(t, []hookCall{{hook: "start", identifier: "hourly", epochNumber: 1}}, hooks.calls)
+
+	// nothing happens within the epoch
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(start.Add(59 * time.Minute))
+	epochs.BeginBlocker(ctx, k)
+	epoch, _ = k.GetEpochInfo(ctx, "hourly")
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+	require.Len(t, hooks.calls, 1)
+
+	// the epoch ends once its duration has elapsed
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(start.Add(time.Hour + time.Minute))
+	epochs.BeginBlocker(ctx, k)
+	epoch, _ = k.GetEpochInfo(ctx, "hourly")
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+	require.Equal(t, start.Add(time.Hour), epoch.CurrentEpochStartTime.UTC())
+	require.Equal(t, int64(4), epoch.CurrentEpochStartHeight)
+	require.Equal(t, []hookCall{
+		{hook: "start", identifier: "hourly", epochNumber: 1},
+		{hook: "end", identifier: "hourly", epochNumber: 1},
+		{hook: "start", identifier: "hourly", epochNumber: 2},
+	}, hooks.calls)
+
+	var epochEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEpochStart || event.Type == types.EventTypeEpochEnd {
+			epochEvents++
+		}
+	}
+	require.Equal(t, 3, epochEvents)
+}
+
+func TestBeginBlockerCatchesUpMissedEpochs(t *testing.T) {
+	k, ctx, hooks := setupKeeperWithHooks(t)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.NewEpochInfo(types.DayEpochID, start, 24*time.Hour))
+
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(start)
+	epochs.BeginBlocker(ctx, k)
+
+	// a single epoch is advanced per block, even if several have elapsed
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(start.Add(72 * time.Hour))
+	epochs.BeginBlocker(ctx, k)
+	epoch, _ := k.GetEpochInfo(ctx, types.DayEpochID)
+	require.Equal(t, int64(2), epoch.CurrentEpoch)
+
+	ctx = ctx.WithBlockHeight(3)
+	epochs.BeginBlocker(ctx, k)
+	epoch, _ = k.GetEpochInfo(ctx, types.DayEpochID)
+	require.Equal(t, int64(3), epoch.CurrentEpoch)
+	require.Equal(t, start.Add(48*time.Hour), epoch.CurrentEpochStartTime.UTC())
+	require.Len(t, hooks.calls, 5)
+}
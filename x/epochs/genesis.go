@@ -0,0 +1,27 @@
+package epochs
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// InitGenesis initializes the epochs module's state from a given genesis
+// state. Epoch timers without a start time start at the genesis block time.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, epoch := range data.Epochs {
+		if epoch.StartTime.IsZero() {
+			epoch.StartTime = ctx.BlockTime()
+		}
+		if !epoch.EpochCountingStarted {
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		}
+
+		k.SetEpochInfo(ctx, epoch)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.AllEpochInfos(ctx))
+}
@@ -0,0 +1,45 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10).WithBlockTime(now)
+
+	for _, epoch := range app.EpochsKeeper.AllEpochInfos(ctx) {
+		app.EpochsKeeper.DeleteEpochInfo(ctx, epoch.Identifier)
+	}
+
+	start := now.Add(time.Hour)
+	genesis := types.NewGenesisState([]types.EpochInfo{
+		types.NewEpochInfo("custom", start, 15*time.Minute),
+		types.NewEpochInfo(types.DayEpochID, time.Time{}, 24*time.Hour),
+	})
+	require.NoError(t, genesis.Validate())
+
+	epochs.InitGenesis(ctx, app.EpochsKeeper, *genesis)
+
+	exported := epochs.ExportGenesis(ctx, app.EpochsKeeper)
+	require.Len(t, exported.Epochs, 2)
+
+	custom, found := app.EpochsKeeper.GetEpochInfo(ctx, "custom")
+	require.True(t, found)
+	require.Equal(t, start, custom.StartTime.UTC())
+	require.Equal(t, int64(10), custom.CurrentEpochStartHeight)
+
+	// epoch timers without a start time start at genesis
+	day, found := app.EpochsKeeper.GetEpochInfo(ctx, types.DayEpochID)
+	require.True(t, found)
+	require.Equal(t, now, day.StartTime.UTC())
+}
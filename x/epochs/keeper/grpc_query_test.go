@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochsKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestEpochInfoStore() {
+	app, ctx := suite.app, suite.ctx
+
+	_, found := app.EpochsKeeper.GetEpochInfo(ctx, "monthly")
+	suite.Require().False(found)
+
+	epoch := types.NewEpochInfo("monthly", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), 30*24*time.Hour)
+	app.EpochsKeeper.SetEpochInfo(ctx, epoch)
+
+	stored, found := app.EpochsKeeper.GetEpochInfo(ctx, "monthly")
+	suite.Require().True(found)
+	suite.Require().Equal(epoch.Duration, stored.Duration)
+	suite.Require().Equal(epoch.StartTime, stored.StartTime.UTC())
+
+	// genesis day and week timers plus the monthly one, ordered by identifier
+	var identifiers []string
+	for _, e := range app.EpochsKeeper.AllEpochInfos(ctx) {
+		identifiers = append(identifiers, e.Identifier)
+	}
+	suite.Require().Equal([]string{types.DayEpochID, "monthly", types.WeekEpochID}, identifiers)
+
+	app.EpochsKeeper.DeleteEpochInfo(ctx, "monthly")
+	_, found = app.EpochsKeeper.GetEpochInfo(ctx, "monthly")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGRPCEpochInfos() {
+	res, err := suite.queryClient.EpochInfos(gocontext.Background(), &types.QueryEpochInfosRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Epochs, 2)
+	suite.Require().Equal(types.DayEpochID, res.Epochs[0].Identifier)
+	suite.Require().Equal(types.WeekEpochID, res.Epochs[1].Identifier)
+}
+
+func (suite *KeeperTestSuite) TestGRPCCurrentEpoch() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	epoch, found := app.EpochsKeeper.GetEpochInfo(ctx, types.WeekEpochID)
+	suite.Require().True(found)
+	epoch.CurrentEpoch = 7
+	app.EpochsKeeper.SetEpochInfo(ctx, epoch)
+
+	res, err := queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{Identifier: types.WeekEpochID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(7), res.CurrentEpoch)
+
+	_, err = queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{Identifier: "unknown"})
+	suite.Require().Error(err)
+
+	_, err = queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{})
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
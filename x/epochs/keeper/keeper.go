@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Keeper of the epochs store
+type Keeper struct {
+	cdc      codec.BinaryMarshaler
+	storeKey sdk.StoreKey
+	hooks    types.EpochHooks
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(cdc codec.BinaryMarshaler, key sdk.StoreKey) *Keeper {
+	return &Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// Set the epoch hooks
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epochs hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetEpochInfo returns the epoch timer with the given identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (epoch types.EpochInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochInfoKey(identifier))
+	if bz == nil {
+		return epoch, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &epoch)
+	return epoch, true
+}
+
+// SetEpochInfo stores the given epoch timer.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&epoch)
+	store.Set(types.EpochInfoKey(epoch.Identifier), bz)
+}
+
+// DeleteEpochInfo removes the epoch timer with the given identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochInfoKey(identifier))
+}
+
+// IterateEpochInfo iterates over all the epoch timers ordered by identifier
+// and calls fn on each of them. Iteration stops when fn returns true.
+func (k Keeper) IterateEpochInfo(ctx sdk.Context, fn func(index int64, epoch types.EpochInfo) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpoch)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &epoch)
+
+		if fn(i, epoch) {
+			break
+		}
+		i++
+	}
+}
+
+// AllEpochInfos returns all the epoch timers.
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	epochs := []types.EpochInfo{}
+	k.IterateEpochInfo(ctx, func(_ int64, epoch types.EpochInfo) (stop bool) {
+		epochs = append(epochs, epoch)
+		return false
+	})
+
+	return epochs
+}
@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding epochs type.
+func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpoch):
+			var epochA, epochB types.EpochInfo
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &epochA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+		default:
+			panic(fmt.Sprintf("invalid epochs key %X", kvA.Key))
+		}
+	}
+}
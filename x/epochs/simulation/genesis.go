@@ -0,0 +1,45 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	DayEpochDuration = "day_epoch_duration"
+)
+
+// GenDayEpochDuration randomized duration of the day epoch timer, kept short
+// so that epochs tick during simulations
+func GenDayEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(60)+1) * time.Minute
+}
+
+// RandomizedGenState generates a random GenesisState for epochs
+func RandomizedGenState(simState *module.SimulationState) {
+	var dayEpochDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DayEpochDuration, &dayEpochDuration, simState.Rand,
+		func(r *rand.Rand) { dayEpochDuration = GenDayEpochDuration(r) },
+	)
+
+	epochsGenesis := types.NewGenesisState([]types.EpochInfo{
+		types.NewEpochInfo(types.DayEpochID, simState.GenTimestamp, dayEpochDuration),
+		types.NewEpochInfo(types.WeekEpochID, simState.GenTimestamp, 7*dayEpochDuration),
+	})
+
+	bz, err := json.MarshalIndent(&epochsGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epochs parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochsGenesis)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Default epoch timer identifiers
+const (
+	DayEpochID  = "day"
+	WeekEpochID = "week"
+)
+
+// NewEpochInfo creates a new epoch timer with the given identifier, starting
+// at startTime and lasting duration per epoch. Counting starts on the first
+// block at or after startTime.
+func NewEpochInfo(identifier string, startTime time.Time, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier:            identifier,
+		StartTime:             startTime,
+		Duration:              duration,
+		CurrentEpoch:          0,
+		CurrentEpochStartTime: time.Time{},
+		EpochCountingStarted:  false,
+	}
+}
+
+// EndTime returns the time at which the running epoch ends.
+func (ei EpochInfo) EndTime() time.Time {
+	return ei.CurrentEpochStartTime.Add(ei.Duration)
+}
+
+// Validate performs a stateless validation of the epoch timer.
+func (ei EpochInfo) Validate() error {
+	if err := ValidateEpochIdentifierString(ei.Identifier); err != nil {
+		return err
+	}
+	if ei.Duration <= 0 {
+		return fmt.Errorf("epoch %s duration must be positive: %s", ei.Identifier, ei.Duration)
+	}
+	if ei.CurrentEpoch < 0 {
+		return fmt.Errorf("epoch %s current epoch cannot be negative: %d", ei.Identifier, ei.CurrentEpoch)
+	}
+	if ei.CurrentEpochStartHeight < 0 {
+		return fmt.Errorf("epoch %s current epoch start height cannot be negative: %d", ei.Identifier, ei.CurrentEpochStartHeight)
+	}
+
+	return nil
+}
+
+// ValidateEpochIdentifierInterface validates an epoch identifier stored as a
+// module parameter. It can be used as a param validation function by modules
+// subscribing to epoch hooks.
+func ValidateEpochIdentifierInterface(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateEpochIdentifierString(v)
+}
+
+// ValidateEpochIdentifierString validates an epoch identifier.
+func ValidateEpochIdentifierString(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New("epoch identifier cannot be blank")
+	}
+
+	return nil
+}
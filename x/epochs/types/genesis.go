@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(epochs []EpochInfo) *GenesisState {
+	return &GenesisState{
+		Epochs: epochs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the epochs module,
+// which tracks a daily and a weekly epoch timer starting at genesis.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewEpochInfo(DayEpochID, time.Time{}, 24*time.Hour),
+		NewEpochInfo(WeekEpochID, time.Time{}, 7*24*time.Hour),
+	})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+		if seen[epoch.Identifier] {
+			return fmt.Errorf("duplicate epoch identifier: %s", epoch.Identifier)
+		}
+		seen[epoch.Identifier] = true
+	}
+
+	return nil
+}
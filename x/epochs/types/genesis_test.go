@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genesis  *types.GenesisState
+		expError bool
+	}{
+		{"default", types.DefaultGenesisState(), false},
+		{"empty", types.NewGenesisState(nil), false},
+		{
+			"blank identifier",
+			types.NewGenesisState([]types.EpochInfo{types.NewEpochInfo(" ", time.Time{}, time.Hour)}),
+			true,
+		},
+		{
+			"zero duration",
+			types.NewGenesisState([]types.EpochInfo{types.NewEpochInfo("hourly", time.Time{}, 0)}),
+			true,
+		},
+		{
+			"duplicate identifier",
+			types.NewGenesisState([]types.EpochInfo{
+				types.NewEpochInfo("hourly", time.Time{}, time.Hour),
+				types.NewEpochInfo("hourly", time.Time{}, 2*time.Hour),
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks defines the hooks called by the epochs module when an epoch timer
+// ticks. Other modules can subscribe to them to run logic once per epoch
+// instead of once per block.
+type EpochHooks interface {
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)    // Must be called when an epoch ends
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) // Must be called when a new epoch starts
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// combine multiple epoch hooks, all hook functions are run in array sequence
+type MultiEpochHooks []EpochHooks
+
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range h {
+		h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range h {
+		h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	}
+}
@@ -0,0 +1,17 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// KeyPrefixEpoch defines the prefix under which the epoch timers are stored.
+var KeyPrefixEpoch = []byte{0x01}
+
+// EpochInfoKey returns the store key of the epoch timer with the given identifier.
+func EpochInfoKey(identifier string) []byte {
+	return append(KeyPrefixEpoch, []byte(identifier)...)
+}
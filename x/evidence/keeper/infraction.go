@@ -6,12 +6,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
-// jailed and tombstoned. Once tombstoned, the validator will not be able to
-// recover. Note, the evidence contains the block time and height at the time of
+// jailed and, by default, tombstoned as defined by the slashing module's
+// double sign infraction params. Once tombstoned, the validator will not be
+// able to recover. Note, the evidence contains the block time and height at the time of
 // the equivocation.
 //
 // The evidence is considered invalid if:
@@ -100,6 +102,10 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	// That's fine since this is just used to filter unbonding delegations & redelegations.
 	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
 
+	// The slash fraction, jail duration and tombstoning of double signing are
+	// defined by the slashing module's infraction params.
+	infractionParams, _ := k.slashingKeeper.InfractionParams(ctx, slashingtypes.InfractionDoubleSign)
+
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
@@ -107,7 +113,7 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		infractionParams.SlashFraction,
 		evidence.GetValidatorPower(), distributionHeight,
 	)
 
@@ -117,6 +123,8 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		k.slashingKeeper.Jail(ctx, consAddr)
 	}
 
-	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
-	k.slashingKeeper.Tombstone(ctx, consAddr)
+	k.slashingKeeper.JailUntil(ctx, consAddr, infractionParams.JailUntil(ctx.BlockHeader().Time))
+	if infractionParams.Tombstone {
+		k.slashingKeeper.Tombstone(ctx, consAddr)
+	}
 }
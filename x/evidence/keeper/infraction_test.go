@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
@@ -71,6 +72,54 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	tstaking.Undelegate(sdk.AccAddress(operatorAddr), operatorAddr, totalBond, true)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_InfractionParams() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0))
+	suite.populateValidators(ctx)
+
+	// punish double signing with a temporary jailing instead of tombstoning
+	slashingParams := suite.app.SlashingKeeper.GetParams(ctx)
+	slashingParams.InfractionParams = []slashingtypes.InfractionParams{
+		slashingtypes.NewInfractionParams(slashingtypes.InfractionDoubleSign, time.Hour, sdk.NewDecWithPrec(5, 1), false),
+	}
+	suite.app.SlashingKeeper.SetParams(ctx, slashingParams)
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.app.StakingKeeper)
+
+	selfDelegation := tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), selfDelegation.Int64(), true)
+
+	oldTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             ctx.BlockTime(),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+	}
+	suite.app.EvidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// should be jailed for an hour but not tombstoned
+	consAddr := sdk.ConsAddress(val.Address())
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	signingInfo, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Equal(ctx.BlockTime().Add(time.Hour).Unix(), signingInfo.JailedUntil.Unix())
+
+	// half of the tokens should be slashed
+	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.True(newTokens.Equal(oldTokens.QuoRaw(2)))
+
+	// require we cannot unjail before the jail duration elapsed
+	suite.Error(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+
+	// require we can unjail afterwards
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.NoError(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// members defines the JSON file format used to create or update the members
+// of a group.
+type members struct {
+	Members []types.Member `json:"members"`
+}
+
+// parseMembers reads and parses a JSON file containing group members, e.g.:
+//
+// {
+//   "members": [
+//     {
+//       "address": "addr1",
+//       "weight": "1",
+//       "metadata": "AQ=="
+//     }
+//   ]
+// }
+//
+// The metadata of the members is base64 encoded.
+func parseMembers(membersFile string) ([]types.Member, error) {
+	contents, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var m members
+	if err := json.Unmarshal(contents, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse members file %s: %w", membersFile, err)
+	}
+
+	return m.Members, nil
+}
+
+// parseDecisionPolicy parses a JSON encoded decision policy, e.g.:
+//
+// {"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy","threshold":"2","timeout":"86400s"}
+func parseDecisionPolicy(cdc codec.JSONMarshaler, policyJSON string) (types.DecisionPolicy, error) {
+	var policy types.DecisionPolicy
+	if err := cdc.UnmarshalInterfaceJSON([]byte(policyJSON), &policy); err != nil {
+		return nil, fmt.Errorf("failed to parse decision policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// parseMetadata decodes base64 encoded metadata.
+func parseMetadata(metadata string) ([]byte, error) {
+	bz, err := base64.StdEncoding.DecodeString(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("metadata is malformed, it must be base64 encoded: %w", err)
+	}
+
+	return bz, nil
+}
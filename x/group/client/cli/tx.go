@@ -0,0 +1,612 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// FlagExec defines the execution mode of proposals, it can be left empty or
+// set to "try" to execute the proposal right away.
+const FlagExec = "exec"
+
+// execTry is the FlagExec value to try executing a proposal right away
+const execTry = "try"
+
+// NewTxCmd returns the transaction commands for the group module
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCmdCreateGroup(),
+		NewCmdUpdateGroupMembers(),
+		NewCmdUpdateGroupAdmin(),
+		NewCmdUpdateGroupMetadata(),
+		NewCmdCreateGroupAccount(),
+		NewCmdUpdateGroupAccountAdmin(),
+		NewCmdUpdateGroupAccountDecisionPolicy(),
+		NewCmdUpdateGroupAccountMetadata(),
+		NewCmdCreateProposal(),
+		NewCmdVote(),
+		NewCmdExec(),
+	)
+
+	return txCmd
+}
+
+// NewCmdCreateGroup implements creating a new group.
+func NewCmdCreateGroup() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin] [metadata] [members-json-file]",
+		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group which is an aggregation of member accounts with associated
+weights and an administrator account. Note, the '--from' flag is ignored as it
+is implied from [admin]. The metadata is base64 encoded.
+
+Example:
+$ %s tx group create-group [admin] [metadata] [members-json-file]
+
+Where members.json contains:
+
+{
+	"members": [
+		{
+			"address": "addr1",
+			"weight": "1",
+			"metadata": "AQ=="
+		},
+		{
+			"address": "addr2",
+			"weight": "1",
+			"metadata": "AQ=="
+		}
+	]
+}
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseMetadata(args[1])
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGroup(clientCtx.GetFromAddress(), members, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupMembers implements updating the members of a group.
+func NewCmdUpdateGroupMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json-file]",
+		Short: "Update a group's members. Set a member's weight to \"0\" to delete it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group's members. Set a member's weight to "0" to delete it.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx group update-group-members [admin] [group-id] [members-json-file]
+
+Where members.json uses the same format as for the create-group command.
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMembers(clientCtx.GetFromAddress(), groupID, members)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAdmin implements updating the admin of a group.
+func NewCmdUpdateGroupAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-group-admin [admin] [group-id] [new-admin]",
+		Short: `Update a group's admin. Note, the '--from' flag is ignored as it is
+implied from [admin].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := clientCtx.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAdmin(clientCtx.GetFromAddress(), groupID, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupMetadata implements updating the metadata of a group.
+func NewCmdUpdateGroupMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-group-metadata [admin] [group-id] [metadata]",
+		Short: `Update a group's base64 encoded metadata. Note, the '--from' flag is
+ignored as it is implied from [admin].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseMetadata(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupMetadata(clientCtx.GetFromAddress(), groupID, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateGroupAccount implements creating a new group account.
+func NewCmdCreateGroupAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-account [admin] [group-id] [metadata] [decision-policy]",
+		Short: "Create a group account which is an account associated with a group and a decision policy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group account which is an account associated with a group and a
+decision policy. Note, the '--from' flag is ignored as it is implied from
+[admin]. The metadata is base64 encoded.
+
+Example:
+$ %s tx group create-group-account [admin] [group-id] [metadata] \
+'{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"1", "timeout":"600s"}'
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := parseGroupID(args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseMetadata(args[2])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx.JSONMarshaler, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateGroupAccount(clientCtx.GetFromAddress(), groupID, metadata, policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountAdmin implements updating the admin of a group
+// account.
+func NewCmdUpdateGroupAccountAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-group-account-admin [admin] [group-account] [new-admin]",
+		Short: `Update a group account's admin. Note, the '--from' flag is ignored as it
+is implied from [admin].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := clientCtx.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			newAdmin, err := clientCtx.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAccountAdmin(clientCtx.GetFromAddress(), address, newAdmin)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountDecisionPolicy implements updating the decision
+// policy of a group account.
+func NewCmdUpdateGroupAccountDecisionPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-group-account-policy [admin] [group-account] [decision-policy]",
+		Short: `Update a group account's decision policy. Note, the '--from' flag is
+ignored as it is implied from [admin].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := clientCtx.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			policy, err := parseDecisionPolicy(clientCtx.JSONMarshaler, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgUpdateGroupAccountDecisionPolicy(clientCtx.GetFromAddress(), address, policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUpdateGroupAccountMetadata implements updating the metadata of a group
+// account.
+func NewCmdUpdateGroupAccountMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "update-group-account-metadata [admin] [group-account] [metadata]",
+		Short: `Update a group account's base64 encoded metadata. Note, the '--from' flag
+is ignored as it is implied from [admin].`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := clientCtx.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseMetadata(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateGroupAccountMetadata(clientCtx.GetFromAddress(), address, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdCreateProposal implements submitting a new proposal to a group
+// account.
+func NewCmdCreateProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-proposal [group-account] [proposer[,proposer]*] [msg-tx-json-file] [metadata]",
+		Short: "Submit a new proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a new proposal containing the messages of a transaction generated
+with the --generate-only flag and signed by the group account. Note, the
+'--from' flag is ignored as it is implied from the first proposer. The metadata
+is base64 encoded.
+
+Example:
+$ %s tx bank send [group-account] [recipient] 10stake --generate-only > msg_tx.json
+$ %s tx group create-proposal [group-account] [proposer] msg_tx.json AQ== --exec try
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposers := strings.Split(args[1], ",")
+			for i := range proposers {
+				proposers[i] = strings.TrimSpace(proposers[i])
+			}
+
+			cmd.Flags().Set(flags.FlagFrom, proposers[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := clientCtx.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseMetadata(args[3])
+			if err != nil {
+				return err
+			}
+
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgCreateProposal(address, proposers, theTx.GetMsgs(), metadata, exec)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to \"try\" to try to execute the proposal immediately after creation (proposers' signatures are considered as Yes votes)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdVote implements voting on a proposal.
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter] [choice] [metadata]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal. Note, the '--from' flag is ignored as it is implied
+from [voter]. The metadata is base64 encoded.
+
+The choice is one of CHOICE_YES, CHOICE_NO, CHOICE_ABSTAIN or CHOICE_VETO.
+
+Example:
+$ %s tx group vote 1 [voter] CHOICE_YES AQ== --exec try
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[1])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			choice, err := types.ChoiceFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseMetadata(args[3])
+			if err != nil {
+				return err
+			}
+
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(clientCtx.GetFromAddress(), proposalID, choice, metadata, exec)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to \"try\" to try to execute the proposal immediately after voting")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdExec implements executing an accepted proposal.
+func NewCmdExec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Short: "Execute a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := parseProposalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(clientCtx.GetFromAddress(), proposalID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseGroupID(arg string) (uint64, error) {
+	groupID, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("group-id %s not a valid uint, please input a valid group-id", arg)
+	}
+
+	return groupID, nil
+}
+
+func parseProposalID(arg string) (uint64, error) {
+	proposalID, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", arg)
+	}
+
+	return proposalID, nil
+}
+
+func parseExec(cmd *cobra.Command) (types.Exec, error) {
+	execStr, err := cmd.Flags().GetString(FlagExec)
+	if err != nil {
+		return types.ExecUnspecified, err
+	}
+
+	switch execStr {
+	case "":
+		return types.ExecUnspecified, nil
+	case execTry:
+		return types.ExecTry, nil
+	default:
+		return types.ExecUnspecified, fmt.Errorf("invalid --%s value %q, only %q is supported", FlagExec, execStr, execTry)
+	}
+}
@@ -0,0 +1,63 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// InitGenesis initializes the group module's state from a given genesis state.
+// The accounts backing the group accounts are expected to be part of the auth
+// genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetGroupSeq(ctx, data.GroupSeq)
+	k.SetGroupAccountSeq(ctx, data.GroupAccountSeq)
+	k.SetProposalSeq(ctx, data.ProposalSeq)
+
+	for _, group := range data.Groups {
+		k.SetGroupInfo(ctx, group)
+	}
+	for _, member := range data.GroupMembers {
+		k.SetGroupMember(ctx, member)
+	}
+	for _, account := range data.GroupAccounts {
+		k.SetGroupAccountInfo(ctx, account)
+	}
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.NewGenesisState()
+	genesis.GroupSeq = k.GetGroupSeq(ctx)
+	genesis.GroupAccountSeq = k.GetGroupAccountSeq(ctx)
+	genesis.ProposalSeq = k.GetProposalSeq(ctx)
+
+	k.IterateGroupInfos(ctx, func(group types.GroupInfo) bool {
+		genesis.Groups = append(genesis.Groups, group)
+		return false
+	})
+	k.IterateAllGroupMembers(ctx, func(member types.GroupMember) bool {
+		genesis.GroupMembers = append(genesis.GroupMembers, member)
+		return false
+	})
+	k.IterateGroupAccountInfos(ctx, func(account types.GroupAccountInfo) bool {
+		genesis.GroupAccounts = append(genesis.GroupAccounts, account)
+		return false
+	})
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		genesis.Proposals = append(genesis.Proposals, proposal)
+		return false
+	})
+	k.IterateVotes(ctx, func(vote types.Vote) bool {
+		genesis.Votes = append(genesis.Votes, vote)
+		return false
+	})
+
+	return genesis
+}
@@ -0,0 +1,72 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(now)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	groupID, err := app.GroupKeeper.CreateGroup(ctx, addrs[0], []types.Member{
+		types.NewMember(addrs[0], sdk.NewDec(1), nil),
+		types.NewMember(addrs[1], sdk.NewDec(2), nil),
+	}, []byte("group"))
+	require.NoError(t, err)
+
+	address, err := app.GroupKeeper.CreateGroupAccount(
+		ctx, addrs[0], groupID, nil, types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour),
+	)
+	require.NoError(t, err)
+
+	send := banktypes.NewMsgSend(address, addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	proposalID, err := app.GroupKeeper.CreateProposal(ctx, address, []string{addrs[0].String()}, []sdk.Msg{send}, nil)
+	require.NoError(t, err)
+	require.NoError(t, app.GroupKeeper.Vote(ctx, proposalID, addrs[1], types.ChoiceYes, nil))
+
+	exported := group.ExportGenesis(ctx, app.GroupKeeper)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, uint64(1), exported.GroupSeq)
+	require.Equal(t, uint64(1), exported.GroupAccountSeq)
+	require.Equal(t, uint64(1), exported.ProposalSeq)
+	require.Len(t, exported.Groups, 1)
+	require.Len(t, exported.GroupMembers, 2)
+	require.Len(t, exported.GroupAccounts, 1)
+	require.Len(t, exported.Proposals, 1)
+	require.Len(t, exported.Votes, 1)
+
+	// the genesis state survives a JSON round trip, including the decision
+	// policies and proposal messages
+	cdc := app.AppCodec()
+	var genesis types.GenesisState
+	cdc.MustUnmarshalJSON(cdc.MustMarshalJSON(exported), &genesis)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(now)
+	group.InitGenesis(ctx2, app2.GroupKeeper, genesis)
+
+	require.Equal(t, cdc.MustMarshalJSON(exported), cdc.MustMarshalJSON(group.ExportGenesis(ctx2, app2.GroupKeeper)))
+
+	proposal, found := app2.GroupKeeper.GetProposal(ctx2, proposalID)
+	require.True(t, found)
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	// new entities get the next IDs
+	groupID2, err := app2.GroupKeeper.CreateGroup(ctx2, addrs[0], nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), groupID2)
+}
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetGroupInfo returns a group from its ID.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, groupID uint64) (types.GroupInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupInfoKey(groupID))
+	if bz == nil {
+		return types.GroupInfo{}, false
+	}
+
+	var group types.GroupInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &group)
+	return group, true
+}
+
+// SetGroupInfo stores a group and indexes it by its admin. The caller is
+// responsible for removing the admin index of a previous admin.
+func (k Keeper) SetGroupInfo(ctx sdk.Context, group types.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupInfoKey(group.GroupId), k.cdc.MustMarshalBinaryBare(&group))
+
+	admin, err := sdk.AccAddressFromBech32(group.Admin)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GroupByAdminKey(admin, group.GroupId), []byte{})
+}
+
+// IterateGroupInfos iterates over all the groups and performs a callback
+// function.
+func (k Keeper) IterateGroupInfos(ctx sdk.Context, cb func(group types.GroupInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupInfoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var group types.GroupInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &group)
+
+		if cb(group) {
+			break
+		}
+	}
+}
+
+// GetGroupMember returns the member of a group.
+func (k Keeper) GetGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) (types.GroupMember, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupMemberKey(groupID, member))
+	if bz == nil {
+		return types.GroupMember{}, false
+	}
+
+	var groupMember types.GroupMember
+	k.cdc.MustUnmarshalBinaryBare(bz, &groupMember)
+	return groupMember, true
+}
+
+// SetGroupMember stores the member of a group.
+func (k Keeper) SetGroupMember(ctx sdk.Context, groupMember types.GroupMember) {
+	member, err := sdk.AccAddressFromBech32(groupMember.Member.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		types.GroupMemberKey(groupMember.GroupId, member), k.cdc.MustMarshalBinaryBare(&groupMember),
+	)
+}
+
+// DeleteGroupMember removes a member from a group.
+func (k Keeper) DeleteGroupMember(ctx sdk.Context, groupID uint64, member sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GroupMemberKey(groupID, member))
+}
+
+// IterateGroupMembers iterates over the members of a group and performs a
+// callback function.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, groupID uint64, cb func(member types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMembersKey(groupID), cb)
+}
+
+// IterateAllGroupMembers iterates over the members of all the groups and
+// performs a callback function.
+func (k Keeper) IterateAllGroupMembers(ctx sdk.Context, cb func(member types.GroupMember) (stop bool)) {
+	k.iterateGroupMembers(ctx, types.GroupMemberPrefix, cb)
+}
+
+func (k Keeper) iterateGroupMembers(ctx sdk.Context, prefix []byte, cb func(member types.GroupMember) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var member types.GroupMember
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &member)
+
+		if cb(member) {
+			break
+		}
+	}
+}
+
+// CreateGroup creates a new group with the given admin and members and returns
+// its ID.
+func (k Keeper) CreateGroup(ctx sdk.Context, admin sdk.AccAddress, members []types.Member, metadata []byte) (uint64, error) {
+	if err := types.ValidateMembers(members, false); err != nil {
+		return 0, err
+	}
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return 0, err
+	}
+
+	groupID := k.nextSeq(ctx, types.GroupSeqKey)
+	totalWeight := sdk.ZeroDec()
+	for _, m := range members {
+		totalWeight = totalWeight.Add(m.Weight)
+		k.SetGroupMember(ctx, types.GroupMember{GroupId: groupID, Member: m})
+	}
+
+	k.SetGroupInfo(ctx, types.GroupInfo{
+		GroupId:     groupID,
+		Admin:       admin.String(),
+		Metadata:    metadata,
+		Version:     1,
+		TotalWeight: totalWeight,
+	})
+
+	return groupID, nil
+}
+
+// UpdateGroupMembers adds, updates or removes the members of a group. A
+// member with a zero weight is removed from the group. The version of the
+// group is incremented so that pending proposals are aborted.
+func (k Keeper) UpdateGroupMembers(ctx sdk.Context, groupID uint64, memberUpdates []types.Member) error {
+	group, found := k.GetGroupInfo(ctx, groupID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	if err := types.ValidateMembers(memberUpdates, true); err != nil {
+		return err
+	}
+
+	totalWeight := group.TotalWeight
+	for _, m := range memberUpdates {
+		addr, err := sdk.AccAddressFromBech32(m.Address)
+		if err != nil {
+			return err
+		}
+
+		previous, found := k.GetGroupMember(ctx, groupID, addr)
+		if found {
+			totalWeight = totalWeight.Sub(previous.Member.Weight)
+		}
+
+		if m.Weight.IsZero() {
+			if !found {
+				return sdkerrors.Wrapf(types.ErrNotFound, "member %s of group %d", m.Address, groupID)
+			}
+			k.DeleteGroupMember(ctx, groupID, addr)
+			continue
+		}
+
+		totalWeight = totalWeight.Add(m.Weight)
+		k.SetGroupMember(ctx, types.GroupMember{GroupId: groupID, Member: m})
+	}
+
+	group.TotalWeight = totalWeight
+	group.Version++
+	k.SetGroupInfo(ctx, group)
+
+	return nil
+}
+
+// UpdateGroupAdmin sets a new admin for a group.
+func (k Keeper) UpdateGroupAdmin(ctx sdk.Context, groupID uint64, newAdmin sdk.AccAddress) error {
+	group, found := k.GetGroupInfo(ctx, groupID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+
+	oldAdmin, err := sdk.AccAddressFromBech32(group.Admin)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GroupByAdminKey(oldAdmin, groupID))
+
+	group.Admin = newAdmin.String()
+	group.Version++
+	k.SetGroupInfo(ctx, group)
+
+	return nil
+}
+
+// UpdateGroupMetadata sets new metadata for a group.
+func (k Keeper) UpdateGroupMetadata(ctx sdk.Context, groupID uint64, metadata []byte) error {
+	group, found := k.GetGroupInfo(ctx, groupID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return err
+	}
+
+	group.Metadata = metadata
+	group.Version++
+	k.SetGroupInfo(ctx, group)
+
+	return nil
+}
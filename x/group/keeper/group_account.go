@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetGroupAccountInfo returns a group account from its address.
+func (k Keeper) GetGroupAccountInfo(ctx sdk.Context, address sdk.AccAddress) (types.GroupAccountInfo, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GroupAccountInfoKey(address))
+	if bz == nil {
+		return types.GroupAccountInfo{}, false
+	}
+
+	var info types.GroupAccountInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+// SetGroupAccountInfo stores a group account and indexes it by its group and
+// admin. The caller is responsible for removing the admin index of a previous
+// admin.
+func (k Keeper) SetGroupAccountInfo(ctx sdk.Context, info types.GroupAccountInfo) {
+	address, err := sdk.AccAddressFromBech32(info.Address)
+	if err != nil {
+		panic(err)
+	}
+	admin, err := sdk.AccAddressFromBech32(info.Admin)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GroupAccountInfoKey(address), k.cdc.MustMarshalBinaryBare(&info))
+	store.Set(types.GroupAccountByGroupKey(info.GroupId, address), []byte{})
+	store.Set(types.GroupAccountByAdminKey(admin, address), []byte{})
+}
+
+// IterateGroupAccountInfos iterates over all the group accounts and performs a
+// callback function.
+func (k Keeper) IterateGroupAccountInfos(ctx sdk.Context, cb func(info types.GroupAccountInfo) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GroupAccountInfoKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.GroupAccountInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &info)
+
+		if cb(info) {
+			break
+		}
+	}
+}
+
+// CreateGroupAccount creates a new group account for a group and returns its
+// address. The address is derived from the group account sequence and backed
+// by a module account so that it can hold funds but never sign transactions.
+func (k Keeper) CreateGroupAccount(
+	ctx sdk.Context, admin sdk.AccAddress, groupID uint64, metadata []byte, policy types.DecisionPolicy,
+) (sdk.AccAddress, error) {
+	group, found := k.GetGroupInfo(ctx, groupID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	if err := policy.Validate(group); err != nil {
+		return nil, err
+	}
+
+	var address sdk.AccAddress
+	for {
+		seq := k.nextSeq(ctx, types.GroupAccountSeqKey)
+		name := fmt.Sprintf("%s/%d", types.ModuleName, seq)
+		address = authtypes.NewModuleAddress(name)
+
+		// skip the addresses that already received funds
+		if k.accKeeper.GetAccount(ctx, address) != nil {
+			continue
+		}
+
+		account := k.accKeeper.NewAccount(
+			ctx, authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(address), name),
+		)
+		k.accKeeper.SetAccount(ctx, account)
+		break
+	}
+
+	info, err := types.NewGroupAccountInfo(address, groupID, admin, metadata, 1, policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := info.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	k.SetGroupAccountInfo(ctx, info)
+	return address, nil
+}
+
+// UpdateGroupAccountAdmin sets a new admin for a group account.
+func (k Keeper) UpdateGroupAccountAdmin(ctx sdk.Context, address, newAdmin sdk.AccAddress) error {
+	info, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group account %s", address)
+	}
+
+	oldAdmin, err := sdk.AccAddressFromBech32(info.Admin)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.GroupAccountByAdminKey(oldAdmin, address))
+
+	info.Admin = newAdmin.String()
+	info.Version++
+	k.SetGroupAccountInfo(ctx, info)
+
+	return nil
+}
+
+// UpdateGroupAccountDecisionPolicy sets a new decision policy for a group
+// account.
+func (k Keeper) UpdateGroupAccountDecisionPolicy(ctx sdk.Context, address sdk.AccAddress, policy types.DecisionPolicy) error {
+	info, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group account %s", address)
+	}
+	group, found := k.GetGroupInfo(ctx, info.GroupId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group %d", info.GroupId)
+	}
+	if err := policy.Validate(group); err != nil {
+		return err
+	}
+
+	if err := info.SetDecisionPolicy(policy); err != nil {
+		return err
+	}
+	info.Version++
+	k.SetGroupAccountInfo(ctx, info)
+
+	return nil
+}
+
+// UpdateGroupAccountMetadata sets new metadata for a group account.
+func (k Keeper) UpdateGroupAccountMetadata(ctx sdk.Context, address sdk.AccAddress, metadata []byte) error {
+	info, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group account %s", address)
+	}
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return err
+	}
+
+	info.Metadata = metadata
+	info.Version++
+	k.SetGroupAccountInfo(ctx, info)
+
+	return nil
+}
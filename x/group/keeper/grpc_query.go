@@ -0,0 +1,327 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GroupInfo queries a group by its ID
+func (k Keeper) GroupInfo(c context.Context, req *types.QueryGroupInfoRequest) (*types.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GroupId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "group id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	group, found := k.GetGroupInfo(ctx, req.GroupId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "group %d doesn't exist", req.GroupId)
+	}
+
+	return &types.QueryGroupInfoResponse{Info: &group}, nil
+}
+
+// GroupAccountInfo queries a group account by its address
+func (k Keeper) GroupAccountInfo(c context.Context, req *types.QueryGroupAccountInfoRequest) (*types.QueryGroupAccountInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "group account %s doesn't exist", req.Address)
+	}
+
+	return &types.QueryGroupAccountInfoResponse{Info: &info}, nil
+}
+
+// GroupMembers queries the members of a group
+func (k Keeper) GroupMembers(c context.Context, req *types.QueryGroupMembersRequest) (*types.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GroupId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "group id can not be 0")
+	}
+
+	var members []*types.GroupMember
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupMembersKey(req.GroupId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var member types.GroupMember
+		if err := k.cdc.UnmarshalBinaryBare(value, &member); err != nil {
+			return err
+		}
+
+		members = append(members, &member)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+// GroupsByAdmin queries the groups administered by an account
+func (k Keeper) GroupsByAdmin(c context.Context, req *types.QueryGroupsByAdminRequest) (*types.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var groups []*types.GroupInfo
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupsByAdminKey(admin))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		group, found := k.GetGroupInfo(ctx, types.GetIDFromBytes(key))
+		if !found {
+			return types.ErrNotFound
+		}
+
+		groups = append(groups, &group)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupsByAdminResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// GroupAccountsByGroup queries the group accounts of a group
+func (k Keeper) GroupAccountsByGroup(c context.Context, req *types.QueryGroupAccountsByGroupRequest) (*types.QueryGroupAccountsByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GroupId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "group id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupAccountsByGroupKey(req.GroupId))
+	accounts, pageRes, err := k.paginateGroupAccounts(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupAccountsByGroupResponse{GroupAccounts: accounts, Pagination: pageRes}, nil
+}
+
+// GroupAccountsByAdmin queries the group accounts administered by an account
+func (k Keeper) GroupAccountsByAdmin(c context.Context, req *types.QueryGroupAccountsByAdminRequest) (*types.QueryGroupAccountsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GroupAccountsByAdminKey(admin))
+	accounts, pageRes, err := k.paginateGroupAccounts(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGroupAccountsByAdminResponse{GroupAccounts: accounts, Pagination: pageRes}, nil
+}
+
+// Proposal queries a proposal by its ID
+func (k Keeper) Proposal(c context.Context, req *types.QueryProposalRequest) (*types.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &types.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// ProposalsByGroupAccount queries the proposals of a group account
+func (k Keeper) ProposalsByGroupAccount(c context.Context, req *types.QueryProposalsByGroupAccountRequest) (*types.QueryProposalsByGroupAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var proposals []*types.Proposal
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProposalsByGroupAccountKey(address))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		proposal, found := k.GetProposal(ctx, types.GetIDFromBytes(key))
+		if !found {
+			return types.ErrNotFound
+		}
+
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposalsByGroupAccountResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// VoteByProposalVoter queries the vote of a voter on a proposal
+func (k Keeper) VoteByProposalVoter(c context.Context, req *types.QueryVoteByProposalVoterRequest) (*types.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	vote, found := k.GetVote(ctx, req.ProposalId, voter)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "voter %s not found for proposal %d", req.Voter, req.ProposalId)
+	}
+
+	return &types.QueryVoteByProposalVoterResponse{Vote: &vote}, nil
+}
+
+// VotesByProposal queries the votes on a proposal
+func (k Keeper) VotesByProposal(c context.Context, req *types.QueryVotesByProposalRequest) (*types.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	var votes []*types.Vote
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotesByProposalKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var vote types.Vote
+		if err := k.cdc.UnmarshalBinaryBare(value, &vote); err != nil {
+			return err
+		}
+
+		votes = append(votes, &vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByProposalResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// VotesByVoter queries the votes of a voter
+func (k Keeper) VotesByVoter(c context.Context, req *types.QueryVotesByVoterRequest) (*types.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var votes []*types.Vote
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotesByVoterKey(voter))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		vote, found := k.GetVote(ctx, types.GetIDFromBytes(key), voter)
+		if !found {
+			return types.ErrNotFound
+		}
+
+		votes = append(votes, &vote)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotesByVoterResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// paginateGroupAccounts paginates over an index store keyed by group account
+// addresses.
+func (k Keeper) paginateGroupAccounts(
+	ctx sdk.Context, store prefix.Store, pageReq *query.PageRequest,
+) ([]*types.GroupAccountInfo, *query.PageResponse, error) {
+	var accounts []*types.GroupAccountInfo
+
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		info, found := k.GetGroupAccountInfo(ctx, sdk.AccAddress(key))
+		if !found {
+			return types.ErrNotFound
+		}
+
+		accounts = append(accounts, &info)
+		return nil
+	})
+
+	return accounts, pageRes, err
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// Keeper manages groups, group accounts, proposals and votes.
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryMarshaler
+	accKeeper types.AccountKeeper
+
+	// router and msgServiceRouter are used to execute the messages of
+	// accepted proposals.
+	router           sdk.Router
+	msgServiceRouter *baseapp.MsgServiceRouter
+}
+
+// NewKeeper creates a new group Keeper instance. The router and
+// msgServiceRouter are the application's legacy message router and Msg
+// service router, they are used to execute the messages of accepted proposals
+// on behalf of group accounts.
+func NewKeeper(
+	cdc codec.BinaryMarshaler, key sdk.StoreKey, accKeeper types.AccountKeeper, router sdk.Router,
+	msgServiceRouter *baseapp.MsgServiceRouter,
+) Keeper {
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		accKeeper:        accKeeper,
+		router:           router,
+		msgServiceRouter: msgServiceRouter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetGroupSeq returns the last group ID that was assigned.
+func (k Keeper) GetGroupSeq(ctx sdk.Context) uint64 {
+	return k.getSeq(ctx, types.GroupSeqKey)
+}
+
+// SetGroupSeq sets the last group ID that was assigned.
+func (k Keeper) SetGroupSeq(ctx sdk.Context, seq uint64) {
+	k.setSeq(ctx, types.GroupSeqKey, seq)
+}
+
+// GetGroupAccountSeq returns the sequence used to derive the address of the
+// last group account.
+func (k Keeper) GetGroupAccountSeq(ctx sdk.Context) uint64 {
+	return k.getSeq(ctx, types.GroupAccountSeqKey)
+}
+
+// SetGroupAccountSeq sets the sequence used to derive the address of the last
+// group account.
+func (k Keeper) SetGroupAccountSeq(ctx sdk.Context, seq uint64) {
+	k.setSeq(ctx, types.GroupAccountSeqKey, seq)
+}
+
+// GetProposalSeq returns the last proposal ID that was assigned.
+func (k Keeper) GetProposalSeq(ctx sdk.Context) uint64 {
+	return k.getSeq(ctx, types.ProposalSeqKey)
+}
+
+// SetProposalSeq sets the last proposal ID that was assigned.
+func (k Keeper) SetProposalSeq(ctx sdk.Context, seq uint64) {
+	k.setSeq(ctx, types.ProposalSeqKey, seq)
+}
+
+func (k Keeper) getSeq(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return types.GetIDFromBytes(bz)
+}
+
+func (k Keeper) setSeq(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, types.GetIDBytes(seq))
+}
+
+// nextSeq increments the sequence stored under key and returns its new value.
+func (k Keeper) nextSeq(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSeq(ctx, key) + 1
+	k.setSeq(ctx, key, seq)
+	return seq
+}
@@ -0,0 +1,318 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+	blockTime   time.Time
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	suite.blockTime = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(suite.blockTime)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.GroupKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.msgServer = keeper.NewMsgServerImpl(app.GroupKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 4, sdk.NewInt(30000000))
+}
+
+// createGroupAndAccount creates a group administered by addrs[0] whose members
+// are addrs[0], addrs[1] and addrs[2] with weights 1, 2 and 3, and a group
+// account with the given decision policy.
+func (suite *KeeperTestSuite) createGroupAndAccount(policy types.DecisionPolicy) (uint64, sdk.AccAddress) {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	groupRes, err := suite.msgServer.CreateGroup(ctx, types.NewMsgCreateGroup(suite.addrs[0], []types.Member{
+		types.NewMember(suite.addrs[0], sdk.NewDec(1), nil),
+		types.NewMember(suite.addrs[1], sdk.NewDec(2), nil),
+		types.NewMember(suite.addrs[2], sdk.NewDec(3), nil),
+	}, []byte("group")))
+	suite.Require().NoError(err)
+
+	msg, err := types.NewMsgCreateGroupAccount(suite.addrs[0], groupRes.GroupId, []byte("account"), policy)
+	suite.Require().NoError(err)
+	accountRes, err := suite.msgServer.CreateGroupAccount(ctx, msg)
+	suite.Require().NoError(err)
+
+	address, err := sdk.AccAddressFromBech32(accountRes.Address)
+	suite.Require().NoError(err)
+
+	return groupRes.GroupId, address
+}
+
+// createSendProposal creates a proposal sending coins from the group account
+// to addrs[3].
+func (suite *KeeperTestSuite) createSendProposal(address sdk.AccAddress, exec types.Exec, proposers ...sdk.AccAddress) (uint64, error) {
+	var proposerAddrs []string
+	for _, p := range proposers {
+		proposerAddrs = append(proposerAddrs, p.String())
+	}
+
+	send := banktypes.NewMsgSend(address, suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	msg, err := types.NewMsgCreateProposal(address, proposerAddrs, []sdk.Msg{send}, nil, exec)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.CreateProposal(sdk.WrapSDKContext(suite.ctx), msg)
+	if err != nil {
+		return 0, err
+	}
+	return res.ProposalId, nil
+}
+
+func (suite *KeeperTestSuite) TestCreateGroup() {
+	app, ctx := suite.app, suite.ctx
+
+	groupID, address := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+	suite.Require().Equal(uint64(1), groupID)
+
+	group, found := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.addrs[0].String(), group.Admin)
+	suite.Require().Equal(uint64(1), group.Version)
+	suite.Require().Equal(sdk.NewDec(6), group.TotalWeight)
+
+	// the group account is backed by a module account
+	acc := app.AccountKeeper.GetAccount(ctx, address)
+	suite.Require().NotNil(acc)
+	_, ok := acc.(authtypes.ModuleAccountI)
+	suite.Require().True(ok)
+
+	info, found := app.GroupKeeper.GetGroupAccountInfo(ctx, address)
+	suite.Require().True(found)
+	suite.Require().Equal(groupID, info.GroupId)
+	suite.Require().Equal(sdk.NewDec(3), info.GetDecisionPolicy().(*types.ThresholdDecisionPolicy).Threshold)
+
+	// group accounts get distinct addresses
+	_, address2 := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+	suite.Require().NotEqual(address, address2)
+}
+
+func (suite *KeeperTestSuite) TestUpdateGroup() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID, _ := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+
+	// only the admin can update the group
+	_, err := suite.msgServer.UpdateGroupMetadata(goCtx, types.NewMsgUpdateGroupMetadata(suite.addrs[1], groupID, []byte("new")))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// remove addrs[0], update addrs[1] and add addrs[3]
+	_, err = suite.msgServer.UpdateGroupMembers(goCtx, types.NewMsgUpdateGroupMembers(suite.addrs[0], groupID, []types.Member{
+		types.NewMember(suite.addrs[0], sdk.ZeroDec(), nil),
+		types.NewMember(suite.addrs[1], sdk.NewDec(5), nil),
+		types.NewMember(suite.addrs[3], sdk.NewDec(4), nil),
+	}))
+	suite.Require().NoError(err)
+
+	group, _ := app.GroupKeeper.GetGroupInfo(ctx, groupID)
+	suite.Require().Equal(uint64(2), group.Version)
+	suite.Require().Equal(sdk.NewDec(12), group.TotalWeight)
+
+	_, found := app.GroupKeeper.GetGroupMember(ctx, groupID, suite.addrs[0])
+	suite.Require().False(found)
+	member, found := app.GroupKeeper.GetGroupMember(ctx, groupID, suite.addrs[1])
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(5), member.Member.Weight)
+
+	_, err = suite.msgServer.UpdateGroupAdmin(goCtx, types.NewMsgUpdateGroupAdmin(suite.addrs[0], groupID, suite.addrs[3]))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: suite.addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Groups)
+
+	res, err = suite.queryClient.GroupsByAdmin(goCtx, &types.QueryGroupsByAdminRequest{Admin: suite.addrs[3].String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Groups, 1)
+	suite.Require().Equal(uint64(3), res.Groups[0].Version)
+}
+
+func (suite *KeeperTestSuite) TestProposalExecution() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, address := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	balanceBefore := app.BankKeeper.GetBalance(ctx, suite.addrs[3], sdk.DefaultBondDenom)
+
+	// proposers must be group members
+	_, err := suite.createSendProposal(address, types.ExecUnspecified, suite.addrs[3])
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	proposalID, err := suite.createSendProposal(address, types.ExecUnspecified, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	// addrs[0] votes yes, the threshold of 3 is not reached
+	_, err = suite.msgServer.Vote(goCtx, types.NewMsgVote(suite.addrs[0], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Vote(goCtx, types.NewMsgVote(suite.addrs[0], proposalID, types.ChoiceNo, nil, types.ExecUnspecified))
+	suite.Require().ErrorIs(err, types.ErrDuplicate)
+	_, err = suite.msgServer.Vote(goCtx, types.NewMsgVote(suite.addrs[3], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.Exec(goCtx, types.NewMsgExec(suite.addrs[3], proposalID))
+	suite.Require().NoError(err)
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusSubmitted, proposal.Status)
+	suite.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+
+	// addrs[1] votes yes, the threshold is reached and the proposal is executed
+	_, err = suite.msgServer.Vote(goCtx, types.NewMsgVote(suite.addrs[1], proposalID, types.ChoiceYes, nil, types.ExecTry))
+	suite.Require().NoError(err)
+
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	suite.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	suite.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	suite.Require().Equal(sdk.NewDec(3), proposal.VoteState.YesCount)
+
+	suite.Require().Equal(int64(900), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount.Int64())
+	suite.Require().Equal(balanceBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), app.BankKeeper.GetBalance(ctx, suite.addrs[3], sdk.DefaultBondDenom))
+
+	// a successful proposal is not executed twice
+	_, err = suite.msgServer.Exec(goCtx, types.NewMsgExec(suite.addrs[3], proposalID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(900), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestServiceMsgProposalExecution() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, address := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	balanceBefore := app.BankKeeper.GetBalance(ctx, suite.addrs[3], sdk.DefaultBondDenom)
+
+	// the bank Msg service method has no legacy route, it can only be
+	// executed through the MsgServiceRouter
+	send := sdk.ServiceMsg{
+		MethodName: "/cosmos.bank.v1beta1.Msg/Send",
+		Request:    banktypes.NewMsgSend(address, suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))),
+	}
+	msg, err := types.NewMsgCreateProposal(address, []string{suite.addrs[2].String()}, []sdk.Msg{send}, nil, types.ExecUnspecified)
+	suite.Require().NoError(err)
+	res, err := suite.msgServer.CreateProposal(goCtx, msg)
+	suite.Require().NoError(err)
+
+	proposal, found := app.GroupKeeper.GetProposal(ctx, res.ProposalId)
+	suite.Require().True(found)
+	msgs, err := proposal.GetMsgs()
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Msg{send}, msgs)
+
+	_, err = suite.msgServer.Vote(goCtx, types.NewMsgVote(suite.addrs[2], res.ProposalId, types.ChoiceYes, nil, types.ExecTry))
+	suite.Require().NoError(err)
+
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, res.ProposalId)
+	suite.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	suite.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	suite.Require().Equal(int64(900), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount.Int64())
+	suite.Require().Equal(balanceBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), app.BankKeeper.GetBalance(ctx, suite.addrs[3], sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestProposalExecutionFailure() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, address := suite.createGroupAndAccount(types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour))
+
+	// the group account has no funds, the proposal is accepted but its
+	// execution fails
+	proposalID, err := suite.createSendProposal(address, types.ExecTry, suite.addrs[2])
+	suite.Require().NoError(err)
+
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	suite.Require().Equal(types.ProposalResultAccepted, proposal.Result)
+	suite.Require().Equal(types.ProposalExecutorResultFailure, proposal.ExecutorResult)
+
+	// the execution can be retried once the group account is funded
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+	_, err = suite.msgServer.Exec(goCtx, types.NewMsgExec(suite.addrs[3], proposalID))
+	suite.Require().NoError(err)
+
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalExecutorResultSuccess, proposal.ExecutorResult)
+	suite.Require().True(app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestProposalAbortedAndExpired() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	groupID, address := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+
+	// the group is modified after the proposal submission
+	proposalID, err := suite.createSendProposal(address, types.ExecUnspecified, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.UpdateGroupMetadata(goCtx, types.NewMsgUpdateGroupMetadata(suite.addrs[0], groupID, []byte("new")))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Vote(goCtx, types.NewMsgVote(suite.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	suite.Require().ErrorIs(err, types.ErrModified)
+
+	_, err = suite.msgServer.Exec(goCtx, types.NewMsgExec(suite.addrs[0], proposalID))
+	suite.Require().NoError(err)
+	proposal, _ := app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusAborted, proposal.Status)
+
+	// the voting period of the proposal ends
+	proposalID, err = suite.createSendProposal(address, types.ExecUnspecified, suite.addrs[0])
+	suite.Require().NoError(err)
+
+	expiredCtx := sdk.WrapSDKContext(ctx.WithBlockTime(suite.blockTime.Add(time.Hour)))
+	_, err = suite.msgServer.Vote(expiredCtx, types.NewMsgVote(suite.addrs[2], proposalID, types.ChoiceYes, nil, types.ExecUnspecified))
+	suite.Require().ErrorIs(err, types.ErrExpired)
+
+	_, err = suite.msgServer.Exec(expiredCtx, types.NewMsgExec(suite.addrs[0], proposalID))
+	suite.Require().NoError(err)
+	proposal, _ = app.GroupKeeper.GetProposal(ctx, proposalID)
+	suite.Require().Equal(types.ProposalStatusClosed, proposal.Status)
+	suite.Require().Equal(types.ProposalResultRejected, proposal.Result)
+	suite.Require().Equal(types.ProposalExecutorResultNotRun, proposal.ExecutorResult)
+}
+
+func (suite *KeeperTestSuite) TestProposalUnauthorizedMsgs() {
+	_, address := suite.createGroupAndAccount(types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour))
+
+	// the messages of a proposal must be signed by the group account
+	send := banktypes.NewMsgSend(suite.addrs[0], suite.addrs[3], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	msg, err := types.NewMsgCreateProposal(address, []string{suite.addrs[0].String()}, []sdk.Msg{send}, nil, types.ExecUnspecified)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CreateProposal(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
@@ -0,0 +1,353 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the group MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) CreateGroup(goCtx context.Context, msg *types.MsgCreateGroup) (*types.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := k.Keeper.CreateGroup(ctx, admin, msg.Members, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+		newMessageEvent(msg.Admin),
+	})
+
+	return &types.MsgCreateGroupResponse{GroupId: groupID}, nil
+}
+
+func (k msgServer) UpdateGroupMembers(goCtx context.Context, msg *types.MsgUpdateGroupMembers) (*types.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertGroupAdmin(ctx, msg.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateGroupMembers(ctx, msg.GroupId, msg.MemberUpdates); err != nil {
+		return nil, err
+	}
+
+	emitUpdateGroupEvents(ctx, msg.GroupId, msg.Admin)
+	return &types.MsgUpdateGroupMembersResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAdmin) (*types.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newAdmin, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.assertGroupAdmin(ctx, msg.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateGroupAdmin(ctx, msg.GroupId, newAdmin); err != nil {
+		return nil, err
+	}
+
+	emitUpdateGroupEvents(ctx, msg.GroupId, msg.Admin)
+	return &types.MsgUpdateGroupAdminResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupMetadata(goCtx context.Context, msg *types.MsgUpdateGroupMetadata) (*types.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.assertGroupAdmin(ctx, msg.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateGroupMetadata(ctx, msg.GroupId, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	emitUpdateGroupEvents(ctx, msg.GroupId, msg.Admin)
+	return &types.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateGroupAccount(goCtx context.Context, msg *types.MsgCreateGroupAccount) (*types.MsgCreateGroupAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin, err := sdk.AccAddressFromBech32(msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	policy := msg.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	// only the group admin can create accounts for the group
+	if err := k.assertGroupAdmin(ctx, msg.GroupId, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	address, err := k.Keeper.CreateGroupAccount(ctx, admin, msg.GroupId, msg.Metadata, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateGroupAccount,
+			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
+		),
+		newMessageEvent(msg.Admin),
+	})
+
+	return &types.MsgCreateGroupAccountResponse{Address: address.String()}, nil
+}
+
+func (k msgServer) UpdateGroupAccountAdmin(goCtx context.Context, msg *types.MsgUpdateGroupAccountAdmin) (*types.MsgUpdateGroupAccountAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	newAdmin, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+	address, err := k.assertGroupAccountAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateGroupAccountAdmin(ctx, address, newAdmin); err != nil {
+		return nil, err
+	}
+
+	emitUpdateGroupAccountEvents(ctx, msg.Address, msg.Admin)
+	return &types.MsgUpdateGroupAccountAdminResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupAccountDecisionPolicy(goCtx context.Context, msg *types.MsgUpdateGroupAccountDecisionPolicy) (*types.MsgUpdateGroupAccountDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy := msg.GetDecisionPolicy()
+	if policy == nil {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+	address, err := k.assertGroupAccountAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateGroupAccountDecisionPolicy(ctx, address, policy); err != nil {
+		return nil, err
+	}
+
+	emitUpdateGroupAccountEvents(ctx, msg.Address, msg.Admin)
+	return &types.MsgUpdateGroupAccountDecisionPolicyResponse{}, nil
+}
+
+func (k msgServer) UpdateGroupAccountMetadata(goCtx context.Context, msg *types.MsgUpdateGroupAccountMetadata) (*types.MsgUpdateGroupAccountMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := k.assertGroupAccountAdmin(ctx, msg.Address, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.UpdateGroupAccountMetadata(ctx, address, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	emitUpdateGroupAccountEvents(ctx, msg.Address, msg.Admin)
+	return &types.MsgUpdateGroupAccountMetadataResponse{}, nil
+}
+
+func (k msgServer) CreateProposal(goCtx context.Context, msg *types.MsgCreateProposal) (*types.MsgCreateProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID, err := k.Keeper.CreateProposal(ctx, address, msg.Proposers, msgs, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	// the proposers vote yes and the proposal is executed right away
+	if msg.Exec == types.ExecTry {
+		for _, proposer := range msg.Proposers {
+			voter, err := sdk.AccAddressFromBech32(proposer)
+			if err != nil {
+				return nil, err
+			}
+			if err := k.vote(ctx, proposalID, voter, types.ChoiceYes, nil); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := k.exec(ctx, proposalID); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, proposer := range msg.Proposers {
+		ctx.EventManager().EmitEvent(newMessageEvent(proposer))
+	}
+
+	return &types.MsgCreateProposalResponse{ProposalId: proposalID}, nil
+}
+
+func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.vote(ctx, msg.ProposalId, voter, msg.Choice, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	if msg.Exec == types.ExecTry {
+		if err := k.exec(ctx, msg.ProposalId); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(newMessageEvent(msg.Voter))
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (k msgServer) Exec(goCtx context.Context, msg *types.MsgExec) (*types.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.exec(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(newMessageEvent(msg.Signer))
+	return &types.MsgExecResponse{}, nil
+}
+
+// vote records a vote and emits the corresponding event.
+func (k msgServer) vote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, choice types.Choice, metadata []byte) error {
+	if err := k.Keeper.Vote(ctx, proposalID, voter, choice, metadata); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVote,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyChoice, choice.String()),
+		),
+	)
+	return nil
+}
+
+// exec executes a proposal and emits the corresponding event.
+func (k msgServer) exec(ctx sdk.Context, proposalID uint64) error {
+	if err := k.Keeper.Exec(ctx, proposalID); err != nil {
+		return err
+	}
+
+	proposal, _ := k.GetProposal(ctx, proposalID)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExec,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyExecutorResult, proposal.ExecutorResult.String()),
+		),
+	)
+	return nil
+}
+
+// assertGroupAdmin checks that admin is the admin of the group.
+func (k msgServer) assertGroupAdmin(ctx sdk.Context, groupID uint64, admin string) error {
+	group, found := k.GetGroupInfo(ctx, groupID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group %d", groupID)
+	}
+	if group.Admin != admin {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group %d", admin, groupID)
+	}
+
+	return nil
+}
+
+// assertGroupAccountAdmin checks that admin is the admin of the group account
+// and returns the account address.
+func (k msgServer) assertGroupAccountAdmin(ctx sdk.Context, bech32Address, admin string) (sdk.AccAddress, error) {
+	address, err := sdk.AccAddressFromBech32(bech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	info, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "group account %s", bech32Address)
+	}
+	if info.Admin != admin {
+		return nil, sdkerrors.Wrapf(types.ErrUnauthorized, "%s is not the admin of group account %s", admin, bech32Address)
+	}
+
+	return address, nil
+}
+
+func emitUpdateGroupEvents(ctx sdk.Context, groupID uint64, admin string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateGroup,
+			sdk.NewAttribute(types.AttributeKeyGroupID, fmt.Sprintf("%d", groupID)),
+		),
+		newMessageEvent(admin),
+	})
+}
+
+func emitUpdateGroupAccountEvents(ctx sdk.Context, address, admin string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateGroupAccount,
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+		),
+		newMessageEvent(admin),
+	})
+}
+
+func newMessageEvent(sender string) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+	)
+}
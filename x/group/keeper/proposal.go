@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetProposal returns a proposal from its ID.
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProposalKey(proposalID))
+	if bz == nil {
+		return types.Proposal{}, false
+	}
+
+	var proposal types.Proposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal stores a proposal and indexes it by its group account.
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.ProposalId), k.cdc.MustMarshalBinaryBare(&proposal))
+	store.Set(types.ProposalByGroupAccountKey(address, proposal.ProposalId), []byte{})
+}
+
+// IterateProposals iterates over all the proposals and performs a callback
+// function.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProposalKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// CreateProposal submits a new proposal on behalf of a group account and
+// returns its ID. All the proposers must be members of the group and every
+// message must be signed by the group account only.
+func (k Keeper) CreateProposal(
+	ctx sdk.Context, address sdk.AccAddress, proposers []string, msgs []sdk.Msg, metadata []byte,
+) (uint64, error) {
+	account, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrNotFound, "group account %s", address)
+	}
+	group, found := k.GetGroupInfo(ctx, account.GroupId)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrNotFound, "group %d", account.GroupId)
+	}
+	policy := account.GetDecisionPolicy()
+	if policy == nil {
+		return 0, sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	for _, proposer := range proposers {
+		addr, err := sdk.AccAddressFromBech32(proposer)
+		if err != nil {
+			return 0, err
+		}
+		if _, found := k.GetGroupMember(ctx, group.GroupId, addr); !found {
+			return 0, sdkerrors.Wrapf(types.ErrUnauthorized, "proposer %s is not a member of group %d", proposer, group.GroupId)
+		}
+	}
+
+	if err := ensureMsgAuthZ(msgs, address); err != nil {
+		return 0, err
+	}
+
+	proposalID := k.nextSeq(ctx, types.ProposalSeqKey)
+	proposal := types.Proposal{
+		ProposalId:          proposalID,
+		Address:             account.Address,
+		Metadata:            metadata,
+		Proposers:           proposers,
+		SubmittedAt:         ctx.BlockTime(),
+		GroupVersion:        group.Version,
+		GroupAccountVersion: account.Version,
+		Status:              types.ProposalStatusSubmitted,
+		Result:              types.ProposalResultUnfinalized,
+		VoteState:           types.DefaultTally(),
+		Timeout:             ctx.BlockTime().Add(policy.GetTimeout()),
+		ExecutorResult:      types.ProposalExecutorResultNotRun,
+	}
+	if err := proposal.SetMsgs(msgs); err != nil {
+		return 0, err
+	}
+	if err := proposal.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	k.SetProposal(ctx, proposal)
+	return proposalID, nil
+}
+
+// Exec tallies a proposal that is still open for voting and executes its
+// messages if it was accepted. The proposal is aborted if its group or group
+// account was modified since its submission. Failing messages don't make Exec
+// fail: the executor result of the proposal is set to failure and the state
+// changes of the messages are discarded, so that the execution can be retried.
+func (k Keeper) Exec(ctx sdk.Context, proposalID uint64) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+	if proposal.Status != types.ProposalStatusSubmitted && proposal.Status != types.ProposalStatusClosed {
+		return sdkerrors.Wrapf(types.ErrInvalid, "cannot execute proposal with status %s", proposal.Status)
+	}
+
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return err
+	}
+	account, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group account %s", proposal.Address)
+	}
+
+	if proposal.Status == types.ProposalStatusSubmitted {
+		group, found := k.GetGroupInfo(ctx, account.GroupId)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrNotFound, "group %d", account.GroupId)
+		}
+
+		if proposal.GroupVersion != group.Version || proposal.GroupAccountVersion != account.Version {
+			proposal.Status = types.ProposalStatusAborted
+			k.SetProposal(ctx, proposal)
+			return nil
+		}
+
+		if err := k.tally(ctx, &proposal, account, group); err != nil {
+			return err
+		}
+	}
+
+	if proposal.Status == types.ProposalStatusClosed &&
+		proposal.Result == types.ProposalResultAccepted &&
+		proposal.ExecutorResult != types.ProposalExecutorResultSuccess {
+		msgs, err := proposal.GetMsgs()
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.execMsgs(cacheCtx, address, msgs); err != nil {
+			proposal.ExecutorResult = types.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "proposal", proposalID, "err", err)
+		} else {
+			proposal.ExecutorResult = types.ProposalExecutorResultSuccess
+			writeCache()
+
+			// The cached context is created with a new EventManager. However, since
+			// the proposal messages were executed successfully, we want to track/keep
+			// any events emitted, so we re-emit to "merge" the events into the
+			// original Context's EventManager.
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
+	}
+
+	k.SetProposal(ctx, proposal)
+	return nil
+}
+
+// tally updates the status and result of a proposal according to the decision
+// policy of its group account.
+func (k Keeper) tally(ctx sdk.Context, proposal *types.Proposal, account types.GroupAccountInfo, group types.GroupInfo) error {
+	policy := account.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(types.ErrEmpty, "decision policy")
+	}
+
+	result, err := policy.Allow(proposal.VoteState, group.TotalWeight, ctx.BlockTime().Sub(proposal.SubmittedAt))
+	if err != nil {
+		return err
+	}
+
+	if result.Final {
+		proposal.Status = types.ProposalStatusClosed
+		if result.Allow {
+			proposal.Result = types.ProposalResultAccepted
+		} else {
+			proposal.Result = types.ProposalResultRejected
+		}
+	}
+
+	return nil
+}
+
+// execMsgs routes the messages of a proposal to their handlers.
+func (k Keeper) execMsgs(ctx sdk.Context, address sdk.AccAddress, msgs []sdk.Msg) error {
+	if err := ensureMsgAuthZ(msgs, address); err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		res, err := baseapp.ExecMsg(ctx, k.router, k.msgServiceRouter, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		// handlers emit their events into a new EventManager, keep them by
+		// merging the returned events into the context's EventManager
+		for _, event := range res.GetEvents() {
+			ctx.EventManager().EmitEvent(sdk.Event(event))
+		}
+	}
+
+	return nil
+}
+
+// ensureMsgAuthZ checks that every message is only signed by the group
+// account.
+func ensureMsgAuthZ(msgs []sdk.Msg, address sdk.AccAddress) error {
+	for i, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !address.Equals(signer) {
+				return sdkerrors.Wrapf(types.ErrUnauthorized, "message %d does not have group account authorization", i)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+// GetVote returns the vote of a voter on a proposal.
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.VoteKey(proposalID, voter))
+	if bz == nil {
+		return types.Vote{}, false
+	}
+
+	var vote types.Vote
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// SetVote stores a vote and indexes it by its voter.
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VoteKey(vote.ProposalId, voter), k.cdc.MustMarshalBinaryBare(&vote))
+	store.Set(types.VoteByVoterKey(voter, vote.ProposalId), []byte{})
+}
+
+// IterateVotes iterates over all the votes and performs a callback function.
+func (k Keeper) IterateVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.VoteKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// Vote records the vote of a group member on a proposal, weighted by the
+// member's weight, and tallies the proposal. Votes are only accepted while the
+// proposal is open, before its timeout and as long as neither the group nor
+// the group account were modified.
+func (k Keeper) Vote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, choice types.Choice, metadata []byte) error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "proposal %d", proposalID)
+	}
+	if proposal.Status != types.ProposalStatusSubmitted {
+		return sdkerrors.Wrapf(types.ErrInvalid, "proposal %d is not open for voting", proposalID)
+	}
+	if !ctx.BlockTime().Before(proposal.Timeout) {
+		return sdkerrors.Wrapf(types.ErrExpired, "voting period of proposal %d has ended", proposalID)
+	}
+	if !types.ValidChoice(choice) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "choice %s", choice)
+	}
+	if err := types.ValidateMetadata(metadata); err != nil {
+		return err
+	}
+
+	address, err := sdk.AccAddressFromBech32(proposal.Address)
+	if err != nil {
+		return err
+	}
+	account, found := k.GetGroupAccountInfo(ctx, address)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group account %s", proposal.Address)
+	}
+	group, found := k.GetGroupInfo(ctx, account.GroupId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNotFound, "group %d", account.GroupId)
+	}
+	if proposal.GroupVersion != group.Version {
+		return sdkerrors.Wrap(types.ErrModified, "group was modified")
+	}
+	if proposal.GroupAccountVersion != account.Version {
+		return sdkerrors.Wrap(types.ErrModified, "group account was modified")
+	}
+
+	member, found := k.GetGroupMember(ctx, group.GroupId, voter)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "voter %s is not a member of group %d", voter, group.GroupId)
+	}
+	if _, found := k.GetVote(ctx, proposalID, voter); found {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "voter %s already voted on proposal %d", voter, proposalID)
+	}
+
+	if err := proposal.VoteState.Add(choice, member.Member.Weight); err != nil {
+		return err
+	}
+	if err := k.tally(ctx, &proposal, account, group); err != nil {
+		return err
+	}
+
+	k.SetVote(ctx, types.Vote{
+		ProposalId:  proposalID,
+		Voter:       voter.String(),
+		Choice:      choice,
+		Metadata:    metadata,
+		SubmittedAt: ctx.BlockTime(),
+	})
+	k.SetProposal(ctx, proposal)
+
+	return nil
+}
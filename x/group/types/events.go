@@ -0,0 +1,21 @@
+package types
+
+// group module event types
+const (
+	EventTypeCreateGroup        = "create_group"
+	EventTypeUpdateGroup        = "update_group"
+	EventTypeCreateGroupAccount = "create_group_account"
+	EventTypeUpdateGroupAccount = "update_group_account"
+	EventTypeCreateProposal     = "create_proposal"
+	EventTypeVote               = "vote"
+	EventTypeExec               = "exec"
+
+	AttributeKeyGroupID        = "group_id"
+	AttributeKeyAddress        = "address"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeyVoter          = "voter"
+	AttributeKeyChoice         = "choice"
+	AttributeKeyExecutorResult = "executor_result"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// NewGenesisState creates a new genesis state for the group module
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// DefaultGenesisState defines the default group genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState()
+}
+
+// Validate performs a basic validation of the group genesis state: every
+// entity must be valid, reference existing entities and have an ID not
+// greater than its sequence.
+func (gs GenesisState) Validate() error {
+	groups := make(map[uint64]GroupInfo, len(gs.Groups))
+	for _, g := range gs.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid group %d: %w", g.GroupId, err)
+		}
+		if _, ok := groups[g.GroupId]; ok {
+			return fmt.Errorf("duplicate group %d", g.GroupId)
+		}
+		if g.GroupId > gs.GroupSeq {
+			return fmt.Errorf("group id %d is greater than the group sequence %d", g.GroupId, gs.GroupSeq)
+		}
+		groups[g.GroupId] = g
+	}
+
+	members := make(map[string]bool, len(gs.GroupMembers))
+	for _, m := range gs.GroupMembers {
+		if _, ok := groups[m.GroupId]; !ok {
+			return fmt.Errorf("group member %s references unknown group %d", m.Member.Address, m.GroupId)
+		}
+		if err := m.Member.ValidateBasic(false); err != nil {
+			return fmt.Errorf("invalid member of group %d: %w", m.GroupId, err)
+		}
+		key := fmt.Sprintf("%d/%s", m.GroupId, m.Member.Address)
+		if members[key] {
+			return fmt.Errorf("duplicate member %s of group %d", m.Member.Address, m.GroupId)
+		}
+		members[key] = true
+	}
+
+	accounts := make(map[string]bool, len(gs.GroupAccounts))
+	for _, a := range gs.GroupAccounts {
+		if err := a.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid group account %s: %w", a.Address, err)
+		}
+		if _, ok := groups[a.GroupId]; !ok {
+			return fmt.Errorf("group account %s references unknown group %d", a.Address, a.GroupId)
+		}
+		if accounts[a.Address] {
+			return fmt.Errorf("duplicate group account %s", a.Address)
+		}
+		accounts[a.Address] = true
+	}
+
+	proposals := make(map[uint64]bool, len(gs.Proposals))
+	for _, p := range gs.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid proposal %d: %w", p.ProposalId, err)
+		}
+		if !accounts[p.Address] {
+			return fmt.Errorf("proposal %d references unknown group account %s", p.ProposalId, p.Address)
+		}
+		if proposals[p.ProposalId] {
+			return fmt.Errorf("duplicate proposal %d", p.ProposalId)
+		}
+		if p.ProposalId > gs.ProposalSeq {
+			return fmt.Errorf("proposal id %d is greater than the proposal sequence %d", p.ProposalId, gs.ProposalSeq)
+		}
+		proposals[p.ProposalId] = true
+	}
+
+	votes := make(map[string]bool, len(gs.Votes))
+	for _, v := range gs.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid vote on proposal %d: %w", v.ProposalId, err)
+		}
+		if !proposals[v.ProposalId] {
+			return fmt.Errorf("vote of %s references unknown proposal %d", v.Voter, v.ProposalId)
+		}
+		key := fmt.Sprintf("%d/%s", v.ProposalId, v.Voter)
+		if votes[key] {
+			return fmt.Errorf("duplicate vote of %s on proposal %d", v.Voter, v.ProposalId)
+		}
+		votes[key] = true
+	}
+
+	return nil
+}
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range gs.GroupAccounts {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range gs.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	submittedAt := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	groupInfo := types.GroupInfo{GroupId: 1, Admin: admin.String(), Version: 1, TotalWeight: sdk.OneDec()}
+	groupMember := types.GroupMember{GroupId: 1, Member: types.NewMember(member1, sdk.OneDec(), nil)}
+	groupAccount, err := types.NewGroupAccountInfo(account, 1, admin, nil, 1, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	require.NoError(t, err)
+	proposal := types.Proposal{
+		ProposalId:          1,
+		Address:             account.String(),
+		Proposers:           []string{member1.String()},
+		SubmittedAt:         submittedAt,
+		GroupVersion:        1,
+		GroupAccountVersion: 1,
+		Status:              types.ProposalStatusSubmitted,
+		Result:              types.ProposalResultUnfinalized,
+		VoteState:           types.DefaultTally(),
+		Timeout:             submittedAt.Add(time.Hour),
+		ExecutorResult:      types.ProposalExecutorResultNotRun,
+	}
+	vote := types.Vote{ProposalId: 1, Voter: member1.String(), Choice: types.ChoiceYes, SubmittedAt: submittedAt}
+
+	validGenesis := func() types.GenesisState {
+		return types.GenesisState{
+			GroupSeq:        1,
+			Groups:          []types.GroupInfo{groupInfo},
+			GroupMembers:    []types.GroupMember{groupMember},
+			GroupAccountSeq: 1,
+			GroupAccounts:   []types.GroupAccountInfo{groupAccount},
+			ProposalSeq:     1,
+			Proposals:       []types.Proposal{proposal},
+			Votes:           []types.Vote{vote},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func(gs *types.GenesisState)
+		expectPass bool
+	}{
+		{"default", func(gs *types.GenesisState) { *gs = *types.DefaultGenesisState() }, true},
+		{"valid", func(gs *types.GenesisState) {}, true},
+		{"group id greater than sequence", func(gs *types.GenesisState) { gs.GroupSeq = 0 }, false},
+		{"duplicate group", func(gs *types.GenesisState) { gs.Groups = append(gs.Groups, groupInfo) }, false},
+		{"member of unknown group", func(gs *types.GenesisState) { gs.GroupMembers[0].GroupId = 2 }, false},
+		{"account of unknown group", func(gs *types.GenesisState) { gs.GroupAccounts[0].GroupId = 2 }, false},
+		{"proposal id greater than sequence", func(gs *types.GenesisState) { gs.ProposalSeq = 0 }, false},
+		{"vote on unknown proposal", func(gs *types.GenesisState) { gs.Votes[0].ProposalId = 2 }, false},
+		{"duplicate vote", func(gs *types.GenesisState) { gs.Votes = append(gs.Votes, vote) }, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gs := validGenesis()
+			tc.malleate(&gs)
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "group"
+
+	// StoreKey is the store key string for group
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for group
+	RouterKey = ModuleName
+)
+
+// Keys for group store
+// Items are stored with the following key: values
+//
+// - 0x00: nextGroupID
+//
+// - 0x01<groupID_Bytes>: GroupInfo
+//
+// - 0x02<groupID_Bytes><memberAddr_Bytes>: GroupMember
+//
+// - 0x03<adminAddr_Bytes><groupID_Bytes>: []byte{}
+//
+// - 0x10: groupAccountSeq
+//
+// - 0x11<accountAddr_Bytes>: GroupAccountInfo
+//
+// - 0x12<groupID_Bytes><accountAddr_Bytes>: []byte{}
+//
+// - 0x13<adminAddr_Bytes><accountAddr_Bytes>: []byte{}
+//
+// - 0x20: nextProposalID
+//
+// - 0x21<proposalID_Bytes>: Proposal
+//
+// - 0x22<accountAddr_Bytes><proposalID_Bytes>: []byte{}
+//
+// - 0x30<proposalID_Bytes><voterAddr_Bytes>: Vote
+//
+// - 0x31<voterAddr_Bytes><proposalID_Bytes>: []byte{}
+var (
+	GroupSeqKey        = []byte{0x00}
+	GroupInfoKeyPrefix = []byte{0x01}
+	GroupMemberPrefix  = []byte{0x02}
+	GroupByAdminPrefix = []byte{0x03}
+
+	GroupAccountSeqKey           = []byte{0x10}
+	GroupAccountInfoKeyPrefix    = []byte{0x11}
+	GroupAccountByGroupPrefix    = []byte{0x12}
+	GroupAccountByAdminPrefix    = []byte{0x13}
+	ProposalSeqKey               = []byte{0x20}
+	ProposalKeyPrefix            = []byte{0x21}
+	ProposalByGroupAccountPrefix = []byte{0x22}
+
+	VoteKeyPrefix     = []byte{0x30}
+	VoteByVoterPrefix = []byte{0x31}
+)
+
+// GetIDBytes returns the byte representation of a group or proposal ID
+func GetIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetIDFromBytes returns a group or proposal ID from its byte representation
+func GetIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GroupInfoKey returns the key of a group from its ID
+func GroupInfoKey(groupID uint64) []byte {
+	return append(GroupInfoKeyPrefix, GetIDBytes(groupID)...)
+}
+
+// GroupMembersKey returns the key prefix of the members of a group
+func GroupMembersKey(groupID uint64) []byte {
+	return append(GroupMemberPrefix, GetIDBytes(groupID)...)
+}
+
+// GroupMemberKey returns the key of a group member
+func GroupMemberKey(groupID uint64, member sdk.AccAddress) []byte {
+	return append(GroupMembersKey(groupID), member.Bytes()...)
+}
+
+// GroupsByAdminKey returns the key prefix of the groups administered by admin
+func GroupsByAdminKey(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminPrefix, admin.Bytes()...)
+}
+
+// GroupByAdminKey returns the index key of a group by its admin
+func GroupByAdminKey(admin sdk.AccAddress, groupID uint64) []byte {
+	return append(GroupsByAdminKey(admin), GetIDBytes(groupID)...)
+}
+
+// GroupAccountInfoKey returns the key of a group account from its address
+func GroupAccountInfoKey(address sdk.AccAddress) []byte {
+	return append(GroupAccountInfoKeyPrefix, address.Bytes()...)
+}
+
+// GroupAccountsByGroupKey returns the key prefix of the group accounts of a group
+func GroupAccountsByGroupKey(groupID uint64) []byte {
+	return append(GroupAccountByGroupPrefix, GetIDBytes(groupID)...)
+}
+
+// GroupAccountByGroupKey returns the index key of a group account by its group
+func GroupAccountByGroupKey(groupID uint64, address sdk.AccAddress) []byte {
+	return append(GroupAccountsByGroupKey(groupID), address.Bytes()...)
+}
+
+// GroupAccountsByAdminKey returns the key prefix of the group accounts
+// administered by admin
+func GroupAccountsByAdminKey(admin sdk.AccAddress) []byte {
+	return append(GroupAccountByAdminPrefix, admin.Bytes()...)
+}
+
+// GroupAccountByAdminKey returns the index key of a group account by its admin
+func GroupAccountByAdminKey(admin, address sdk.AccAddress) []byte {
+	return append(GroupAccountsByAdminKey(admin), address.Bytes()...)
+}
+
+// ProposalKey returns the key of a proposal from its ID
+func ProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, GetIDBytes(proposalID)...)
+}
+
+// ProposalsByGroupAccountKey returns the key prefix of the proposals of a
+// group account
+func ProposalsByGroupAccountKey(address sdk.AccAddress) []byte {
+	return append(ProposalByGroupAccountPrefix, address.Bytes()...)
+}
+
+// ProposalByGroupAccountKey returns the index key of a proposal by its group
+// account
+func ProposalByGroupAccountKey(address sdk.AccAddress, proposalID uint64) []byte {
+	return append(ProposalsByGroupAccountKey(address), GetIDBytes(proposalID)...)
+}
+
+// VotesByProposalKey returns the key prefix of the votes on a proposal
+func VotesByProposalKey(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, GetIDBytes(proposalID)...)
+}
+
+// VoteKey returns the key of a vote from its proposal ID and voter
+func VoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
+	return append(VotesByProposalKey(proposalID), voter.Bytes()...)
+}
+
+// VotesByVoterKey returns the key prefix of the votes of a voter
+func VotesByVoterKey(voter sdk.AccAddress) []byte {
+	return append(VoteByVoterPrefix, voter.Bytes()...)
+}
+
+// VoteByVoterKey returns the index key of a vote by its voter
+func VoteByVoterKey(voter sdk.AccAddress, proposalID uint64) []byte {
+	return append(VotesByVoterKey(voter), GetIDBytes(proposalID)...)
+}
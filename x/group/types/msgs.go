@@ -0,0 +1,642 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// group message types
+const (
+	TypeMsgCreateGroup                      = "create_group"
+	TypeMsgUpdateGroupMembers               = "update_group_members"
+	TypeMsgUpdateGroupAdmin                 = "update_group_admin"
+	TypeMsgUpdateGroupMetadata              = "update_group_metadata"
+	TypeMsgCreateGroupAccount               = "create_group_account"
+	TypeMsgUpdateGroupAccountAdmin          = "update_group_account_admin"
+	TypeMsgUpdateGroupAccountDecisionPolicy = "update_group_account_decision_policy"
+	TypeMsgUpdateGroupAccountMetadata       = "update_group_account_metadata"
+	TypeMsgCreateProposal                   = "create_proposal"
+	TypeMsgVote                             = "vote"
+	TypeMsgExec                             = "exec"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroupMembers{}
+	_ sdk.Msg = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupMetadata{}
+	_ sdk.Msg = &MsgCreateGroupAccount{}
+	_ sdk.Msg = &MsgUpdateGroupAccountAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupAccountDecisionPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupAccountMetadata{}
+	_ sdk.Msg = &MsgCreateProposal{}
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgCreateGroupAccount{}
+	_ codectypes.UnpackInterfacesMessage = MsgUpdateGroupAccountDecisionPolicy{}
+	_ codectypes.UnpackInterfacesMessage = MsgCreateProposal{}
+)
+
+// NewMsgCreateGroup creates a new MsgCreateGroup instance
+//nolint:interfacer
+func NewMsgCreateGroup(admin sdk.AccAddress, members []Member, metadata []byte) *MsgCreateGroup {
+	return &MsgCreateGroup{
+		Admin:    admin.String(),
+		Members:  members,
+		Metadata: metadata,
+	}
+}
+
+// Route implements Msg
+func (msg MsgCreateGroup) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateGroup) Type() string { return TypeMsgCreateGroup }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if err := ValidateMembers(msg.Members, false); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateGroup) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// NewMsgUpdateGroupMembers creates a new MsgUpdateGroupMembers instance
+//nolint:interfacer
+func NewMsgUpdateGroupMembers(admin sdk.AccAddress, groupID uint64, memberUpdates []Member) *MsgUpdateGroupMembers {
+	return &MsgUpdateGroupMembers{
+		Admin:         admin.String(),
+		GroupId:       groupID,
+		MemberUpdates: memberUpdates,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUpdateGroupMembers) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateGroupMembers) Type() string { return TypeMsgUpdateGroupMembers }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupMembers) ValidateBasic() error {
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if len(msg.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+
+	return ValidateMembers(msg.MemberUpdates, true)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateGroupMembers) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// NewMsgUpdateGroupAdmin creates a new MsgUpdateGroupAdmin instance
+//nolint:interfacer
+func NewMsgUpdateGroupAdmin(admin sdk.AccAddress, groupID uint64, newAdmin sdk.AccAddress) *MsgUpdateGroupAdmin {
+	return &MsgUpdateGroupAdmin{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgUpdateGroupAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateGroupAdmin) Type() string { return TypeMsgUpdateGroupAdmin }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupAdmin) ValidateBasic() error {
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	if msg.Admin == msg.NewAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// NewMsgUpdateGroupMetadata creates a new MsgUpdateGroupMetadata instance
+//nolint:interfacer
+func NewMsgUpdateGroupMetadata(admin sdk.AccAddress, groupID uint64, metadata []byte) *MsgUpdateGroupMetadata {
+	return &MsgUpdateGroupMetadata{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		Metadata: metadata,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUpdateGroupMetadata) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateGroupMetadata) Type() string { return TypeMsgUpdateGroupMetadata }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupMetadata) ValidateBasic() error {
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// NewMsgCreateGroupAccount creates a new MsgCreateGroupAccount instance
+//nolint:interfacer
+func NewMsgCreateGroupAccount(
+	admin sdk.AccAddress, groupID uint64, metadata []byte, decisionPolicy DecisionPolicy,
+) (*MsgCreateGroupAccount, error) {
+	m := &MsgCreateGroupAccount{
+		Admin:    admin.String(),
+		GroupId:  groupID,
+		Metadata: metadata,
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy returns the decision policy of the message.
+func (msg MsgCreateGroupAccount) GetDecisionPolicy() DecisionPolicy {
+	if msg.DecisionPolicy == nil {
+		return nil
+	}
+
+	decisionPolicy, ok := msg.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy sets the decision policy of the message.
+func (msg *MsgCreateGroupAccount) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	msg.DecisionPolicy = any
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgCreateGroupAccount) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateGroupAccount) Type() string { return TypeMsgCreateGroupAccount }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateGroupAccount) ValidateBasic() error {
+	if msg.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	policy := msg.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateGroupAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateGroupAccount) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateGroupAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgUpdateGroupAccountAdmin creates a new MsgUpdateGroupAccountAdmin instance
+//nolint:interfacer
+func NewMsgUpdateGroupAccountAdmin(admin, address, newAdmin sdk.AccAddress) *MsgUpdateGroupAccountAdmin {
+	return &MsgUpdateGroupAccountAdmin{
+		Admin:    admin.String(),
+		Address:  address.String(),
+		NewAdmin: newAdmin.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgUpdateGroupAccountAdmin) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateGroupAccountAdmin) Type() string { return TypeMsgUpdateGroupAccountAdmin }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupAccountAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	if msg.Admin == msg.NewAdmin {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateGroupAccountAdmin) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupAccountAdmin) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// NewMsgUpdateGroupAccountDecisionPolicy creates a new
+// MsgUpdateGroupAccountDecisionPolicy instance
+//nolint:interfacer
+func NewMsgUpdateGroupAccountDecisionPolicy(
+	admin, address sdk.AccAddress, decisionPolicy DecisionPolicy,
+) (*MsgUpdateGroupAccountDecisionPolicy, error) {
+	m := &MsgUpdateGroupAccountDecisionPolicy{
+		Admin:   admin.String(),
+		Address: address.String(),
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetDecisionPolicy returns the decision policy of the message.
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetDecisionPolicy() DecisionPolicy {
+	if msg.DecisionPolicy == nil {
+		return nil
+	}
+
+	decisionPolicy, ok := msg.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy sets the decision policy of the message.
+func (msg *MsgUpdateGroupAccountDecisionPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	msg.DecisionPolicy = any
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgUpdateGroupAccountDecisionPolicy) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateGroupAccountDecisionPolicy) Type() string {
+	return TypeMsgUpdateGroupAccountDecisionPolicy
+}
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupAccountDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	policy := msg.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupAccountDecisionPolicy) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateGroupAccountDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(msg.DecisionPolicy, &decisionPolicy)
+}
+
+// NewMsgUpdateGroupAccountMetadata creates a new MsgUpdateGroupAccountMetadata
+// instance
+//nolint:interfacer
+func NewMsgUpdateGroupAccountMetadata(admin, address sdk.AccAddress, metadata []byte) *MsgUpdateGroupAccountMetadata {
+	return &MsgUpdateGroupAccountMetadata{
+		Admin:    admin.String(),
+		Address:  address.String(),
+		Metadata: metadata,
+	}
+}
+
+// Route implements Msg
+func (msg MsgUpdateGroupAccountMetadata) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateGroupAccountMetadata) Type() string { return TypeMsgUpdateGroupAccountMetadata }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateGroupAccountMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateGroupAccountMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateGroupAccountMetadata) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+// NewMsgCreateProposal creates a new MsgCreateProposal instance
+//nolint:interfacer
+func NewMsgCreateProposal(
+	address sdk.AccAddress, proposers []string, msgs []sdk.Msg, metadata []byte, exec Exec,
+) (*MsgCreateProposal, error) {
+	m := &MsgCreateProposal{
+		Address:   address.String(),
+		Proposers: proposers,
+		Metadata:  metadata,
+		Exec:      exec,
+	}
+	err := m.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (msg MsgCreateProposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(msg.Msgs)
+}
+
+// SetMsgs packs the messages of the proposal.
+func (msg *MsgCreateProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	msg.Msgs = anys
+	return nil
+}
+
+// Route implements Msg
+func (msg MsgCreateProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgCreateProposal) Type() string { return TypeMsgCreateProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgCreateProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	if len(msg.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+
+	seen := make(map[string]bool, len(msg.Proposers))
+	for _, proposer := range msg.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.Wrap(err, "proposer")
+		}
+		if seen[proposer] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposer %s", proposer)
+		}
+		seen[proposer] = true
+	}
+
+	msgs, err := msg.GetMsgs()
+	if err != nil {
+		return err
+	}
+	for i, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCreateProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCreateProposal) GetSigners() []sdk.AccAddress {
+	signers := make([]sdk.AccAddress, len(msg.Proposers))
+	for i, proposer := range msg.Proposers {
+		signers[i], _ = sdk.AccAddressFromBech32(proposer)
+	}
+	return signers
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgCreateProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, msg.Msgs)
+}
+
+// NewMsgVote creates a new MsgVote instance
+//nolint:interfacer
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, choice Choice, metadata []byte, exec Exec) *MsgVote {
+	return &MsgVote{
+		ProposalId: proposalID,
+		Voter:      voter.String(),
+		Choice:     choice,
+		Metadata:   metadata,
+		Exec:       exec,
+	}
+}
+
+// Route implements Msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic implements Msg
+func (msg MsgVote) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if !ValidChoice(msg.Choice) {
+		return sdkerrors.Wrapf(ErrInvalid, "choice %s", msg.Choice)
+	}
+
+	return ValidateMetadata(msg.Metadata)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgExec creates a new MsgExec instance
+//nolint:interfacer
+func NewMsgExec(signer sdk.AccAddress, proposalID uint64) *MsgExec {
+	return &MsgExec{
+		ProposalId: proposalID,
+		Signer:     signer.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgExec) Type() string { return TypeMsgExec }
+
+// ValidateBasic implements Msg
+func (msg MsgExec) ValidateBasic() error {
+	if msg.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrap(err, "signer")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgExec) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
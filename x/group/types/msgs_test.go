@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+var (
+	admin   = sdk.AccAddress("admin_______________")
+	member1 = sdk.AccAddress("member1_____________")
+	member2 = sdk.AccAddress("member2_____________")
+	account = sdk.AccAddress("account_____________")
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	testCases := []struct {
+		name       string
+		msg        *types.MsgCreateGroup
+		expectPass bool
+	}{
+		{
+			"valid",
+			types.NewMsgCreateGroup(admin, []types.Member{types.NewMember(member1, sdk.OneDec(), []byte("m"))}, []byte("group")),
+			true,
+		},
+		{
+			"invalid admin",
+			&types.MsgCreateGroup{Admin: "invalid", Members: []types.Member{types.NewMember(member1, sdk.OneDec(), nil)}},
+			false,
+		},
+		{
+			"zero weight member",
+			types.NewMsgCreateGroup(admin, []types.Member{types.NewMember(member1, sdk.ZeroDec(), nil)}, nil),
+			false,
+		},
+		{
+			"duplicate member",
+			types.NewMsgCreateGroup(admin, []types.Member{
+				types.NewMember(member1, sdk.OneDec(), nil),
+				types.NewMember(member1, sdk.OneDec(), nil),
+			}, nil),
+			false,
+		},
+		{
+			"metadata too long",
+			types.NewMsgCreateGroup(admin, nil, make([]byte, types.MaxMetadataLength+1)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{admin}, tc.msg.GetSigners())
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	// members can be removed with a zero weight
+	msg := types.NewMsgUpdateGroupMembers(admin, 1, []types.Member{types.NewMember(member1, sdk.ZeroDec(), nil)})
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = types.NewMsgUpdateGroupMembers(admin, 1, nil)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = types.NewMsgUpdateGroupMembers(admin, 0, []types.Member{types.NewMember(member1, sdk.OneDec(), nil)})
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGroupAccount(t *testing.T) {
+	msg, err := types.NewMsgCreateGroupAccount(admin, 1, nil, types.NewThresholdDecisionPolicy(sdk.OneDec(), time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, sdk.OneDec(), msg.GetDecisionPolicy().(*types.ThresholdDecisionPolicy).Threshold)
+
+	msg, err = types.NewMsgCreateGroupAccount(admin, 1, nil, types.NewThresholdDecisionPolicy(sdk.OneDec(), 0))
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = &types.MsgCreateGroupAccount{Admin: admin.String(), GroupId: 1}
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateProposal(t *testing.T) {
+	send := banktypes.NewMsgSend(account, member1, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	msg, err := types.NewMsgCreateProposal(account, []string{member1.String(), member2.String()}, []sdk.Msg{send}, nil, types.ExecTry)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{member1, member2}, msg.GetSigners())
+
+	msgs, err := msg.GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	msg, err = types.NewMsgCreateProposal(account, []string{member1.String(), member1.String()}, []sdk.Msg{send}, nil, types.ExecTry)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	msg, err = types.NewMsgCreateProposal(account, nil, []sdk.Msg{send}, nil, types.ExecTry)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+
+	invalidSend := banktypes.NewMsgSend(account, member1, nil)
+	msg, err = types.NewMsgCreateProposal(account, []string{member1.String()}, []sdk.Msg{invalidSend}, nil, types.ExecTry)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgVote(t *testing.T) {
+	require.NoError(t, types.NewMsgVote(member1, 1, types.ChoiceYes, nil, types.ExecUnspecified).ValidateBasic())
+	require.Error(t, types.NewMsgVote(member1, 0, types.ChoiceYes, nil, types.ExecUnspecified).ValidateBasic())
+	require.Error(t, types.NewMsgVote(member1, 1, types.ChoiceUnspecified, nil, types.ExecUnspecified).ValidateBasic())
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not.
+	Final bool
+}
+
+// DecisionPolicy is the persistent set of rules to determine the result of
+// election on a proposal.
+type DecisionPolicy interface {
+	proto.Message
+
+	// GetTimeout returns the duration after submission at which proposals
+	// using this policy time out.
+	GetTimeout() time.Duration
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result, the group's total weight and the time since
+	// the proposal was submitted.
+	Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error)
+	// Validate performs a stateful validation of the policy against a group.
+	Validate(g GroupInfo) error
+	// ValidateBasic performs a stateless validation of the policy.
+	ValidateBasic() error
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a threshold decision policy
+func NewThresholdDecisionPolicy(threshold sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
+}
+
+// GetTimeout returns the timeout of a threshold decision policy
+func (p ThresholdDecisionPolicy) GetTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Allow allows a proposal to pass when the weighted sum of the yes votes
+// reaches the threshold, or the total weight of the group if the threshold
+// exceeds it.
+func (p ThresholdDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration >= p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	if !totalWeight.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	threshold := sdk.MinDec(p.Threshold, totalWeight)
+	if tally.YesCount.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate performs a stateful validation of the policy. The threshold may
+// exceed the total weight of the group, in which case all the members need to
+// vote yes for a proposal to pass.
+func (p ThresholdDecisionPolicy) Validate(_ GroupInfo) error {
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the policy.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if p.Threshold.IsNil() || !p.Threshold.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalid, "threshold must be positive")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+
+	return nil
+}
+
+// NewPercentageDecisionPolicy creates a percentage decision policy
+func NewPercentageDecisionPolicy(percentage sdk.Dec, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+// GetTimeout returns the timeout of a percentage decision policy
+func (p PercentageDecisionPolicy) GetTimeout() time.Duration {
+	return p.Timeout
+}
+
+// Allow allows a proposal to pass when the weighted sum of the yes votes
+// divided by the total weight of the group reaches the percentage.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalWeight sdk.Dec, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	if votingDuration >= p.Timeout {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	if !totalWeight.IsPositive() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	if tally.YesCount.Quo(totalWeight).GTE(p.Percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided := totalWeight.Sub(tally.TotalCounts())
+	if tally.YesCount.Add(undecided).Quo(totalWeight).LT(p.Percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate performs a stateful validation of the policy.
+func (p PercentageDecisionPolicy) Validate(_ GroupInfo) error {
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the policy.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if p.Percentage.IsNil() || !p.Percentage.IsPositive() || p.Percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be positive and not greater than one")
+	}
+	if p.Timeout <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "timeout must be positive")
+	}
+
+	return nil
+}
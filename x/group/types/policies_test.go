@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/types"
+)
+
+func tally(yes, no int64) types.Tally {
+	t := types.DefaultTally()
+	t.YesCount = sdk.NewDec(yes)
+	t.NoCount = sdk.NewDec(no)
+	return t
+}
+
+func TestThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := types.NewThresholdDecisionPolicy(sdk.NewDec(3), time.Hour)
+
+	testCases := []struct {
+		name           string
+		tally          types.Tally
+		totalWeight    sdk.Dec
+		votingDuration time.Duration
+		expResult      types.DecisionPolicyResult
+	}{
+		{"threshold reached", tally(3, 0), sdk.NewDec(6), time.Minute, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"threshold not reached yet", tally(2, 1), sdk.NewDec(6), time.Minute, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"threshold can't be reached", tally(1, 4), sdk.NewDec(6), time.Minute, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"threshold capped by total weight", tally(2, 0), sdk.NewDec(2), time.Minute, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"empty group", tally(0, 0), sdk.ZeroDec(), time.Minute, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"timed out", tally(3, 0), sdk.NewDec(6), time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := policy.Allow(tc.tally, tc.totalWeight, tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.expResult, result)
+		})
+	}
+}
+
+func TestPercentageDecisionPolicyAllow(t *testing.T) {
+	policy := types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(5, 1), time.Hour)
+
+	testCases := []struct {
+		name           string
+		tally          types.Tally
+		totalWeight    sdk.Dec
+		votingDuration time.Duration
+		expResult      types.DecisionPolicyResult
+	}{
+		{"percentage reached", tally(3, 0), sdk.NewDec(6), time.Minute, types.DecisionPolicyResult{Allow: true, Final: true}},
+		{"percentage not reached yet", tally(2, 1), sdk.NewDec(6), time.Minute, types.DecisionPolicyResult{Allow: false, Final: false}},
+		{"percentage can't be reached", tally(2, 4), sdk.NewDec(6), time.Minute, types.DecisionPolicyResult{Allow: false, Final: true}},
+		{"timed out", tally(3, 0), sdk.NewDec(6), 2 * time.Hour, types.DecisionPolicyResult{Allow: false, Final: true}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := policy.Allow(tc.tally, tc.totalWeight, tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.expResult, result)
+		})
+	}
+}
+
+func TestDecisionPolicyValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewThresholdDecisionPolicy(sdk.NewDec(1), time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy(sdk.ZeroDec(), time.Hour).ValidateBasic())
+	require.Error(t, types.NewThresholdDecisionPolicy(sdk.NewDec(1), 0).ValidateBasic())
+	require.Error(t, (&types.ThresholdDecisionPolicy{Timeout: time.Hour}).ValidateBasic())
+
+	require.NoError(t, types.NewPercentageDecisionPolicy(sdk.OneDec(), time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(11, 1), time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.NewDecWithPrec(-1, 1), time.Hour).ValidateBasic())
+	require.Error(t, types.NewPercentageDecisionPolicy(sdk.OneDec(), -time.Hour).ValidateBasic())
+}
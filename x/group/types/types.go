@@ -0,0 +1,379 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxMetadataLength is the maximum length in bytes of the metadata attached
+// to groups, group accounts, members, proposals and votes.
+const MaxMetadataLength = 255
+
+// NewMember creates a new group member
+//nolint:interfacer
+func NewMember(address sdk.AccAddress, weight sdk.Dec, metadata []byte) Member {
+	return Member{
+		Address:  address.String(),
+		Weight:   weight,
+		Metadata: metadata,
+	}
+}
+
+// ValidateBasic performs a stateless validation of a member. Zero weights are
+// only allowed when updating the members of a group, to remove a member.
+func (m Member) ValidateBasic(allowZeroWeight bool) error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "member address")
+	}
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "member weight cannot be negative")
+	}
+	if !allowZeroWeight && m.Weight.IsZero() {
+		return sdkerrors.Wrap(ErrInvalid, "member weight must be positive")
+	}
+
+	return ValidateMetadata(m.Metadata)
+}
+
+// ValidateMembers performs a stateless validation of a list of members.
+func ValidateMembers(members []Member, allowZeroWeight bool) error {
+	seen := make(map[string]bool, len(members))
+	for _, m := range members {
+		if err := m.ValidateBasic(allowZeroWeight); err != nil {
+			return err
+		}
+		if seen[m.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s", m.Address)
+		}
+		seen[m.Address] = true
+	}
+
+	return nil
+}
+
+// ValidateMetadata checks the length of the metadata.
+func ValidateMetadata(metadata []byte) error {
+	if len(metadata) > MaxMetadataLength {
+		return sdkerrors.Wrapf(ErrMaxLimit, "metadata length %d exceeds %d", len(metadata), MaxMetadataLength)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of a group.
+func (g GroupInfo) ValidateBasic() error {
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if g.TotalWeight.IsNil() || g.TotalWeight.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalid, "total weight cannot be negative")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+
+	return ValidateMetadata(g.Metadata)
+}
+
+var _ codectypes.UnpackInterfacesMessage = GroupAccountInfo{}
+
+// NewGroupAccountInfo creates a new group account
+//nolint:interfacer
+func NewGroupAccountInfo(
+	address sdk.AccAddress, groupID uint64, admin sdk.AccAddress, metadata []byte,
+	version uint64, decisionPolicy DecisionPolicy,
+) (GroupAccountInfo, error) {
+	info := GroupAccountInfo{
+		Address:  address.String(),
+		GroupId:  groupID,
+		Admin:    admin.String(),
+		Metadata: metadata,
+		Version:  version,
+	}
+
+	err := info.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return GroupAccountInfo{}, err
+	}
+
+	return info, nil
+}
+
+// GetDecisionPolicy returns the decision policy of the group account.
+func (g GroupAccountInfo) GetDecisionPolicy() DecisionPolicy {
+	if g.DecisionPolicy == nil {
+		return nil
+	}
+
+	decisionPolicy, ok := g.DecisionPolicy.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil
+	}
+	return decisionPolicy
+}
+
+// SetDecisionPolicy sets the decision policy of the group account.
+func (g *GroupAccountInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of a group account.
+func (g GroupAccountInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrap(err, "group account")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+
+	policy := g.GetDecisionPolicy()
+	if policy == nil {
+		return sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+	if err := policy.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	return ValidateMetadata(g.Metadata)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupAccountInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// DefaultTally returns a tally with all the counts set to zero.
+func DefaultTally() Tally {
+	return Tally{
+		YesCount:     sdk.ZeroDec(),
+		NoCount:      sdk.ZeroDec(),
+		AbstainCount: sdk.ZeroDec(),
+		VetoCount:    sdk.ZeroDec(),
+	}
+}
+
+// TotalCounts returns the weighted sum of all the votes.
+func (t Tally) TotalCounts() sdk.Dec {
+	return t.YesCount.Add(t.NoCount).Add(t.AbstainCount).Add(t.VetoCount)
+}
+
+// Add adds the weight of a vote to the tally.
+func (t *Tally) Add(choice Choice, weight sdk.Dec) error {
+	switch choice {
+	case ChoiceYes:
+		t.YesCount = t.YesCount.Add(weight)
+	case ChoiceNo:
+		t.NoCount = t.NoCount.Add(weight)
+	case ChoiceAbstain:
+		t.AbstainCount = t.AbstainCount.Add(weight)
+	case ChoiceVeto:
+		t.VetoCount = t.VetoCount.Add(weight)
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown choice %s", choice)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the tally.
+func (t Tally) ValidateBasic() error {
+	for _, count := range []sdk.Dec{t.YesCount, t.NoCount, t.AbstainCount, t.VetoCount} {
+		if count.IsNil() || count.IsNegative() {
+			return sdkerrors.Wrap(ErrInvalid, "tally counts cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = Proposal{}
+
+// GetMsgs unpacks the messages of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(p.Msgs)
+}
+
+// SetMsgs packs the messages of the proposal.
+func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Msgs = anys
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of a proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return sdkerrors.Wrap(err, "proposal group account")
+	}
+	if len(p.Proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+	for _, proposer := range p.Proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.Wrap(err, "proposer")
+		}
+	}
+	if p.GroupVersion == 0 || p.GroupAccountVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group or group account version")
+	}
+	if p.Status == ProposalStatusUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "proposal status")
+	}
+	if p.Result == ProposalResultUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "proposal result")
+	}
+	if p.ExecutorResult == ProposalExecutorResultUnspecified {
+		return sdkerrors.Wrap(ErrEmpty, "proposal executor result")
+	}
+	if err := p.VoteState.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := p.GetMsgs(); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(p.Metadata)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, p.Msgs)
+}
+
+// ValidChoice returns true if the choice is a valid voting choice.
+func ValidChoice(choice Choice) bool {
+	switch choice {
+	case ChoiceYes, ChoiceNo, ChoiceAbstain, ChoiceVeto:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChoiceFromString returns a Choice from a string. It returns an error if the
+// string is invalid.
+func ChoiceFromString(str string) (Choice, error) {
+	choice, ok := Choice_value[str]
+	if !ok || !ValidChoice(Choice(choice)) {
+		return ChoiceUnspecified, fmt.Errorf("'%s' is not a valid vote choice", str)
+	}
+	return Choice(choice), nil
+}
+
+// ValidateBasic performs a stateless validation of a vote.
+func (v Vote) ValidateBasic() error {
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if !ValidChoice(v.Choice) {
+		return sdkerrors.Wrapf(ErrInvalid, "choice %s", v.Choice)
+	}
+	if v.SubmittedAt.Equal(time.Time{}) {
+		return sdkerrors.Wrap(ErrEmpty, "submitted at")
+	}
+
+	return ValidateMetadata(v.Metadata)
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", decisionPolicy)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		switch msg := msg.(type) {
+		case sdk.ServiceMsg:
+			anys[i], err = codectypes.NewAnyWithCustomTypeURL(msg.Request, msg.MethodName)
+		default:
+			anys[i], err = codectypes.NewAnyWithValue(msg)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return anys, nil
+}
+
+func unpackMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		if isServiceMsg(any.TypeUrl) {
+			req, ok := any.GetCachedValue().(sdk.MsgRequest)
+			if !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.MsgRequest: %T", i, any.GetCachedValue())
+			}
+			msgs[i] = sdk.ServiceMsg{MethodName: any.TypeUrl, Request: req}
+			continue
+		}
+
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.Msg: %T", i, any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
+
+func unpackMsgAnys(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		// Anys whose type URL is a service method name unpack into the
+		// request of a ServiceMsg as per ADR-031.
+		if isServiceMsg(any.TypeUrl) {
+			var req sdk.MsgRequest
+			if err := unpacker.UnpackAny(any, &req); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isServiceMsg checks if a type URL corresponds to a service method name,
+// i.e. /cosmos.bank.v1beta1.Msg/Send vs /cosmos.bank.v1beta1.MsgSend
+func isServiceMsg(typeURL string) bool {
+	return strings.Count(typeURL, "/") >= 2
+}
@@ -12,13 +12,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
 )
 
-// GetCmdQueryDenomTrace defines the command to query a a denomination trace from a given hash.
+// GetCmdQueryDenomTrace defines the command to query a a denomination trace from a given hash
+// or IBC denomination.
 func GetCmdQueryDenomTrace() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "denom-trace [hash]",
-		Short:   "Query the denom trace info from a given trace hash",
-		Long:    "Query the denom trace info from a given trace hash",
-		Example: fmt.Sprintf("%s query ibc-transfer denom-trace [hash]", version.AppName),
+		Use:   "denom-trace [hash/denom]",
+		Short: "Query the denom trace info from a given trace hash or IBC denomination",
+		Long: "Query the denom trace info, i.e. the path and base denomination, from a given trace hash " +
+			"or IBC denomination (ibc/{hash})",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-trace ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.AppName),
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -108,7 +110,7 @@ func GetCmdParams() *cobra.Command {
 	return cmd
 }
 
-// GetCmdParams returns the command handler for ibc-transfer parameter querying.
+// GetCmdQueryEscrowAddress returns the command handler for ibc-transfer escrow address querying.
 func GetCmdQueryEscrowAddress() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "escrow-address",
@@ -129,3 +131,37 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryEscrowBalance returns the command handler for ibc-transfer escrow balance querying.
+func GetCmdQueryEscrowBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-balance [port] [channel-id]",
+		Short:   "Query the tokens held in escrow for a channel",
+		Long:    "Query the escrow address of a channel and the tokens it holds",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-balance transfer channel-0", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEscrowBalanceRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.EscrowBalance(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/ibc/applications/transfer/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
 )
 
 func (suite *KeeperTestSuite) TestQueryDenomTrace() {
@@ -52,6 +54,19 @@ func (suite *KeeperTestSuite) TestQueryDenomTrace() {
 			},
 			true,
 		},
+		{
+			"success with IBC denom",
+			func() {
+				expTrace.Path = "transfer/channelToA/transfer/channelToB"
+				expTrace.BaseDenom = "uatom"
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+				req = &types.QueryDenomTraceRequest{
+					Hash: expTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -140,3 +155,85 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryEscrowBalance() {
+	var (
+		req         *types.QueryEscrowBalanceRequest
+		expBalances sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryEscrowBalanceRequest{PortId: "", ChannelId: "channel-0"}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryEscrowBalanceRequest{PortId: types.PortID, ChannelId: ""}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryEscrowBalanceRequest{PortId: types.PortID, ChannelId: "channel-9"}
+			},
+			false,
+		},
+		{
+			"success, empty escrow",
+			func() {
+				_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+				channelA, _ := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+				expBalances = nil
+				req = &types.QueryEscrowBalanceRequest{PortId: channelA.PortID, ChannelId: channelA.ID}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+				channelA, _ := suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+
+				expBalances = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+				escrow := types.GetEscrowAddress(channelA.PortID, channelA.ID)
+				err := suite.chainA.App.BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), escrow, expBalances)
+				suite.Require().NoError(err)
+				suite.coordinator.CommitBlock(suite.chainA)
+
+				req = &types.QueryEscrowBalanceRequest{PortId: channelA.PortID, ChannelId: channelA.ID}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.EscrowBalance(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(types.GetEscrowAddress(req.PortId, req.ChannelId).String(), res.EscrowAddress)
+				suite.Require().Equal(expBalances, res.Balances)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
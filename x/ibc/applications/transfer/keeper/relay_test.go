@@ -71,6 +71,15 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				channelA, channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
 				amount = sdk.NewCoin("randomdenom", sdk.NewInt(100))
 			}, true, false},
+		{"send disabled denom from source chain",
+			func() {
+				_, _, connA, connB := suite.coordinator.SetupClientConnections(suite.chainA, suite.chainB, exported.Tendermint)
+				channelA, channelB = suite.coordinator.CreateTransferChannels(suite.chainA, suite.chainB, connA, connB, channeltypes.UNORDERED)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+
+				bankParams := suite.chainA.App.BankKeeper.GetParams(suite.chainA.GetContext())
+				suite.chainA.App.BankKeeper.SetParams(suite.chainA.GetContext(), bankParams.SetSendEnabledParam(sdk.DefaultBondDenom, false))
+			}, true, false},
 		// - receiving chain
 		{"send from module account failed",
 			func() {
@@ -335,6 +344,19 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(coin))
 				suite.Require().NoError(err)
 			}, true},
+		{"successful timeout of a send disabled denom from sender as source chain",
+			func() {
+				escrow := types.GetEscrowAddress(channelA.PortID, channelA.ID)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+
+				err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(coin))
+				suite.Require().NoError(err)
+
+				// the refund must not be blocked once the denom is disabled
+				bankParams := suite.chainA.App.BankKeeper.GetParams(suite.chainA.GetContext())
+				suite.chainA.App.BankKeeper.SetParams(suite.chainA.GetContext(), bankParams.SetSendEnabledParam(coin.Denom, false))
+			}, true},
 		{"successful timeout from external chain",
 			func() {
 				escrow := types.GetEscrowAddress(channelA.PortID, channelA.ID)
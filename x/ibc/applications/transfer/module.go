@@ -32,9 +32,10 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ porttypes.IBCModule   = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ porttypes.IBCModule         = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ module.InitGenesisDependent = AppModule{}
 )
 
 // AppModuleBasic is the IBC Transfer AppModuleBasic
@@ -138,6 +139,12 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data j
 	return []abci.ValidatorUpdate{}
 }
 
+// InitGenesisDependencies returns the modules whose InitGenesis must run before
+// the ibc-transfer module's, the transfer port capability is claimed at genesis.
+func (AppModule) InitGenesisDependencies() []string {
+	return []string{capabilitytypes.ModuleName}
+}
+
 // ExportGenesis returns the exported genesis state as raw bytes for the ibc-transfer
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
@@ -145,6 +152,9 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(gs)
 }
 
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 }
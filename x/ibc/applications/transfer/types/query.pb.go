@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -33,7 +35,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // QueryDenomTraceRequest is the request type for the Query/DenomTrace RPC
 // method
 type QueryDenomTraceRequest struct {
-	// hash (in hex format) of the denomination trace information.
+	// hash (in hex format) of the denomination trace information. The IBC
+	// denomination (ibc/{hash}) is accepted as well.
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
@@ -310,6 +313,118 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryEscrowBalanceRequest is the request type for the Query/EscrowBalance
+// RPC method.
+type QueryEscrowBalanceRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryEscrowBalanceRequest) Reset()         { *m = QueryEscrowBalanceRequest{} }
+func (m *QueryEscrowBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalanceRequest) ProtoMessage()    {}
+func (*QueryEscrowBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{6}
+}
+func (m *QueryEscrowBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalanceRequest.Merge(m, src)
+}
+func (m *QueryEscrowBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowBalanceRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryEscrowBalanceRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryEscrowBalanceResponse is the response type for the Query/EscrowBalance
+// RPC method.
+type QueryEscrowBalanceResponse struct {
+	// escrow_address is the address of the escrow account of the channel.
+	EscrowAddress string `protobuf:"bytes,1,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	// balances are the tokens held in escrow for the channel.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+}
+
+func (m *QueryEscrowBalanceResponse) Reset()         { *m = QueryEscrowBalanceResponse{} }
+func (m *QueryEscrowBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalanceResponse) ProtoMessage()    {}
+func (*QueryEscrowBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a638e2800a01538c, []int{7}
+}
+func (m *QueryEscrowBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalanceResponse.Merge(m, src)
+}
+func (m *QueryEscrowBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowBalanceResponse) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+func (m *QueryEscrowBalanceResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryDenomTraceRequest)(nil), "ibc.applications.transfer.v1.QueryDenomTraceRequest")
 	proto.RegisterType((*QueryDenomTraceResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTraceResponse")
@@ -317,6 +432,8 @@ func init() {
 	proto.RegisterType((*QueryDenomTracesResponse)(nil), "ibc.applications.transfer.v1.QueryDenomTracesResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.transfer.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.transfer.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEscrowBalanceRequest)(nil), "ibc.applications.transfer.v1.QueryEscrowBalanceRequest")
+	proto.RegisterType((*QueryEscrowBalanceResponse)(nil), "ibc.applications.transfer.v1.QueryEscrowBalanceResponse")
 }
 
 func init() {
@@ -324,40 +441,51 @@ func init() {
 }
 
 var fileDescriptor_a638e2800a01538c = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x95, 0x12, 0x89, 0x17, 0xc4, 0x70, 0x54, 0x10, 0x59, 0x95, 0x5b, 0x59, 0x08, 0x02,
-	0x85, 0x3b, 0x5c, 0xa0, 0x30, 0xa0, 0x0e, 0x15, 0x02, 0xb1, 0x95, 0xc0, 0x80, 0x60, 0x40, 0x67,
-	0xe7, 0x70, 0x2c, 0x1a, 0x9f, 0xeb, 0xbb, 0x44, 0x54, 0x88, 0x85, 0x4f, 0x80, 0xc4, 0x8e, 0x98,
-	0xd9, 0x19, 0xd8, 0x18, 0x3b, 0x56, 0x62, 0x61, 0x02, 0x94, 0xf0, 0x41, 0x90, 0xef, 0xce, 0x8d,
-	0xa3, 0x20, 0x13, 0x4f, 0x39, 0x5d, 0xde, 0xef, 0xfd, 0xfe, 0xbc, 0xe7, 0x83, 0x4e, 0x1c, 0x84,
-	0x94, 0xa5, 0xe9, 0x5e, 0x1c, 0x32, 0x15, 0x8b, 0x44, 0x52, 0x95, 0xb1, 0x44, 0xbe, 0xe4, 0x19,
-	0x1d, 0xf9, 0x74, 0x7f, 0xc8, 0xb3, 0x03, 0x92, 0x66, 0x42, 0x09, 0xbc, 0x1a, 0x07, 0x21, 0x29,
-	0x57, 0x92, 0xa2, 0x92, 0x8c, 0x7c, 0x67, 0x25, 0x12, 0x91, 0xd0, 0x85, 0x34, 0x3f, 0x19, 0x8c,
-	0x73, 0x25, 0x14, 0x72, 0x20, 0x24, 0x0d, 0x98, 0xe4, 0xa6, 0x19, 0x1d, 0xf9, 0x01, 0x57, 0xcc,
-	0xa7, 0x29, 0x8b, 0xe2, 0x44, 0x37, 0xb2, 0xb5, 0x1b, 0x95, 0x4a, 0x8e, 0xb9, 0x4c, 0xf1, 0x6a,
-	0x24, 0x44, 0xb4, 0xc7, 0x29, 0x4b, 0x63, 0xca, 0x92, 0x44, 0x28, 0x2b, 0x49, 0xff, 0xeb, 0x5d,
-	0x85, 0x73, 0x8f, 0x72, 0xb2, 0x7b, 0x3c, 0x11, 0x83, 0x27, 0x19, 0x0b, 0x79, 0x97, 0xef, 0x0f,
-	0xb9, 0x54, 0x18, 0xc3, 0x72, 0x9f, 0xc9, 0x7e, 0x1b, 0xad, 0xa3, 0xce, 0xa9, 0xae, 0x3e, 0x7b,
-	0x3d, 0x38, 0x3f, 0x57, 0x2d, 0x53, 0x91, 0x48, 0x8e, 0x1f, 0x42, 0xab, 0x97, 0xdf, 0xbe, 0x50,
-	0xf9, 0xb5, 0x46, 0xb5, 0x36, 0x3b, 0xa4, 0x2a, 0x09, 0x52, 0x6a, 0x03, 0xbd, 0xe3, 0xb3, 0xc7,
-	0xe6, 0x58, 0x64, 0x21, 0xea, 0x3e, 0xc0, 0x34, 0x0d, 0x4b, 0x72, 0x91, 0x98, 0xe8, 0x48, 0x1e,
-	0x1d, 0x31, 0x73, 0xb0, 0xd1, 0x91, 0x5d, 0x16, 0x15, 0x86, 0xba, 0x25, 0xa4, 0xf7, 0x0d, 0x41,
-	0x7b, 0x9e, 0xc3, 0x5a, 0x79, 0x0e, 0xa7, 0x4b, 0x56, 0x64, 0x1b, 0xad, 0x9f, 0xa8, 0xe3, 0x65,
-	0xe7, 0xcc, 0xe1, 0xcf, 0xb5, 0xc6, 0xe7, 0x5f, 0x6b, 0x4d, 0xdb, 0xb7, 0x35, 0xf5, 0x26, 0xf1,
-	0x83, 0x19, 0x07, 0x4b, 0xda, 0xc1, 0xa5, 0xff, 0x3a, 0x30, 0xca, 0x66, 0x2c, 0xac, 0x00, 0xd6,
-	0x0e, 0x76, 0x59, 0xc6, 0x06, 0x45, 0x40, 0xde, 0x63, 0x38, 0x3b, 0x73, 0x6b, 0x2d, 0xdd, 0x85,
-	0x66, 0xaa, 0x6f, 0x6c, 0x66, 0x17, 0xaa, 0xcd, 0x58, 0xb4, 0xc5, 0x6c, 0x7e, 0x5c, 0x86, 0x93,
-	0xba, 0x2b, 0xfe, 0x8a, 0x00, 0xa6, 0x4e, 0xf1, 0xcd, 0xea, 0x36, 0xff, 0xde, 0x2c, 0xe7, 0x56,
-	0x4d, 0x94, 0xf1, 0xe0, 0x6d, 0xbf, 0xfb, 0xfe, 0xe7, 0xc3, 0xd2, 0x1d, 0xbc, 0x45, 0xab, 0xd6,
-	0xdf, 0x7c, 0x32, 0xe5, 0xf9, 0xd1, 0x37, 0xf9, 0xee, 0xbe, 0xc5, 0x5f, 0x10, 0xb4, 0x4a, 0xe3,
-	0xc6, 0xf5, 0x64, 0x14, 0x09, 0x3b, 0x5b, 0x75, 0x61, 0x56, 0xfe, 0x6d, 0x2d, 0xdf, 0xc7, 0xb4,
-	0xa6, 0x7c, 0xfc, 0x09, 0x41, 0xd3, 0x0c, 0x04, 0x5f, 0x5f, 0x80, 0x7b, 0x66, 0x1f, 0x1c, 0xbf,
-	0x06, 0xc2, 0x0a, 0xf5, 0xb5, 0xd0, 0x0d, 0x7c, 0x79, 0x01, 0xa1, 0x66, 0x41, 0x76, 0x9e, 0x1e,
-	0x8e, 0x5d, 0x74, 0x34, 0x76, 0xd1, 0xef, 0xb1, 0x8b, 0xde, 0x4f, 0xdc, 0xc6, 0xd1, 0xc4, 0x6d,
-	0xfc, 0x98, 0xb8, 0x8d, 0x67, 0xdb, 0x51, 0xac, 0xfa, 0xc3, 0x80, 0x84, 0x62, 0x40, 0xed, 0x0b,
-	0x67, 0x7e, 0xae, 0xc9, 0xde, 0x2b, 0xfa, 0xba, 0x82, 0x42, 0x1d, 0xa4, 0x5c, 0x06, 0x4d, 0xfd,
-	0x4c, 0xdd, 0xf8, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xb2, 0x7f, 0xfe, 0xbd, 0x7d, 0x05, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0xf3, 0xde, 0xcb, 0x7b, 0xbd, 0x79, 0xed, 0x62, 0xa8, 0x68, 0x1a, 0x15, 0xb7, 0xb2,
+	0xf8, 0x08, 0x14, 0x3c, 0x75, 0x81, 0xb6, 0x0b, 0x54, 0x89, 0x50, 0x40, 0xdd, 0x95, 0x14, 0x89,
+	0xaf, 0x45, 0x34, 0xb6, 0x07, 0xc7, 0x22, 0xf1, 0xb8, 0x1e, 0xb7, 0x50, 0x55, 0xdd, 0x20, 0x7e,
+	0x00, 0x12, 0x7f, 0x80, 0x35, 0x62, 0xcb, 0x82, 0x1d, 0xcb, 0x2e, 0x2b, 0xb1, 0x61, 0x05, 0xa8,
+	0xe1, 0x87, 0x20, 0xcf, 0x8c, 0x1b, 0x47, 0xad, 0x4c, 0xbc, 0xea, 0xf4, 0xce, 0xfd, 0x38, 0xe7,
+	0xcc, 0x3d, 0x0e, 0x34, 0x7c, 0xdb, 0xc1, 0x24, 0x0c, 0xbb, 0xbe, 0x43, 0x62, 0x9f, 0x05, 0x1c,
+	0xc7, 0x11, 0x09, 0xf8, 0x73, 0x1a, 0xe1, 0x1d, 0x0b, 0x6f, 0x6d, 0xd3, 0x68, 0xd7, 0x0c, 0x23,
+	0x16, 0x33, 0x34, 0xe3, 0xdb, 0x8e, 0x99, 0xcd, 0x34, 0xd3, 0x4c, 0x73, 0xc7, 0xaa, 0x4f, 0x7a,
+	0xcc, 0x63, 0x22, 0x11, 0x27, 0x27, 0x59, 0x53, 0xbf, 0xe2, 0x30, 0xde, 0x63, 0x1c, 0xdb, 0x84,
+	0x53, 0xd9, 0x0c, 0xef, 0x58, 0x36, 0x8d, 0x89, 0x85, 0x43, 0xe2, 0xf9, 0x81, 0x68, 0xa4, 0x72,
+	0xf5, 0x6c, 0x6e, 0x9a, 0xe5, 0x30, 0x3f, 0xbd, 0x9f, 0xcf, 0x45, 0x7a, 0x8c, 0x45, 0x26, 0xcf,
+	0x78, 0x8c, 0x79, 0x5d, 0x8a, 0x49, 0xe8, 0x63, 0x12, 0x04, 0x2c, 0x56, 0x90, 0xc5, 0xad, 0x71,
+	0x15, 0xce, 0x3e, 0x48, 0xc0, 0xac, 0xd1, 0x80, 0xf5, 0x1e, 0x46, 0xc4, 0xa1, 0x2d, 0xba, 0xb5,
+	0x4d, 0x79, 0x8c, 0x10, 0xfc, 0xdd, 0x21, 0xbc, 0x53, 0xd3, 0xe6, 0xb4, 0xc6, 0x58, 0x4b, 0x9c,
+	0x0d, 0x17, 0xa6, 0x4e, 0x64, 0xf3, 0x90, 0x05, 0x9c, 0xa2, 0x75, 0xa8, 0xba, 0x49, 0xb4, 0x1d,
+	0x27, 0x61, 0x51, 0x55, 0x5d, 0x6c, 0x98, 0x79, 0x4a, 0x99, 0x99, 0x36, 0xe0, 0x1e, 0x9f, 0x0d,
+	0x72, 0x62, 0x0a, 0x4f, 0x41, 0xdd, 0x03, 0x18, 0xa8, 0xa5, 0x86, 0x5c, 0x34, 0xa5, 0x5c, 0x66,
+	0x22, 0x97, 0x29, 0xdf, 0x49, 0x89, 0x66, 0x6e, 0x10, 0x2f, 0x25, 0xd4, 0xca, 0x54, 0x1a, 0x5f,
+	0x34, 0xa8, 0x9d, 0x9c, 0xa1, 0xa8, 0x3c, 0x83, 0xff, 0x33, 0x54, 0x78, 0x4d, 0x9b, 0xfb, 0xab,
+	0x08, 0x97, 0xe6, 0xc4, 0xc1, 0xf7, 0xd9, 0xd2, 0x87, 0x1f, 0xb3, 0x15, 0xd5, 0xb7, 0x3a, 0xe0,
+	0xc6, 0xd1, 0xfd, 0x21, 0x06, 0x65, 0xc1, 0xe0, 0xd2, 0x1f, 0x19, 0x48, 0x64, 0x43, 0x14, 0x26,
+	0x01, 0x09, 0x06, 0x1b, 0x24, 0x22, 0xbd, 0x54, 0x20, 0x63, 0x13, 0xce, 0x0c, 0x45, 0x15, 0xa5,
+	0x5b, 0x50, 0x09, 0x45, 0x44, 0x69, 0x76, 0x3e, 0x9f, 0x8c, 0xaa, 0x56, 0x35, 0xc6, 0x26, 0x4c,
+	0x8b, 0xa6, 0x77, 0xb9, 0x13, 0xb1, 0x97, 0x4d, 0xd2, 0x25, 0xc1, 0x60, 0x4f, 0xa6, 0xe0, 0xdf,
+	0x90, 0x45, 0x71, 0xdb, 0x77, 0xd5, 0xaa, 0x54, 0x92, 0x7f, 0xd7, 0x5d, 0x74, 0x0e, 0xc0, 0xe9,
+	0x90, 0x20, 0xa0, 0xdd, 0xe4, 0xae, 0x2c, 0xee, 0xc6, 0x54, 0x64, 0xdd, 0x35, 0x3e, 0x6a, 0x50,
+	0x3f, 0xad, 0xab, 0x42, 0x7c, 0x01, 0x26, 0xa8, 0xb8, 0x68, 0x13, 0xd7, 0x8d, 0x28, 0xe7, 0xaa,
+	0xfb, 0xb8, 0x8c, 0xde, 0x96, 0x41, 0xe4, 0xc1, 0x7f, 0xb6, 0xac, 0xe4, 0xb5, 0xb2, 0x78, 0xa7,
+	0xe9, 0x21, 0x31, 0x53, 0x19, 0xef, 0x30, 0x3f, 0x68, 0x2e, 0xa8, 0x87, 0x69, 0x78, 0x7e, 0xdc,
+	0xd9, 0xb6, 0x4d, 0x87, 0xf5, 0xb0, 0xb2, 0x9a, 0xfc, 0x73, 0x8d, 0xbb, 0x2f, 0x70, 0xbc, 0x1b,
+	0x52, 0x2e, 0x0a, 0x78, 0xeb, 0xb8, 0xf9, 0xe2, 0x9b, 0x0a, 0xfc, 0x23, 0xe0, 0xa2, 0xcf, 0x1a,
+	0xc0, 0xe0, 0xb5, 0xd1, 0x8d, 0x7c, 0x29, 0x4f, 0x77, 0x57, 0xfd, 0x66, 0xc1, 0x2a, 0xa9, 0x8a,
+	0xb1, 0xfa, 0xfa, 0xeb, 0xaf, 0x77, 0xe5, 0x15, 0xb4, 0x84, 0xf3, 0x3e, 0x01, 0xf2, 0x83, 0x91,
+	0xdd, 0x61, 0xbc, 0x97, 0xf8, 0x77, 0x1f, 0x7d, 0xd2, 0xa0, 0xba, 0x96, 0xd9, 0xc6, 0x62, 0x30,
+	0xd2, 0x2d, 0xab, 0x2f, 0x15, 0x2d, 0x53, 0xf0, 0x97, 0x05, 0x7c, 0x0b, 0xe1, 0x82, 0xf0, 0xd1,
+	0x7b, 0x0d, 0x2a, 0x72, 0x29, 0xd1, 0xc2, 0x08, 0xb3, 0x87, 0x3c, 0x51, 0xb7, 0x0a, 0x54, 0x28,
+	0xa0, 0x96, 0x00, 0x3a, 0x8f, 0x2e, 0x8f, 0x00, 0x54, 0x9a, 0x04, 0xf5, 0x35, 0x18, 0x1f, 0x5a,
+	0x65, 0xb4, 0x3c, 0xc2, 0xdc, 0xd3, 0x2c, 0x55, 0x5f, 0x29, 0x5e, 0xa8, 0x70, 0xb7, 0x05, 0xee,
+	0x27, 0xe8, 0xd1, 0x08, 0xb8, 0x95, 0x15, 0x39, 0xde, 0x1b, 0xd8, 0x74, 0x1f, 0x27, 0xe6, 0xe5,
+	0x78, 0x4f, 0x59, 0x7a, 0x1f, 0x2b, 0x13, 0x2a, 0x1f, 0x34, 0x1f, 0x1f, 0x1c, 0xe9, 0xda, 0xe1,
+	0x91, 0xae, 0xfd, 0x3c, 0xd2, 0xb5, 0xb7, 0x7d, 0xbd, 0x74, 0xd8, 0xd7, 0x4b, 0xdf, 0xfa, 0x7a,
+	0xe9, 0xe9, 0x6a, 0xae, 0xa9, 0x5e, 0xe5, 0x00, 0x12, 0x86, 0xb3, 0x2b, 0xe2, 0x07, 0xe9, 0xfa,
+	0xef, 0x01, 0x00, 0xba, 0x51, 0xb2, 0xad, 0x87, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +506,9 @@ type QueryClient interface {
 	DenomTraces(ctx context.Context, in *QueryDenomTracesRequest, opts ...grpc.CallOption) (*QueryDenomTracesResponse, error)
 	// Params queries all parameters of the ibc-transfer module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EscrowBalance queries the escrow address of a channel and the tokens it
+	// holds.
+	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +546,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
+	out := new(QueryEscrowBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Query/EscrowBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// DenomTrace queries a denomination trace information.
@@ -423,6 +563,9 @@ type QueryServer interface {
 	DenomTraces(context.Context, *QueryDenomTracesRequest) (*QueryDenomTracesResponse, error)
 	// Params queries all parameters of the ibc-transfer module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EscrowBalance queries the escrow address of a channel and the tokens it
+	// holds.
+	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +581,9 @@ func (*UnimplementedQueryServer) DenomTraces(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) EscrowBalance(ctx context.Context, req *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,6 +643,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Query/EscrowBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -513,6 +677,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EscrowBalance",
+			Handler:    _Query_EscrowBalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/query.proto",
@@ -725,6 +893,87 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -816,6 +1065,42 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEscrowBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1332,6 +1617,236 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -95,6 +95,80 @@ func (q Keeper) ClientStates(c context.Context, req *types.QueryClientStatesRequ
 	}, nil
 }
 
+// ClientStatus implements the Query/ClientStatus gRPC method
+func (q Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusRequest) (*types.QueryClientStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientState, found := q.GetClientState(ctx, req.ClientId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+		)
+	}
+
+	return &types.QueryClientStatusResponse{
+		ClientStatus: types.NewIdentifiedClientStatus(
+			req.ClientId, clientState, q.GetClientStatus(ctx, clientState, req.ClientId),
+		),
+	}, nil
+}
+
+// ClientStatuses implements the Query/ClientStatuses gRPC method
+func (q Keeper) ClientStatuses(c context.Context, req *types.QueryClientStatusesRequest) (*types.QueryClientStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clientStatuses := []types.IdentifiedClientStatus{}
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), host.KeyClientStorePrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		keySplit := strings.Split(string(key), "/")
+		if keySplit[len(keySplit)-1] != "clientState" {
+			return nil
+		}
+
+		clientState, err := q.UnmarshalClientState(value)
+		if err != nil {
+			return err
+		}
+
+		clientID := keySplit[1]
+		if err := host.ClientIdentifierValidator(clientID); err != nil {
+			return err
+		}
+
+		clientStatuses = append(
+			clientStatuses,
+			types.NewIdentifiedClientStatus(clientID, clientState, q.GetClientStatus(ctx, clientState, clientID)),
+		)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(clientStatuses, func(i, j int) bool {
+		return clientStatuses[i].ClientId < clientStatuses[j].ClientId
+	})
+
+	return &types.QueryClientStatusesResponse{
+		ClientStatuses: clientStatuses,
+		Pagination:     pageRes,
+	}, nil
+}
+
 // ConsensusState implements the Query/ConsensusState gRPC method
 func (q Keeper) ConsensusState(c context.Context, req *types.QueryConsensusStateRequest) (*types.QueryConsensusStateResponse, error) {
 	if req == nil {
@@ -163,6 +163,148 @@ func (suite *KeeperTestSuite) TestQueryClientStates() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientStatus() {
+	var (
+		req       *types.QueryClientStatusRequest
+		expStatus types.IdentifiedClientStatus
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"invalid clientID",
+			func() {
+				req = &types.QueryClientStatusRequest{}
+			},
+			false,
+		},
+		{"client not found",
+			func() {
+				req = &types.QueryClientStatusRequest{
+					ClientId: testClientID,
+				}
+			},
+			false,
+		},
+		{
+			"success, active client",
+			func() {
+				clientA, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+				clientState := suite.chainA.GetClientState(clientA)
+
+				expStatus = types.NewIdentifiedClientStatus(clientA, clientState, exported.Active)
+				req = &types.QueryClientStatusRequest{
+					ClientId: clientA,
+				}
+			},
+			true,
+		},
+		{
+			"success, frozen client",
+			func() {
+				clientA, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+				clientState := suite.chainA.GetClientState(clientA).(*ibctmtypes.ClientState)
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				suite.chainA.App.IBCKeeper.ClientKeeper.SetClientState(suite.chainA.GetContext(), clientA, clientState)
+
+				expStatus = types.NewIdentifiedClientStatus(clientA, clientState, exported.Frozen)
+				req = &types.QueryClientStatusRequest{
+					ClientId: clientA,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.QueryServer.ClientStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.ClientStatus)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClientStatuses() {
+	var (
+		req         *types.QueryClientStatusesRequest
+		expStatuses []types.IdentifiedClientStatus
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryClientStatusesRequest{}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				clientA1, _ := suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+				clientA2, _ := suite.coordinator.CreateClient(suite.chainA, suite.chainB, exported.Tendermint)
+
+				clientStateA1 := suite.chainA.GetClientState(clientA1)
+				clientStateA2 := suite.chainA.GetClientState(clientA2)
+
+				expStatuses = []types.IdentifiedClientStatus{
+					types.NewIdentifiedClientStatus(clientA1, clientStateA1, exported.Active),
+					types.NewIdentifiedClientStatus(clientA2, clientStateA2, exported.Active),
+				}
+				req = &types.QueryClientStatusesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      7,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expStatuses = nil
+
+			tc.malleate()
+
+			// always add localhost which is created by default in init genesis, sorted last
+			localhostClientState := suite.chainA.GetClientState(exported.Localhost)
+			expStatuses = append(expStatuses, types.NewIdentifiedClientStatus(exported.Localhost, localhostClientState, exported.Active))
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.ClientStatuses(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatuses, res.ClientStatuses)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConsensusState() {
 	var (
 		req               *types.QueryConsensusStateRequest
@@ -42,6 +42,18 @@ func (ics IdentifiedClientState) UnpackInterfaces(unpacker codectypes.AnyUnpacke
 	return unpacker.UnpackAny(ics.ClientState, new(exported.ClientState))
 }
 
+// NewIdentifiedClientStatus creates a new IdentifiedClientStatus instance
+func NewIdentifiedClientStatus(clientID string, clientState exported.ClientState, status exported.Status) IdentifiedClientStatus {
+	latestHeight := clientState.GetLatestHeight()
+
+	return IdentifiedClientStatus{
+		ClientId:     clientID,
+		ClientType:   clientState.ClientType(),
+		Status:       status.String(),
+		LatestHeight: NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()),
+	}
+}
+
 var _ sort.Interface = IdentifiedClientStates{}
 
 // IdentifiedClientStates defines a slice of ClientConsensusStates that supports the sort interface
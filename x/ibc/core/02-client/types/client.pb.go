@@ -80,6 +80,81 @@ func (m *IdentifiedClientState) GetClientState() *types.Any {
 	return nil
 }
 
+// IdentifiedClientStatus defines the status of a client along with its
+// identifier and latest height.
+type IdentifiedClientStatus struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty" yaml:"client_id"`
+	// client type
+	ClientType string `protobuf:"bytes,2,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty" yaml:"client_type"`
+	// status of the client: Active, Frozen, Expired or Unknown.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// latest height of the client, i.e. the height of its latest consensus
+	// state.
+	LatestHeight Height `protobuf:"bytes,4,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height" yaml:"latest_height"`
+}
+
+func (m *IdentifiedClientStatus) Reset()         { *m = IdentifiedClientStatus{} }
+func (m *IdentifiedClientStatus) String() string { return proto.CompactTextString(m) }
+func (*IdentifiedClientStatus) ProtoMessage()    {}
+func (*IdentifiedClientStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{1}
+}
+func (m *IdentifiedClientStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentifiedClientStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentifiedClientStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentifiedClientStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentifiedClientStatus.Merge(m, src)
+}
+func (m *IdentifiedClientStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentifiedClientStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentifiedClientStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentifiedClientStatus proto.InternalMessageInfo
+
+func (m *IdentifiedClientStatus) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *IdentifiedClientStatus) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *IdentifiedClientStatus) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *IdentifiedClientStatus) GetLatestHeight() Height {
+	if m != nil {
+		return m.LatestHeight
+	}
+	return Height{}
+}
+
 // ConsensusStateWithHeight defines a consensus state with an additional height field.
 type ConsensusStateWithHeight struct {
 	// consensus state height
@@ -92,7 +167,7 @@ func (m *ConsensusStateWithHeight) Reset()         { *m = ConsensusStateWithHeig
 func (m *ConsensusStateWithHeight) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStateWithHeight) ProtoMessage()    {}
 func (*ConsensusStateWithHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{1}
+	return fileDescriptor_b6bc4c8185546947, []int{2}
 }
 func (m *ConsensusStateWithHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,7 +223,7 @@ func (m *ClientConsensusStates) Reset()         { *m = ClientConsensusStates{} }
 func (m *ClientConsensusStates) String() string { return proto.CompactTextString(m) }
 func (*ClientConsensusStates) ProtoMessage()    {}
 func (*ClientConsensusStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{2}
+	return fileDescriptor_b6bc4c8185546947, []int{3}
 }
 func (m *ClientConsensusStates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -209,7 +284,7 @@ func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
 func (m *ClientUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*ClientUpdateProposal) ProtoMessage()    {}
 func (*ClientUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{3}
+	return fileDescriptor_b6bc4c8185546947, []int{4}
 }
 func (m *ClientUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +332,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{4}
+	return fileDescriptor_b6bc4c8185546947, []int{5}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +371,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,6 +409,7 @@ func (m *Params) GetAllowedClients() []string {
 
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
+	proto.RegisterType((*IdentifiedClientStatus)(nil), "ibc.core.client.v1.IdentifiedClientStatus")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
@@ -344,43 +420,48 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbd, 0x8e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x93, 0x7c, 0xd1, 0x66, 0xf2, 0x29, 0x59, 0x99, 0x84, 0xf5, 0xa6, 0xb0, 0xa3, 0xa9,
-	0x52, 0xec, 0xda, 0x24, 0x14, 0xa0, 0x74, 0x38, 0x0d, 0x5b, 0x80, 0x82, 0x11, 0x02, 0xd1, 0x44,
-	0xfe, 0x99, 0x75, 0x46, 0x38, 0x9e, 0xc8, 0x33, 0x09, 0x9b, 0x37, 0xa0, 0xa4, 0xa4, 0xa0, 0xe0,
-	0x09, 0xe8, 0x78, 0x03, 0x8a, 0x2d, 0xb7, 0xa4, 0xb2, 0x50, 0xf2, 0x06, 0x79, 0x02, 0xe4, 0x99,
-	0xc9, 0x12, 0x07, 0x22, 0xad, 0xa8, 0x7c, 0x7d, 0xe6, 0xcc, 0xb9, 0xe7, 0xdc, 0x19, 0x0d, 0x30,
-	0xb0, 0xe7, 0x5b, 0x3e, 0x49, 0x90, 0xe5, 0x47, 0x18, 0xc5, 0xcc, 0x5a, 0xf4, 0x64, 0x65, 0xce,
-	0x12, 0xc2, 0x88, 0xaa, 0x62, 0xcf, 0x37, 0x33, 0x82, 0x29, 0xe1, 0x45, 0xaf, 0xdd, 0x0c, 0x49,
-	0x48, 0xf8, 0xb2, 0x95, 0x55, 0x82, 0xd9, 0x3e, 0x0d, 0x09, 0x09, 0x23, 0x64, 0xf1, 0x3f, 0x6f,
-	0x7e, 0x69, 0xb9, 0xf1, 0x52, 0x2c, 0xc1, 0xcf, 0x0a, 0x68, 0x5d, 0x04, 0x28, 0x66, 0xf8, 0x12,
-	0xa3, 0x60, 0xc8, 0x85, 0x5e, 0x32, 0x97, 0x21, 0xb5, 0x07, 0xaa, 0x42, 0x77, 0x8c, 0x03, 0x4d,
-	0xe9, 0x28, 0xdd, 0xaa, 0xdd, 0xdc, 0xa4, 0xc6, 0xf1, 0xd2, 0x9d, 0x46, 0x03, 0x78, 0xbb, 0x04,
-	0x9d, 0x23, 0x51, 0x5f, 0x04, 0xea, 0x08, 0xfc, 0x2f, 0x71, 0x9a, 0x49, 0x68, 0xc5, 0x8e, 0xd2,
-	0xad, 0xf5, 0x9b, 0xa6, 0x68, 0x6f, 0x6e, 0xdb, 0x9b, 0x4f, 0xe2, 0xa5, 0x7d, 0xb2, 0x49, 0x8d,
-	0x7b, 0x39, 0x2d, 0xbe, 0x07, 0x3a, 0x35, 0xff, 0xb7, 0x09, 0xf8, 0x55, 0x01, 0xda, 0x90, 0xc4,
-	0x14, 0xc5, 0x74, 0x4e, 0x39, 0xf4, 0x1a, 0xb3, 0xc9, 0x53, 0x84, 0xc3, 0x09, 0x53, 0x1f, 0x83,
-	0xca, 0x84, 0x57, 0xdc, 0x5e, 0xad, 0xdf, 0x36, 0xff, 0x9c, 0x88, 0x29, 0xb8, 0x76, 0xf9, 0x3a,
-	0x35, 0x0a, 0x8e, 0xe4, 0xab, 0x6f, 0x40, 0xc3, 0xdf, 0xaa, 0xde, 0xc1, 0xeb, 0xe9, 0x26, 0x35,
-	0x5a, 0x99, 0x57, 0xb8, 0xb7, 0x0b, 0x3a, 0x75, 0x3f, 0xe7, 0x0e, 0x7e, 0x57, 0x40, 0x4b, 0x4c,
-	0x31, 0x6f, 0x9b, 0xfe, 0xcb, 0x3c, 0xaf, 0xc0, 0xf1, 0x5e, 0x43, 0xaa, 0x15, 0x3b, 0xa5, 0x6e,
-	0xad, 0x7f, 0xf6, 0xb7, 0xa8, 0x87, 0x06, 0x65, 0x1b, 0x59, 0xf8, 0x4d, 0x6a, 0x9c, 0xc8, 0x5e,
-	0x7b, 0x9a, 0xd0, 0x69, 0xe4, 0x53, 0x50, 0xf8, 0x4d, 0x01, 0x4d, 0x11, 0xe3, 0xd5, 0x2c, 0x70,
-	0x19, 0x1a, 0x25, 0x64, 0x46, 0xa8, 0x1b, 0xa9, 0x4d, 0xf0, 0x1f, 0xc3, 0x2c, 0x42, 0x22, 0x81,
-	0x23, 0x7e, 0xd4, 0x0e, 0xa8, 0x05, 0x88, 0xfa, 0x09, 0x9e, 0x31, 0x4c, 0x62, 0x3e, 0xcb, 0xaa,
-	0xb3, 0x0b, 0xe5, 0xd3, 0x97, 0xee, 0x94, 0xfe, 0x2c, 0x3b, 0x5e, 0x37, 0x40, 0x89, 0x56, 0x3e,
-	0x7c, 0x36, 0x8e, 0xe4, 0x0c, 0xca, 0x1f, 0xbe, 0x18, 0x85, 0xec, 0x3a, 0x57, 0xe4, 0xed, 0x18,
-	0x82, 0x46, 0x82, 0x16, 0x98, 0x62, 0x12, 0x8f, 0xe3, 0xf9, 0xd4, 0x43, 0x09, 0xf7, 0x5c, 0xb6,
-	0xdb, 0x9b, 0xd4, 0xb8, 0x2f, 0xfa, 0xee, 0x11, 0xa0, 0x53, 0xdf, 0x22, 0xcf, 0x39, 0x90, 0x13,
-	0x91, 0x77, 0xad, 0x78, 0x50, 0x44, 0x10, 0x76, 0x44, 0x84, 0x93, 0xc1, 0x51, 0x66, 0xed, 0x53,
-	0x66, 0xef, 0x19, 0xa8, 0x8c, 0xdc, 0xc4, 0x9d, 0xd2, 0x4c, 0xd8, 0x8d, 0x22, 0xf2, 0x1e, 0x05,
-	0x63, 0x11, 0x98, 0x6a, 0x4a, 0xa7, 0xd4, 0xad, 0xee, 0x0a, 0xef, 0x11, 0xa0, 0x53, 0x97, 0x88,
-	0x38, 0x19, 0x6a, 0xbf, 0xb8, 0x5e, 0xe9, 0xca, 0xcd, 0x4a, 0x57, 0x7e, 0xae, 0x74, 0xe5, 0xe3,
-	0x5a, 0x2f, 0xdc, 0xac, 0xf5, 0xc2, 0x8f, 0xb5, 0x5e, 0x78, 0xfb, 0x28, 0xc4, 0x6c, 0x32, 0xf7,
-	0x4c, 0x9f, 0x4c, 0x2d, 0x9f, 0xd0, 0x29, 0xa1, 0xf2, 0x73, 0x4e, 0x83, 0x77, 0xd6, 0x95, 0x75,
-	0xfb, 0xb6, 0x3c, 0xe8, 0x9f, 0xcb, 0xe7, 0x85, 0x2d, 0x67, 0x88, 0x7a, 0x15, 0x3e, 0xdc, 0x87,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x39, 0xbe, 0xfd, 0x04, 0x7e, 0x04, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbf, 0x6e, 0xd3, 0x40,
+	0x18, 0xcf, 0x35, 0x21, 0x6a, 0x2e, 0xa5, 0xad, 0x4c, 0x9a, 0xba, 0x11, 0x8a, 0xa3, 0x9b, 0x32,
+	0xb4, 0x36, 0x2d, 0x43, 0x51, 0x37, 0xdc, 0x85, 0x0e, 0xa0, 0x72, 0x80, 0x40, 0x48, 0x28, 0x72,
+	0xec, 0x6b, 0x72, 0xc2, 0xf1, 0x59, 0xbe, 0x4b, 0x69, 0xde, 0x80, 0x91, 0x91, 0x81, 0x81, 0x27,
+	0x60, 0xe3, 0x0d, 0x18, 0x3a, 0x76, 0x64, 0xb2, 0x50, 0xfb, 0x06, 0x5e, 0x59, 0xd0, 0xf9, 0x2e,
+	0xa5, 0x0e, 0x8d, 0xa8, 0x3a, 0xf9, 0xbe, 0x7f, 0xbf, 0xdf, 0xef, 0xbb, 0xef, 0xf3, 0x41, 0x8b,
+	0xf6, 0x7d, 0xc7, 0x67, 0x09, 0x71, 0xfc, 0x90, 0x92, 0x48, 0x38, 0xc7, 0xdb, 0xfa, 0x64, 0xc7,
+	0x09, 0x13, 0xcc, 0x30, 0x68, 0xdf, 0xb7, 0x65, 0x82, 0xad, 0xdd, 0xc7, 0xdb, 0xad, 0xc6, 0x80,
+	0x0d, 0x58, 0x1e, 0x76, 0xe4, 0x49, 0x65, 0xb6, 0x36, 0x06, 0x8c, 0x0d, 0x42, 0xe2, 0xe4, 0x56,
+	0x7f, 0x7c, 0xe4, 0x78, 0xd1, 0x44, 0x85, 0xd0, 0x17, 0x00, 0xd7, 0x0e, 0x02, 0x12, 0x09, 0x7a,
+	0x44, 0x49, 0xb0, 0x9f, 0x03, 0xbd, 0x10, 0x9e, 0x20, 0xc6, 0x36, 0xac, 0x29, 0xdc, 0x1e, 0x0d,
+	0x4c, 0xd0, 0x01, 0xdd, 0x9a, 0xdb, 0xc8, 0x52, 0x6b, 0x75, 0xe2, 0x8d, 0xc2, 0x3d, 0x74, 0x19,
+	0x42, 0x78, 0x51, 0x9d, 0x0f, 0x02, 0xe3, 0x10, 0x2e, 0x69, 0x3f, 0x97, 0x10, 0xe6, 0x42, 0x07,
+	0x74, 0xeb, 0x3b, 0x0d, 0x5b, 0xd1, 0xdb, 0x53, 0x7a, 0xfb, 0x71, 0x34, 0x71, 0xd7, 0xb3, 0xd4,
+	0xba, 0x57, 0xc0, 0xca, 0x6b, 0x10, 0xae, 0xfb, 0x7f, 0x45, 0xa0, 0xdf, 0x00, 0x36, 0xaf, 0x93,
+	0x37, 0xe6, 0xb7, 0xd1, 0xb7, 0x0b, 0x35, 0x78, 0x4f, 0x4c, 0x62, 0x25, 0xaf, 0xe6, 0x36, 0xb3,
+	0xd4, 0x32, 0x0a, 0x45, 0x32, 0x88, 0x30, 0x54, 0xd6, 0xcb, 0x49, 0x4c, 0x8c, 0x26, 0xac, 0xf2,
+	0x9c, 0xd5, 0x2c, 0xcb, 0x1a, 0xac, 0x2d, 0xe3, 0x1d, 0xbc, 0x1b, 0x7a, 0x82, 0x70, 0xd1, 0x1b,
+	0x12, 0x3a, 0x18, 0x0a, 0xb3, 0x92, 0x77, 0xdc, 0xb2, 0xff, 0x1d, 0x8d, 0xfd, 0x24, 0xcf, 0x70,
+	0xef, 0x9f, 0xa6, 0x56, 0x29, 0x4b, 0xad, 0x86, 0xa2, 0x2c, 0x94, 0x23, 0xbc, 0xa4, 0x6c, 0x95,
+	0x8b, 0xbe, 0x01, 0x68, 0xee, 0xb3, 0x88, 0x93, 0x88, 0x8f, 0x79, 0x7e, 0x21, 0xaf, 0xa9, 0x18,
+	0xaa, 0xa0, 0xf1, 0x08, 0x56, 0x35, 0x29, 0xf8, 0x2f, 0x69, 0x45, 0x92, 0x62, 0x9d, 0x6f, 0xbc,
+	0x81, 0x2b, 0xfe, 0x14, 0xf5, 0x06, 0x93, 0xda, 0xc8, 0x52, 0x6b, 0x4d, 0xaa, 0x45, 0x33, 0x55,
+	0x08, 0x2f, 0xfb, 0x05, 0x75, 0xe8, 0x07, 0x80, 0x6b, 0x6a, 0x48, 0x45, 0xd9, 0xb7, 0x9a, 0xd6,
+	0x09, 0x5c, 0x9d, 0x21, 0xe4, 0xe6, 0x42, 0xa7, 0xdc, 0xad, 0xef, 0x6c, 0x5e, 0xd7, 0xea, 0xbc,
+	0x8b, 0x72, 0x2d, 0x7d, 0xe3, 0xeb, 0x9a, 0x6b, 0x06, 0x13, 0xe1, 0x95, 0x62, 0x17, 0x1c, 0x7d,
+	0x07, 0xb0, 0xa1, 0xda, 0x78, 0x15, 0x07, 0x9e, 0x20, 0x87, 0x09, 0x8b, 0x19, 0xf7, 0x42, 0xa3,
+	0x01, 0xef, 0x08, 0x2a, 0x42, 0xa2, 0x3a, 0xc0, 0xca, 0x30, 0x3a, 0xb0, 0x1e, 0x10, 0xee, 0x27,
+	0x34, 0x16, 0x94, 0x45, 0x6a, 0xad, 0xf0, 0x55, 0x57, 0xb1, 0xfb, 0xf2, 0x8d, 0xba, 0xdf, 0x94,
+	0xe3, 0xf5, 0x02, 0x92, 0x98, 0x95, 0xf9, 0xb3, 0xc1, 0x3a, 0x67, 0xaf, 0xf2, 0xf1, 0xab, 0x55,
+	0x92, 0x3f, 0x73, 0x55, 0x6f, 0xc7, 0x3e, 0x5c, 0x49, 0xc8, 0x31, 0xe5, 0x94, 0x45, 0xbd, 0x68,
+	0x3c, 0xea, 0x93, 0x24, 0xd7, 0x5c, 0x71, 0x5b, 0x59, 0x6a, 0x35, 0x15, 0xef, 0x4c, 0x02, 0xc2,
+	0xcb, 0x53, 0xcf, 0xb3, 0xdc, 0x51, 0x00, 0xd1, 0xbb, 0xb6, 0x30, 0x17, 0x64, 0xba, 0xc2, 0x97,
+	0x20, 0x4a, 0xc9, 0xde, 0xa2, 0x94, 0xf6, 0x59, 0xca, 0x7b, 0x0a, 0xab, 0x87, 0x5e, 0xe2, 0x8d,
+	0xb8, 0x04, 0xf6, 0xc2, 0x90, 0x7d, 0x20, 0x41, 0x4f, 0x35, 0xcc, 0x4d, 0xd0, 0x29, 0x77, 0x6b,
+	0x57, 0x81, 0x67, 0x12, 0x10, 0x5e, 0xd6, 0x1e, 0x35, 0x19, 0xee, 0x3e, 0x3f, 0x3d, 0x6f, 0x83,
+	0xb3, 0xf3, 0x36, 0xf8, 0x75, 0xde, 0x06, 0x9f, 0x2e, 0xda, 0xa5, 0xb3, 0x8b, 0x76, 0xe9, 0xe7,
+	0x45, 0xbb, 0xf4, 0x76, 0x77, 0x40, 0xc5, 0x70, 0xdc, 0xb7, 0x7d, 0x36, 0x72, 0x7c, 0xc6, 0x47,
+	0x8c, 0xeb, 0xcf, 0x16, 0x0f, 0xde, 0x3b, 0x27, 0xce, 0xe5, 0xcb, 0xfa, 0x60, 0x67, 0x4b, 0x3f,
+	0xae, 0xf2, 0x9f, 0xe7, 0xfd, 0x6a, 0x7e, 0xb9, 0x0f, 0xff, 0x0c, 0x00, 0xb2, 0xa7, 0x1c, 0x03,
+	0x7c, 0x05, 0x00, 0x00,
 }
 
 func (m *IdentifiedClientState) Marshal() (dAtA []byte, err error) {
@@ -425,6 +506,60 @@ func (m *IdentifiedClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IdentifiedClientStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentifiedClientStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentifiedClientStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusStateWithHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -663,6 +798,29 @@ func (m *IdentifiedClientState) Size() (n int) {
 	return n
 }
 
+func (m *IdentifiedClientStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = m.LatestHeight.Size()
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
 func (m *ConsensusStateWithHeight) Size() (n int) {
 	if m == nil {
 		return 0
@@ -876,6 +1034,185 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IdentifiedClientStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedClientStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedClientStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -456,3 +456,51 @@ func GetCmdQueryNextSequenceReceive() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryPendingPackets defines the command to query the packets sent on a
+// channel that are neither acknowledged nor timed out yet.
+func GetCmdQueryPendingPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packets [port-id] [channel-id]",
+		Short: "Query the pending packet sequence ranges of a channel",
+		Long: `Query the sequence ranges of the packets sent on a channel which are neither
+acknowledged nor timed out yet, i.e. the packets which were not received by the
+counterparty chain or whose acknowledgement or timeout was not relayed back, along
+with the next send, receive and acknowledgement sequences of the channel. The
+ranges are built from a page of the packet commitments of the channel.`,
+		Example: fmt.Sprintf(
+			"%s query %s %s pending-packets [port-id] [channel-id]", version.AppName, host.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingPacketsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PendingPackets(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending packets of a channel")
+
+	return cmd
+}
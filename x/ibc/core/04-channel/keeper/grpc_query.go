@@ -473,6 +473,58 @@ func (q Keeper) NextSequenceReceive(c context.Context, req *types.QueryNextSeque
 	return types.NewQueryNextSequenceReceiveResponse(sequence, nil, selfHeight), nil
 }
 
+// PendingPackets implements the Query/PendingPackets gRPC method
+func (q Keeper) PendingPackets(c context.Context, req *types.QueryPendingPacketsRequest) (*types.QueryPendingPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validategRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := q.GetChannel(ctx, req.PortId, req.ChannelId); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	var sequences []uint64
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(host.PacketCommitmentPrefixPath(req.PortId, req.ChannelId)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		keySplit := strings.Split(string(key), "/")
+
+		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		sequences = append(sequences, sequence)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the next sequences are set along with the channel
+	nextSequenceSend, _ := q.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	nextSequenceRecv, _ := q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
+	nextSequenceAck, _ := q.GetNextSequenceAck(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryPendingPacketsResponse{
+		Pending:             types.NewSequenceRanges(sequences),
+		NextSequenceSend:    nextSequenceSend,
+		NextSequenceReceive: nextSequenceRecv,
+		NextSequenceAck:     nextSequenceAck,
+		Height:              clienttypes.GetSelfHeight(ctx),
+		Pagination:          pageRes,
+	}, nil
+}
+
 func validategRPCRequest(portID, channelID string) error {
 	if err := host.PortIdentifierValidator(portID); err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
@@ -1374,3 +1374,105 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryPendingPackets() {
+	var (
+		req       *types.QueryPendingPacketsRequest
+		expRanges []types.SequenceRange
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryPendingPacketsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryPendingPacketsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{"channel not found",
+			func() {
+				req = &types.QueryPendingPacketsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success, no pending packets",
+			func() {
+				_, _, _, _, channelA, _ := suite.coordinator.Setup(suite.chainA, suite.chainB, types.UNORDERED)
+				expRanges = []types.SequenceRange{}
+
+				req = &types.QueryPendingPacketsRequest{
+					PortId:    channelA.PortID,
+					ChannelId: channelA.ID,
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				_, _, _, _, channelA, _ := suite.coordinator.Setup(suite.chainA, suite.chainB, types.UNORDERED)
+
+				for _, seq := range []uint64{1, 2, 3, 6, 8, 9} {
+					suite.chainA.App.IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), channelA.PortID, channelA.ID, seq, []byte(fmt.Sprintf("hash_%d", seq)))
+				}
+				expRanges = []types.SequenceRange{{Start: 1, End: 3}, {Start: 6, End: 6}, {Start: 8, End: 9}}
+
+				req = &types.QueryPendingPacketsRequest{
+					PortId:    channelA.PortID,
+					ChannelId: channelA.ID,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.QueryServer.PendingPackets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRanges, res.Pending)
+				suite.Require().Equal(uint64(1), res.NextSequenceSend)
+				suite.Require().Equal(uint64(1), res.NextSequenceReceive)
+				suite.Require().Equal(uint64(1), res.NextSequenceAck)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -347,6 +347,61 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// SequenceRange defines an inclusive range of packet sequences.
+type SequenceRange struct {
+	// first sequence of the range.
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// last sequence of the range.
+	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *SequenceRange) Reset()         { *m = SequenceRange{} }
+func (m *SequenceRange) String() string { return proto.CompactTextString(m) }
+func (*SequenceRange) ProtoMessage()    {}
+func (*SequenceRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *SequenceRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SequenceRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SequenceRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SequenceRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SequenceRange.Merge(m, src)
+}
+func (m *SequenceRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SequenceRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SequenceRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SequenceRange proto.InternalMessageInfo
+
+func (m *SequenceRange) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *SequenceRange) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 // Acknowledgement is the recommended acknowledgement format to be used by
 // app-specific protocols.
 // NOTE: The field numbers 21 and 22 were explicitly chosen to avoid accidental
@@ -367,7 +422,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{6}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,70 +504,73 @@ func init() {
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*SequenceRange)(nil), "ibc.core.channel.v1.SequenceRange")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 904 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x15, 0x25, 0xea, 0x75, 0x65, 0xc9, 0xf2, 0xa4, 0x56, 0x58, 0x36, 0x11, 0x15, 0xa2, 0x0b,
-	0x23, 0x45, 0xa4, 0x38, 0x0d, 0xda, 0x22, 0xab, 0x5a, 0x8f, 0xc0, 0x44, 0x03, 0xc9, 0xa0, 0xe4,
-	0x45, 0xb3, 0x51, 0x65, 0x72, 0x2a, 0x11, 0x96, 0x38, 0x2a, 0x39, 0xb2, 0xeb, 0x3f, 0x08, 0xb4,
-	0xea, 0x0f, 0x08, 0x28, 0x50, 0xb4, 0xbf, 0xd0, 0x5f, 0xc8, 0x32, 0xcb, 0xae, 0x88, 0xc2, 0x5e,
-	0x74, 0xaf, 0x1f, 0x68, 0xc1, 0x99, 0xa1, 0x1e, 0x4e, 0xe0, 0x65, 0x57, 0x59, 0x71, 0xee, 0x39,
-	0xe7, 0x3e, 0x74, 0xef, 0xd5, 0x0c, 0x3c, 0x72, 0xce, 0xac, 0x9a, 0x45, 0x3c, 0x5c, 0xb3, 0x46,
-	0x03, 0xd7, 0xc5, 0xe3, 0xda, 0xc5, 0x61, 0x74, 0xac, 0x4e, 0x3d, 0x42, 0x09, 0xba, 0xe7, 0x9c,
-	0x59, 0xd5, 0x50, 0x52, 0x8d, 0xf0, 0x8b, 0x43, 0xf5, 0x93, 0x21, 0x19, 0x12, 0xc6, 0xd7, 0xc2,
-	0x13, 0x97, 0xaa, 0xda, 0x3a, 0xda, 0xd8, 0xc1, 0x2e, 0x65, 0xc1, 0xd8, 0x89, 0x0b, 0xf4, 0xdf,
-	0xe3, 0x90, 0x6e, 0xf0, 0x28, 0xe8, 0x29, 0x24, 0x7d, 0x3a, 0xa0, 0x58, 0x91, 0x2a, 0xd2, 0x41,
-	0xe1, 0x99, 0x5a, 0xfd, 0x40, 0x9e, 0x6a, 0x37, 0x54, 0x98, 0x5c, 0x88, 0xbe, 0x82, 0x0c, 0xf1,
-	0x6c, 0xec, 0x39, 0xee, 0x50, 0x89, 0xdf, 0xe1, 0xd4, 0x09, 0x45, 0xe6, 0x4a, 0x8b, 0xbe, 0x83,
-	0x1d, 0x8b, 0xcc, 0x5c, 0x8a, 0xbd, 0xe9, 0xc0, 0xa3, 0x57, 0x4a, 0xa2, 0x22, 0x1d, 0xe4, 0x9e,
-	0x3d, 0xfa, 0xa0, 0x6f, 0x63, 0x43, 0x58, 0x97, 0xdf, 0x06, 0x5a, 0xcc, 0xdc, 0x72, 0x46, 0x0d,
-	0xd8, 0xb5, 0x88, 0xeb, 0x62, 0x8b, 0x3a, 0xc4, 0xed, 0x8f, 0xc8, 0xd4, 0x57, 0xe4, 0x4a, 0xe2,
-	0x20, 0x5b, 0x57, 0x97, 0x81, 0x56, 0xba, 0x1a, 0x4c, 0xc6, 0x2f, 0xf4, 0x5b, 0x02, 0xdd, 0x2c,
-	0xac, 0x91, 0x63, 0x32, 0xf5, 0x91, 0x02, 0xe9, 0x0b, 0xec, 0xf9, 0x0e, 0x71, 0x95, 0x64, 0x45,
-	0x3a, 0xc8, 0x9a, 0x91, 0xf9, 0x42, 0x7e, 0xf3, 0xab, 0x16, 0xd3, 0xff, 0x89, 0xc3, 0x9e, 0x61,
-	0x63, 0x97, 0x3a, 0x3f, 0x3a, 0xd8, 0xfe, 0xd8, 0xb1, 0x3b, 0x3a, 0x86, 0xee, 0x43, 0x7a, 0x4a,
-	0x3c, 0xda, 0x77, 0x6c, 0x25, 0xc5, 0x98, 0x54, 0x68, 0x1a, 0x36, 0x7a, 0x08, 0x20, 0xca, 0x0c,
-	0xb9, 0x34, 0xe3, 0xb2, 0x02, 0x31, 0x6c, 0xd1, 0xe9, 0x4b, 0xd8, 0xd9, 0xfc, 0x01, 0xe8, 0x8b,
-	0x75, 0xb4, 0xb0, 0xcb, 0xd9, 0x3a, 0x5a, 0x06, 0x5a, 0x81, 0x17, 0x29, 0x08, 0x7d, 0x95, 0xe1,
-	0xf9, 0x56, 0x86, 0x38, 0xd3, 0xef, 0x2f, 0x03, 0x6d, 0x4f, 0xfc, 0xa8, 0x15, 0xa7, 0xbf, 0x9f,
-	0xf8, 0xdf, 0x04, 0xa4, 0x4e, 0x06, 0xd6, 0x39, 0xa6, 0x48, 0x85, 0x8c, 0x8f, 0x7f, 0x9a, 0x61,
-	0xd7, 0xe2, 0xa3, 0x95, 0xcd, 0x95, 0x8d, 0xbe, 0x86, 0x9c, 0x4f, 0x66, 0x9e, 0x85, 0xfb, 0x61,
-	0x4e, 0x91, 0xa3, 0xb4, 0x0c, 0x34, 0xc4, 0x73, 0x6c, 0x90, 0xba, 0x09, 0xdc, 0x3a, 0x21, 0x1e,
-	0x45, 0xdf, 0x42, 0x41, 0x70, 0x22, 0x33, 0x1b, 0x62, 0xb6, 0xfe, 0xe9, 0x32, 0xd0, 0xf6, 0xb7,
-	0x7c, 0x05, 0xaf, 0x9b, 0x79, 0x0e, 0x44, 0xeb, 0xf6, 0x12, 0x8a, 0x36, 0xf6, 0xa9, 0xe3, 0x0e,
-	0xd8, 0x5c, 0x58, 0x7e, 0x99, 0xc5, 0xf8, 0x6c, 0x19, 0x68, 0xf7, 0x79, 0x8c, 0xdb, 0x0a, 0xdd,
-	0xdc, 0xdd, 0x80, 0x58, 0x25, 0x1d, 0xb8, 0xb7, 0xa9, 0x8a, 0xca, 0x61, 0x63, 0xac, 0x97, 0x97,
-	0x81, 0xa6, 0xbe, 0x1f, 0x6a, 0x55, 0x13, 0xda, 0x40, 0xa3, 0xc2, 0x10, 0xc8, 0xf6, 0x80, 0x0e,
-	0xd8, 0xb8, 0x77, 0x4c, 0x76, 0x46, 0x3f, 0x40, 0x81, 0x3a, 0x13, 0x4c, 0x66, 0xb4, 0x3f, 0xc2,
-	0xce, 0x70, 0x44, 0xd9, 0xc0, 0x73, 0x5b, 0xfb, 0xce, 0x6f, 0xa2, 0x8b, 0xc3, 0xea, 0x31, 0x53,
-	0xd4, 0x1f, 0x86, 0xcb, 0xba, 0x6e, 0xc7, 0xb6, 0xbf, 0x6e, 0xe6, 0x05, 0xc0, 0xd5, 0xc8, 0x80,
-	0xbd, 0x48, 0x11, 0x7e, 0x7d, 0x3a, 0x98, 0x4c, 0x95, 0x4c, 0x38, 0xae, 0xfa, 0x83, 0x65, 0xa0,
-	0x29, 0xdb, 0x41, 0x56, 0x12, 0xdd, 0x2c, 0x0a, 0xac, 0x17, 0x41, 0x62, 0x03, 0xfe, 0x90, 0x20,
-	0xc7, 0x37, 0x80, 0xfd, 0x67, 0xff, 0x87, 0xd5, 0xdb, 0xda, 0xb4, 0xc4, 0xad, 0x4d, 0x8b, 0xba,
-	0x2a, 0xaf, 0xbb, 0x2a, 0x0a, 0xed, 0xc0, 0xee, 0x91, 0x75, 0xee, 0x92, 0xcb, 0x31, 0xb6, 0x87,
-	0x78, 0x82, 0x5d, 0x8a, 0x14, 0x48, 0x79, 0xd8, 0x9f, 0x8d, 0xa9, 0xb2, 0x1f, 0xca, 0x8f, 0x63,
-	0xa6, 0xb0, 0x51, 0x09, 0x92, 0xd8, 0xf3, 0x88, 0xa7, 0x94, 0xc2, 0x9a, 0x8e, 0x63, 0x26, 0x37,
-	0xeb, 0x00, 0x19, 0x0f, 0xfb, 0x53, 0xe2, 0xfa, 0xf8, 0xf1, 0x9f, 0x12, 0x24, 0xbb, 0xe2, 0x82,
-	0xd2, 0xba, 0xbd, 0xa3, 0x5e, 0xab, 0x7f, 0xda, 0x36, 0xda, 0x46, 0xcf, 0x38, 0x7a, 0x65, 0xbc,
-	0x6e, 0x35, 0xfb, 0xa7, 0xed, 0xee, 0x49, 0xab, 0x61, 0xbc, 0x34, 0x5a, 0xcd, 0x62, 0x4c, 0xdd,
-	0x9b, 0x2f, 0x2a, 0xf9, 0x2d, 0x01, 0x52, 0x00, 0xb8, 0x5f, 0x08, 0x16, 0x25, 0x35, 0x33, 0x5f,
-	0x54, 0xe4, 0xf0, 0x8c, 0xca, 0x90, 0xe7, 0x4c, 0xcf, 0xfc, 0xbe, 0x73, 0xd2, 0x6a, 0x17, 0xe3,
-	0x6a, 0x6e, 0xbe, 0xa8, 0xa4, 0x85, 0xb9, 0xf6, 0x64, 0x64, 0x82, 0x7b, 0x32, 0xe6, 0x01, 0xec,
-	0x70, 0xa6, 0xf1, 0xaa, 0xd3, 0x6d, 0x35, 0x8b, 0xb2, 0x0a, 0xf3, 0x45, 0x25, 0xc5, 0x2d, 0x55,
-	0x7e, 0xf3, 0x5b, 0x39, 0xf6, 0xf8, 0x12, 0x92, 0xec, 0xae, 0x44, 0x9f, 0x43, 0xa9, 0x63, 0x36,
-	0x5b, 0x66, 0xbf, 0xdd, 0x69, 0xb7, 0x6e, 0xd5, 0xcb, 0x42, 0x86, 0x38, 0xd2, 0x61, 0x97, 0xab,
-	0x4e, 0xdb, 0xec, 0xdb, 0x6a, 0x16, 0x25, 0x35, 0x3f, 0x5f, 0x54, 0xb2, 0x2b, 0x20, 0x2c, 0x98,
-	0x6b, 0x22, 0x85, 0x28, 0x58, 0x98, 0x3c, 0x71, 0xdd, 0x7c, 0x7b, 0x5d, 0x96, 0xde, 0x5d, 0x97,
-	0xa5, 0xbf, 0xaf, 0xcb, 0xd2, 0x2f, 0x37, 0xe5, 0xd8, 0xbb, 0x9b, 0x72, 0xec, 0xaf, 0x9b, 0x72,
-	0xec, 0xf5, 0x37, 0x43, 0x87, 0x8e, 0x66, 0x67, 0x55, 0x8b, 0x4c, 0x6a, 0x16, 0xf1, 0x27, 0xc4,
-	0x17, 0x9f, 0x27, 0xbe, 0x7d, 0x5e, 0xfb, 0xb9, 0xb6, 0x7a, 0x93, 0x9f, 0x3e, 0x7f, 0x12, 0x3d,
-	0xf2, 0xf4, 0x6a, 0x8a, 0xfd, 0xb3, 0x14, 0x7b, 0x94, 0xbf, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0xd7, 0x33, 0x69, 0x35, 0x05, 0x08, 0x00, 0x00,
+	// 931 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0x5a, 0xb6, 0x46, 0x96, 0x2c, 0x6f, 0x62, 0x85, 0x65, 0x13, 0x51, 0x21, 0x7a,
+	0x30, 0x52, 0x44, 0x8a, 0xd3, 0xa0, 0x29, 0x72, 0xaa, 0xf5, 0x13, 0x98, 0x68, 0x20, 0x19, 0x2b,
+	0xf9, 0xd0, 0x5c, 0x54, 0x9a, 0xdc, 0x4a, 0x84, 0x25, 0xae, 0x4a, 0xae, 0xec, 0xfa, 0x0d, 0x02,
+	0x9d, 0xfa, 0x02, 0x02, 0x0a, 0x14, 0xed, 0x2b, 0xf4, 0x15, 0x72, 0xcc, 0xb1, 0x27, 0xa1, 0xb0,
+	0x0f, 0xbd, 0xeb, 0x05, 0x5a, 0x70, 0x77, 0xa9, 0x1f, 0x27, 0xc8, 0xb1, 0xa7, 0x9c, 0xb8, 0xf3,
+	0x7d, 0xdf, 0xfc, 0x70, 0x66, 0xb4, 0x14, 0x3c, 0xf4, 0xce, 0x9c, 0x8a, 0x43, 0x03, 0x52, 0x71,
+	0xfa, 0xb6, 0xef, 0x93, 0x41, 0xe5, 0xe2, 0x30, 0x3e, 0x96, 0x47, 0x01, 0x65, 0x14, 0xdd, 0xf1,
+	0xce, 0x9c, 0x72, 0x24, 0x29, 0xc7, 0xf8, 0xc5, 0xa1, 0x7e, 0xb7, 0x47, 0x7b, 0x94, 0xf3, 0x95,
+	0xe8, 0x24, 0xa4, 0xba, 0xb1, 0x8c, 0x36, 0xf0, 0x88, 0xcf, 0x78, 0x30, 0x7e, 0x12, 0x02, 0xf3,
+	0xf7, 0x0d, 0xd8, 0xaa, 0x89, 0x28, 0xe8, 0x09, 0x6c, 0x86, 0xcc, 0x66, 0x44, 0x53, 0x4a, 0xca,
+	0x41, 0xee, 0xa9, 0x5e, 0xfe, 0x40, 0x9e, 0x72, 0x3b, 0x52, 0x60, 0x21, 0x44, 0x5f, 0xc3, 0x36,
+	0x0d, 0x5c, 0x12, 0x78, 0x7e, 0x4f, 0xdb, 0xf8, 0x88, 0x53, 0x2b, 0x12, 0xe1, 0x85, 0x16, 0x7d,
+	0x07, 0x3b, 0x0e, 0x1d, 0xfb, 0x8c, 0x04, 0x23, 0x3b, 0x60, 0x57, 0x5a, 0xb2, 0xa4, 0x1c, 0x64,
+	0x9e, 0x3e, 0xfc, 0xa0, 0x6f, 0x6d, 0x45, 0x58, 0x55, 0xdf, 0xce, 0x8c, 0x04, 0x5e, 0x73, 0x46,
+	0x35, 0xd8, 0x75, 0xa8, 0xef, 0x13, 0x87, 0x79, 0xd4, 0xef, 0xf6, 0xe9, 0x28, 0xd4, 0xd4, 0x52,
+	0xf2, 0x20, 0x5d, 0xd5, 0xe7, 0x33, 0xa3, 0x70, 0x65, 0x0f, 0x07, 0x2f, 0xcc, 0x5b, 0x02, 0x13,
+	0xe7, 0x96, 0xc8, 0x31, 0x1d, 0x85, 0x48, 0x83, 0xad, 0x0b, 0x12, 0x84, 0x1e, 0xf5, 0xb5, 0xcd,
+	0x92, 0x72, 0x90, 0xc6, 0xb1, 0xf9, 0x42, 0x7d, 0xf3, 0xab, 0x91, 0x30, 0xff, 0xd9, 0x80, 0x3d,
+	0xcb, 0x25, 0x3e, 0xf3, 0x7e, 0xf4, 0x88, 0xfb, 0xa9, 0x63, 0x1f, 0xe9, 0x18, 0xba, 0x07, 0x5b,
+	0x23, 0x1a, 0xb0, 0xae, 0xe7, 0x6a, 0x29, 0xce, 0xa4, 0x22, 0xd3, 0x72, 0xd1, 0x03, 0x00, 0x59,
+	0x66, 0xc4, 0x6d, 0x71, 0x2e, 0x2d, 0x11, 0xcb, 0x95, 0x9d, 0xbe, 0x84, 0x9d, 0xd5, 0x17, 0x40,
+	0x5f, 0x2e, 0xa3, 0x45, 0x5d, 0x4e, 0x57, 0xd1, 0x7c, 0x66, 0xe4, 0x44, 0x91, 0x92, 0x30, 0x17,
+	0x19, 0x9e, 0xad, 0x65, 0xd8, 0xe0, 0xfa, 0xfd, 0xf9, 0xcc, 0xd8, 0x93, 0x2f, 0xb5, 0xe0, 0xcc,
+	0xf7, 0x13, 0xff, 0x9b, 0x84, 0xd4, 0x89, 0xed, 0x9c, 0x13, 0x86, 0x74, 0xd8, 0x0e, 0xc9, 0x4f,
+	0x63, 0xe2, 0x3b, 0x62, 0xb4, 0x2a, 0x5e, 0xd8, 0xe8, 0x39, 0x64, 0x42, 0x3a, 0x0e, 0x1c, 0xd2,
+	0x8d, 0x72, 0xca, 0x1c, 0x85, 0xf9, 0xcc, 0x40, 0x22, 0xc7, 0x0a, 0x69, 0x62, 0x10, 0xd6, 0x09,
+	0x0d, 0x18, 0xfa, 0x16, 0x72, 0x92, 0x93, 0x99, 0xf9, 0x10, 0xd3, 0xd5, 0xcf, 0xe6, 0x33, 0x63,
+	0x7f, 0xcd, 0x57, 0xf2, 0x26, 0xce, 0x0a, 0x20, 0x5e, 0xb7, 0x97, 0x90, 0x77, 0x49, 0xc8, 0x3c,
+	0xdf, 0xe6, 0x73, 0xe1, 0xf9, 0x55, 0x1e, 0xe3, 0xf3, 0xf9, 0xcc, 0xb8, 0x27, 0x62, 0xdc, 0x56,
+	0x98, 0x78, 0x77, 0x05, 0xe2, 0x95, 0xb4, 0xe0, 0xce, 0xaa, 0x2a, 0x2e, 0x87, 0x8f, 0xb1, 0x5a,
+	0x9c, 0xcf, 0x0c, 0xfd, 0xfd, 0x50, 0x8b, 0x9a, 0xd0, 0x0a, 0x1a, 0x17, 0x86, 0x40, 0x75, 0x6d,
+	0x66, 0xf3, 0x71, 0xef, 0x60, 0x7e, 0x46, 0x3f, 0x40, 0x8e, 0x79, 0x43, 0x42, 0xc7, 0xac, 0xdb,
+	0x27, 0x5e, 0xaf, 0xcf, 0xf8, 0xc0, 0x33, 0x6b, 0xfb, 0x2e, 0x6e, 0xa2, 0x8b, 0xc3, 0xf2, 0x31,
+	0x57, 0x54, 0x1f, 0x44, 0xcb, 0xba, 0x6c, 0xc7, 0xba, 0xbf, 0x89, 0xb3, 0x12, 0x10, 0x6a, 0x64,
+	0xc1, 0x5e, 0xac, 0x88, 0x9e, 0x21, 0xb3, 0x87, 0x23, 0x6d, 0x3b, 0x1a, 0x57, 0xf5, 0xfe, 0x7c,
+	0x66, 0x68, 0xeb, 0x41, 0x16, 0x12, 0x13, 0xe7, 0x25, 0xd6, 0x89, 0x21, 0xb9, 0x01, 0x7f, 0x28,
+	0x90, 0x11, 0x1b, 0xc0, 0x7f, 0xb3, 0xff, 0xc3, 0xea, 0xad, 0x6d, 0x5a, 0xf2, 0xd6, 0xa6, 0xc5,
+	0x5d, 0x55, 0x97, 0x5d, 0x95, 0x85, 0x3e, 0x87, 0x6c, 0x5b, 0xaa, 0xb0, 0xed, 0xf7, 0x08, 0xba,
+	0xcb, 0x2f, 0xa2, 0x80, 0xc9, 0x6d, 0x15, 0x06, 0xca, 0x43, 0x92, 0xf8, 0xa2, 0x16, 0x15, 0x47,
+	0x47, 0xb3, 0x05, 0xbb, 0x47, 0xce, 0xb9, 0x4f, 0x2f, 0x07, 0xc4, 0xed, 0x91, 0x21, 0xf1, 0x19,
+	0xd2, 0x20, 0x15, 0x90, 0x70, 0x3c, 0x60, 0xda, 0x7e, 0x94, 0xe7, 0x38, 0x81, 0xa5, 0x8d, 0x0a,
+	0xb0, 0x49, 0x82, 0x80, 0x06, 0x5a, 0x21, 0x7a, 0x99, 0xe3, 0x04, 0x16, 0x66, 0x15, 0x60, 0x3b,
+	0x20, 0xe1, 0x88, 0xfa, 0x21, 0x79, 0xf4, 0xa7, 0x02, 0x9b, 0x6d, 0x79, 0xb3, 0x19, 0xed, 0xce,
+	0x51, 0xa7, 0xd1, 0x3d, 0x6d, 0x5a, 0x4d, 0xab, 0x63, 0x1d, 0xbd, 0xb2, 0x5e, 0x37, 0xea, 0xdd,
+	0xd3, 0x66, 0xfb, 0xa4, 0x51, 0xb3, 0x5e, 0x5a, 0x8d, 0x7a, 0x3e, 0xa1, 0xef, 0x4d, 0xa6, 0xa5,
+	0xec, 0x9a, 0x00, 0x69, 0x00, 0xc2, 0x2f, 0x02, 0xf3, 0x8a, 0xbe, 0x3d, 0x99, 0x96, 0xd4, 0xe8,
+	0x8c, 0x8a, 0x90, 0x15, 0x4c, 0x07, 0x7f, 0xdf, 0x3a, 0x69, 0x34, 0xf3, 0x1b, 0x7a, 0x66, 0x32,
+	0x2d, 0x6d, 0x49, 0x73, 0xe9, 0xc9, 0xc9, 0xa4, 0xf0, 0xe4, 0xcc, 0x7d, 0xd8, 0x11, 0x4c, 0xed,
+	0x55, 0xab, 0xdd, 0xa8, 0xe7, 0x55, 0x1d, 0x26, 0xd3, 0x52, 0x4a, 0x58, 0xba, 0xfa, 0xe6, 0xb7,
+	0x62, 0xe2, 0xd1, 0x25, 0x6c, 0xf2, 0x4b, 0x16, 0x7d, 0x01, 0x85, 0x16, 0xae, 0x37, 0x70, 0xb7,
+	0xd9, 0x6a, 0x36, 0x6e, 0xd5, 0xcb, 0x43, 0x46, 0x38, 0x32, 0x61, 0x57, 0xa8, 0x4e, 0x9b, 0xfc,
+	0xd9, 0xa8, 0xe7, 0x15, 0x3d, 0x3b, 0x99, 0x96, 0xd2, 0x0b, 0x20, 0x2a, 0x58, 0x68, 0x62, 0x85,
+	0x2c, 0x58, 0x9a, 0x22, 0x71, 0x15, 0xbf, 0xbd, 0x2e, 0x2a, 0xef, 0xae, 0x8b, 0xca, 0xdf, 0xd7,
+	0x45, 0xe5, 0x97, 0x9b, 0x62, 0xe2, 0xdd, 0x4d, 0x31, 0xf1, 0xd7, 0x4d, 0x31, 0xf1, 0xfa, 0x9b,
+	0x9e, 0xc7, 0xfa, 0xe3, 0xb3, 0xb2, 0x43, 0x87, 0x15, 0x87, 0x86, 0x43, 0x1a, 0xca, 0xc7, 0xe3,
+	0xd0, 0x3d, 0xaf, 0xfc, 0x5c, 0x59, 0x7c, 0xcc, 0x9f, 0x3c, 0x7b, 0x1c, 0xff, 0x3b, 0x60, 0x57,
+	0x23, 0x12, 0x9e, 0xa5, 0xf8, 0xd7, 0xfc, 0xab, 0xff, 0x06, 0x00, 0x0e, 0xc1, 0x28, 0x9c, 0x3e,
+	0x08, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -811,6 +869,39 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SequenceRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SequenceRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SequenceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Start != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1028,6 +1119,21 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
+func (m *SequenceRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovChannel(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovChannel(uint64(m.End))
+	}
+	return n
+}
+
 func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2067,6 +2173,94 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SequenceRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SequenceRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SequenceRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/sha256"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -110,3 +111,25 @@ func (p Packet) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewSequenceRanges compresses a set of packet sequences into the minimal
+// sorted list of inclusive sequence ranges covering them.
+func NewSequenceRanges(sequences []uint64) []SequenceRange {
+	sorted := make([]uint64, len(sequences))
+	copy(sorted, sequences)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	ranges := []SequenceRange{}
+	for _, seq := range sorted {
+		if n := len(ranges); n > 0 && seq <= ranges[n-1].End+1 {
+			if seq > ranges[n-1].End {
+				ranges[n-1].End = seq
+			}
+			continue
+		}
+
+		ranges = append(ranges, SequenceRange{Start: seq, End: seq})
+	}
+
+	return ranges
+}
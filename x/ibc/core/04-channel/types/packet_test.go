@@ -51,3 +51,21 @@ func TestPacketValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestNewSequenceRanges(t *testing.T) {
+	testCases := []struct {
+		msg       string
+		sequences []uint64
+		expRanges []types.SequenceRange
+	}{
+		{"no sequences", nil, []types.SequenceRange{}},
+		{"single sequence", []uint64{4}, []types.SequenceRange{{Start: 4, End: 4}}},
+		{"consecutive sequences", []uint64{1, 2, 3}, []types.SequenceRange{{Start: 1, End: 3}}},
+		{"gaps between sequences", []uint64{1, 2, 5, 7, 8}, []types.SequenceRange{{Start: 1, End: 2}, {Start: 5, End: 5}, {Start: 7, End: 8}}},
+		{"unsorted sequences with duplicates", []uint64{8, 1, 2, 2, 7}, []types.SequenceRange{{Start: 1, End: 2}, {Start: 7, End: 8}}},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expRanges, types.NewSequenceRanges(tc.sequences), tc.msg)
+	}
+}
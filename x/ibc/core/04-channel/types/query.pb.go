@@ -1667,6 +1667,165 @@ func (m *QueryNextSequenceReceiveResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryPendingPacketsRequest is the request type for the Query/PendingPackets
+// RPC method
+type QueryPendingPacketsRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPacketsRequest) Reset()         { *m = QueryPendingPacketsRequest{} }
+func (m *QueryPendingPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsRequest) ProtoMessage()    {}
+func (*QueryPendingPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{26}
+}
+func (m *QueryPendingPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPendingPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPendingPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingPacketsResponse is the response type for the
+// Query/PendingPackets RPC method
+type QueryPendingPacketsResponse struct {
+	// sequence ranges of the packets whose commitment is still stored, i.e.
+	// which were not received by the counterparty or whose acknowledgement or
+	// timeout was not relayed back yet.
+	Pending []SequenceRange `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending"`
+	// next sequence send number
+	NextSequenceSend uint64 `protobuf:"varint,2,opt,name=next_sequence_send,json=nextSequenceSend,proto3" json:"next_sequence_send,omitempty"`
+	// next sequence receive number
+	NextSequenceReceive uint64 `protobuf:"varint,3,opt,name=next_sequence_receive,json=nextSequenceReceive,proto3" json:"next_sequence_receive,omitempty"`
+	// next sequence acknowledgement number
+	NextSequenceAck uint64 `protobuf:"varint,4,opt,name=next_sequence_ack,json=nextSequenceAck,proto3" json:"next_sequence_ack,omitempty"`
+	// query block height
+	Height types.Height `protobuf:"bytes,5,opt,name=height,proto3" json:"height"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingPacketsResponse) Reset()         { *m = QueryPendingPacketsResponse{} }
+func (m *QueryPendingPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketsResponse) ProtoMessage()    {}
+func (*QueryPendingPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{27}
+}
+func (m *QueryPendingPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketsResponse.Merge(m, src)
+}
+func (m *QueryPendingPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketsResponse) GetPending() []SequenceRange {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *QueryPendingPacketsResponse) GetNextSequenceSend() uint64 {
+	if m != nil {
+		return m.NextSequenceSend
+	}
+	return 0
+}
+
+func (m *QueryPendingPacketsResponse) GetNextSequenceReceive() uint64 {
+	if m != nil {
+		return m.NextSequenceReceive
+	}
+	return 0
+}
+
+func (m *QueryPendingPacketsResponse) GetNextSequenceAck() uint64 {
+	if m != nil {
+		return m.NextSequenceAck
+	}
+	return 0
+}
+
+func (m *QueryPendingPacketsResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+func (m *QueryPendingPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.core.channel.v1.QueryChannelRequest")
 	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.core.channel.v1.QueryChannelResponse")
@@ -1694,105 +1853,115 @@ func init() {
 	proto.RegisterType((*QueryUnreceivedAcksResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksResponse")
 	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveRequest")
 	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveResponse")
+	proto.RegisterType((*QueryPendingPacketsRequest)(nil), "ibc.core.channel.v1.QueryPendingPacketsRequest")
+	proto.RegisterType((*QueryPendingPacketsResponse)(nil), "ibc.core.channel.v1.QueryPendingPacketsResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
 
 var fileDescriptor_1034a1e9abc4cca1 = []byte{
-	// 1482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x14, 0x55,
-	0x14, 0xef, 0xdd, 0x16, 0x68, 0x0f, 0xc8, 0xc7, 0x6d, 0x0b, 0x65, 0x28, 0x4b, 0x19, 0x8d, 0x14,
-	0x12, 0xe6, 0xd2, 0x82, 0x48, 0x62, 0xd0, 0x94, 0x26, 0x62, 0x13, 0x40, 0x1c, 0x40, 0x81, 0x28,
-	0x9b, 0xd9, 0xd9, 0xcb, 0x76, 0xd2, 0x76, 0x66, 0xd8, 0x99, 0x2d, 0x6d, 0x9a, 0x7d, 0x50, 0x13,
-	0xe2, 0x83, 0x24, 0x26, 0x3c, 0x68, 0x7c, 0xf1, 0xc5, 0xc4, 0xf0, 0xe0, 0x83, 0xff, 0x83, 0x0f,
-	0xbc, 0x49, 0xa2, 0x26, 0x18, 0x13, 0x24, 0x60, 0x22, 0x0f, 0x3e, 0xeb, 0xab, 0x99, 0xfb, 0x31,
-	0x1f, 0xbb, 0x33, 0xd3, 0x2e, 0xdb, 0x4d, 0x1a, 0x9f, 0xba, 0x73, 0xe7, 0x9c, 0x73, 0x7f, 0xbf,
-	0xdf, 0xb9, 0xe7, 0xec, 0x3d, 0x5b, 0x38, 0x60, 0x95, 0x4d, 0x62, 0x3a, 0x35, 0x4a, 0xcc, 0x59,
-	0xc3, 0xb6, 0xe9, 0x3c, 0x59, 0x9c, 0x20, 0xb7, 0xea, 0xb4, 0xb6, 0xac, 0xb9, 0x35, 0xc7, 0x77,
-	0xf0, 0xa0, 0x55, 0x36, 0xb5, 0xc0, 0x40, 0x13, 0x06, 0xda, 0xe2, 0x84, 0x12, 0xf3, 0x9a, 0xb7,
-	0xa8, 0xed, 0x07, 0x4e, 0xfc, 0x13, 0xf7, 0x52, 0x8e, 0x98, 0x8e, 0xb7, 0xe0, 0x78, 0xa4, 0x6c,
-	0x78, 0x94, 0x87, 0x23, 0x8b, 0x13, 0x65, 0xea, 0x1b, 0x13, 0xc4, 0x35, 0xaa, 0x96, 0x6d, 0xf8,
-	0x96, 0x63, 0x0b, 0xdb, 0x83, 0x69, 0x10, 0xe4, 0x66, 0xdc, 0x64, 0xb4, 0xea, 0x38, 0xd5, 0x79,
-	0x4a, 0x0c, 0xd7, 0x22, 0x86, 0x6d, 0x3b, 0x3e, 0xf3, 0xf7, 0xc4, 0xdb, 0xbd, 0xe2, 0x2d, 0x7b,
-	0x2a, 0xd7, 0x6f, 0x12, 0xc3, 0x16, 0xe8, 0x95, 0xa1, 0xaa, 0x53, 0x75, 0xd8, 0x47, 0x12, 0x7c,
-	0xe2, 0xab, 0xea, 0x79, 0x18, 0x7c, 0x2f, 0xc0, 0x34, 0xcd, 0x37, 0xd1, 0xe9, 0xad, 0x3a, 0xf5,
-	0x7c, 0xbc, 0x07, 0xb6, 0xb8, 0x4e, 0xcd, 0x2f, 0x59, 0x95, 0x11, 0x34, 0x86, 0xc6, 0x07, 0xf4,
-	0xcd, 0xc1, 0xe3, 0x4c, 0x05, 0xef, 0x07, 0x10, 0x78, 0x82, 0x77, 0x05, 0xf6, 0x6e, 0x40, 0xac,
-	0xcc, 0x54, 0xd4, 0xfb, 0x08, 0x86, 0x92, 0xf1, 0x3c, 0xd7, 0xb1, 0x3d, 0x8a, 0x4f, 0xc2, 0x16,
-	0x61, 0xc5, 0x02, 0x6e, 0x9d, 0x1c, 0xd5, 0x52, 0xd4, 0xd4, 0xa4, 0x9b, 0x34, 0xc6, 0x43, 0xb0,
-	0xc9, 0xad, 0x39, 0xce, 0x4d, 0xb6, 0xd5, 0x36, 0x9d, 0x3f, 0xe0, 0x69, 0xd8, 0xc6, 0x3e, 0x94,
-	0x66, 0xa9, 0x55, 0x9d, 0xf5, 0x47, 0x7a, 0x59, 0x48, 0x25, 0x16, 0x92, 0x67, 0x60, 0x71, 0x42,
-	0x7b, 0x87, 0x59, 0x9c, 0xe9, 0x7b, 0xf0, 0xf8, 0x40, 0x8f, 0xbe, 0x95, 0x79, 0xf1, 0x25, 0xf5,
-	0x46, 0x12, 0xaa, 0x27, 0xb9, 0xbf, 0x0d, 0x10, 0x25, 0x46, 0xa0, 0x7d, 0x55, 0xe3, 0x59, 0xd4,
-	0x82, 0x2c, 0x6a, 0xfc, 0x50, 0x88, 0x2c, 0x6a, 0x17, 0x8d, 0x2a, 0x15, 0xbe, 0x7a, 0xcc, 0x53,
-	0x7d, 0x8c, 0x60, 0xb8, 0x69, 0x03, 0x21, 0xc6, 0x19, 0xe8, 0x17, 0xfc, 0xbc, 0x11, 0x34, 0xd6,
-	0xcb, 0xe2, 0xa7, 0xa9, 0x31, 0x53, 0xa1, 0xb6, 0x6f, 0xdd, 0xb4, 0x68, 0x45, 0xea, 0x12, 0xfa,
-	0xe1, 0xb3, 0x09, 0x94, 0x05, 0x86, 0xf2, 0xd0, 0xaa, 0x28, 0x39, 0x80, 0x38, 0x4c, 0x7c, 0x0a,
-	0x36, 0xb7, 0xa9, 0xa2, 0xb0, 0x57, 0x3f, 0x43, 0x50, 0xe4, 0x04, 0x1d, 0xdb, 0xa6, 0x66, 0x10,
-	0xad, 0x59, 0xcb, 0x22, 0x80, 0x19, 0xbe, 0x14, 0x47, 0x29, 0xb6, 0xd2, 0xa4, 0x75, 0xe1, 0x85,
-	0xb5, 0x7e, 0x8e, 0xe0, 0x40, 0x26, 0x94, 0xff, 0x97, 0xea, 0x57, 0xa5, 0xe8, 0x1c, 0xd3, 0x34,
-	0xb3, 0xbe, 0xe4, 0x1b, 0x3e, 0xed, 0xb4, 0x78, 0xff, 0x08, 0x45, 0x4c, 0x09, 0x2d, 0x44, 0x34,
-	0x60, 0x8f, 0x15, 0xea, 0x53, 0xe2, 0x50, 0x4b, 0x5e, 0x60, 0x22, 0x2a, 0xe5, 0x70, 0x1a, 0x91,
-	0x98, 0xa4, 0xb1, 0x98, 0xc3, 0x56, 0xda, 0x72, 0x37, 0x4b, 0xfe, 0x7b, 0x04, 0x07, 0x13, 0x0c,
-	0x03, 0x4e, 0xb6, 0x57, 0xf7, 0xd6, 0x43, 0x3f, 0x7c, 0x08, 0x76, 0xd4, 0xe8, 0xa2, 0xe5, 0x59,
-	0x8e, 0x5d, 0xb2, 0xeb, 0x0b, 0x65, 0x5a, 0x63, 0x28, 0xfb, 0xf4, 0xed, 0x72, 0xf9, 0x02, 0x5b,
-	0x4d, 0x18, 0x0a, 0x3a, 0x7d, 0x49, 0x43, 0x81, 0xf7, 0x77, 0x04, 0x6a, 0x1e, 0x5e, 0x91, 0x94,
-	0xd3, 0xb0, 0xc3, 0x94, 0x6f, 0x12, 0xc9, 0x18, 0xd2, 0xf8, 0xf7, 0x81, 0x26, 0xbf, 0x0f, 0xb4,
-	0x29, 0x7b, 0x59, 0xdf, 0x6e, 0x26, 0xc2, 0xe0, 0x7d, 0x30, 0x20, 0x12, 0x19, 0xb2, 0xea, 0xe7,
-	0x0b, 0x33, 0x95, 0x28, 0x1b, 0xbd, 0x79, 0xd9, 0xe8, 0x7b, 0x91, 0x6c, 0xd4, 0x60, 0x94, 0x91,
-	0xbb, 0x68, 0x98, 0x73, 0xd4, 0x9f, 0x76, 0x16, 0x16, 0x2c, 0x7f, 0x81, 0xda, 0x7e, 0xa7, 0x79,
-	0x50, 0xa0, 0xdf, 0x0b, 0x42, 0xd8, 0x26, 0x15, 0x09, 0x08, 0x9f, 0xd5, 0xaf, 0x11, 0xec, 0xcf,
-	0xd8, 0x54, 0x88, 0xc9, 0x5a, 0x96, 0x5c, 0x65, 0x1b, 0x6f, 0xd3, 0x63, 0x2b, 0xdd, 0x3c, 0x9e,
-	0xdf, 0x64, 0x81, 0xf3, 0x3a, 0x95, 0x24, 0xd9, 0x67, 0x7b, 0x5f, 0xb8, 0xcf, 0xfe, 0x25, 0x5b,
-	0x7e, 0x0a, 0xc2, 0xb0, 0xcd, 0x6e, 0x8d, 0xd4, 0x92, 0x9d, 0x76, 0x2c, 0xb5, 0xd3, 0xf2, 0x20,
-	0xfc, 0x2c, 0xc7, 0x9d, 0x36, 0x42, 0x9b, 0x75, 0x60, 0x6f, 0x8c, 0xa8, 0x4e, 0x4d, 0x6a, 0xb9,
-	0x5d, 0x3d, 0x99, 0xf7, 0x10, 0x28, 0x69, 0x3b, 0x0a, 0x59, 0x15, 0xe8, 0xaf, 0x05, 0x4b, 0x8b,
-	0x94, 0xc7, 0xed, 0xd7, 0xc3, 0xe7, 0x6e, 0xd6, 0xe8, 0x6d, 0xd1, 0x30, 0x39, 0xa8, 0x29, 0x73,
-	0xce, 0x76, 0x6e, 0xcf, 0xd3, 0x4a, 0x95, 0x76, 0xbb, 0x50, 0xef, 0xcb, 0xd6, 0x97, 0xb1, 0xb3,
-	0x90, 0x65, 0x1c, 0x76, 0x18, 0xc9, 0x57, 0xa2, 0x64, 0x9b, 0x97, 0xbb, 0x59, 0xb7, 0xdf, 0xe6,
-	0x62, 0xdd, 0x30, 0xc5, 0xfb, 0x0f, 0x82, 0x97, 0x73, 0x61, 0x0a, 0x4d, 0xcf, 0xc1, 0xce, 0x26,
-	0xf1, 0xd6, 0x5e, 0xc6, 0x2d, 0x9e, 0x1b, 0xa1, 0x96, 0xbf, 0x94, 0x7d, 0xf5, 0x8a, 0x2d, 0x6b,
-	0x86, 0x63, 0xee, 0x38, 0x35, 0x6f, 0xc2, 0x3e, 0x97, 0x45, 0x2a, 0x45, 0xed, 0xab, 0x24, 0xcf,
-	0xb0, 0x37, 0xd2, 0x3b, 0xd6, 0x3b, 0xde, 0xa7, 0xef, 0x75, 0x9b, 0x9a, 0xe5, 0x25, 0x69, 0xa0,
-	0x2e, 0x89, 0x76, 0x9a, 0x02, 0x4c, 0x24, 0x63, 0x14, 0x06, 0xa2, 0x78, 0x88, 0xc5, 0x8b, 0x16,
-	0x62, 0x9a, 0x14, 0xda, 0xd4, 0xe4, 0x8e, 0x6c, 0x37, 0xd1, 0xd6, 0x53, 0xe6, 0x5c, 0xc7, 0x82,
-	0x1c, 0x83, 0x21, 0x21, 0x88, 0x61, 0xce, 0xb5, 0x28, 0x81, 0x5d, 0x79, 0xf2, 0x22, 0x09, 0xea,
-	0xb0, 0x2f, 0x15, 0x47, 0x97, 0xf9, 0x5f, 0x13, 0x77, 0xdd, 0x0b, 0x74, 0x29, 0xcc, 0x87, 0xce,
-	0x01, 0x74, 0x7a, 0x8f, 0xfe, 0x01, 0xc1, 0x58, 0x76, 0x6c, 0xc1, 0x6b, 0x12, 0x86, 0x6d, 0xba,
-	0x14, 0x1d, 0x96, 0x92, 0x60, 0xcf, 0xb6, 0xea, 0xd3, 0x07, 0xed, 0x56, 0xdf, 0x2e, 0xb6, 0xb0,
-	0xc9, 0x9f, 0x76, 0xc3, 0x26, 0x86, 0x19, 0x7f, 0x87, 0x60, 0x8b, 0xb8, 0x6e, 0xe2, 0xf1, 0xd4,
-	0x7a, 0x4f, 0xf9, 0xc1, 0x40, 0x39, 0xbc, 0x06, 0x4b, 0xce, 0x5c, 0x3d, 0xfb, 0xc9, 0xcf, 0x7f,
-	0xde, 0x2b, 0x4c, 0xe1, 0xb7, 0x48, 0xca, 0xaf, 0x1d, 0xfc, 0x87, 0x11, 0x39, 0x6f, 0x91, 0x95,
-	0x48, 0xe7, 0x06, 0x09, 0xd4, 0xf7, 0xc8, 0x8a, 0xc8, 0x49, 0x03, 0xdf, 0x45, 0xd0, 0x2f, 0xa7,
-	0x3c, 0xbc, 0x3a, 0x00, 0x79, 0xb6, 0x95, 0x23, 0x6b, 0x31, 0x15, 0x60, 0x8f, 0x30, 0xb0, 0xaf,
-	0x60, 0x75, 0x75, 0xb0, 0xf8, 0x47, 0x04, 0xb8, 0x75, 0xfe, 0xc4, 0xc7, 0x73, 0xb6, 0xcb, 0x1a,
-	0x9c, 0x95, 0x13, 0xed, 0x39, 0x09, 0xb4, 0xd3, 0x0c, 0xed, 0x69, 0xfc, 0x46, 0x0e, 0xda, 0xd0,
-	0x3b, 0x50, 0x37, 0x7c, 0x68, 0x44, 0x34, 0x7e, 0x0d, 0x68, 0xb4, 0x4c, 0x80, 0xb9, 0x34, 0xb2,
-	0x46, 0xd1, 0x5c, 0x1a, 0x99, 0x43, 0xa6, 0x7a, 0x99, 0xd1, 0xb8, 0x80, 0xcf, 0x75, 0x78, 0x42,
-	0x48, 0x7c, 0x3e, 0xc5, 0x5f, 0x15, 0x60, 0x38, 0x75, 0x8e, 0xc2, 0x27, 0x57, 0x47, 0x99, 0x36,
-	0x28, 0x2a, 0xaf, 0xb7, 0xed, 0x27, 0x08, 0xde, 0x45, 0x8c, 0xe1, 0x1d, 0x84, 0x3f, 0x45, 0x1d,
-	0x73, 0x4c, 0x4e, 0x7e, 0x44, 0x8e, 0x90, 0x64, 0xa5, 0x69, 0x18, 0x6d, 0x10, 0xde, 0x20, 0x62,
-	0x2f, 0xf8, 0x42, 0x03, 0x3f, 0x41, 0xb0, 0xb3, 0xf9, 0x46, 0x8f, 0x27, 0xb2, 0xd9, 0x65, 0x4c,
-	0x6c, 0xca, 0x64, 0x3b, 0x2e, 0x42, 0x0b, 0xca, 0xa4, 0x28, 0xe1, 0x8f, 0x3a, 0x15, 0xa2, 0xe5,
-	0x8b, 0xd8, 0x23, 0x2b, 0xb2, 0xbb, 0x36, 0xf0, 0x23, 0x04, 0xbb, 0x5a, 0x86, 0x16, 0xdc, 0x06,
-	0xe0, 0xb0, 0x34, 0x8f, 0xb7, 0xe5, 0x23, 0x58, 0x5e, 0x67, 0x2c, 0x2f, 0x63, 0x7d, 0xfd, 0x59,
-	0xe2, 0x5f, 0x10, 0xbc, 0x94, 0x18, 0x1a, 0xb0, 0xb6, 0x1a, 0xc4, 0xe4, 0x3c, 0xa3, 0x90, 0x35,
-	0xdb, 0x0b, 0x3a, 0x65, 0x46, 0xe7, 0x43, 0x7c, 0x7d, 0x9d, 0xe8, 0xd4, 0x78, 0xfc, 0x44, 0xc6,
-	0x9e, 0x23, 0x18, 0x4e, 0xbd, 0xa9, 0xe6, 0xd5, 0x6b, 0xde, 0x9c, 0x92, 0x57, 0xaf, 0xb9, 0x53,
-	0x86, 0x7a, 0x83, 0xd1, 0xbd, 0x8a, 0xdf, 0x5f, 0x27, 0xba, 0x86, 0x39, 0x97, 0xa0, 0xfa, 0x37,
-	0x82, 0xdd, 0xe9, 0x97, 0x72, 0xdc, 0x2e, 0xe6, 0xf0, 0x98, 0x9e, 0x6a, 0xdf, 0x51, 0xb0, 0x2d,
-	0x31, 0xb6, 0xd7, 0xf0, 0x07, 0xeb, 0xc7, 0x36, 0xc9, 0xe9, 0xf3, 0x02, 0xec, 0x6a, 0xb9, 0xf1,
-	0xe6, 0xd5, 0x62, 0xd6, 0xbd, 0x3d, 0xaf, 0x16, 0x33, 0xaf, 0xd4, 0xeb, 0xda, 0x7d, 0xd3, 0x9a,
-	0x4e, 0xce, 0x44, 0xd0, 0x20, 0xf5, 0x10, 0x56, 0xc9, 0x15, 0xc4, 0xff, 0x45, 0xb0, 0x3d, 0x79,
-	0xfb, 0xc5, 0x64, 0x2d, 0xbc, 0x62, 0xf7, 0x75, 0xe5, 0xd8, 0xda, 0x1d, 0x84, 0x0a, 0x1f, 0x73,
-	0x15, 0x56, 0xf0, 0x72, 0x17, 0x35, 0x48, 0x0c, 0x01, 0x09, 0xf2, 0x41, 0x09, 0xe0, 0xdf, 0x10,
-	0x0c, 0xa6, 0x5c, 0x92, 0x71, 0xce, 0xb5, 0x21, 0xfb, 0xbe, 0xae, 0xbc, 0xd6, 0xa6, 0x97, 0x10,
-	0xe2, 0x0a, 0xd3, 0xe1, 0x5d, 0x7c, 0xbe, 0x53, 0x1d, 0x12, 0xf7, 0xf9, 0x33, 0xfa, 0x83, 0xa7,
-	0x45, 0xf4, 0xf0, 0x69, 0x11, 0x3d, 0x79, 0x5a, 0x44, 0x5f, 0x3c, 0x2b, 0xf6, 0x3c, 0x7c, 0x56,
-	0xec, 0x79, 0xf4, 0xac, 0xd8, 0x73, 0xfd, 0x54, 0xd5, 0xf2, 0x67, 0xeb, 0x65, 0xcd, 0x74, 0x16,
-	0x88, 0xf8, 0xe7, 0x20, 0xff, 0x73, 0xd4, 0xab, 0xcc, 0x91, 0xa5, 0x08, 0xc6, 0xb1, 0x13, 0x47,
-	0x25, 0x12, 0x7f, 0xd9, 0xa5, 0x5e, 0x79, 0x33, 0xfb, 0x1d, 0xf7, 0xf8, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xce, 0x1d, 0x1b, 0xcd, 0xab, 0x1c, 0x00, 0x00,
+	// 1606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc4, 0x6e, 0x3e, 0x5e, 0x4b, 0xd2, 0x4e, 0x12, 0x9a, 0x6e, 0x52, 0x37, 0x35, 0x88,
+	0xa6, 0x11, 0xdd, 0xcd, 0x47, 0x69, 0x2b, 0xa1, 0x82, 0xd2, 0x48, 0x2d, 0x91, 0xfa, 0xb9, 0x6d,
+	0xe9, 0x87, 0xa0, 0xd6, 0x7a, 0x3d, 0x75, 0x56, 0x4e, 0x66, 0x5d, 0xef, 0x3a, 0x4d, 0x14, 0xf9,
+	0x00, 0x48, 0x15, 0x07, 0x2a, 0x21, 0xf5, 0x00, 0x42, 0x08, 0x2e, 0x48, 0xa8, 0x07, 0x0e, 0xfc,
+	0x0f, 0x1c, 0x7a, 0xe0, 0x50, 0x09, 0x90, 0x8a, 0x90, 0x4a, 0xd5, 0x22, 0xd1, 0x03, 0x27, 0x0e,
+	0x70, 0x45, 0x3b, 0x33, 0xbb, 0xde, 0xb5, 0x77, 0x1d, 0x6f, 0x6c, 0x4b, 0x15, 0xa7, 0xec, 0xce,
+	0xcc, 0x7b, 0xf3, 0xfb, 0xfd, 0xde, 0x9b, 0xe7, 0x7d, 0x13, 0xd8, 0x67, 0x64, 0x75, 0x45, 0x37,
+	0x4b, 0x44, 0xd1, 0x97, 0x34, 0x4a, 0xc9, 0xb2, 0xb2, 0x3a, 0xa3, 0xdc, 0x2a, 0x93, 0xd2, 0xba,
+	0x5c, 0x2c, 0x99, 0xb6, 0x89, 0x87, 0x8c, 0xac, 0x2e, 0x3b, 0x0b, 0x64, 0xb1, 0x40, 0x5e, 0x9d,
+	0x91, 0x7c, 0x56, 0xcb, 0x06, 0xa1, 0xb6, 0x63, 0xc4, 0x9f, 0xb8, 0x95, 0x34, 0xa5, 0x9b, 0xd6,
+	0x8a, 0x69, 0x29, 0x59, 0xcd, 0x22, 0xdc, 0x9d, 0xb2, 0x3a, 0x93, 0x25, 0xb6, 0x36, 0xa3, 0x14,
+	0xb5, 0xbc, 0x41, 0x35, 0xdb, 0x30, 0xa9, 0x58, 0xbb, 0x3f, 0x0c, 0x82, 0xbb, 0x19, 0x5f, 0x32,
+	0x9e, 0x37, 0xcd, 0xfc, 0x32, 0x51, 0xb4, 0xa2, 0xa1, 0x68, 0x94, 0x9a, 0x36, 0xb3, 0xb7, 0xc4,
+	0xec, 0x1e, 0x31, 0xcb, 0xde, 0xb2, 0xe5, 0x9b, 0x8a, 0x46, 0x05, 0x7a, 0x69, 0x38, 0x6f, 0xe6,
+	0x4d, 0xf6, 0xa8, 0x38, 0x4f, 0x7c, 0x34, 0x7d, 0x06, 0x86, 0x2e, 0x38, 0x98, 0x16, 0xf8, 0x26,
+	0x2a, 0xb9, 0x55, 0x26, 0x96, 0x8d, 0x77, 0x43, 0x6f, 0xd1, 0x2c, 0xd9, 0x19, 0x23, 0x37, 0x8a,
+	0x26, 0xd0, 0x64, 0xbf, 0xda, 0xe3, 0xbc, 0x2e, 0xe6, 0xf0, 0x5e, 0x00, 0x81, 0xc7, 0x99, 0xeb,
+	0x66, 0x73, 0xfd, 0x62, 0x64, 0x31, 0x97, 0xbe, 0x8f, 0x60, 0x38, 0xe8, 0xcf, 0x2a, 0x9a, 0xd4,
+	0x22, 0xf8, 0x08, 0xf4, 0x8a, 0x55, 0xcc, 0xe1, 0xf6, 0xd9, 0x71, 0x39, 0x44, 0x4d, 0xd9, 0x35,
+	0x73, 0x17, 0xe3, 0x61, 0xd8, 0x56, 0x2c, 0x99, 0xe6, 0x4d, 0xb6, 0xd5, 0x0e, 0x95, 0xbf, 0xe0,
+	0x05, 0xd8, 0xc1, 0x1e, 0x32, 0x4b, 0xc4, 0xc8, 0x2f, 0xd9, 0xa3, 0x09, 0xe6, 0x52, 0xf2, 0xb9,
+	0xe4, 0x11, 0x58, 0x9d, 0x91, 0xdf, 0x61, 0x2b, 0x4e, 0x24, 0x1f, 0x3c, 0xde, 0xd7, 0xa5, 0x6e,
+	0x67, 0x56, 0x7c, 0x28, 0x7d, 0x23, 0x08, 0xd5, 0x72, 0xb9, 0x9f, 0x04, 0xa8, 0x06, 0x46, 0xa0,
+	0x7d, 0x4d, 0xe6, 0x51, 0x94, 0x9d, 0x28, 0xca, 0x3c, 0x29, 0x44, 0x14, 0xe5, 0xf3, 0x5a, 0x9e,
+	0x08, 0x5b, 0xd5, 0x67, 0x99, 0x7e, 0x8c, 0x60, 0xa4, 0x66, 0x03, 0x21, 0xc6, 0x09, 0xe8, 0x13,
+	0xfc, 0xac, 0x51, 0x34, 0x91, 0x60, 0xfe, 0xc3, 0xd4, 0x58, 0xcc, 0x11, 0x6a, 0x1b, 0x37, 0x0d,
+	0x92, 0x73, 0x75, 0xf1, 0xec, 0xf0, 0xa9, 0x00, 0xca, 0x6e, 0x86, 0xf2, 0xc0, 0xa6, 0x28, 0x39,
+	0x00, 0x3f, 0x4c, 0x7c, 0x0c, 0x7a, 0x62, 0xaa, 0x28, 0xd6, 0xa7, 0x3f, 0x46, 0x90, 0xe2, 0x04,
+	0x4d, 0x4a, 0x89, 0xee, 0x78, 0xab, 0xd5, 0x32, 0x05, 0xa0, 0x7b, 0x93, 0x22, 0x95, 0x7c, 0x23,
+	0xf8, 0x64, 0x08, 0x8b, 0xad, 0x68, 0xfd, 0x1c, 0xc1, 0xbe, 0x48, 0x28, 0xff, 0x2f, 0xd5, 0xaf,
+	0xba, 0xa2, 0x73, 0x4c, 0x0b, 0x6c, 0xf5, 0x45, 0x5b, 0xb3, 0x49, 0xab, 0x87, 0xf7, 0x77, 0x4f,
+	0xc4, 0x10, 0xd7, 0x42, 0x44, 0x0d, 0x76, 0x1b, 0x9e, 0x3e, 0x19, 0x0e, 0x35, 0x63, 0x39, 0x4b,
+	0xc4, 0x49, 0x39, 0x18, 0x46, 0xc4, 0x27, 0xa9, 0xcf, 0xe7, 0x88, 0x11, 0x36, 0xdc, 0xc9, 0x23,
+	0xff, 0x1d, 0x82, 0xfd, 0x01, 0x86, 0x0e, 0x27, 0x6a, 0x95, 0xad, 0x76, 0xe8, 0x87, 0x0f, 0xc0,
+	0x60, 0x89, 0xac, 0x1a, 0x96, 0x61, 0xd2, 0x0c, 0x2d, 0xaf, 0x64, 0x49, 0x89, 0xa1, 0x4c, 0xaa,
+	0x03, 0xee, 0xf0, 0x59, 0x36, 0x1a, 0x58, 0x28, 0xe8, 0x24, 0x83, 0x0b, 0x05, 0xde, 0xdf, 0x10,
+	0xa4, 0x1b, 0xe1, 0x15, 0x41, 0x39, 0x0e, 0x83, 0xba, 0x3b, 0x13, 0x08, 0xc6, 0xb0, 0xcc, 0x7f,
+	0x0f, 0x64, 0xf7, 0xf7, 0x40, 0x9e, 0xa7, 0xeb, 0xea, 0x80, 0x1e, 0x70, 0x83, 0xc7, 0xa0, 0x5f,
+	0x04, 0xd2, 0x63, 0xd5, 0xc7, 0x07, 0x16, 0x73, 0xd5, 0x68, 0x24, 0x1a, 0x45, 0x23, 0xb9, 0x95,
+	0x68, 0x94, 0x60, 0x9c, 0x91, 0x3b, 0xaf, 0xe9, 0x05, 0x62, 0x2f, 0x98, 0x2b, 0x2b, 0x86, 0xbd,
+	0x42, 0xa8, 0xdd, 0x6a, 0x1c, 0x24, 0xe8, 0xb3, 0x1c, 0x17, 0x54, 0x27, 0x22, 0x00, 0xde, 0x7b,
+	0xfa, 0x0b, 0x04, 0x7b, 0x23, 0x36, 0x15, 0x62, 0xb2, 0x92, 0xe5, 0x8e, 0xb2, 0x8d, 0x77, 0xa8,
+	0xbe, 0x91, 0x4e, 0xa6, 0xe7, 0xd7, 0x51, 0xe0, 0xac, 0x56, 0x25, 0x09, 0xd6, 0xd9, 0xc4, 0x96,
+	0xeb, 0xec, 0x9f, 0x6e, 0xc9, 0x0f, 0x41, 0xe8, 0x95, 0xd9, 0xed, 0x55, 0xb5, 0xdc, 0x4a, 0x3b,
+	0x11, 0x5a, 0x69, 0xb9, 0x13, 0x9e, 0xcb, 0x7e, 0xa3, 0x17, 0xa1, 0xcc, 0x9a, 0xb0, 0xc7, 0x47,
+	0x54, 0x25, 0x3a, 0x31, 0x8a, 0x1d, 0xcd, 0xcc, 0x7b, 0x08, 0xa4, 0xb0, 0x1d, 0x85, 0xac, 0x12,
+	0xf4, 0x95, 0x9c, 0xa1, 0x55, 0xc2, 0xfd, 0xf6, 0xa9, 0xde, 0x7b, 0x27, 0xcf, 0xe8, 0x6d, 0xd8,
+	0xef, 0x03, 0x35, 0xaf, 0x17, 0xa8, 0x79, 0x7b, 0x99, 0xe4, 0xf2, 0xa4, 0xd3, 0x07, 0xf5, 0xbe,
+	0x5b, 0xfa, 0x22, 0x76, 0x16, 0xb2, 0x4c, 0xc2, 0xa0, 0x16, 0x9c, 0x12, 0x47, 0xb6, 0x76, 0xb8,
+	0x93, 0xe7, 0xf6, 0x9b, 0x86, 0x58, 0x5f, 0x98, 0xc3, 0xfb, 0x0f, 0x82, 0x57, 0x1a, 0xc2, 0x14,
+	0x9a, 0x9e, 0x86, 0x9d, 0x35, 0xe2, 0x35, 0x7f, 0x8c, 0xeb, 0x2c, 0x5f, 0x84, 0xb3, 0xfc, 0x99,
+	0x5b, 0x57, 0x2f, 0x53, 0xf7, 0xcc, 0x70, 0xcc, 0x2d, 0x87, 0xe6, 0x2d, 0x18, 0x2b, 0x32, 0x4f,
+	0x99, 0x6a, 0xf9, 0xca, 0xb8, 0x39, 0x6c, 0x8d, 0x26, 0x26, 0x12, 0x93, 0x49, 0x75, 0x4f, 0xb1,
+	0xa6, 0x58, 0x5e, 0x74, 0x17, 0xa4, 0xd7, 0x20, 0x15, 0x05, 0x4c, 0x04, 0x63, 0x1c, 0xfa, 0xab,
+	0xfe, 0x10, 0xf3, 0x57, 0x1d, 0xf0, 0x69, 0xd2, 0x1d, 0x53, 0x93, 0x3b, 0x6e, 0xb9, 0xa9, 0x6e,
+	0x3d, 0xaf, 0x17, 0x5a, 0x16, 0x64, 0x1a, 0x86, 0x85, 0x20, 0x9a, 0x5e, 0xa8, 0x53, 0x02, 0x17,
+	0xdd, 0xcc, 0xab, 0x4a, 0x50, 0x86, 0xb1, 0x50, 0x1c, 0x1d, 0xe6, 0x7f, 0x4d, 0x7c, 0xeb, 0x9e,
+	0x25, 0x6b, 0x5e, 0x3c, 0x54, 0x0e, 0xa0, 0xd5, 0xef, 0xe8, 0xef, 0x11, 0x4c, 0x44, 0xfb, 0x16,
+	0xbc, 0x66, 0x61, 0x84, 0x92, 0xb5, 0x6a, 0xb2, 0x64, 0x04, 0x7b, 0xb6, 0x55, 0x52, 0x1d, 0xa2,
+	0xf5, 0xb6, 0x9d, 0x2c, 0x61, 0x5f, 0x7a, 0xbf, 0x3e, 0x84, 0xe6, 0x0c, 0x9a, 0x6f, 0xd3, 0xf9,
+	0x68, 0x57, 0xe9, 0xfa, 0xbb, 0x1b, 0xc6, 0x42, 0xe1, 0x79, 0x1f, 0x1d, 0xbd, 0x45, 0x3e, 0x23,
+	0x2a, 0x55, 0x3a, 0xb4, 0x52, 0x79, 0x82, 0x6a, 0x34, 0x4f, 0x84, 0x0c, 0xae, 0x21, 0x7e, 0x1d,
+	0x70, 0x30, 0x22, 0x16, 0xa1, 0x9c, 0x52, 0x52, 0xdd, 0xe9, 0x0f, 0xc7, 0x45, 0x42, 0x73, 0xd1,
+	0xf1, 0x4b, 0x44, 0xc7, 0x6f, 0x0a, 0x76, 0x05, 0x6d, 0x34, 0xbd, 0x20, 0xbe, 0xfc, 0x07, 0xfd,
+	0xeb, 0xe7, 0xf5, 0x82, 0x2f, 0xb3, 0xb7, 0xc5, 0xcb, 0xec, 0x9a, 0x82, 0xdb, 0xb3, 0xe5, 0x82,
+	0x3b, 0xfb, 0xd5, 0x28, 0x6c, 0x63, 0xa2, 0xe3, 0x6f, 0x11, 0xf4, 0x8a, 0x16, 0x04, 0x4f, 0x86,
+	0x2a, 0x1b, 0x72, 0x89, 0x24, 0x1d, 0x6c, 0x62, 0x25, 0xdf, 0x36, 0x7d, 0xea, 0xc3, 0x9f, 0xfe,
+	0xb8, 0xd7, 0x3d, 0x8f, 0xdf, 0x56, 0x42, 0x6e, 0xc0, 0xf8, 0x65, 0x99, 0x78, 0xb7, 0x94, 0x8d,
+	0x6a, 0xc2, 0x55, 0x14, 0x27, 0x0d, 0x2d, 0x65, 0x43, 0x24, 0x67, 0x05, 0xdf, 0x45, 0xd0, 0x27,
+	0x9c, 0x5b, 0x78, 0x73, 0x00, 0x6e, 0x82, 0x4b, 0x53, 0xcd, 0x2c, 0x15, 0x60, 0xa7, 0x18, 0xd8,
+	0x57, 0x71, 0x7a, 0x73, 0xb0, 0xf8, 0x07, 0x04, 0xb8, 0xfe, 0x4e, 0x02, 0xcf, 0x35, 0xd8, 0x2e,
+	0xea, 0x32, 0x45, 0x3a, 0x1c, 0xcf, 0x48, 0xa0, 0x5d, 0x60, 0x68, 0x8f, 0xe3, 0x37, 0x1b, 0xa0,
+	0xf5, 0xac, 0x1d, 0x75, 0xbd, 0x97, 0x4a, 0x95, 0xc6, 0x2f, 0x0e, 0x8d, 0xba, 0x5b, 0x81, 0x86,
+	0x34, 0xa2, 0xae, 0x27, 0xa4, 0xc3, 0xf1, 0x8c, 0x04, 0x8d, 0x4b, 0x8c, 0xc6, 0x59, 0x7c, 0xba,
+	0xc5, 0x0c, 0x51, 0xfc, 0x77, 0x16, 0xf8, 0xf3, 0x6e, 0x18, 0x09, 0xed, 0xad, 0xf1, 0x91, 0xcd,
+	0x51, 0x86, 0x5d, 0x1e, 0x48, 0x47, 0x63, 0xdb, 0x09, 0x82, 0x77, 0x11, 0x63, 0x78, 0x07, 0xe1,
+	0x8f, 0x50, 0xcb, 0x1c, 0x83, 0xb7, 0x01, 0x8a, 0x7b, 0xad, 0xa0, 0x6c, 0xd4, 0x5c, 0x50, 0x54,
+	0x14, 0x5e, 0x34, 0x7c, 0x13, 0x7c, 0xa0, 0x82, 0x9f, 0x20, 0xd8, 0x59, 0xdb, 0xe5, 0xe1, 0x99,
+	0x68, 0x76, 0x11, 0x5d, 0xbc, 0x34, 0x1b, 0xc7, 0x44, 0x68, 0x41, 0x98, 0x14, 0x19, 0xfc, 0x7e,
+	0xab, 0x42, 0xd4, 0x7d, 0x9c, 0x59, 0xca, 0x86, 0x5b, 0x7d, 0x2b, 0xf8, 0x11, 0x82, 0x5d, 0x75,
+	0x8d, 0x2c, 0x8e, 0x01, 0xd8, 0x3b, 0x9a, 0x73, 0xb1, 0x6c, 0x04, 0xcb, 0xeb, 0x8c, 0xe5, 0x25,
+	0xac, 0xb6, 0x9f, 0x25, 0xfe, 0x19, 0xc1, 0x4b, 0x81, 0x46, 0x12, 0xcb, 0x9b, 0x41, 0x0c, 0xf6,
+	0xb8, 0x92, 0xd2, 0xf4, 0x7a, 0x41, 0x27, 0xcb, 0xe8, 0xbc, 0x87, 0xaf, 0xb7, 0x89, 0x4e, 0x89,
+	0xfb, 0x0f, 0x44, 0xec, 0x39, 0x82, 0x91, 0xd0, 0xee, 0xa5, 0xd1, 0x79, 0x6d, 0xd4, 0xbb, 0x4a,
+	0x47, 0x63, 0xdb, 0x09, 0xba, 0x37, 0x18, 0xdd, 0xab, 0xf8, 0xdd, 0x36, 0xd1, 0xd5, 0xf4, 0x42,
+	0x80, 0xea, 0x5f, 0x08, 0x5e, 0x0e, 0x6f, 0xd4, 0x70, 0x5c, 0xcc, 0x5e, 0x9a, 0x1e, 0x8b, 0x6f,
+	0x28, 0xd8, 0x66, 0x18, 0xdb, 0x6b, 0xf8, 0x4a, 0xfb, 0xd8, 0x06, 0x39, 0x7d, 0xd2, 0x0d, 0xbb,
+	0xea, 0xba, 0xa0, 0x46, 0x67, 0x31, 0xaa, 0x97, 0x93, 0xe6, 0x62, 0xd9, 0x74, 0xa0, 0xfa, 0x86,
+	0x15, 0x9d, 0x06, 0x5d, 0x62, 0x45, 0x29, 0x7b, 0xb0, 0x32, 0x45, 0x41, 0xfc, 0x5f, 0x04, 0x03,
+	0xc1, 0x8e, 0x08, 0x2b, 0xcd, 0xf0, 0xf2, 0xf5, 0x70, 0xd2, 0x74, 0xf3, 0x06, 0x42, 0x85, 0x0f,
+	0xb8, 0x0a, 0x1b, 0x78, 0xbd, 0x83, 0x1a, 0x04, 0x1a, 0xc3, 0x00, 0x79, 0xe7, 0x08, 0xe0, 0x5f,
+	0x11, 0x0c, 0x85, 0x34, 0x4e, 0xb8, 0xc1, 0x67, 0x43, 0x74, 0x0f, 0x27, 0xbd, 0x11, 0xd3, 0x4a,
+	0x08, 0x71, 0x99, 0xe9, 0x70, 0x0e, 0x9f, 0x69, 0x55, 0x87, 0xc0, 0xf7, 0x3e, 0xfe, 0x11, 0xc1,
+	0x40, 0xb0, 0x83, 0x69, 0x14, 0xd5, 0xd0, 0x56, 0x4c, 0x9a, 0x6e, 0xde, 0x40, 0x90, 0xb9, 0xc2,
+	0xc8, 0x5c, 0xc0, 0xe7, 0x5a, 0x0e, 0x2a, 0xf7, 0xef, 0x26, 0xe9, 0x09, 0xf5, 0xc1, 0xd3, 0x14,
+	0x7a, 0xf8, 0x34, 0x85, 0x9e, 0x3c, 0x4d, 0xa1, 0x4f, 0x9f, 0xa5, 0xba, 0x1e, 0x3e, 0x4b, 0x75,
+	0x3d, 0x7a, 0x96, 0xea, 0xba, 0x7e, 0x2c, 0x6f, 0xd8, 0x4b, 0xe5, 0xac, 0xac, 0x9b, 0x2b, 0x8a,
+	0xf8, 0xff, 0x37, 0xff, 0x73, 0xc8, 0xca, 0x15, 0x94, 0xb5, 0x2a, 0x90, 0xe9, 0xc3, 0x87, 0x5c,
+	0x2c, 0xf6, 0x7a, 0x91, 0x58, 0xd9, 0x1e, 0xf6, 0xaf, 0x8a, 0xb9, 0xff, 0x06, 0x00, 0xed, 0x59,
+	0xaf, 0x18, 0x8e, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1840,6 +2009,11 @@ type QueryClient interface {
 	UnreceivedAcks(ctx context.Context, in *QueryUnreceivedAcksRequest, opts ...grpc.CallOption) (*QueryUnreceivedAcksResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(ctx context.Context, in *QueryNextSequenceReceiveRequest, opts ...grpc.CallOption) (*QueryNextSequenceReceiveResponse, error)
+	// PendingPackets returns the sequence ranges of the packets sent on a
+	// channel which are neither acknowledged nor timed out yet, along with the
+	// next sequences of the channel. The ranges are built from a page of the
+	// packet commitments of the channel.
+	PendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error)
 }
 
 type queryClient struct {
@@ -1967,6 +2141,15 @@ func (c *queryClient) NextSequenceReceive(ctx context.Context, in *QueryNextSequ
 	return out, nil
 }
 
+func (c *queryClient) PendingPackets(ctx context.Context, in *QueryPendingPacketsRequest, opts ...grpc.CallOption) (*QueryPendingPacketsResponse, error) {
+	out := new(QueryPendingPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PendingPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Channel queries an IBC Channel.
@@ -2002,6 +2185,11 @@ type QueryServer interface {
 	UnreceivedAcks(context.Context, *QueryUnreceivedAcksRequest) (*QueryUnreceivedAcksResponse, error)
 	// NextSequenceReceive returns the next receive sequence for a given channel.
 	NextSequenceReceive(context.Context, *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error)
+	// PendingPackets returns the sequence ranges of the packets sent on a
+	// channel which are neither acknowledged nor timed out yet, along with the
+	// next sequences of the channel. The ranges are built from a page of the
+	// packet commitments of the channel.
+	PendingPackets(context.Context, *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2047,6 +2235,9 @@ func (*UnimplementedQueryServer) UnreceivedAcks(ctx context.Context, req *QueryU
 func (*UnimplementedQueryServer) NextSequenceReceive(ctx context.Context, req *QueryNextSequenceReceiveRequest) (*QueryNextSequenceReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NextSequenceReceive not implemented")
 }
+func (*UnimplementedQueryServer) PendingPackets(ctx context.Context, req *QueryPendingPacketsRequest) (*QueryPendingPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPackets not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2286,6 +2477,24 @@ func _Query_NextSequenceReceive_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/PendingPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPackets(ctx, req.(*QueryPendingPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2342,6 +2551,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextSequenceReceive",
 			Handler:    _Query_NextSequenceReceive_Handler,
 		},
+		{
+			MethodName: "PendingPackets",
+			Handler:    _Query_PendingPackets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/query.proto",
@@ -3606,6 +3819,129 @@ func (m *QueryNextSequenceReceiveResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NextSequenceAck != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceAck))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextSequenceReceive != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceReceive))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NextSequenceSend != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequenceSend))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4126,11 +4462,62 @@ func (m *QueryNextSequenceReceiveResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryPendingPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextSequenceSend != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceSend))
+	}
+	if m.NextSequenceReceive != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceReceive))
+	}
+	if m.NextSequenceAck != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequenceAck))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -7905,6 +8292,366 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPendingPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, SequenceRange{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			}
+			m.NextSequenceSend = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+			}
+			m.NextSequenceReceive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceAck", wireType)
+			}
+			m.NextSequenceAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,28 @@ const (
 	Localhost string = "09-localhost"
 )
 
+// Status represents the status of a client
+type Status string
+
+const (
+	// Active is a status type of a client. An active client is allowed to be used.
+	Active Status = "Active"
+
+	// Frozen is a status type of a client. A frozen client is not allowed to be used.
+	Frozen Status = "Frozen"
+
+	// Expired is a status type of a client. An expired client is not allowed to be used.
+	Expired Status = "Expired"
+
+	// Unknown indicates there was an error in determining the status of a client.
+	Unknown Status = "Unknown"
+)
+
+// String implements the Stringer interface
+func (s Status) String() string {
+	return string(s)
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -34,6 +56,10 @@ type ClientState interface {
 	Validate() error
 	GetProofSpecs() []*ics23.ProofSpec
 
+	// Status returns the status of the client. Only Active clients are allowed
+	// to process packets.
+	Status(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryMarshaler) Status
+
 	// Initialization function
 	// Clients must validate the initial consensus state, and may store any client-specific metadata
 	// necessary for correct light client operation
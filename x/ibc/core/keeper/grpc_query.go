@@ -28,6 +28,16 @@ func (q Keeper) ConsensusStates(c context.Context, req *clienttypes.QueryConsens
 	return q.ClientKeeper.ConsensusStates(c, req)
 }
 
+// ClientStatus implements the IBC QueryServer interface
+func (q Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientStatusRequest) (*clienttypes.QueryClientStatusResponse, error) {
+	return q.ClientKeeper.ClientStatus(c, req)
+}
+
+// ClientStatuses implements the IBC QueryServer interface
+func (q Keeper) ClientStatuses(c context.Context, req *clienttypes.QueryClientStatusesRequest) (*clienttypes.QueryClientStatusesResponse, error) {
+	return q.ClientKeeper.ClientStatuses(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (q Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return q.ClientKeeper.ClientParams(c, req)
@@ -122,3 +132,8 @@ func (q Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnrecei
 func (q Keeper) NextSequenceReceive(c context.Context, req *channeltypes.QueryNextSequenceReceiveRequest) (*channeltypes.QueryNextSequenceReceiveResponse, error) {
 	return q.ChannelKeeper.NextSequenceReceive(c, req)
 }
+
+// PendingPackets implements the IBC QueryServer interface
+func (q Keeper) PendingPackets(c context.Context, req *channeltypes.QueryPendingPacketsRequest) (*channeltypes.QueryPendingPacketsResponse, error) {
+	return q.ChannelKeeper.PendingPackets(c, req)
+}
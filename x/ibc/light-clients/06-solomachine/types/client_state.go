@@ -45,6 +45,16 @@ func (cs ClientState) IsFrozen() bool {
 	return cs.FrozenSequence != 0
 }
 
+// Status returns Frozen if the client is frozen and Active otherwise, solo
+// machine clients do not expire.
+func (cs ClientState) Status(_ sdk.Context, _ sdk.KVStore, _ codec.BinaryMarshaler) exported.Status {
+	if cs.IsFrozen() {
+		return exported.Frozen
+	}
+
+	return exported.Active
+}
+
 // GetFrozenHeight returns the frozen sequence of the client.
 // Return exported.Height to satisfy interface
 // Revision number is always 0 for a solo-machine
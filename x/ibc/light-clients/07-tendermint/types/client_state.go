@@ -68,6 +68,28 @@ func (cs ClientState) GetFrozenHeight() exported.Height {
 	return cs.FrozenHeight
 }
 
+// Status returns the status of the tendermint client: Frozen if the frozen
+// height has been set, Expired if the trusting period has passed since the
+// latest consensus state or if the latest consensus state is missing, and
+// Active otherwise.
+func (cs ClientState) Status(ctx sdk.Context, clientStore sdk.KVStore, cdc codec.BinaryMarshaler) exported.Status {
+	if cs.IsFrozen() {
+		return exported.Frozen
+	}
+
+	// get latest consensus state from clientStore to check for expiry
+	consState, err := GetConsensusState(clientStore, cdc, cs.GetLatestHeight())
+	if err != nil {
+		return exported.Expired
+	}
+
+	if cs.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+		return exported.Expired
+	}
+
+	return exported.Active
+}
+
 // IsExpired returns whether or not the client has passed the trusting period since the last
 // update (in which case no headers are considered valid).
 func (cs ClientState) IsExpired(latestTimestamp, now time.Time) bool {
@@ -5,6 +5,7 @@ import (
 
 	ics23 "github.com/confio/ics23/go"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
@@ -100,6 +101,47 @@ func (suite *TendermintTestSuite) TestValidate() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestStatus() {
+	var (
+		clientA     string
+		clientState *types.ClientState
+		ctx         sdk.Context
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expStatus exported.Status
+	}{
+		{"client is active", func() {}, exported.Active},
+		{"client is frozen", func() {
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+		}, exported.Frozen},
+		{"client status without consensus state", func() {
+			clientState.LatestHeight = clientState.LatestHeight.Increment().(clienttypes.Height)
+		}, exported.Expired},
+		{"client status is expired", func() {
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(clientState.TrustingPeriod))
+		}, exported.Expired},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			clientA, _ = suite.coordinator.SetupClients(suite.chainA, suite.chainB, exported.Tendermint)
+			clientState = suite.chainA.GetClientState(clientA).(*types.ClientState)
+			ctx = suite.chainA.GetContext()
+
+			tc.malleate()
+
+			clientStore := suite.chainA.App.IBCKeeper.ClientKeeper.ClientStore(ctx, clientA)
+			status := clientState.Status(ctx, clientStore, suite.chainA.App.AppCodec())
+			suite.Require().Equal(tc.expStatus, status)
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestInitialize() {
 
 	testCases := []struct {
@@ -48,6 +48,12 @@ func (cs ClientState) IsFrozen() bool {
 	return false
 }
 
+// Status always returns Active, the localhost client can neither be frozen
+// nor expire.
+func (cs ClientState) Status(_ sdk.Context, _ sdk.KVStore, _ codec.BinaryMarshaler) exported.Status {
+	return exported.Active
+}
+
 // GetFrozenHeight returns an uninitialized IBC Height.
 func (cs ClientState) GetFrozenHeight() exported.Height {
 	return clienttypes.ZeroHeight()
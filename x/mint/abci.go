@@ -33,8 +33,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// split the minted coins between the community pool, the weighted
+	// recipients and the fee collector account
+	err = k.DistributeMintedCoin(ctx, mintedCoin)
 	if err != nil {
 		panic(err)
 	}
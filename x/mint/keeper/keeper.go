@@ -16,6 +16,8 @@ type Keeper struct {
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
 	bankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
+	moduleAddress    sdk.AccAddress
 	feeCollectorName string
 }
 
@@ -23,10 +25,11 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryMarshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	dk types.DistributionKeeper, feeCollectorName string,
 ) Keeper {
 	// ensure mint module account is set
-	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+	moduleAddr := ak.GetModuleAddress(types.ModuleName)
+	if moduleAddr == nil {
 		panic("the mint module account has not been set")
 	}
 
@@ -41,6 +44,8 @@ func NewKeeper(
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
 		bankKeeper:       bk,
+		distrKeeper:      dk,
+		moduleAddress:    moduleAddr,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -114,3 +119,90 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// DistributeMintedCoin splits the newly minted coin between the community pool,
+// the weighted recipients and the fee collector according to the module
+// parameters. The fee collector receives the remainder not routed elsewhere.
+// An event is emitted for every destination receiving a non-zero amount.
+//
+// The proportions are validated per param key, so a governance change can make
+// them total more than one: the shares are then capped by the amount left, in
+// order. A share that cannot be sent, e.g. to a blocked address, is left to the
+// fee collector rather than halting the chain.
+func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error {
+	params := k.GetParams(ctx)
+	remaining := mintedCoin.Amount
+
+	communityPoolAmt := mintedCoin.Amount.ToDec().Mul(params.CommunityPoolProportion).TruncateInt()
+	if communityPoolAmt.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, communityPoolAmt))
+		err := k.sendMintedShare(ctx, func(ctx sdk.Context) error {
+			return k.distrKeeper.FundCommunityPool(ctx, coins, k.moduleAddress)
+		})
+		if err == nil {
+			remaining = remaining.Sub(communityPoolAmt)
+			emitMintDistributionEvent(ctx, types.AttributeValueCommunityPool, coins)
+		} else {
+			k.Logger(ctx).Error("failed to fund the community pool with minted coins", "amount", coins, "err", err)
+		}
+	}
+
+	for _, wa := range params.WeightedRecipients {
+		recipientAmt := sdk.MinInt(mintedCoin.Amount.ToDec().Mul(wa.Weight).TruncateInt(), remaining)
+		if !recipientAmt.IsPositive() {
+			continue
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(wa.Address)
+		if err != nil {
+			k.Logger(ctx).Error("invalid weighted recipient address", "address", wa.Address, "err", err)
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, recipientAmt))
+		err = k.sendMintedShare(ctx, func(ctx sdk.Context) error {
+			return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("failed to send minted coins to weighted recipient", "address", wa.Address, "amount", coins, "err", err)
+			continue
+		}
+
+		remaining = remaining.Sub(recipientAmt)
+		emitMintDistributionEvent(ctx, wa.Address, coins)
+	}
+
+	if remaining.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(mintedCoin.Denom, remaining))
+		if err := k.AddCollectedFees(ctx, coins); err != nil {
+			return err
+		}
+
+		emitMintDistributionEvent(ctx, types.AttributeValueFeeCollector, coins)
+	}
+
+	return nil
+}
+
+// sendMintedShare sends a share of the minted coin on a cached context, only
+// writing the state changes if the send succeeds.
+func (k Keeper) sendMintedShare(ctx sdk.Context, send func(ctx sdk.Context) error) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := send(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+func emitMintDistributionEvent(ctx sdk.Context, destination string, amount sdk.Coins) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDistribution,
+			sdk.NewAttribute(types.AttributeKeyDestination, destination),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestDistributeMintedCoin(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	devFund := sdk.AccAddress([]byte("dev_fund____________"))
+	params := types.DefaultParams()
+	params.CommunityPoolProportion = sdk.NewDecWithPrec(10, 2)
+	params.WeightedRecipients = []types.WeightedAddress{
+		types.NewWeightedAddress(devFund.String(), sdk.NewDecWithPrec(25, 2)),
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	poolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+
+	minted := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(minted)))
+	require.NoError(t, app.MintKeeper.DistributeMintedCoin(ctx, minted))
+
+	// 10% to the community pool, 25% to the developer fund and the remainder
+	// to the fee collector
+	poolAfter := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewDec(100), poolAfter.Sub(poolBefore))
+	require.Equal(t, sdk.NewInt(250), app.BankKeeper.GetBalance(ctx, devFund, sdk.DefaultBondDenom).Amount)
+	feesAfter := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	require.Equal(t, sdk.NewInt(651), feesAfter.Sub(feesBefore).Amount)
+
+	mintAcc := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, mintAcc).IsZero())
+
+	var destinations []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeMintDistribution {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyDestination {
+				destinations = append(destinations, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{types.AttributeValueCommunityPool, devFund.String(), types.AttributeValueFeeCollector}, destinations)
+}
+
+func TestDistributeMintedCoinFallback(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	devFund := sdk.AccAddress([]byte("dev_fund____________"))
+	blocked := sdk.AccAddress([]byte("blocked_____________"))
+	app.BankKeeper.SetBlockedAddr(ctx, blocked)
+
+	// the proportions are validated per param key, so they can total more than
+	// one after separate governance changes
+	params := types.DefaultParams()
+	params.CommunityPoolProportion = sdk.NewDecWithPrec(60, 2)
+	params.WeightedRecipients = []types.WeightedAddress{
+		types.NewWeightedAddress(blocked.String(), sdk.NewDecWithPrec(10, 2)),
+		types.NewWeightedAddress(devFund.String(), sdk.NewDecWithPrec(50, 2)),
+	}
+	app.MintKeeper.SetParams(ctx, params)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+
+	minted := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(minted)))
+	require.NoError(t, app.MintKeeper.DistributeMintedCoin(ctx, minted))
+
+	// the blocked address share is skipped and the developer fund share is
+	// capped by the amount left after the community pool
+	require.True(t, app.BankKeeper.GetBalance(ctx, blocked, sdk.DefaultBondDenom).IsZero())
+	require.Equal(t, sdk.NewInt(400), app.BankKeeper.GetBalance(ctx, devFund, sdk.DefaultBondDenom).Amount)
+	feesAfter := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	require.True(t, feesAfter.Sub(feesBefore).IsZero())
+
+	mintAcc := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, mintAcc).IsZero())
+}
+
+func TestDistributeMintedCoinDefaultParams(t *testing.T) {
+	app, ctx := createTestApp(false)
+
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feesBefore := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+
+	minted := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(minted)))
+	require.NoError(t, app.MintKeeper.DistributeMintedCoin(ctx, minted))
+
+	feesAfter := app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom)
+	require.Equal(t, minted, feesAfter.Sub(feesBefore))
+}
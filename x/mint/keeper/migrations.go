@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the minted coin
+// distribution params, missing from the param store of version 1, to their
+// default values, leaving the whole minted coin to the fee collector.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	m.keeper.paramSpace.Set(ctx, types.KeyCommunityPoolProportion, defaults.CommunityPoolProportion)
+	m.keeper.paramSpace.Set(ctx, types.KeyWeightedRecipients, defaults.WeightedRecipients)
+	return nil
+}
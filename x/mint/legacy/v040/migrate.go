@@ -1,6 +1,7 @@
 package v040
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	v039mint "github.com/cosmos/cosmos-sdk/x/mint/legacy/v039"
 	v040mint "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -22,6 +23,8 @@ func Migrate(mintGenState v039mint.GenesisState) *v040mint.GenesisState {
 			InflationMin:        mintGenState.Params.InflationMin,
 			GoalBonded:          mintGenState.Params.GoalBonded,
 			BlocksPerYear:       mintGenState.Params.BlocksPerYear,
+
+			CommunityPoolProportion: sdk.ZeroDec(),
 		},
 	}
 }
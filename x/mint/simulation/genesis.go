@@ -19,6 +19,8 @@ const (
 	InflationMax        = "inflation_max"
 	InflationMin        = "inflation_min"
 	GoalBonded          = "goal_bonded"
+
+	CommunityPoolProportion = "community_pool_proportion"
 )
 
 // GenInflation randomized Inflation
@@ -46,6 +48,11 @@ func GenGoalBonded(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(67, 2)
 }
 
+// GenCommunityPoolProportion randomized CommunityPoolProportion
+func GenCommunityPoolProportion(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(10)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -80,9 +87,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { goalBonded = GenGoalBonded(r) },
 	)
 
+	var communityPoolProportion sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CommunityPoolProportion, &communityPoolProportion, simState.Rand,
+		func(r *rand.Rand) { communityPoolProportion = GenCommunityPoolProportion(r) },
+	)
+
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear)
+	params := types.NewParams(
+		mintDenom, inflationRateChange, inflationMax, inflationMin, goalBonded, blocksPerYear,
+		communityPoolProportion, nil,
+	)
 
 	mintGenesis := types.NewGenesisState(types.InitialMinter(inflation), params)
 
@@ -2,9 +2,14 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint             = ModuleName
+	EventTypeMintDistribution = "mint_distribution"
 
 	AttributeKeyBondedRatio      = "bonded_ratio"
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
+	AttributeKeyDestination      = "destination"
+
+	AttributeValueFeeCollector  = "fee_collector"
+	AttributeValueCommunityPool = "community_pool"
 )
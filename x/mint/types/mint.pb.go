@@ -79,6 +79,11 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// fraction of newly minted tokens sent to the community pool
+	CommunityPoolProportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=community_pool_proportion,json=communityPoolProportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_proportion" yaml:"community_pool_proportion"`
+	// weighted recipients of newly minted tokens, the remainder not routed to the
+	// community pool or to a weighted recipient is sent to the fee collector
+	WeightedRecipients []WeightedAddress `protobuf:"bytes,8,rep,name=weighted_recipients,json=weightedRecipients,proto3" json:"weighted_recipients" yaml:"weighted_recipients"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,43 +132,100 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetWeightedRecipients() []WeightedAddress {
+	if m != nil {
+		return m.WeightedRecipients
+	}
+	return nil
+}
+
+// WeightedAddress defines an address receiving a fraction of the newly minted
+// tokens.
+type WeightedAddress struct {
+	// bech32 encoded address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// fraction of newly minted tokens sent to the address
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedAddress) Reset()      { *m = WeightedAddress{} }
+func (*WeightedAddress) ProtoMessage() {}
+func (*WeightedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *WeightedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedAddress.Merge(m, src)
+}
+func (m *WeightedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedAddress proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*WeightedAddress)(nil), "cosmos.mint.v1beta1.WeightedAddress")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x28, 0x95, 0x6a, 0x98, 0x00, 0x6f, 0xa0, 0x68, 0x82, 0x64, 0xca, 0x01, 0x8d,
-	0x03, 0x89, 0x26, 0x6e, 0x3b, 0x66, 0x15, 0x07, 0xc4, 0x50, 0xe5, 0x1b, 0x5c, 0xa2, 0x7f, 0x52,
-	0x93, 0x59, 0x8d, 0xed, 0xca, 0xf6, 0x46, 0x7b, 0xe5, 0x09, 0x38, 0x72, 0xe4, 0x2d, 0x78, 0x85,
-	0xdd, 0xd8, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x06, 0x7b, 0x02, 0x14, 0xbb, 0x6a, 0xa1, 0x20, 0xa4,
-	0x4a, 0x9c, 0x92, 0xef, 0xfb, 0xff, 0xf3, 0xfd, 0xbe, 0x58, 0x32, 0x8e, 0x2a, 0x65, 0x84, 0x32,
-	0x99, 0xe0, 0xd2, 0x66, 0x17, 0x47, 0x25, 0xb3, 0x70, 0xe4, 0x44, 0x3a, 0xd6, 0xca, 0x2a, 0xb2,
-	0xeb, 0xe7, 0xa9, 0xb3, 0x96, 0xf3, 0xfd, 0xbd, 0x5a, 0xd5, 0xca, 0xcd, 0xb3, 0xf6, 0xcd, 0xaf,
-	0x26, 0x5f, 0x11, 0xee, 0x9e, 0x72, 0x69, 0x99, 0x26, 0xaf, 0x70, 0x8f, 0xcb, 0x77, 0x0d, 0x58,
-	0xae, 0x64, 0x88, 0x0e, 0xd0, 0x61, 0x2f, 0x4f, 0x2f, 0x67, 0x71, 0xf0, 0x7d, 0x16, 0x3f, 0xa9,
-	0xb9, 0x3d, 0x3b, 0x2f, 0xd3, 0x4a, 0x89, 0x6c, 0xc9, 0xf6, 0x8f, 0x67, 0x66, 0x38, 0xca, 0xec,
-	0x74, 0xcc, 0x4c, 0xda, 0x67, 0x15, 0x5d, 0x07, 0x90, 0xf7, 0xf8, 0x3e, 0x48, 0x79, 0x0e, 0x4d,
-	0x31, 0xd6, 0xea, 0x82, 0x1b, 0xae, 0xa4, 0x09, 0x6f, 0xb8, 0xd4, 0x97, 0xdb, 0xa5, 0x5e, 0xcf,
-	0xe2, 0x70, 0x0a, 0xa2, 0x39, 0x4e, 0xfe, 0x08, 0x4c, 0xe8, 0x3d, 0xef, 0x0d, 0xd6, 0xd6, 0x97,
-	0x0e, 0xee, 0x0e, 0x40, 0x83, 0x30, 0xe4, 0x31, 0xc6, 0xed, 0x11, 0x14, 0x43, 0x26, 0x95, 0xf0,
-	0xbf, 0x44, 0x7b, 0xad, 0xd3, 0x6f, 0x0d, 0xf2, 0x01, 0xe1, 0x07, 0xab, 0xc2, 0x85, 0x06, 0xcb,
-	0x8a, 0xea, 0x0c, 0x64, 0xcd, 0x96, 0x3d, 0x5f, 0x6f, 0xdd, 0xf3, 0x91, 0xef, 0xf9, 0xd7, 0xd0,
-	0x84, 0xee, 0xae, 0x7c, 0x0a, 0x96, 0x9d, 0x38, 0x97, 0x8c, 0xf0, 0xce, 0x7a, 0x5d, 0xc0, 0x24,
-	0xbc, 0xe9, 0xd8, 0x2f, 0xb6, 0x66, 0xef, 0x6d, 0xb2, 0x05, 0x4c, 0x12, 0x7a, 0x67, 0xa5, 0x4f,
-	0x61, 0xb2, 0x01, 0xe3, 0x32, 0xec, 0xfc, 0x37, 0x18, 0x97, 0xbf, 0xc1, 0xb8, 0x24, 0x0c, 0xdf,
-	0xae, 0x15, 0x34, 0x45, 0xa9, 0xe4, 0x90, 0x0d, 0xc3, 0x5b, 0x0e, 0xd5, 0xdf, 0x1a, 0x45, 0x3c,
-	0xea, 0x97, 0xa8, 0x84, 0xe2, 0x56, 0xe5, 0x4e, 0x90, 0x1c, 0xdf, 0x2d, 0x1b, 0x55, 0x8d, 0x4c,
-	0x31, 0x66, 0xba, 0x98, 0x32, 0xd0, 0x61, 0xf7, 0x00, 0x1d, 0x76, 0xf2, 0xfd, 0xeb, 0x59, 0xfc,
-	0xd0, 0x7f, 0xbc, 0xb1, 0x90, 0xd0, 0x1d, 0xef, 0x0c, 0x98, 0x7e, 0xc3, 0x40, 0x1f, 0x77, 0x3e,
-	0x7d, 0x8e, 0x83, 0xfc, 0xe4, 0x72, 0x1e, 0xa1, 0xab, 0x79, 0x84, 0x7e, 0xcc, 0x23, 0xf4, 0x71,
-	0x11, 0x05, 0x57, 0x8b, 0x28, 0xf8, 0xb6, 0x88, 0x82, 0xb7, 0x4f, 0xff, 0xd9, 0x76, 0xe2, 0x2f,
-	0xa2, 0x2b, 0x5d, 0x76, 0xdd, 0xbd, 0x7a, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x34, 0x52, 0xcf,
-	0x87, 0xa4, 0x03, 0x00, 0x00,
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0xed, 0xb6, 0xb8, 0xf4, 0xda, 0xaa, 0x70, 0x2d, 0x60, 0x2a, 0xb0, 0x23, 0x0b, 0xa1,
+	0x32, 0xe0, 0xa8, 0xb0, 0x75, 0xc3, 0xad, 0x3a, 0x20, 0x8a, 0xa2, 0x5b, 0x10, 0x2c, 0xd6, 0xc5,
+	0x3e, 0xdc, 0x53, 0xed, 0x3b, 0xeb, 0x7c, 0x69, 0x93, 0x11, 0x26, 0x26, 0xc4, 0xc8, 0x98, 0xff,
+	0x85, 0xa5, 0x1b, 0x1d, 0x11, 0x43, 0x84, 0x92, 0x85, 0x39, 0x7f, 0x01, 0xf2, 0x9d, 0xeb, 0x40,
+	0x08, 0x48, 0x41, 0x4c, 0xbe, 0xf7, 0xbd, 0x77, 0xdf, 0xef, 0xb3, 0xe5, 0x3b, 0xe0, 0x44, 0xbc,
+	0xc8, 0x78, 0xd1, 0xcc, 0x28, 0x93, 0xcd, 0xd3, 0xdd, 0x36, 0x91, 0x78, 0x57, 0x15, 0x7e, 0x2e,
+	0xb8, 0xe4, 0x70, 0x53, 0xf7, 0x7d, 0x25, 0x55, 0xfd, 0xed, 0xad, 0x84, 0x27, 0x5c, 0xf5, 0x9b,
+	0xe5, 0x4a, 0x8f, 0x7a, 0x9f, 0x4d, 0x60, 0x1d, 0x51, 0x26, 0x89, 0x80, 0xcf, 0xc0, 0x0a, 0x65,
+	0xaf, 0x53, 0x2c, 0x29, 0x67, 0xb6, 0xd9, 0x30, 0x77, 0x56, 0x02, 0xff, 0x7c, 0xe0, 0x1a, 0x5f,
+	0x07, 0xee, 0xfd, 0x84, 0xca, 0xe3, 0x4e, 0xdb, 0x8f, 0x78, 0xd6, 0xac, 0xd8, 0xfa, 0xf1, 0xb0,
+	0x88, 0x4f, 0x9a, 0xb2, 0x97, 0x93, 0xc2, 0x3f, 0x20, 0x11, 0x9a, 0x18, 0xc0, 0x33, 0x70, 0x1d,
+	0x33, 0xd6, 0xc1, 0x69, 0x98, 0x0b, 0x7e, 0x4a, 0x0b, 0xca, 0x59, 0x61, 0x2f, 0x28, 0xd7, 0xa7,
+	0xf3, 0xb9, 0x8e, 0x07, 0xae, 0xdd, 0xc3, 0x59, 0xba, 0xe7, 0xfd, 0x66, 0xe8, 0xa1, 0x6b, 0x5a,
+	0x6b, 0x4d, 0xa4, 0x4f, 0x16, 0xb0, 0x5a, 0x58, 0xe0, 0xac, 0x80, 0x77, 0x01, 0x28, 0x3f, 0x41,
+	0x18, 0x13, 0xc6, 0x33, 0xfd, 0x4a, 0x68, 0xa5, 0x54, 0x0e, 0x4a, 0x01, 0xbe, 0x35, 0xc1, 0x8d,
+	0x3a, 0x70, 0x28, 0xb0, 0x24, 0x61, 0x74, 0x8c, 0x59, 0x42, 0xaa, 0x9c, 0xcf, 0xe7, 0xce, 0x79,
+	0x47, 0xe7, 0x9c, 0x69, 0xea, 0xa1, 0xcd, 0x5a, 0x47, 0x58, 0x92, 0x7d, 0xa5, 0xc2, 0x13, 0xb0,
+	0x3e, 0x19, 0xcf, 0x70, 0xd7, 0x5e, 0x54, 0xec, 0xc3, 0xb9, 0xd9, 0x5b, 0xd3, 0xec, 0x0c, 0x77,
+	0x3d, 0xb4, 0x56, 0xd7, 0x47, 0xb8, 0x3b, 0x05, 0xa3, 0xcc, 0x5e, 0xfa, 0x6f, 0x30, 0xca, 0x7e,
+	0x81, 0x51, 0x06, 0x09, 0x58, 0x4d, 0x38, 0x4e, 0xc3, 0x36, 0x67, 0x31, 0x89, 0xed, 0x2b, 0x0a,
+	0x75, 0x30, 0x37, 0x0a, 0x6a, 0xd4, 0x4f, 0x56, 0x1e, 0x02, 0x65, 0x15, 0xa8, 0x02, 0x06, 0x60,
+	0xa3, 0x9d, 0xf2, 0xe8, 0xa4, 0x08, 0x73, 0x22, 0xc2, 0x1e, 0xc1, 0xc2, 0xb6, 0x1a, 0xe6, 0xce,
+	0x52, 0xb0, 0x3d, 0x1e, 0xb8, 0x37, 0xf5, 0xe6, 0xa9, 0x01, 0x0f, 0xad, 0x6b, 0xa5, 0x45, 0xc4,
+	0x4b, 0x82, 0x05, 0x7c, 0x6f, 0x82, 0xdb, 0x11, 0xcf, 0xb2, 0x0e, 0xa3, 0xb2, 0x17, 0xe6, 0x9c,
+	0xab, 0x9f, 0x2c, 0xe7, 0x42, 0x9d, 0x85, 0x65, 0x95, 0x1c, 0xcd, 0x9d, 0xbc, 0xa1, 0xe1, 0x7f,
+	0x34, 0xf6, 0xd0, 0xad, 0xba, 0xd7, 0xe2, 0x3c, 0x6d, 0xd5, 0x1d, 0xd8, 0x03, 0x9b, 0x67, 0x84,
+	0x26, 0xc7, 0x92, 0xc4, 0xa1, 0x20, 0x11, 0xcd, 0x29, 0x61, 0xb2, 0xb0, 0xaf, 0x36, 0x16, 0x77,
+	0x56, 0x1f, 0xdd, 0xf3, 0x67, 0x9c, 0x6f, 0xff, 0x45, 0x35, 0xff, 0x24, 0x8e, 0x05, 0x29, 0x8a,
+	0xc0, 0x2b, 0xf3, 0x8e, 0x07, 0xee, 0xb6, 0x4e, 0x31, 0xc3, 0xce, 0x43, 0xf0, 0x52, 0x45, 0xb5,
+	0xb8, 0xb7, 0xf4, 0xb1, 0xef, 0x1a, 0xde, 0x1b, 0x13, 0x6c, 0x4c, 0x39, 0x42, 0x1b, 0x2c, 0x63,
+	0xbd, 0xac, 0xce, 0xd2, 0x65, 0x09, 0x0f, 0x81, 0xa5, 0x9d, 0xec, 0x85, 0x7f, 0xba, 0x37, 0xaa,
+	0xdd, 0x7b, 0x6b, 0xef, 0xfa, 0xae, 0x51, 0xf2, 0xbf, 0xf7, 0x5d, 0x23, 0xd8, 0x3f, 0x1f, 0x3a,
+	0xe6, 0xc5, 0xd0, 0x31, 0xbf, 0x0d, 0x1d, 0xf3, 0xc3, 0xc8, 0x31, 0x2e, 0x46, 0x8e, 0xf1, 0x65,
+	0xe4, 0x18, 0xaf, 0x1e, 0xfc, 0xd5, 0xb7, 0xab, 0x2f, 0x46, 0x65, 0xdf, 0xb6, 0xd4, 0x3d, 0xf7,
+	0xf8, 0xc7, 0x00, 0x6e, 0xc6, 0xe8, 0x90, 0x34, 0x05, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -229,6 +291,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WeightedRecipients) > 0 {
+		for iNdEx := len(m.WeightedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WeightedRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.CommunityPoolProportion.Size()
+		i -= size
+		if _, err := m.CommunityPoolProportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -284,6 +370,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -329,6 +455,29 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	l = m.CommunityPoolProportion.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.WeightedRecipients) > 0 {
+		for _, e := range m.WeightedRecipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WeightedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -672,6 +821,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolProportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolProportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WeightedRecipients = append(m.WeightedRecipients, WeightedAddress{})
+			if err := m.WeightedRecipients[len(m.WeightedRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightedAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
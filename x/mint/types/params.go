@@ -19,6 +19,9 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+
+	KeyCommunityPoolProportion = []byte("CommunityPoolProportion")
+	KeyWeightedRecipients      = []byte("WeightedRecipients")
 )
 
 // ParamTable for minting module.
@@ -28,18 +31,35 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(
 	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64,
+	communityPoolProportion sdk.Dec, weightedRecipients []WeightedAddress,
 ) Params {
 
 	return Params{
-		MintDenom:           mintDenom,
-		InflationRateChange: inflationRateChange,
-		InflationMax:        inflationMax,
-		InflationMin:        inflationMin,
-		GoalBonded:          goalBonded,
-		BlocksPerYear:       blocksPerYear,
+		MintDenom:               mintDenom,
+		InflationRateChange:     inflationRateChange,
+		InflationMax:            inflationMax,
+		InflationMin:            inflationMin,
+		GoalBonded:              goalBonded,
+		BlocksPerYear:           blocksPerYear,
+		CommunityPoolProportion: communityPoolProportion,
+		WeightedRecipients:      weightedRecipients,
+	}
+}
+
+// NewWeightedAddress creates a new WeightedAddress instance
+func NewWeightedAddress(address string, weight sdk.Dec) WeightedAddress {
+	return WeightedAddress{
+		Address: address,
+		Weight:  weight,
 	}
 }
 
+// String implements the Stringer interface.
+func (wa WeightedAddress) String() string {
+	out, _ := yaml.Marshal(wa)
+	return string(out)
+}
+
 // default minting module parameters
 func DefaultParams() Params {
 	return Params{
@@ -49,6 +69,8 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+
+		CommunityPoolProportion: sdk.ZeroDec(),
 	}
 }
 
@@ -72,17 +94,38 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateCommunityPoolProportion(p.CommunityPoolProportion); err != nil {
+		return err
+	}
+	if err := validateWeightedRecipients(p.WeightedRecipients); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if total := p.CommunityPoolProportion.Add(p.TotalRecipientsWeight()); total.GT(sdk.OneDec()) {
+		return fmt.Errorf(
+			"community pool proportion and weighted recipients must not exceed one: %s", total,
+		)
+	}
 
 	return nil
 
 }
 
+// TotalRecipientsWeight returns the sum of the weights of all weighted recipients.
+func (p Params) TotalRecipientsWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, wa := range p.WeightedRecipients {
+		total = total.Add(wa.Weight)
+	}
+
+	return total
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -98,6 +141,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyCommunityPoolProportion, &p.CommunityPoolProportion, validateCommunityPoolProportion),
+		paramtypes.NewParamSetPair(KeyWeightedRecipients, &p.WeightedRecipients, validateWeightedRecipients),
 	}
 }
 
@@ -193,3 +238,52 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateCommunityPoolProportion(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("community pool proportion cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("community pool proportion cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("community pool proportion too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateWeightedRecipients(i interface{}) error {
+	v, ok := i.([]WeightedAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(v))
+	for _, wa := range v {
+		if _, err := sdk.AccAddressFromBech32(wa.Address); err != nil {
+			return fmt.Errorf("invalid weighted recipient address %s: %w", wa.Address, err)
+		}
+		if seen[wa.Address] {
+			return fmt.Errorf("duplicate weighted recipient: %s", wa.Address)
+		}
+		seen[wa.Address] = true
+
+		if wa.Weight.IsNil() || !wa.Weight.IsPositive() {
+			return fmt.Errorf("weighted recipient %s weight must be positive: %s", wa.Address, wa.Weight)
+		}
+		total = total.Add(wa.Weight)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("total weighted recipients weight too large: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidateMintDistribution(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________")).String()
+	addr2 := sdk.AccAddress([]byte("addr2_______________")).String()
+
+	tests := []struct {
+		name          string
+		communityPool sdk.Dec
+		recipients    []WeightedAddress
+		expErr        bool
+	}{
+		{"default", sdk.ZeroDec(), nil, false},
+		{"valid split", sdk.NewDecWithPrec(2, 1), []WeightedAddress{NewWeightedAddress(addr1, sdk.NewDecWithPrec(3, 1)), NewWeightedAddress(addr2, sdk.NewDecWithPrec(5, 1))}, false},
+		{"negative community pool", sdk.NewDec(-1), nil, true},
+		{"nil community pool", sdk.Dec{}, nil, true},
+		{"invalid address", sdk.ZeroDec(), []WeightedAddress{NewWeightedAddress("invalid", sdk.NewDecWithPrec(1, 1))}, true},
+		{"duplicate recipient", sdk.ZeroDec(), []WeightedAddress{NewWeightedAddress(addr1, sdk.NewDecWithPrec(1, 1)), NewWeightedAddress(addr1, sdk.NewDecWithPrec(1, 1))}, true},
+		{"zero weight", sdk.ZeroDec(), []WeightedAddress{NewWeightedAddress(addr1, sdk.ZeroDec())}, true},
+		{"recipients exceed one", sdk.ZeroDec(), []WeightedAddress{NewWeightedAddress(addr1, sdk.NewDecWithPrec(6, 1)), NewWeightedAddress(addr2, sdk.NewDecWithPrec(5, 1))}, true},
+		{"total exceeds one", sdk.NewDecWithPrec(5, 1), []WeightedAddress{NewWeightedAddress(addr1, sdk.NewDecWithPrec(6, 1))}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.CommunityPoolProportion = tc.communityPool
+			params.WeightedRecipients = tc.recipients
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
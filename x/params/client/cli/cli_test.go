@@ -82,6 +82,15 @@ value: "100"`,
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewQueryAllParamsCmd() {
+	val := s.network.Validators[0]
+
+	cmd := cli.NewQueryAllParamsCmd()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().Contains(out.String(), `{"subspace":"staking","key":"MaxValidators","value":"100","decoded":"100"}`)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
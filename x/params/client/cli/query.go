@@ -21,7 +21,10 @@ func NewQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd())
+	cmd.AddCommand(
+		NewQuerySubspaceParamsCmd(),
+		NewQueryAllParamsCmd(),
+	)
 
 	return cmd
 }
@@ -54,3 +57,32 @@ func NewQuerySubspaceParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryAllParamsCmd returns a CLI command handler for querying the parameters
+// of every subspace registered in the x/params module.
+func NewQueryAllParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all",
+		Short: "Query all parameters of every registered subspace",
+		Long:  "Query all parameters of every registered subspace, returning both the raw stored value and its decoded form.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllParams(context.Background(), &proposal.QueryAllParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
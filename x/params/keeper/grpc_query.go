@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -34,3 +35,35 @@ func (k Keeper) Params(c context.Context, req *proposal.QueryParamsRequest) (*pr
 
 	return &proposal.QueryParamsResponse{Param: param}, nil
 }
+
+// AllParams returns the params of all registered subspaces
+func (k Keeper) AllParams(c context.Context, req *proposal.QueryAllParamsRequest) (*proposal.QueryAllParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var params []proposal.ParamValue
+	for _, ss := range k.GetSubspaces() {
+		for _, key := range ss.Keys() {
+			if !ss.Has(ctx, key) {
+				continue
+			}
+
+			decoded, err := ss.GetDecoded(ctx, key)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to decode %s/%s: %s", ss.Name(), key, err)
+			}
+
+			params = append(params, proposal.ParamValue{
+				Subspace: ss.Name(),
+				Key:      string(key),
+				Value:    string(ss.GetRaw(ctx, key)),
+				Decoded:  fmt.Sprintf("%v", decoded),
+			})
+		}
+	}
+
+	return &proposal.QueryAllParamsResponse{Params: params}, nil
+}
@@ -84,3 +84,31 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryAllParams() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	space := suite.app.ParamsKeeper.Subspace("test").WithKeyTable(types.NewKeyTable(
+		types.NewParamSetPair([]byte("b"), uint64(0), validateNoOp),
+		types.NewParamSetPair([]byte("a"), "", validateNoOp),
+		types.NewParamSetPair([]byte("c"), false, validateNoOp),
+	))
+	space.Set(suite.ctx, []byte("b"), uint64(10))
+	space.Set(suite.ctx, []byte("a"), "stake")
+
+	res, err := suite.queryClient.AllParams(ctx, &proposal.QueryAllParamsRequest{})
+	suite.Require().NoError(err)
+
+	var params []proposal.ParamValue
+	for _, param := range res.Params {
+		if param.Subspace == "test" {
+			params = append(params, param)
+		}
+	}
+
+	suite.Require().Equal([]proposal.ParamValue{
+		{Subspace: "test", Key: "a", Value: `"stake"`, Decoded: "stake"},
+		{Subspace: "test", Key: "b", Value: `"10"`, Decoded: "10"},
+	}, params)
+}
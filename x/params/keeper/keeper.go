@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
@@ -59,3 +62,37 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// GetSubspaces returns all the registered subspaces sorted by name.
+func (k Keeper) GetSubspaces() []types.Subspace {
+	names := make([]string, 0, len(k.spaces))
+	for name := range k.spaces {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	spaces := make([]types.Subspace, len(names))
+	for i, name := range names {
+		spaces[i] = *k.spaces[name]
+	}
+
+	return spaces
+}
+
+// ValidateParamChanges checks that every change targets a registered subspace and
+// parameter key, and that its value can be decoded into the registered type and
+// passes the parameter's validation function. No state is written.
+func (k Keeper) ValidateParamChanges(ctx sdk.Context, changes []proposal.ParamChange) error {
+	for _, c := range changes {
+		ss, ok := k.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+		}
+
+		if err := ss.ValidateUpdate(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+			return sdkerrors.Wrapf(proposal.ErrInvalidParamValue, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -261,3 +262,41 @@ func TestJSONUpdate(t *testing.T) {
 	space.Get(ctx, key, &param)
 	require.Equal(t, paramJSON{40964096, "goodbyeworld"}, param)
 }
+
+func TestValidateParamChanges(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	key := []byte("key")
+	validatePositive := func(i interface{}) error {
+		if i.(int64) <= 0 {
+			return errors.New("must be positive")
+		}
+		return nil
+	}
+
+	space := keeper.Subspace("test").WithKeyTable(types.NewKeyTable(types.NewParamSetPair(key, int64(0), validatePositive)))
+
+	testCases := []struct {
+		name    string
+		changes []proposal.ParamChange
+		expErr  error
+	}{
+		{"valid", []proposal.ParamChange{proposal.NewParamChange("test", "key", `"10"`)}, nil},
+		{"unknown subspace", []proposal.ParamChange{proposal.NewParamChange("unknown", "key", `"10"`)}, proposal.ErrUnknownSubspace},
+		{"unregistered key", []proposal.ParamChange{proposal.NewParamChange("test", "other", `"10"`)}, proposal.ErrInvalidParamValue},
+		{"unparseable value", []proposal.ParamChange{proposal.NewParamChange("test", "key", `"ten"`)}, proposal.ErrInvalidParamValue},
+		{"invalid value", []proposal.ParamChange{proposal.NewParamChange("test", "key", `"-1"`)}, proposal.ErrInvalidParamValue},
+	}
+
+	for _, tc := range testCases {
+		err := keeper.ValidateParamChanges(ctx, tc.changes)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.True(t, errors.Is(err, tc.expErr), tc.name)
+		}
+	}
+
+	// validation never writes to the store
+	require.False(t, space.Has(ctx, key))
+}
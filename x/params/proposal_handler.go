@@ -24,6 +24,14 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+	// Validate all the changes against the registered parameter types and
+	// validation functions first. The gov module runs the handler on a branched
+	// context when the proposal is submitted, so invalid changes are rejected
+	// before any deposit is collected.
+	if err := k.ValidateParamChanges(ctx, p.Changes); err != nil {
+		return err
+	}
+
 	for _, c := range p.Changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
@@ -132,3 +132,33 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+func TestProposalHandlerUnregisteredKey(t *testing.T) {
+	input := newTestInput(t)
+	input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(proposal.NewParamChange(testSubspace, "UnknownKey", "1"))
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	var err error
+	require.NotPanics(t, func() { err = hdlr(input.ctx, tp) })
+	require.True(t, proposal.ErrInvalidParamValue.Is(err), err)
+}
+
+func TestProposalHandlerAtomic(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+
+	tp := testProposal(
+		proposal.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		proposal.NewParamChange(testSubspace, keySlashingRate, `{"downtime": "invalid"}`),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	require.Error(t, hdlr(input.ctx, tp))
+
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+}
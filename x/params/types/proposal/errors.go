@@ -6,10 +6,11 @@ import (
 
 // x/params module sentinel errors
 var (
-	ErrUnknownSubspace  = sdkerrors.Register(ModuleName, 2, "unknown subspace")
-	ErrSettingParameter = sdkerrors.Register(ModuleName, 3, "failed to set parameter")
-	ErrEmptyChanges     = sdkerrors.Register(ModuleName, 4, "submitted parameter changes are empty")
-	ErrEmptySubspace    = sdkerrors.Register(ModuleName, 5, "parameter subspace is empty")
-	ErrEmptyKey         = sdkerrors.Register(ModuleName, 6, "parameter key is empty")
-	ErrEmptyValue       = sdkerrors.Register(ModuleName, 7, "parameter value is empty")
+	ErrUnknownSubspace   = sdkerrors.Register(ModuleName, 2, "unknown subspace")
+	ErrSettingParameter  = sdkerrors.Register(ModuleName, 3, "failed to set parameter")
+	ErrEmptyChanges      = sdkerrors.Register(ModuleName, 4, "submitted parameter changes are empty")
+	ErrEmptySubspace     = sdkerrors.Register(ModuleName, 5, "parameter subspace is empty")
+	ErrEmptyKey          = sdkerrors.Register(ModuleName, 6, "parameter key is empty")
+	ErrEmptyValue        = sdkerrors.Register(ModuleName, 7, "parameter value is empty")
+	ErrInvalidParamValue = sdkerrors.Register(ModuleName, 8, "invalid parameter value")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -133,6 +134,40 @@ func (s Subspace) GetRaw(ctx sdk.Context, key []byte) []byte {
 	return store.Get(key)
 }
 
+// GetDecoded queries for a parameter by key and returns its value decoded into
+// the type registered for the key. It returns an error if the key is not registered
+// or the stored value cannot be decoded.
+func (s Subspace) GetDecoded(ctx sdk.Context, key []byte) (interface{}, error) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, fmt.Errorf("parameter %s not registered", string(key))
+	}
+
+	dest := reflect.New(attr.ty).Interface()
+	if err := s.legacyAmino.UnmarshalJSON(s.GetRaw(ctx, key), dest); err != nil {
+		return nil, err
+	}
+
+	return reflect.Indirect(reflect.ValueOf(dest)).Interface(), nil
+}
+
+// Keys returns the sorted list of parameter keys registered in the Subspace's
+// KeyTable.
+func (s Subspace) Keys() [][]byte {
+	keys := make([]string, 0, len(s.table.m))
+	for k := range s.table.m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	res := make([][]byte, len(keys))
+	for i, k := range keys {
+		res[i] = []byte(k)
+	}
+
+	return res
+}
+
 // Has returns if a parameter key exists or not in the Subspace's KVStore.
 func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	store := s.kvStore(ctx)
@@ -190,28 +225,50 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 // key or if the new value is invalid as determined by the registered type's
 // validation function.
 func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
-	attr, ok := s.table.m[string(key)]
-	if !ok {
+	if _, ok := s.table.m[string(key)]; !ok {
 		panic(fmt.Sprintf("parameter %s not registered", string(key)))
 	}
 
-	ty := attr.ty
-	dest := reflect.New(ty).Interface()
+	dest, err := s.decodeUpdate(ctx, key, value)
+	if err != nil {
+		return err
+	}
+
+	s.Set(ctx, key, dest)
+	return nil
+}
+
+// ValidateUpdate checks that a raw value would be accepted by Update for the
+// given parameter key without storing it. Unlike Update, it returns an error
+// instead of panicking if the key is not registered.
+func (s Subspace) ValidateUpdate(ctx sdk.Context, key, value []byte) error {
+	if _, ok := s.table.m[string(key)]; !ok {
+		return fmt.Errorf("parameter %s not registered", string(key))
+	}
+
+	_, err := s.decodeUpdate(ctx, key, value)
+	return err
+}
+
+// decodeUpdate decodes a raw value on top of the current value of a registered
+// parameter key and validates the result. It returns a pointer to the decoded
+// value.
+func (s Subspace) decodeUpdate(ctx sdk.Context, key, value []byte) (interface{}, error) {
+	dest := reflect.New(s.table.m[string(key)].ty).Interface()
 	s.GetIfExists(ctx, key, dest)
 
 	if err := s.legacyAmino.UnmarshalJSON(value, dest); err != nil {
-		return err
+		return nil, err
 	}
 
 	// destValue contains the dereferenced value of dest so validation function do
 	// not have to operate on pointers.
 	destValue := reflect.Indirect(reflect.ValueOf(dest)).Interface()
 	if err := s.Validate(ctx, key, destValue); err != nil {
-		return err
+		return nil, err
 	}
 
-	s.Set(ctx, key, dest)
-	return nil
+	return dest, nil
 }
 
 // GetParamSet iterates through each ParamSetPair where for each pair, it will
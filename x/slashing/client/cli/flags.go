@@ -2,4 +2,6 @@ package cli
 
 const (
 	FlagAddressValidator = "validator"
+	FlagJailed           = "jailed"
+	FlagMinMissedBlocks  = "min-missed-blocks"
 )
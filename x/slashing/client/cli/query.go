@@ -77,11 +77,14 @@ func GetCmdQuerySigningInfos() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "signing-infos",
 		Short: "Query signing information of all validators",
-		Long: strings.TrimSpace(`signing infos of validators:
+		Long: strings.TrimSpace(`signing infos of validators, optionally filtered on the jailed validators
+or on the validators which missed at least a number of blocks:
 
 $ <appd> query slashing signing-infos
+$ <appd> query slashing signing-infos --jailed
+$ <appd> query slashing signing-infos --min-missed-blocks 100
 `),
-		Args: cobra.ExactArgs(1),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -94,7 +97,21 @@ $ <appd> query slashing signing-infos
 				return err
 			}
 
-			params := &types.QuerySigningInfosRequest{Pagination: pageReq}
+			jailed, err := cmd.Flags().GetBool(FlagJailed)
+			if err != nil {
+				return err
+			}
+
+			minMissedBlocks, err := cmd.Flags().GetInt64(FlagMinMissedBlocks)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QuerySigningInfosRequest{
+				Pagination:      pageReq,
+				Jailed:          jailed,
+				MinMissedBlocks: minMissedBlocks,
+			}
 			res, err := queryClient.SigningInfos(context.Background(), params)
 			if err != nil {
 				return err
@@ -104,6 +121,8 @@ $ <appd> query slashing signing-infos
 		},
 	}
 
+	cmd.Flags().Bool(FlagJailed, false, "Only query the signing infos of the jailed validators")
+	cmd.Flags().Int64(FlagMinMissedBlocks, 0, "Only query the signing infos of the validators which missed at least this number of blocks in the signed blocks window")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "signing infos")
 
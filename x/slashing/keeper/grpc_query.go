@@ -53,19 +53,38 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
 	}
 
+	if req.MinMissedBlocks < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "negative min missed blocks %d", req.MinMissedBlocks)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 	store := ctx.KVStore(k.storeKey)
 	var signInfos []types.ValidatorSigningInfo
 
 	sigInfoStore := prefix.NewStore(store, types.ValidatorSigningInfoKeyPrefix)
-	pageRes, err := query.Paginate(sigInfoStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(sigInfoStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var info types.ValidatorSigningInfo
 		err := k.cdc.UnmarshalBinaryBare(value, &info)
 		if err != nil {
-			return err
+			return false, err
 		}
-		signInfos = append(signInfos, info)
-		return nil
+
+		if info.MissedBlocksCounter < req.MinMissedBlocks {
+			return false, nil
+		}
+
+		if req.Jailed {
+			validator := k.sk.ValidatorByConsAddr(ctx, sdk.ConsAddress(key))
+			if validator == nil || !validator.IsJailed() {
+				return false, nil
+			}
+		}
+
+		if accumulate {
+			signInfos = append(signInfos, info)
+		}
+
+		return true, nil
 	})
 	if err != nil {
 		return nil, err
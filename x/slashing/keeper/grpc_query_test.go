@@ -16,6 +16,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 type SlashingTestSuite struct {
@@ -105,6 +106,46 @@ func (suite *SlashingTestSuite) TestGRPCSigningInfos() {
 	suite.Equal(uint64(2), infoResp.Pagination.Total)
 }
 
+func (suite *SlashingTestSuite) TestGRPCSigningInfosFilters() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// create a jailed validator, with a signing info missing 3 blocks
+	pk := simapp.CreateTestPubKeys(1)[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(sdk.ValAddress(suite.addrDels[0]), pk, 100, true)
+	consAddr := sdk.ConsAddress(pk.Address())
+	jailedInfo := types.NewValidatorSigningInfo(consAddr, int64(1), int64(2), time.Unix(2, 0).UTC(), false, int64(3))
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, jailedInfo)
+	app.StakingKeeper.Jail(ctx, consAddr)
+
+	infoResp, err := queryClient.SigningInfos(gocontext.Background(), &types.QuerySigningInfosRequest{Jailed: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ValidatorSigningInfo{jailedInfo}, infoResp.Info)
+
+	// the signing infos of addrDels missed 10 blocks
+	infoResp, err = queryClient.SigningInfos(gocontext.Background(), &types.QuerySigningInfosRequest{MinMissedBlocks: 4})
+	suite.Require().NoError(err)
+	suite.Require().Len(infoResp.Info, 2)
+	for _, info := range infoResp.Info {
+		suite.Require().NotEqual(consAddr.String(), info.Address)
+	}
+
+	infoResp, err = queryClient.SigningInfos(gocontext.Background(), &types.QuerySigningInfosRequest{
+		MinMissedBlocks: 4,
+		Pagination:      &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(infoResp.Info, 1)
+	suite.Require().Equal(uint64(2), infoResp.Pagination.Total)
+
+	infoResp, err = queryClient.SigningInfos(gocontext.Background(), &types.QuerySigningInfosRequest{Jailed: true, MinMissedBlocks: 4})
+	suite.Require().NoError(err)
+	suite.Require().Empty(infoResp.Info)
+
+	_, err = queryClient.SigningInfos(gocontext.Background(), &types.QuerySigningInfosRequest{MinMissedBlocks: -1})
+	suite.Require().Error(err)
+}
+
 func TestSlashingTestSuite(t *testing.T) {
 	suite.Run(t, new(SlashingTestSuite))
 }
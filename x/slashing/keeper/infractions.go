@@ -93,10 +93,14 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
 				),
 			)
-			k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			infractionParams, _ := k.InfractionParams(ctx, types.InfractionDowntime)
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, infractionParams.SlashFraction)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = infractionParams.JailUntil(ctx.BlockHeader().Time)
+			if infractionParams.Tombstone {
+				signInfo.Tombstoned = true
+			}
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -109,7 +113,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", infractionParams.SlashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
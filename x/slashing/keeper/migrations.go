@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It sets the InfractionParams
+// param, missing from the param store of version 1, to its default value.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramspace.Set(ctx, types.KeyInfractionParams, types.DefaultInfractionParams)
+	return nil
+}
@@ -42,6 +42,12 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// InfractionParams returns the jail duration and slash fraction applied for
+// the given infraction, and false if the infraction is unknown.
+func (k Keeper) InfractionParams(ctx sdk.Context, infraction string) (types.InfractionParams, bool) {
+	return k.GetParams(ctx).GetParamsForInfraction(infraction)
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
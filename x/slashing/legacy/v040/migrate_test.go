@@ -95,6 +95,7 @@ func TestMigrate(t *testing.T) {
   ],
   "params": {
     "downtime_jail_duration": "600s",
+    "infraction_params": [],
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
     "slash_fraction_double_sign": "0.050000000000000000",
@@ -63,5 +63,9 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateInfractionParams(data.Params.InfractionParams); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,8 +19,19 @@ var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultInfractionParams        []InfractionParams
 )
 
+// Built-in infraction types
+const (
+	InfractionDowntime   = "downtime"
+	InfractionDoubleSign = "double_sign"
+)
+
+// JailForeverTime is the time tombstoned validators are jailed until, which is
+// the max time supported by Amino (Dec 31, 9999 - 23:59:59 GMT).
+var JailForeverTime = time.Unix(253402300799, 0)
+
 // Parameter store keys
 var (
 	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
@@ -27,6 +39,7 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyInfractionParams        = []byte("InfractionParams")
 )
 
 // ParamKeyTable for slashing module
@@ -37,7 +50,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, infractionParams []InfractionParams,
 ) Params {
 
 	return Params{
@@ -46,6 +59,17 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		InfractionParams:        infractionParams,
+	}
+}
+
+// NewInfractionParams creates a new InfractionParams object
+func NewInfractionParams(infraction string, jailDuration time.Duration, slashFraction sdk.Dec, tombstone bool) InfractionParams {
+	return InfractionParams{
+		Infraction:    infraction,
+		JailDuration:  jailDuration,
+		SlashFraction: slashFraction,
+		Tombstone:     tombstone,
 	}
 }
 
@@ -57,14 +81,69 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyInfractionParams, &p.InfractionParams, validateInfractionParams),
+	}
+}
+
+// GetParamsForInfraction returns the punishment of the given infraction. Entries
+// of InfractionParams take precedence; the downtime and double sign
+// infractions otherwise use the DowntimeJailDuration, SlashFractionDowntime and
+// SlashFractionDoubleSign parameters, double signing being punished by
+// tombstoning. It returns false if the infraction is unknown.
+func (p Params) GetParamsForInfraction(infraction string) (InfractionParams, bool) {
+	for _, ip := range p.InfractionParams {
+		if ip.Infraction == infraction {
+			return ip, true
+		}
+	}
+
+	switch infraction {
+	case InfractionDowntime:
+		return NewInfractionParams(InfractionDowntime, p.DowntimeJailDuration, p.SlashFractionDowntime, false), true
+
+	case InfractionDoubleSign:
+		return NewInfractionParams(InfractionDoubleSign, 0, p.SlashFractionDoubleSign, true), true
+
+	default:
+		return InfractionParams{}, false
+	}
+}
+
+// JailUntil returns the time until which a validator committing the infraction
+// at blockTime is jailed.
+func (ip InfractionParams) JailUntil(blockTime time.Time) time.Time {
+	if ip.Tombstone {
+		return JailForeverTime
+	}
+
+	return blockTime.Add(ip.JailDuration)
+}
+
+// Validate checks that the infraction params are valid.
+func (ip InfractionParams) Validate() error {
+	if strings.TrimSpace(ip.Infraction) == "" {
+		return fmt.Errorf("infraction name cannot be blank")
+	}
+
+	if ip.JailDuration < 0 {
+		return fmt.Errorf("%s jail duration cannot be negative: %s", ip.Infraction, ip.JailDuration)
+	}
+
+	if ip.SlashFraction.IsNil() || ip.SlashFraction.IsNegative() {
+		return fmt.Errorf("%s slash fraction cannot be negative: %s", ip.Infraction, ip.SlashFraction)
+	}
+	if ip.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s slash fraction too large: %s", ip.Infraction, ip.SlashFraction)
 	}
+
+	return nil
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultInfractionParams,
 	)
 }
 
@@ -141,3 +220,24 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateInfractionParams(i interface{}) error {
+	v, ok := i.([]InfractionParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, ip := range v {
+		if err := ip.Validate(); err != nil {
+			return err
+		}
+
+		if seen[ip.Infraction] {
+			return fmt.Errorf("duplicate infraction params: %s", ip.Infraction)
+		}
+		seen[ip.Infraction] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestGetParamsForInfraction(t *testing.T) {
+	params := types.DefaultParams()
+
+	// built-in infractions fall back to the legacy params
+	downtime, found := params.GetParamsForInfraction(types.InfractionDowntime)
+	require.True(t, found)
+	require.Equal(t, types.NewInfractionParams(types.InfractionDowntime, params.DowntimeJailDuration, params.SlashFractionDowntime, false), downtime)
+
+	doubleSign, found := params.GetParamsForInfraction(types.InfractionDoubleSign)
+	require.True(t, found)
+	require.Equal(t, params.SlashFractionDoubleSign, doubleSign.SlashFraction)
+	require.True(t, doubleSign.Tombstone)
+	require.Equal(t, types.JailForeverTime, doubleSign.JailUntil(time.Unix(0, 0)))
+
+	_, found = params.GetParamsForInfraction("light_client_attack")
+	require.False(t, found)
+
+	// entries take precedence over the legacy params
+	custom := types.NewInfractionParams("light_client_attack", time.Hour, sdk.NewDecWithPrec(2, 1), false)
+	override := types.NewInfractionParams(types.InfractionDowntime, time.Minute, sdk.NewDecWithPrec(1, 1), false)
+	params.InfractionParams = []types.InfractionParams{custom, override}
+
+	ip, found := params.GetParamsForInfraction("light_client_attack")
+	require.True(t, found)
+	require.Equal(t, custom, ip)
+	require.Equal(t, time.Unix(3600, 0), ip.JailUntil(time.Unix(0, 0)))
+
+	ip, found = params.GetParamsForInfraction(types.InfractionDowntime)
+	require.True(t, found)
+	require.Equal(t, override, ip)
+}
+
+func TestValidateInfractionParams(t *testing.T) {
+	testCases := []struct {
+		name     string
+		params   []types.InfractionParams
+		expError bool
+	}{
+		{"empty", []types.InfractionParams{}, false},
+		{"valid", []types.InfractionParams{
+			types.NewInfractionParams(types.InfractionDowntime, time.Hour, sdk.NewDecWithPrec(1, 2), false),
+			types.NewInfractionParams(types.InfractionDoubleSign, 0, sdk.NewDecWithPrec(5, 2), true),
+		}, false},
+		{"blank infraction", []types.InfractionParams{
+			types.NewInfractionParams(" ", time.Hour, sdk.NewDecWithPrec(1, 2), false),
+		}, true},
+		{"negative jail duration", []types.InfractionParams{
+			types.NewInfractionParams(types.InfractionDowntime, -time.Hour, sdk.NewDecWithPrec(1, 2), false),
+		}, true},
+		{"negative slash fraction", []types.InfractionParams{
+			types.NewInfractionParams(types.InfractionDowntime, time.Hour, sdk.NewDecWithPrec(-1, 2), false),
+		}, true},
+		{"slash fraction too large", []types.InfractionParams{
+			types.NewInfractionParams(types.InfractionDowntime, time.Hour, sdk.NewDec(2), false),
+		}, true},
+		{"duplicate infraction", []types.InfractionParams{
+			types.NewInfractionParams(types.InfractionDowntime, time.Hour, sdk.NewDecWithPrec(1, 2), false),
+			types.NewInfractionParams(types.InfractionDowntime, time.Minute, sdk.NewDecWithPrec(1, 2), false),
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.Params.InfractionParams = tc.params
+
+			err := types.ValidateGenesis(*genState)
+			if tc.expError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
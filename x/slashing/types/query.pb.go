@@ -210,6 +210,11 @@ func (m *QuerySigningInfoResponse) GetValSigningInfo() ValidatorSigningInfo {
 // method
 type QuerySigningInfosRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// jailed, if set, filters the signing infos of the jailed validators.
+	Jailed bool `protobuf:"varint,2,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// min_missed_blocks, if set, filters the signing infos of the validators
+	// which missed at least this number of blocks in the signed blocks window.
+	MinMissedBlocks int64 `protobuf:"varint,3,opt,name=min_missed_blocks,json=minMissedBlocks,proto3" json:"min_missed_blocks,omitempty"`
 }
 
 func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosRequest{} }
@@ -252,6 +257,20 @@ func (m *QuerySigningInfosRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySigningInfosRequest) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *QuerySigningInfosRequest) GetMinMissedBlocks() int64 {
+	if m != nil {
+		return m.MinMissedBlocks
+	}
+	return 0
+}
+
 // QuerySigningInfosResponse is the response type for the Query/SigningInfos RPC
 // method
 type QuerySigningInfosResponse struct {
@@ -321,40 +340,43 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x0d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x35, 0xc8, 0xc6, 0xae, 0x90, 0x16,
-	0x35, 0x3b, 0x26, 0x22, 0x5e, 0xec, 0xc1, 0x1e, 0x0c, 0xde, 0x34, 0x8a, 0x07, 0x41, 0xc2, 0x6c,
-	0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0xfb, 0x36, 0xc1, 0x20, 0x5e, 0x3c, 0x7b, 0x10, 0xfc, 0x0c,
-	0x1e, 0x3d, 0xf8, 0x2d, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x09, 0xfc, 0x04, 0x92, 0x99,
-	0x49, 0xb2, 0x25, 0xae, 0xa6, 0x3d, 0xed, 0xf0, 0xe6, 0xfd, 0xdf, 0xff, 0xf7, 0xe6, 0x3d, 0x16,
-	0xdf, 0xe8, 0x68, 0xe8, 0x69, 0xa0, 0x10, 0x31, 0x38, 0x94, 0x4a, 0xd0, 0x41, 0x3d, 0xe4, 0x29,
-	0xab, 0xd3, 0xa3, 0x3e, 0x4f, 0x86, 0x41, 0x9c, 0xe8, 0x54, 0x93, 0x4d, 0x9b, 0x14, 0x4c, 0x93,
-	0x02, 0x97, 0x54, 0xbe, 0xe9, 0xd4, 0x21, 0x03, 0x6e, 0x15, 0x33, 0x7d, 0xcc, 0x84, 0x54, 0x2c,
-	0x95, 0x5a, 0xd9, 0x22, 0xe5, 0x0d, 0xa1, 0x85, 0x36, 0x47, 0x3a, 0x39, 0xb9, 0xe8, 0x35, 0xa1,
-	0xb5, 0x88, 0x38, 0x65, 0xb1, 0xa4, 0x4c, 0x29, 0x9d, 0x1a, 0x09, 0xb8, 0xdb, 0x6a, 0x1e, 0xdd,
-	0x8c, 0xc4, 0xe4, 0xf9, 0x1b, 0x98, 0x3c, 0x9d, 0xb8, 0x3f, 0x61, 0x09, 0xeb, 0x41, 0x8b, 0x1f,
-	0xf5, 0x39, 0xa4, 0xfe, 0x73, 0x7c, 0xe5, 0x54, 0x14, 0x62, 0xad, 0x80, 0x93, 0x3d, 0x5c, 0x8c,
-	0x4d, 0x64, 0x0b, 0x5d, 0x47, 0xbb, 0xa5, 0x46, 0x25, 0xc8, 0x69, 0x2f, 0xb0, 0xc2, 0xfd, 0xb5,
-	0xe3, 0x1f, 0x95, 0x42, 0xcb, 0x89, 0xfc, 0x07, 0x78, 0xd3, 0x54, 0x7d, 0x26, 0x85, 0x92, 0x4a,
-	0x3c, 0x56, 0x07, 0xda, 0x19, 0x92, 0x6d, 0xbc, 0xde, 0xd1, 0x0a, 0xda, 0xac, 0xdb, 0x4d, 0x38,
-	0xd8, 0xfa, 0x17, 0x5b, 0xa5, 0x49, 0xec, 0xa1, 0x0d, 0xf9, 0x43, 0xbc, 0xb5, 0xa8, 0x76, 0x60,
-	0xaf, 0xf0, 0xe5, 0x01, 0x8b, 0xda, 0x60, 0xaf, 0xda, 0x52, 0x1d, 0x68, 0x87, 0x58, 0xcb, 0x45,
-	0x7c, 0xc1, 0x22, 0xd9, 0x65, 0xa9, 0x4e, 0x32, 0x05, 0x1d, 0xf0, 0xa5, 0x01, 0x8b, 0x32, 0x51,
-	0x3f, 0x5c, 0xb4, 0x9e, 0x3e, 0x15, 0x79, 0x84, 0xf1, 0x7c, 0x60, 0xce, 0xb4, 0x3a, 0x35, 0x9d,
-	0x4c, 0x37, 0xb0, 0xfb, 0x30, 0x7f, 0x19, 0xc1, 0x9d, 0xb6, 0x95, 0x51, 0xfa, 0x5f, 0x10, 0xbe,
-	0xfa, 0x17, 0x13, 0xd7, 0x60, 0x13, 0xaf, 0xb9, 0xa6, 0x56, 0xcf, 0xdb, 0x94, 0x29, 0x40, 0x9a,
-	0xa7, 0x70, 0x57, 0x0c, 0xee, 0xce, 0x7f, 0x71, 0x2d, 0x45, 0x96, 0xb7, 0xf1, 0x7b, 0x15, 0x5f,
-	0x30, 0xbc, 0xe4, 0x03, 0xc2, 0x45, 0x3b, 0x6f, 0x72, 0x2b, 0x17, 0x6c, 0x71, 0xc9, 0xca, 0xb7,
-	0x97, 0x4b, 0xb6, 0xde, 0xfe, 0xce, 0xfb, 0x6f, 0xbf, 0x3e, 0xad, 0x6c, 0x93, 0x0a, 0xcd, 0xdb,
-	0x6c, 0xbb, 0x65, 0xe4, 0x2b, 0xc2, 0xa5, 0x4c, 0xf7, 0xe4, 0xce, 0xbf, 0x6d, 0x16, 0x97, 0xb1,
-	0x5c, 0x3f, 0x83, 0xc2, 0xd1, 0xed, 0x19, 0xba, 0xfb, 0xe4, 0x5e, 0x2e, 0x5d, 0x76, 0x37, 0x81,
-	0xbe, 0xcd, 0x6e, 0xfb, 0x3b, 0xf2, 0x19, 0xe1, 0xf5, 0xec, 0xdc, 0xc9, 0xf2, 0x08, 0xb3, 0xe7,
-	0x6c, 0x9c, 0x45, 0xe2, 0xb0, 0x03, 0x83, 0xbd, 0x4b, 0xaa, 0xcb, 0x61, 0xef, 0x37, 0x8f, 0x47,
-	0x1e, 0x3a, 0x19, 0x79, 0xe8, 0xe7, 0xc8, 0x43, 0x1f, 0xc7, 0x5e, 0xe1, 0x64, 0xec, 0x15, 0xbe,
-	0x8f, 0xbd, 0xc2, 0xcb, 0x9a, 0x90, 0xe9, 0x61, 0x3f, 0x0c, 0x3a, 0xba, 0x37, 0xad, 0x65, 0x3f,
-	0x35, 0xe8, 0xbe, 0xa6, 0x6f, 0xe6, 0x85, 0xd3, 0x61, 0xcc, 0x21, 0x2c, 0x9a, 0xbf, 0xcf, 0xdd,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x0f, 0xc0, 0xe6, 0x45, 0x05, 0x00, 0x00,
+	// 576 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0x12, 0x4f,
+	0x18, 0xc7, 0x19, 0xe8, 0x8f, 0xfc, 0x1c, 0x1a, 0xff, 0x8c, 0x8d, 0x45, 0x62, 0x16, 0xba, 0x26,
+	0x94, 0x54, 0xd9, 0x15, 0x8c, 0xf1, 0x62, 0x0f, 0x72, 0x90, 0x78, 0x30, 0xd1, 0xd5, 0x78, 0x30,
+	0x31, 0x64, 0x60, 0xa7, 0xd3, 0xb1, 0xbb, 0x33, 0x5b, 0x66, 0x21, 0x12, 0xe3, 0xc5, 0xb3, 0x07,
+	0x13, 0x5f, 0x83, 0xde, 0x3c, 0xf8, 0x2e, 0x7a, 0x6c, 0xe2, 0xc5, 0x93, 0x31, 0xe0, 0x2b, 0xf0,
+	0x15, 0x18, 0x66, 0x06, 0xd8, 0x06, 0x57, 0xa9, 0x27, 0x96, 0xef, 0x3e, 0xdf, 0xe7, 0xf9, 0x3c,
+	0xcf, 0x3c, 0xb3, 0xf0, 0x6a, 0x4f, 0xc8, 0x50, 0x48, 0x57, 0x06, 0x58, 0xee, 0x33, 0x4e, 0xdd,
+	0x61, 0xa3, 0x4b, 0x62, 0xdc, 0x70, 0x0f, 0x07, 0xa4, 0x3f, 0x72, 0xa2, 0xbe, 0x88, 0x05, 0xda,
+	0xd4, 0x41, 0xce, 0x2c, 0xc8, 0x31, 0x41, 0xa5, 0x1d, 0xe3, 0xee, 0x62, 0x49, 0xb4, 0x63, 0xee,
+	0x8f, 0x30, 0x65, 0x1c, 0xc7, 0x4c, 0x70, 0x9d, 0xa4, 0xb4, 0x41, 0x05, 0x15, 0xea, 0xd1, 0x9d,
+	0x3e, 0x19, 0xf5, 0x0a, 0x15, 0x82, 0x06, 0xc4, 0xc5, 0x11, 0x73, 0x31, 0xe7, 0x22, 0x56, 0x16,
+	0x69, 0xde, 0x56, 0xd3, 0xe8, 0xe6, 0x24, 0x2a, 0xce, 0xde, 0x80, 0xe8, 0xd1, 0xb4, 0xfa, 0x43,
+	0xdc, 0xc7, 0xa1, 0xf4, 0xc8, 0xe1, 0x80, 0xc8, 0xd8, 0x7e, 0x02, 0x2f, 0x9e, 0x50, 0x65, 0x24,
+	0xb8, 0x24, 0x68, 0x17, 0xe6, 0x23, 0xa5, 0x14, 0x41, 0x05, 0xd4, 0x0a, 0xcd, 0xb2, 0x93, 0xd2,
+	0x9e, 0xa3, 0x8d, 0xad, 0xb5, 0xa3, 0x6f, 0xe5, 0x8c, 0x67, 0x4c, 0xf6, 0x1d, 0xb8, 0xa9, 0xb2,
+	0x3e, 0x66, 0x94, 0x33, 0x4e, 0xef, 0xf3, 0x3d, 0x61, 0x0a, 0xa2, 0x2d, 0xb8, 0xde, 0x13, 0x5c,
+	0x76, 0xb0, 0xef, 0xf7, 0x89, 0xd4, 0xf9, 0xcf, 0x78, 0x85, 0xa9, 0x76, 0x57, 0x4b, 0xf6, 0x08,
+	0x16, 0x97, 0xdd, 0x06, 0xec, 0x39, 0x3c, 0x3f, 0xc4, 0x41, 0x47, 0xea, 0x57, 0x1d, 0xc6, 0xf7,
+	0x84, 0x41, 0xac, 0xa7, 0x22, 0x3e, 0xc5, 0x01, 0xf3, 0x71, 0x2c, 0xfa, 0x89, 0x84, 0x06, 0xf8,
+	0xec, 0x10, 0x07, 0x09, 0xd5, 0xfe, 0x08, 0x96, 0x6b, 0xcf, 0x66, 0x85, 0xee, 0x41, 0xb8, 0x38,
+	0x31, 0x53, 0xb5, 0x3a, 0xab, 0x3a, 0x3d, 0x5e, 0x47, 0x2f, 0xc4, 0x62, 0x34, 0x94, 0x18, 0xaf,
+	0x97, 0x70, 0xa2, 0x4b, 0x30, 0xff, 0x02, 0xb3, 0x80, 0xf8, 0xc5, 0x6c, 0x05, 0xd4, 0xfe, 0xf7,
+	0xcc, 0x3f, 0xb4, 0x03, 0x2f, 0x84, 0x8c, 0x77, 0x42, 0x26, 0x25, 0xf1, 0x3b, 0xdd, 0x40, 0xf4,
+	0x0e, 0x64, 0x31, 0x57, 0x01, 0xb5, 0x9c, 0x77, 0x2e, 0x64, 0xfc, 0x81, 0xd2, 0x5b, 0x4a, 0xb6,
+	0x3f, 0x01, 0x78, 0xf9, 0x37, 0xa0, 0x66, 0x4a, 0x6d, 0xb8, 0x66, 0x26, 0x93, 0xfb, 0xd7, 0xc9,
+	0xa8, 0x04, 0xa8, 0x7d, 0xa2, 0xe5, 0xac, 0x6a, 0x79, 0xfb, 0xaf, 0x2d, 0x6b, 0x8a, 0x64, 0xcf,
+	0xcd, 0x9f, 0x39, 0xf8, 0x9f, 0xe2, 0x45, 0x6f, 0x01, 0xcc, 0xeb, 0xa5, 0x41, 0xd7, 0x52, 0xc1,
+	0x96, 0x37, 0xb5, 0x74, 0x7d, 0xb5, 0x60, 0x5d, 0xdb, 0xde, 0x7e, 0xf3, 0xe5, 0xc7, 0xfb, 0xec,
+	0x16, 0x2a, 0xbb, 0x69, 0xd7, 0x43, 0xaf, 0x2a, 0xfa, 0x0c, 0x60, 0x21, 0xd1, 0x3d, 0xba, 0xf1,
+	0xe7, 0x32, 0xcb, 0x1b, 0x5d, 0x6a, 0x9c, 0xc2, 0x61, 0xe8, 0x76, 0x15, 0xdd, 0x6d, 0x74, 0x2b,
+	0x95, 0x2e, 0xb9, 0xe0, 0xd2, 0x7d, 0x95, 0xbc, 0x32, 0xaf, 0xd1, 0x07, 0x00, 0xd7, 0x93, 0xe7,
+	0x8e, 0x56, 0x47, 0x98, 0x8f, 0xb3, 0x79, 0x1a, 0x8b, 0xc1, 0x76, 0x14, 0x76, 0x0d, 0x55, 0x57,
+	0xc3, 0x6e, 0xb5, 0x8f, 0xc6, 0x16, 0x38, 0x1e, 0x5b, 0xe0, 0xfb, 0xd8, 0x02, 0xef, 0x26, 0x56,
+	0xe6, 0x78, 0x62, 0x65, 0xbe, 0x4e, 0xac, 0xcc, 0xb3, 0x3a, 0x65, 0xf1, 0xfe, 0xa0, 0xeb, 0xf4,
+	0x44, 0x38, 0xcb, 0xa5, 0x7f, 0xea, 0xd2, 0x3f, 0x70, 0x5f, 0x2e, 0x12, 0xc7, 0xa3, 0x88, 0xc8,
+	0x6e, 0x5e, 0x7d, 0xc2, 0x6e, 0xfe, 0x1a, 0x00, 0x1c, 0x7e, 0x9e, 0xc7, 0x8a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -654,6 +676,21 @@ func (m *QuerySigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.MinMissedBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinMissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -783,6 +820,12 @@ func (m *QuerySigningInfosRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.MinMissedBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MinMissedBlocks))
+	}
 	return n
 }
 
@@ -1174,6 +1217,45 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMissedBlocks", wireType)
+			}
+			m.MinMissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -33,19 +33,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // liveness activity.
 type ValidatorSigningInfo struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Height at which validator was first a candidate OR was unjailed
+	// height at which validator was first a candidate OR was unjailed
 	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
-	// Index which is incremented each time the validator was a bonded
-	// in a block and may have signed a precommit or not. This in conjunction with the
-	// `SignedBlocksWindow` param determines the index in the `MissedBlocksBitArray`.
+	// index offset into signed block bit array
 	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty" yaml:"index_offset"`
-	// Timestamp until which the validator is jailed due to liveness downtime.
+	// timestamp validator cannot be unjailed until
 	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until" yaml:"jailed_until"`
-	// Whether or not a validator has been tombstoned (killed out of validator set). It is set
-	// once the validator commits an equivocation or for any other configured misbehiavor.
+	// whether or not a validator has been tombstoned (killed out of validator
+	// set)
 	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
-	// A counter kept to avoid unnecessary array reads.
-	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
+	// missed blocks counter (to avoid scanning the array every time)
 	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty" yaml:"missed_blocks_counter"`
 }
 
@@ -130,6 +127,10 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// infraction_params defines the jail duration and slash fraction of each
+	// infraction type. The downtime and double sign infractions fall back to the
+	// parameters above when they have no entry.
+	InfractionParams []InfractionParams `protobuf:"bytes,6,rep,name=infraction_params,json=infractionParams,proto3" json:"infraction_params" yaml:"infraction_params"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,9 +180,88 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetInfractionParams() []InfractionParams {
+	if m != nil {
+		return m.InfractionParams
+	}
+	return nil
+}
+
+// InfractionParams defines the punishment of validators for a type of
+// infraction.
+type InfractionParams struct {
+	// infraction is the name of the infraction type, e.g. "downtime" or
+	// "double_sign".
+	Infraction string `protobuf:"bytes,1,opt,name=infraction,proto3" json:"infraction,omitempty"`
+	// jail_duration is the duration a validator is jailed for after committing
+	// the infraction.
+	JailDuration time.Duration `protobuf:"bytes,2,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration" yaml:"jail_duration"`
+	// slash_fraction is the fraction of the validator's stake slashed for the
+	// infraction.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
+	// tombstone defines whether the validator is tombstoned, and thus jailed
+	// forever, for the infraction. The jail duration is ignored if set.
+	Tombstone bool `protobuf:"varint,4,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+}
+
+func (m *InfractionParams) Reset()         { *m = InfractionParams{} }
+func (m *InfractionParams) String() string { return proto.CompactTextString(m) }
+func (*InfractionParams) ProtoMessage()    {}
+func (*InfractionParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *InfractionParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InfractionParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InfractionParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InfractionParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InfractionParams.Merge(m, src)
+}
+func (m *InfractionParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *InfractionParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_InfractionParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InfractionParams proto.InternalMessageInfo
+
+func (m *InfractionParams) GetInfraction() string {
+	if m != nil {
+		return m.Infraction
+	}
+	return ""
+}
+
+func (m *InfractionParams) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *InfractionParams) GetTombstone() bool {
+	if m != nil {
+		return m.Tombstone
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*InfractionParams)(nil), "cosmos.slashing.v1beta1.InfractionParams")
 }
 
 func init() {
@@ -189,47 +269,54 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x53, 0xd3, 0x40,
+	0x14, 0x6e, 0xa8, 0x54, 0xd8, 0x16, 0x07, 0x43, 0xb1, 0xb1, 0x62, 0x52, 0xf7, 0xc0, 0x94, 0x03,
+	0xe9, 0x80, 0x37, 0x8e, 0x91, 0x51, 0xd1, 0x19, 0xc5, 0x80, 0x3a, 0xe3, 0xc1, 0x98, 0x36, 0xdb,
+	0x74, 0x25, 0xd9, 0xed, 0x64, 0xb7, 0x02, 0xde, 0xbc, 0x71, 0xe4, 0xc8, 0x91, 0xf1, 0xe4, 0x9f,
+	0xc2, 0x91, 0xa3, 0xe3, 0xa1, 0x3a, 0xe5, 0xe2, 0x19, 0xff, 0x01, 0x27, 0xbb, 0x49, 0x5b, 0x4a,
+	0xd1, 0xe1, 0xd4, 0xbe, 0xef, 0xfd, 0xd8, 0xb7, 0xef, 0xfb, 0xf6, 0x05, 0x2c, 0x36, 0x28, 0x0b,
+	0x29, 0xab, 0xb1, 0xc0, 0x65, 0x2d, 0x4c, 0xfc, 0xda, 0xa7, 0x95, 0x3a, 0xe2, 0xee, 0x4a, 0x1f,
+	0x30, 0xdb, 0x11, 0xe5, 0x54, 0x2d, 0xc9, 0x38, 0xb3, 0x0f, 0x27, 0x71, 0xe5, 0xa2, 0x4f, 0x7d,
+	0x2a, 0x62, 0x6a, 0xf1, 0x3f, 0x19, 0x5e, 0xd6, 0x7d, 0x4a, 0xfd, 0x00, 0xd5, 0x84, 0x55, 0xef,
+	0x34, 0x6b, 0x5e, 0x27, 0x72, 0x39, 0xa6, 0x24, 0xf1, 0x1b, 0xa3, 0x7e, 0x8e, 0x43, 0xc4, 0xb8,
+	0x1b, 0xb6, 0x65, 0x00, 0x3c, 0xc8, 0x82, 0xe2, 0x1b, 0x37, 0xc0, 0x9e, 0xcb, 0x69, 0xb4, 0x85,
+	0x7d, 0x82, 0x89, 0xbf, 0x41, 0x9a, 0x54, 0xd5, 0xc0, 0x4d, 0xd7, 0xf3, 0x22, 0xc4, 0x98, 0xa6,
+	0x54, 0x94, 0xea, 0xb4, 0x9d, 0x9a, 0xea, 0x1a, 0x28, 0x30, 0xee, 0x46, 0xdc, 0x69, 0x21, 0xec,
+	0xb7, 0xb8, 0x36, 0x51, 0x51, 0xaa, 0x59, 0xab, 0x74, 0xde, 0x35, 0xe6, 0xf6, 0xdd, 0x30, 0x58,
+	0x83, 0xc3, 0x5e, 0x68, 0xe7, 0x85, 0xf9, 0x54, 0x58, 0x71, 0x2e, 0x26, 0x1e, 0xda, 0x73, 0x68,
+	0xb3, 0xc9, 0x10, 0xd7, 0xb2, 0xa3, 0xb9, 0xc3, 0x5e, 0x68, 0xe7, 0x85, 0xf9, 0x52, 0x58, 0xea,
+	0x7b, 0x50, 0xf8, 0xe8, 0xe2, 0x00, 0x79, 0x4e, 0x87, 0x70, 0x1c, 0x68, 0x37, 0x2a, 0x4a, 0x35,
+	0xbf, 0x5a, 0x36, 0xe5, 0x15, 0xcd, 0xf4, 0x8a, 0xe6, 0x76, 0x7a, 0x45, 0xcb, 0x38, 0xe9, 0x1a,
+	0x99, 0x41, 0xed, 0xe1, 0x6c, 0x78, 0xf8, 0xd3, 0x50, 0xec, 0xbc, 0x84, 0x5e, 0xc7, 0x88, 0xaa,
+	0x03, 0xc0, 0x69, 0x58, 0x67, 0x9c, 0x12, 0xe4, 0x69, 0x93, 0x15, 0xa5, 0x3a, 0x65, 0x0f, 0x21,
+	0xea, 0x36, 0x98, 0x0f, 0x31, 0x63, 0xc8, 0x73, 0xea, 0x01, 0x6d, 0xec, 0x30, 0xa7, 0x41, 0x3b,
+	0x84, 0xa3, 0x48, 0xcb, 0x89, 0x4b, 0x54, 0xce, 0xbb, 0xc6, 0x82, 0x3c, 0x68, 0x6c, 0x18, 0xb4,
+	0xe7, 0x24, 0x6e, 0x09, 0xf8, 0x91, 0x44, 0xd7, 0xa6, 0x8e, 0x8e, 0x8d, 0xcc, 0xef, 0x63, 0x43,
+	0x81, 0x7f, 0x26, 0x41, 0x6e, 0xd3, 0x8d, 0xdc, 0x90, 0xa9, 0xaf, 0x40, 0x91, 0x61, 0x9f, 0x0c,
+	0x6a, 0xec, 0x62, 0xe2, 0xd1, 0x5d, 0xc1, 0x44, 0xd6, 0x32, 0xce, 0xbb, 0xc6, 0xbd, 0x64, 0xd4,
+	0x63, 0xa2, 0xa0, 0xad, 0x4a, 0x58, 0x1e, 0xf4, 0x56, 0x80, 0xea, 0x17, 0x25, 0x6e, 0x9f, 0x38,
+	0x49, 0x46, 0x1b, 0x45, 0x69, 0xd1, 0x98, 0xbf, 0x82, 0xf5, 0x22, 0x9e, 0xd5, 0x8f, 0xae, 0xb1,
+	0xe8, 0x63, 0xde, 0xea, 0xd4, 0xcd, 0x06, 0x0d, 0x6b, 0x89, 0x66, 0xe5, 0xcf, 0x32, 0xf3, 0x76,
+	0x6a, 0x7c, 0xbf, 0x8d, 0x98, 0xb9, 0x8e, 0x1a, 0xc3, 0x97, 0x1d, 0x53, 0x14, 0xda, 0x6a, 0x88,
+	0xc9, 0x96, 0x80, 0x37, 0x51, 0x94, 0xf4, 0xf0, 0x19, 0xdc, 0xf1, 0xe8, 0x2e, 0x89, 0x35, 0xe8,
+	0xc4, 0x93, 0x77, 0x52, 0xb5, 0x0a, 0x1d, 0xe4, 0x57, 0xef, 0x5e, 0xe2, 0x72, 0x3d, 0x09, 0xb0,
+	0x96, 0x12, 0x2a, 0xef, 0xcb, 0x43, 0xc7, 0x97, 0x81, 0x47, 0x31, 0xa9, 0xc5, 0xd4, 0xf9, 0xcc,
+	0xc5, 0x41, 0x5a, 0x40, 0x3d, 0x54, 0x40, 0x59, 0x3c, 0x2a, 0xa7, 0x19, 0xb9, 0x8d, 0x18, 0x72,
+	0x3c, 0xda, 0xa9, 0x07, 0x48, 0x34, 0x2f, 0xc4, 0x54, 0xb0, 0xb6, 0xae, 0x3d, 0x84, 0x07, 0x09,
+	0x0f, 0x57, 0x56, 0x86, 0x76, 0x49, 0x38, 0x1f, 0x27, 0xbe, 0x75, 0xe1, 0x8a, 0x27, 0xa3, 0x1e,
+	0x28, 0xa0, 0x74, 0x29, 0x51, 0xb6, 0x2e, 0xe4, 0x57, 0xb0, 0x36, 0xaf, 0xdd, 0x8f, 0x7e, 0x45,
+	0x3f, 0xb2, 0x2c, 0xb4, 0xe7, 0x47, 0x9a, 0x91, 0xb8, 0xba, 0x07, 0x6e, 0x63, 0xd2, 0x0f, 0x6f,
+	0x0b, 0x15, 0x6a, 0xb9, 0x4a, 0xb6, 0x9a, 0x5f, 0x5d, 0x32, 0xaf, 0x58, 0x49, 0xe6, 0x46, 0x3f,
+	0x43, 0xca, 0xd6, 0xaa, 0x24, 0x24, 0x69, 0xe9, 0x5b, 0x1e, 0xa9, 0x08, 0xed, 0x59, 0x3c, 0x92,
+	0x03, 0xbf, 0x4e, 0x80, 0xd9, 0xd1, 0x42, 0xf1, 0x53, 0x1c, 0x04, 0x26, 0xfb, 0x67, 0x08, 0x51,
+	0x3f, 0x80, 0x99, 0x8b, 0xfa, 0x99, 0xf8, 0x9f, 0x7e, 0xd2, 0xd6, 0x8a, 0x83, 0x55, 0x30, 0x22,
+	0x1b, 0xb1, 0x5c, 0xfa, 0x72, 0x21, 0xe0, 0xd6, 0xc5, 0x19, 0x0a, 0x89, 0x16, 0xac, 0x27, 0xd7,
+	0x66, 0x64, 0x7e, 0x1c, 0x23, 0xd0, 0x9e, 0xb9, 0x40, 0x84, 0xba, 0x00, 0xa6, 0xfb, 0xab, 0x46,
+	0x88, 0x71, 0xca, 0x1e, 0x00, 0xd6, 0xf3, 0x6f, 0x3d, 0x5d, 0x39, 0xe9, 0xe9, 0xca, 0x69, 0x4f,
+	0x57, 0x7e, 0xf5, 0x74, 0xe5, 0xf0, 0x4c, 0xcf, 0x9c, 0x9e, 0xe9, 0x99, 0xef, 0x67, 0x7a, 0xe6,
+	0xdd, 0xf2, 0x3f, 0x7b, 0xd9, 0x1b, 0x7c, 0x73, 0x44, 0x5b, 0xf5, 0x9c, 0x98, 0xce, 0xc3, 0xbf,
+	0x03, 0x00, 0x37, 0xaf, 0xfc, 0x6a, 0x93, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -305,6 +392,47 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if len(this.InfractionParams) != len(that1.InfractionParams) {
+		return false
+	}
+	for i := range this.InfractionParams {
+		if !this.InfractionParams[i].Equal(&that1.InfractionParams[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *InfractionParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InfractionParams)
+	if !ok {
+		that2, ok := that.(InfractionParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Infraction != that1.Infraction {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.Tombstone != that1.Tombstone {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -390,6 +518,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InfractionParams) > 0 {
+		for iNdEx := len(m.InfractionParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InfractionParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashing(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -436,6 +578,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InfractionParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfractionParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfractionParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone {
+		i--
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Infraction) > 0 {
+		i -= len(m.Infraction)
+		copy(dAtA[i:], m.Infraction)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Infraction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -491,6 +691,32 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	if len(m.InfractionParams) > 0 {
+		for _, e := range m.InfractionParams {
+			l = e.Size()
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InfractionParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Infraction)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstone {
+		n += 2
+	}
 	return n
 }
 
@@ -872,6 +1098,208 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InfractionParams = append(m.InfractionParams, InfractionParams{})
+			if err := m.InfractionParams[len(m.InfractionParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfractionParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfractionParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfractionParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
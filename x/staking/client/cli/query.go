@@ -69,12 +69,12 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			addr, err := sdk.ValAddressFromBech32(args[0])
+			addr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			params := &types.QueryValidatorRequest{ValidatorAddr: addr.String()}
+			params := &types.QueryValidatorRequest{ValidatorAddr: clientCtx.ValAddressString(addr)}
 			res, err := queryClient.Validator(cmd.Context(), params)
 			if err != nil {
 				return err
@@ -157,7 +157,7 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -168,7 +168,7 @@ $ %s query staking unbonding-delegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9l
 			}
 
 			params := &types.QueryValidatorUnbondingDelegationsRequest{
-				ValidatorAddr: valAddr.String(),
+				ValidatorAddr: clientCtx.ValAddressString(valAddr),
 				Pagination:    pageReq,
 			}
 
@@ -212,7 +212,7 @@ $ %s query staking redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			valSrcAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -223,7 +223,7 @@ $ %s query staking redelegations-from %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 
 			params := &types.QueryRedelegationsRequest{
-				SrcValidatorAddr: valSrcAddr.String(),
+				SrcValidatorAddr: clientCtx.ValAddressString(valSrcAddr),
 				Pagination:       pageReq,
 			}
 
@@ -267,19 +267,19 @@ $ %s query staking delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1gghju
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			delAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			valAddr, err := clientCtx.ValAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
 
 			params := &types.QueryDelegationRequest{
-				DelegatorAddr: delAddr.String(),
-				ValidatorAddr: valAddr.String(),
+				DelegatorAddr: clientCtx.AccAddressString(delAddr),
+				ValidatorAddr: clientCtx.ValAddressString(valAddr),
 			}
 
 			res, err := queryClient.Delegation(context.Background(), params)
@@ -321,7 +321,7 @@ $ %s query staking delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			delAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -332,7 +332,7 @@ $ %s query staking delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			}
 
 			params := &types.QueryDelegatorDelegationsRequest{
-				DelegatorAddr: delAddr.String(),
+				DelegatorAddr: clientCtx.AccAddressString(delAddr),
 				Pagination:    pageReq,
 			}
 
@@ -376,7 +376,7 @@ $ %s query staking delegations-to %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			valAddr, err := clientCtx.ValAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -387,7 +387,7 @@ $ %s query staking delegations-to %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 			}
 
 			params := &types.QueryValidatorDelegationsRequest{
-				ValidatorAddr: valAddr.String(),
+				ValidatorAddr: clientCtx.ValAddressString(valAddr),
 				Pagination:    pageReq,
 			}
 
@@ -432,19 +432,19 @@ $ %s query staking unbonding-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			valAddr, err := clientCtx.ValAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
 
-			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			delAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
 			params := &types.QueryUnbondingDelegationRequest{
-				DelegatorAddr: delAddr.String(),
-				ValidatorAddr: valAddr.String(),
+				DelegatorAddr: clientCtx.AccAddressString(delAddr),
+				ValidatorAddr: clientCtx.ValAddressString(valAddr),
 			}
 
 			res, err := queryClient.UnbondingDelegation(context.Background(), params)
@@ -486,7 +486,7 @@ $ %s query staking unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			delegatorAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -497,7 +497,7 @@ $ %s query staking unbonding-delegations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru
 			}
 
 			params := &types.QueryDelegatorUnbondingDelegationsRequest{
-				DelegatorAddr: delegatorAddr.String(),
+				DelegatorAddr: clientCtx.AccAddressString(delegatorAddr),
 				Pagination:    pageReq,
 			}
 
@@ -542,25 +542,25 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p %s1l2r
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			delAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
 
-			valSrcAddr, err := sdk.ValAddressFromBech32(args[1])
+			valSrcAddr, err := clientCtx.ValAddressFromBech32(args[1])
 			if err != nil {
 				return err
 			}
 
-			valDstAddr, err := sdk.ValAddressFromBech32(args[2])
+			valDstAddr, err := clientCtx.ValAddressFromBech32(args[2])
 			if err != nil {
 				return err
 			}
 
 			params := &types.QueryRedelegationsRequest{
-				DelegatorAddr:    delAddr.String(),
-				DstValidatorAddr: valDstAddr.String(),
-				SrcValidatorAddr: valSrcAddr.String(),
+				DelegatorAddr:    clientCtx.AccAddressString(delAddr),
+				DstValidatorAddr: clientCtx.ValAddressString(valDstAddr),
+				SrcValidatorAddr: clientCtx.ValAddressString(valSrcAddr),
 			}
 
 			res, err := queryClient.Redelegations(context.Background(), params)
@@ -602,7 +602,7 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			delAddr, err := clientCtx.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -613,7 +613,7 @@ $ %s query staking redelegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			}
 
 			params := &types.QueryRedelegationsRequest{
-				DelegatorAddr: delAddr.String(),
+				DelegatorAddr: clientCtx.AccAddressString(delAddr),
 				Pagination:    pageReq,
 			}
 
@@ -0,0 +1,379 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RegisterGRPCCompatHandlers registers the x/staking legacy REST query handlers
+// served from the gRPC query service instead of the legacy querier. Their
+// responses have the same shape as the ones of the handlers registered by
+// RegisterHandlers, which they take precedence over when registered first.
+func RegisterGRPCCompatHandlers(clientCtx client.Context, rtr *mux.Router) {
+	r := clientrest.WithHTTPDeprecationHeaders(rtr)
+
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegations",
+		grpcDelegatorDelegationsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
+		grpcDelegatorUnbondingDelegationsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/delegations/{validatorAddr}",
+		grpcDelegationHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/validators",
+		grpcValidatorsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}",
+		grpcValidatorHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/delegations",
+		grpcValidatorDelegationsHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/pool",
+		grpcPoolHandlerFn(clientCtx),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/staking/parameters",
+		grpcParamsHandlerFn(clientCtx),
+	).Methods("GET")
+}
+
+// HTTP request handler to query all delegations from a delegator from the gRPC
+// query service
+func grpcDelegatorDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			header      metadata.MD
+			delegations types.DelegationResponses
+			nextKey     []byte
+		)
+		for {
+			res, err := queryClient.DelegatorDelegations(context.Background(), &types.QueryDelegatorDelegationsRequest{
+				DelegatorAddr: delegatorAddr.String(),
+				Pagination:    &query.PageRequest{Key: nextKey},
+			}, grpc.Header(&header))
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			delegations = append(delegations, res.DelegationResponses...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, delegations)
+	}
+}
+
+// HTTP request handler to query all unbonding delegations from a delegator from
+// the gRPC query service
+func grpcDelegatorUnbondingDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		queryClient := types.NewQueryClient(clientCtx)
+
+		var (
+			header     metadata.MD
+			unbondings []types.UnbondingDelegation
+			nextKey    []byte
+		)
+		for {
+			res, err := queryClient.DelegatorUnbondingDelegations(context.Background(), &types.QueryDelegatorUnbondingDelegationsRequest{
+				DelegatorAddr: delegatorAddr.String(),
+				Pagination:    &query.PageRequest{Key: nextKey},
+			}, grpc.Header(&header))
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			unbondings = append(unbondings, res.UnbondingResponses...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, unbondings)
+	}
+}
+
+// HTTP request handler to query a delegation from the gRPC query service
+func grpcDelegationHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(vars["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).Delegation(context.Background(), &types.QueryDelegationRequest{
+			DelegatorAddr: delegatorAddr.String(),
+			ValidatorAddr: validatorAddr.String(),
+		}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.DelegationResponse)
+	}
+}
+
+// HTTP request handler to query list of validators from the gRPC query service
+func grpcValidatorsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		status := r.FormValue("status")
+		if status == "bonded" || status == "unbonding" || status == "unbonded" {
+			err := fmt.Errorf("cosmos sdk v0.40 introduces a breaking change on this endpoint:"+
+				" instead of querying using `?status=%s`, please use `status=BOND_STATUS_%s`. For more"+
+				" info, please see our REST endpoint migration guide at %s", status, strings.ToUpper(status), clientrest.DeprecationURL)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		if status == "" {
+			status = types.BondStatusBonded
+		}
+
+		queryClient := types.NewQueryClient(clientCtx)
+
+		// the legacy querier defaults to the maximum number of validators
+		params, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		var (
+			header     metadata.MD
+			validators types.Validators
+			nextKey    []byte
+		)
+		for {
+			res, err := queryClient.Validators(context.Background(), &types.QueryValidatorsRequest{
+				Status:     status,
+				Pagination: &query.PageRequest{Key: nextKey},
+			}, grpc.Header(&header))
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			validators = append(validators, res.Validators...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+
+		start, end := client.Paginate(len(validators), page, limit, int(params.Params.MaxValidators))
+		if start < 0 || end < 0 {
+			validators = types.Validators{}
+		} else {
+			validators = validators[start:end]
+		}
+
+		// the legacy amino JSON encoding needs the cached consensus pubkeys
+		if err := validators.UnpackInterfaces(clientCtx.InterfaceRegistry); rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, validators)
+	}
+}
+
+// HTTP request handler to query a validator from the gRPC query service
+func grpcValidatorHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).Validator(context.Background(), &types.QueryValidatorRequest{
+			ValidatorAddr: validatorAddr.String(),
+		}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		if err := res.Validator.UnpackInterfaces(clientCtx.InterfaceRegistry); rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Validator)
+	}
+}
+
+// HTTP request handler to query all delegations to a validator from the gRPC
+// query service
+func grpcValidatorDelegationsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		validatorAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		queryClient := types.NewQueryClient(clientCtx)
+
+		// the legacy querier defaults to the maximum number of validators
+		params, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		var (
+			header      metadata.MD
+			delegations types.DelegationResponses
+			nextKey     []byte
+		)
+		for {
+			res, err := queryClient.ValidatorDelegations(context.Background(), &types.QueryValidatorDelegationsRequest{
+				ValidatorAddr: validatorAddr.String(),
+				Pagination:    &query.PageRequest{Key: nextKey},
+			}, grpc.Header(&header))
+			if rest.CheckInternalServerError(w, err) {
+				return
+			}
+
+			delegations = append(delegations, res.DelegationResponses...)
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			nextKey = res.Pagination.NextKey
+		}
+
+		start, end := client.Paginate(len(delegations), page, limit, int(params.Params.MaxValidators))
+		if start < 0 || end < 0 {
+			delegations = types.DelegationResponses{}
+		} else {
+			delegations = delegations[start:end]
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, delegations)
+	}
+}
+
+// HTTP request handler to query the pool information from the gRPC query
+// service
+func grpcPoolHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).Pool(context.Background(), &types.QueryPoolRequest{}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Pool)
+	}
+}
+
+// HTTP request handler to query the staking params values from the gRPC query
+// service
+func grpcParamsHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		var header metadata.MD
+		res, err := types.NewQueryClient(clientCtx).Params(context.Background(), &types.QueryParamsRequest{}, grpc.Header(&header))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessGRPCResponse(w, clientCtx, header, res.Params)
+	}
+}
@@ -0,0 +1,59 @@
+// +build norace
+
+package rest_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	stakingrest "github.com/cosmos/cosmos-sdk/x/staking/client/rest"
+)
+
+func (s *IntegrationTestSuite) TestGRPCCompatHandlers() {
+	val := s.network.Validators[0]
+
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	router := mux.NewRouter()
+	stakingrest.RegisterGRPCCompatHandlers(val.ClientCtx, router)
+
+	paths := []string{
+		fmt.Sprintf("/staking/delegators/%s/delegations", val.Address),
+		fmt.Sprintf("/staking/delegators/%s/unbonding_delegations", val.Address),
+		fmt.Sprintf("/staking/delegators/%s/delegations/%s", val.Address, val.ValAddress),
+		"/staking/validators",
+		"/staking/validators?status=BOND_STATUS_BONDED&page=2&limit=1",
+		"/staking/validators?status=BOND_STATUS_UNBONDED",
+		fmt.Sprintf("/staking/validators/%s", val.ValAddress),
+		fmt.Sprintf("/staking/validators/%s/delegations", val.ValAddress),
+		"/staking/pool",
+		"/staking/parameters",
+	}
+
+	for _, path := range paths {
+		path := path
+		s.Run(path, func() {
+			// query both handlers at the same height, as rewards change every block
+			sep := "?"
+			if strings.Contains(path, "?") {
+				sep = "&"
+			}
+			path = fmt.Sprintf("%s%sheight=%d", path, sep, height)
+
+			legacy, err := rest.GetRequest(val.APIAddress + path)
+			s.Require().NoError(err)
+
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			s.Require().Equal(http.StatusOK, rec.Code, rec.Body.String())
+			s.Require().Equal("true", rec.Header().Get("Deprecation"))
+			s.Require().JSONEq(string(legacy), rec.Body.String())
+		})
+	}
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -134,6 +136,23 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, delAddr sdk.AccAddress,
 	}
 }
 
+// IterateDelegationsPaginated iterates through a page of the delegations of a
+// delegator, in increasing validator address order, and returns the page
+// response. It follows the semantics of query.Paginate.
+func (k Keeper) IterateDelegationsPaginated(ctx sdk.Context, delAddr sdk.AccAddress, pageReq *query.PageRequest,
+	fn func(del types.DelegationI) error) (*query.PageResponse, error) {
+	delStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetDelegationsKey(delAddr))
+
+	return query.Paginate(delStore, pageReq, func(_ []byte, value []byte) error {
+		del, err := types.UnmarshalDelegation(k.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		return fn(del)
+	})
+}
+
 // return all delegations used during genesis dump
 // TODO: remove this func, change all usage for iterate functionality
 func (k Keeper) GetAllSDKDelegations(ctx sdk.Context) (delegations []types.Delegation) {
@@ -936,3 +936,129 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation
+// entries of fromAddr from valAddr to toAddr, keeping the creation height and
+// completion time of every entry so that the moved tokens stay subject to
+// slashing and mature at the same time. Entries are not transferred once
+// toAddr has reached the maximum number of unbonding delegation entries. It
+// returns the amount of tokens actually transferred.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+
+		amt := sdk.MinInt(entry.Balance, wantAmt)
+		if !amt.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		entry.Balance = entry.Balance.Sub(amt)
+		entry.InitialBalance = entry.InitialBalance.Sub(amt)
+		if entry.Balance.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+		} else {
+			ubdFrom.Entries[i] = entry
+		}
+
+		modified = true
+		transferred = transferred.Add(amt)
+		wantAmt = wantAmt.Sub(amt)
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares shares of the delegation of
+// fromAddr to valAddr over to toAddr, without changing the validator's tokens
+// or shares. Delegations which are the destination of an in-progress
+// redelegation are not transferred, since their shares remain liable for
+// slashing of the source validator. It returns the shares actually
+// transferred.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) sdk.Dec {
+	transferred := sdk.ZeroDec()
+
+	if !wantShares.IsPositive() {
+		return transferred
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	if k.HasReceivingRedelegation(ctx, fromAddr, valAddr) {
+		return transferred
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	if !transferred.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	// credit the shares to the receiving delegation
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	k.AfterDelegationModified(ctx, toAddr, valAddr)
+
+	// debit the shares from the sending delegation
+	k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr)
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	// If the delegation is the operator of the validator and the transfer
+	// decreases the validator's self-delegation below their minimum, we jail
+	// the validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delFrom.Shares.IsZero() {
+		k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	return transferred
+}
@@ -1017,3 +1017,94 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(10))
+	require.Equal(t, sdk.NewDec(10), issuedShares)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[1], valAddrs[0], issuedShares))
+
+	// transfer part of the delegation to a new delegator
+	transferred := app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(4))
+	require.Equal(t, sdk.NewDec(4), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(6), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrDels[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(4), delTo.Shares)
+
+	// the validator is unaffected
+	resVal, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resVal.Tokens)
+	require.Equal(t, validator.DelegatorShares, resVal.DelegatorShares)
+
+	// transferring more than the delegation moves all of it
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(6), transferred)
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.False(t, found)
+	delTo, found = app.StakingKeeper.GetDelegation(ctx, addrDels[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), delTo.Shares)
+
+	// nothing is transferred from a missing delegation
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(1))
+	require.True(t, transferred.IsZero())
+
+	// delegations receiving a redelegation are not transferred
+	app.StakingKeeper.SetRedelegation(ctx, types.NewRedelegation(addrDels[2], valAddrs[1], valAddrs[0], 0,
+		time.Unix(0, 0), sdk.NewInt(5), sdk.NewDec(5)))
+	transferred = app.StakingKeeper.TransferDelegation(ctx, addrDels[2], addrDels[1], valAddrs[0], sdk.NewDec(1))
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	completionTime := time.Unix(1000, 0).UTC()
+	ubd := types.NewUnbondingDelegation(addrDels[0], valAddrs[0], 0, completionTime, sdk.NewInt(5))
+	ubd.AddEntry(1, completionTime.Add(time.Second), sdk.NewInt(5))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// transfer the first entry and part of the second one
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewInt(7))
+	require.Equal(t, sdk.NewInt(7), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, int64(1), ubdFrom.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(3), ubdFrom.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(3), ubdFrom.Entries[0].InitialBalance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[0].Balance)
+	require.Equal(t, completionTime, ubdTo.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(2), ubdTo.Entries[1].Balance)
+	require.Equal(t, completionTime.Add(time.Second), ubdTo.Entries[1].CompletionTime)
+
+	// the transferred entries mature for the receiving delegator
+	ctx = ctx.WithBlockTime(completionTime.Add(time.Second))
+	matured := app.StakingKeeper.DequeueAllMatureUBDQueue(ctx, ctx.BlockTime())
+	require.Contains(t, matured, types.DVPair{DelegatorAddress: addrDels[1].String(), ValidatorAddress: valAddrs[0].String()})
+
+	// transferring more than the remaining balance moves all of it
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(3), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[0])
+	require.False(t, found)
+}
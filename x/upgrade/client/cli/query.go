@@ -2,7 +2,12 @@ package cli
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 
@@ -11,6 +16,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
+// Flags for verifying the binaries of a scheduled upgrade plan.
+const (
+	FlagVerifyBinaries = "verify-binaries"
+	FlagBinary         = "binary"
+)
+
 // GetQueryCmd returns the parent command for all x/upgrade CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,8 +42,13 @@ func GetCurrentPlanCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "plan",
 		Short: "get upgrade plan (if one exists)",
-		Long:  "Gets the currently scheduled upgrade plan, if one exists",
-		Args:  cobra.ExactArgs(0),
+		Long: `Gets the currently scheduled upgrade plan, if one exists.
+
+With --verify-binaries, the plan info is validated as a binaries map and the sha256
+checksum pinned for the local os/arch is compared against the local upgrade binary.
+The binary defaults to the cosmovisor location derived from $DAEMON_HOME and $DAEMON_NAME
+and can be set explicitly with --binary.`,
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -50,10 +66,34 @@ func GetCurrentPlanCmd() *cobra.Command {
 				return fmt.Errorf("no upgrade scheduled")
 			}
 
+			verify, err := cmd.Flags().GetBool(FlagVerifyBinaries)
+			if err != nil {
+				return err
+			}
+
+			if verify {
+				binary, err := cmd.Flags().GetString(FlagBinary)
+				if err != nil {
+					return err
+				}
+
+				if len(binary) == 0 {
+					binary = cosmovisorUpgradeBinary(res.Plan.Name)
+				}
+
+				if err := verifyPlanBinary(*res.Plan, binary); err != nil {
+					return err
+				}
+
+				cmd.PrintErrf("Binary %s matches the checksum of upgrade %q for %s\n", binary, res.Plan.Name, types.OSArch())
+			}
+
 			return clientCtx.PrintProto(res.GetPlan())
 		},
 	}
 
+	cmd.Flags().Bool(FlagVerifyBinaries, false, "Validate the plan binaries and verify the checksum of the local upgrade binary")
+	cmd.Flags().String(FlagBinary, "", "Path of the local upgrade binary to verify (defaults to the cosmovisor upgrade binary)")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -111,3 +151,54 @@ func GetAppliedPlanCmd() *cobra.Command {
 
 	return cmd
 }
+
+// cosmovisorUpgradeBinary returns the path under which cosmovisor expects the binary
+// of the named upgrade, or an empty string if cosmovisor is not configured.
+func cosmovisorUpgradeBinary(upgradeName string) string {
+	home, name := os.Getenv("DAEMON_HOME"), os.Getenv("DAEMON_NAME")
+	if len(home) == 0 || len(name) == 0 {
+		return ""
+	}
+
+	return filepath.Join(home, "cosmovisor", "upgrades", upgradeName, "bin", name)
+}
+
+// verifyPlanBinary checks that the binary at the given path matches the sha256 checksum
+// pinned in the plan info for the local os/arch.
+func verifyPlanBinary(plan types.Plan, binary string) error {
+	planInfo, err := types.ParsePlanInfo(plan.Info)
+	if err != nil {
+		return err
+	}
+
+	downloadURL, ok := planInfo.Binaries.URLFor(types.OSArch())
+	if !ok {
+		return fmt.Errorf("upgrade %q has no binary for %s", plan.Name, types.OSArch())
+	}
+
+	expected, err := types.ChecksumFromURL(downloadURL)
+	if err != nil {
+		return err
+	}
+
+	if len(binary) == 0 {
+		return fmt.Errorf("no binary to verify: set --%s or $DAEMON_HOME and $DAEMON_NAME", FlagBinary)
+	}
+
+	f, err := os.Open(binary)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", binary, expected, actual)
+	}
+
+	return nil
+}
@@ -27,6 +27,10 @@ func NewSoftwareUpgradeProposalHandler(k keeper.Keeper) govtypes.Handler {
 }
 
 func handleSoftwareUpgradeProposal(ctx sdk.Context, k keeper.Keeper, p *types.SoftwareUpgradeProposal) error {
+	if err := p.Plan.ValidateInfo(); err != nil {
+		return err
+	}
+
 	return k.ScheduleUpgrade(ctx, p.Plan)
 }
 
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/02-client/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/core/23-commitment/types"
 	ibcexported "github.com/cosmos/cosmos-sdk/x/ibc/core/exported"
@@ -197,7 +200,7 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 				Height: 123450000,
 			},
 			setup: func() {
-				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan) {})
+				s.app.UpgradeKeeper.SetUpgradeHandler("all-good", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) { return vm, nil })
 				s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, types.Plan{
 					Name:   "all-good",
 					Info:   "some text here",
@@ -296,6 +299,35 @@ func (s *KeeperTestSuite) TestSetUpgradedClient() {
 
 }
 
+func (s *KeeperTestSuite) TestModuleVersionMap() {
+	// the module versions are persisted at genesis
+	vm := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(2), vm[banktypes.ModuleName])
+	s.Require().Equal(uint64(1), vm[types.ModuleName])
+
+	plan := types.Plan{Name: "versions", Height: s.ctx.BlockHeight()}
+	s.app.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(_ sdk.Context, _ types.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		s.Require().Equal(vm, fromVM)
+		return module.VersionMap{banktypes.ModuleName: 3}, nil
+	})
+	s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, plan)
+
+	// the version map returned by the handler is persisted
+	updatedVM := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().Equal(uint64(3), updatedVM[banktypes.ModuleName])
+	s.Require().Equal(uint64(1), updatedVM[types.ModuleName])
+
+	// a failing handler aborts the upgrade
+	failing := types.Plan{Name: "failing", Height: s.ctx.BlockHeight()}
+	s.app.UpgradeKeeper.SetUpgradeHandler(failing.Name, func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		return nil, fmt.Errorf("migration failed")
+	})
+	s.Require().Panics(func() {
+		s.app.UpgradeKeeper.ApplyUpgrade(s.ctx, failing)
+	})
+	s.Require().Zero(s.app.UpgradeKeeper.GetDoneHeight(s.ctx, failing.Name))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
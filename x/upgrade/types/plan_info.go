@@ -0,0 +1,139 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"runtime"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// AnyOSArch is the binaries map key used for a binary that runs on every platform
+	AnyOSArch = "any"
+
+	// ChecksumQueryParam is the download URL query parameter holding the binary checksum,
+	// following the go-getter convention used by cosmovisor
+	ChecksumQueryParam = "checksum"
+
+	// ChecksumAlgoSHA256 is the only checksum algorithm accepted for pinned binaries
+	ChecksumAlgoSHA256 = "sha256"
+)
+
+var osArchRegex = regexp.MustCompile(`^[a-z0-9]+/[a-z0-9_]+$`)
+
+// BinaryDownloadURLMap maps an os/arch pair (e.g. linux/amd64) or "any" to the URL
+// from which the upgrade binary can be downloaded.
+type BinaryDownloadURLMap map[string]string
+
+// PlanInfo is the structured form of a Plan's Info field, as consumed by cosmovisor
+// to automatically download upgrade binaries.
+type PlanInfo struct {
+	Binaries BinaryDownloadURLMap `json:"binaries"`
+}
+
+// OSArch returns the os/arch key of the running binary.
+func OSArch() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
+
+// IsStructuredInfo returns true if the given info string is a JSON object, in which
+// case it is expected to follow the PlanInfo format.
+func IsStructuredInfo(info string) bool {
+	return strings.HasPrefix(strings.TrimSpace(info), "{")
+}
+
+// ParsePlanInfo parses and validates a Plan's Info field as a PlanInfo document.
+func ParsePlanInfo(info string) (*PlanInfo, error) {
+	var planInfo PlanInfo
+	if err := json.Unmarshal([]byte(strings.TrimSpace(info)), &planInfo); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid upgrade info: %s", err)
+	}
+
+	if err := planInfo.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &planInfo, nil
+}
+
+// ValidateBasic validates that the PlanInfo lists at least one binary and that every
+// binary is keyed by a valid platform and pinned to a sha256 checksum.
+func (pi PlanInfo) ValidateBasic() error {
+	if len(pi.Binaries) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade info must contain at least one binary")
+	}
+
+	return pi.Binaries.ValidateBasic()
+}
+
+// ValidateBasic validates every os/arch key and download URL of the map.
+func (m BinaryDownloadURLMap) ValidateBasic() error {
+	for osArch, downloadURL := range m {
+		if osArch != AnyOSArch && !osArchRegex.MatchString(osArch) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid os/arch %q in upgrade binaries", osArch)
+		}
+
+		if _, err := ChecksumFromURL(downloadURL); err != nil {
+			return sdkerrors.Wrapf(err, "binary for %s", osArch)
+		}
+	}
+
+	return nil
+}
+
+// URLFor returns the download URL for the given os/arch, falling back to the "any"
+// entry if there is no platform specific binary.
+func (m BinaryDownloadURLMap) URLFor(osArch string) (string, bool) {
+	if u, ok := m[osArch]; ok {
+		return u, true
+	}
+
+	u, ok := m[AnyOSArch]
+	return u, ok
+}
+
+// ChecksumFromURL validates the download URL and returns the hex encoded sha256
+// checksum it is pinned to.
+func ChecksumFromURL(downloadURL string) (string, error) {
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid binary url %q: %s", downloadURL, err)
+	}
+	if !u.IsAbs() || len(u.Host) == 0 {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "binary url %q must be absolute", downloadURL)
+	}
+
+	checksum := u.Query().Get(ChecksumQueryParam)
+	if len(checksum) == 0 {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "binary url %q is missing a %s checksum", downloadURL, ChecksumAlgoSHA256)
+	}
+
+	parts := strings.SplitN(checksum, ":", 2)
+	if len(parts) != 2 || parts[0] != ChecksumAlgoSHA256 {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "binary url %q must be pinned with a %s checksum", downloadURL, ChecksumAlgoSHA256)
+	}
+
+	sum, err := hex.DecodeString(parts[1])
+	if err != nil || len(sum) != 32 {
+		return "", sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid %s checksum %q", ChecksumAlgoSHA256, parts[1])
+	}
+
+	return strings.ToLower(parts[1]), nil
+}
+
+// ValidateInfo validates the Info field of the Plan. Free-form info is accepted as is,
+// but a JSON object must be a valid PlanInfo so that sidecar processes such as
+// cosmovisor are able to download a verified binary when the upgrade height is reached.
+func (p Plan) ValidateInfo() error {
+	if !IsStructuredInfo(p.Info) {
+		return nil
+	}
+
+	_, err := ParsePlanInfo(p.Info)
+	return err
+}
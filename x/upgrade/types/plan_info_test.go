@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const testChecksum = "aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"
+
+func TestParsePlanInfo(t *testing.T) {
+	cases := map[string]struct {
+		info  string
+		valid bool
+	}{
+		"valid": {
+			info:  `{"binaries":{"linux/amd64":"https://example.com/simd.zip?checksum=sha256:` + testChecksum + `"}}`,
+			valid: true,
+		},
+		"valid any": {
+			info:  `{"binaries":{"any":"https://example.com/simd.zip?checksum=sha256:` + testChecksum + `"}}`,
+			valid: true,
+		},
+		"not json": {
+			info: `{"binaries":`,
+		},
+		"no binaries": {
+			info: `{"binaries":{}}`,
+		},
+		"invalid os/arch": {
+			info: `{"binaries":{"Linux-amd64":"https://example.com/simd.zip?checksum=sha256:` + testChecksum + `"}}`,
+		},
+		"relative url": {
+			info: `{"binaries":{"linux/amd64":"/simd.zip?checksum=sha256:` + testChecksum + `"}}`,
+		},
+		"missing checksum": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/simd.zip"}}`,
+		},
+		"md5 checksum": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/simd.zip?checksum=md5:b1946ac92492d2347c6235b4d2611184"}}`,
+		},
+		"short checksum": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/simd.zip?checksum=sha256:aec070"}}`,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			info, err := types.ParsePlanInfo(tc.info)
+			if tc.valid {
+				require.NoError(t, err)
+				require.NotEmpty(t, info.Binaries)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPlanValidateInfo(t *testing.T) {
+	require.NoError(t, types.Plan{Info: "https://example.com/upgrade-info.json"}.ValidateInfo())
+	require.NoError(t, types.Plan{Info: "git commit 1234567"}.ValidateInfo())
+	require.Error(t, types.Plan{Info: `{"binaries":{"linux/amd64":"https://example.com/simd"}}`}.ValidateInfo())
+}
+
+func TestBinaryDownloadURLMapURLFor(t *testing.T) {
+	m := types.BinaryDownloadURLMap{
+		"linux/amd64": "https://example.com/linux",
+		"any":         "https://example.com/any",
+	}
+
+	u, ok := m.URLFor("linux/amd64")
+	require.True(t, ok)
+	require.Equal(t, "https://example.com/linux", u)
+
+	u, ok = m.URLFor("darwin/arm64")
+	require.True(t, ok)
+	require.Equal(t, "https://example.com/any", u)
+
+	delete(m, "any")
+	_, ok = m.URLFor("darwin/arm64")
+	require.False(t, ok)
+}
+
+func TestChecksumFromURL(t *testing.T) {
+	sum, err := types.ChecksumFromURL("https://example.com/simd?checksum=sha256:" + testChecksum)
+	require.NoError(t, err)
+	require.Equal(t, testChecksum, sum)
+}